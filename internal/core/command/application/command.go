@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2025 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -61,8 +61,17 @@ func AllCommands(offset int, limit int, dic *di.Container) (deviceCoreCommands [
 }
 
 // IssueGetCommandByName issues the specified get(read) command referenced by the command name to the device/sensor, also
-// referenced by name.
-func IssueGetCommandByName(deviceName string, commandName string, queryParams string, dic *di.Container) (res *responses.EventResponse, err errors.EdgeX) {
+// referenced by name. When the event cache is enabled, a fresh enough cached event is returned instead unless noCache is set.
+func IssueGetCommandByName(deviceName string, commandName string, queryParams string, noCache bool, dic *di.Container) (res *responses.EventResponse, err errors.EdgeX) {
+	if eventCache := commandContainer.EventCacheFrom(dic.Get); eventCache != nil {
+		return eventCache.Get(deviceName, commandName, queryParams, noCache, func() (*responses.EventResponse, errors.EdgeX) {
+			return issueGetCommandByName(context.Background(), deviceName, commandName, queryParams, dic)
+		})
+	}
+	return issueGetCommandByName(context.Background(), deviceName, commandName, queryParams, dic)
+}
+
+func issueGetCommandByName(ctx context.Context, deviceName string, commandName string, queryParams string, dic *di.Container) (res *responses.EventResponse, err errors.EdgeX) {
 	if deviceName == "" {
 		return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
 	}
@@ -71,22 +80,12 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 		return res, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
 	}
 
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	// retrieve device and device service information through the metadata cache
+	device, err := DeviceByName(ctx, deviceName, dic)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
-
-	// retrieve device service information through Metadata DeviceClient
-	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
-	if dsc == nil {
-		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
-	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	deviceService, err := DeviceServiceByName(ctx, device.ServiceName, dic)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -96,7 +95,7 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 	if dscc == nil {
 		return res, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
-	res, err = dscc.GetCommand(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams)
+	res, err = dscc.GetCommand(ctx, deviceService.BaseAddress, deviceName, commandName, queryParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -107,6 +106,10 @@ func IssueGetCommandByName(deviceName string, commandName string, queryParams st
 // IssueSetCommandByName issues the specified set(write) command referenced by the command name to the device/sensor, also
 // referenced by name.
 func IssueSetCommandByName(deviceName string, commandName string, queryParams string, settings map[string]interface{}, dic *di.Container) (response commonDTO.BaseResponse, err errors.EdgeX) {
+	return issueSetCommandByName(context.Background(), deviceName, commandName, queryParams, settings, dic)
+}
+
+func issueSetCommandByName(ctx context.Context, deviceName string, commandName string, queryParams string, settings map[string]interface{}, dic *di.Container) (response commonDTO.BaseResponse, err errors.EdgeX) {
 	if deviceName == "" {
 		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
 	}
@@ -115,22 +118,12 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 		return response, errors.NewCommonEdgeX(errors.KindContractInvalid, "command name cannot be empty", nil)
 	}
 
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	// retrieve device and device service information through the metadata cache
+	device, err := DeviceByName(ctx, deviceName, dic)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
-
-	// retrieve device service information through Metadata DeviceClient
-	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
-	if dsc == nil {
-		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
-	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	deviceService, err := DeviceServiceByName(ctx, device.ServiceName, dic)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -140,7 +133,11 @@ func IssueSetCommandByName(deviceName string, commandName string, queryParams st
 	if dscc == nil {
 		return response, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceCommandClient returned", nil)
 	}
-	return dscc.SetCommandWithObject(context.Background(), deviceServiceResponse.Service.BaseAddress, deviceName, commandName, queryParams, settings)
+	// the set may change any reading of the device, so the cached events can't be trusted anymore
+	if eventCache := commandContainer.EventCacheFrom(dic.Get); eventCache != nil {
+		defer eventCache.InvalidateDevice(deviceName)
+	}
+	return dscc.SetCommandWithObject(ctx, deviceService.BaseAddress, deviceName, commandName, queryParams, settings)
 }
 
 // CommandsByDeviceName query coreCommands with device name
@@ -0,0 +1,384 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	contractDTOs "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// sequenceDBClient returns the database client storing the command sequences, which is only available if the command
+// sequences are enabled
+func sequenceDBClient(dic *di.Container) (interfaces.DBClient, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	if dbClient == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServiceUnavailable, "command sequences are not enabled, set CommandSequence.Enabled to true to connect core-command to the database", nil)
+	}
+	return dbClient, nil
+}
+
+// AddCommandSequence adds a new command sequence
+func AddCommandSequence(ctx context.Context, sequence models.CommandSequence, dic *di.Container) (string, errors.EdgeX) {
+	dbClient, err := sequenceDBClient(dic)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	addedSequence, err := dbClient.AddCommandSequence(ctx, sequence)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully created the command sequence. CommandSequence ID: %s, Correlation-ID: %s", addedSequence.Id, correlationId)
+	return addedSequence.Id, nil
+}
+
+// CommandSequenceByName queries the command sequence by name
+func CommandSequenceByName(ctx context.Context, name string, dic *di.Container) (dto dtos.CommandSequence, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient, err := sequenceDBClient(dic)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+	sequence, err := dbClient.CommandSequenceByName(ctx, name)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return dtos.FromCommandSequenceModelToDTO(sequence), nil
+}
+
+// AllCommandSequences queries all the command sequences with offset and limit
+func AllCommandSequences(ctx context.Context, labels []string, offset, limit int, dic *di.Container) (sequenceDTOs []dtos.CommandSequence, totalCount int64, err errors.EdgeX) {
+	dbClient, err := sequenceDBClient(dic)
+	if err != nil {
+		return sequenceDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	totalCount, err = dbClient.CommandSequenceTotalCount(ctx, labels)
+	if err != nil {
+		return sequenceDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dtos.CommandSequence{}, totalCount, err
+	}
+
+	sequences, err := dbClient.AllCommandSequences(ctx, labels, offset, limit)
+	if err != nil {
+		return sequenceDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	sequenceDTOs = make([]dtos.CommandSequence, len(sequences))
+	for i, sequence := range sequences {
+		sequenceDTOs[i] = dtos.FromCommandSequenceModelToDTO(sequence)
+	}
+
+	return sequenceDTOs, totalCount, nil
+}
+
+// PatchCommandSequence executes the PATCH operation with the DTO to replace the old data
+func PatchCommandSequence(ctx context.Context, dto dtos.UpdateCommandSequence, dic *di.Container) errors.EdgeX {
+	dbClient, err := sequenceDBClient(dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	sequence, err := commandSequenceByDTO(ctx, dbClient, dto)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	requests.ReplaceCommandSequenceModelFieldsWithDTO(&sequence, dto)
+
+	err = dbClient.UpdateCommandSequence(ctx, sequence)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully patched the command sequence: %s. CommandSequence ID: %s, Correlation-ID: %s", sequence.Name, sequence.Id, correlationId)
+	return nil
+}
+
+func commandSequenceByDTO(ctx context.Context, dbClient interfaces.DBClient, dto dtos.UpdateCommandSequence) (sequence models.CommandSequence, err errors.EdgeX) {
+	// The ID or Name is required by DTO and the DTO also accepts empty string ID if the Name is provided
+	if dto.Id != nil && *dto.Id != "" {
+		sequence, err = dbClient.CommandSequenceById(ctx, *dto.Id)
+		if err != nil {
+			return sequence, errors.NewCommonEdgeXWrapper(err)
+		}
+	} else {
+		sequence, err = dbClient.CommandSequenceByName(ctx, *dto.Name)
+		if err != nil {
+			return sequence, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if dto.Name != nil && *dto.Name != sequence.Name {
+		return sequence, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("command sequence name '%s' not match the existing '%s' ", *dto.Name, sequence.Name), nil)
+	}
+	return sequence, nil
+}
+
+// DeleteCommandSequenceByName deletes the command sequence by name
+func DeleteCommandSequenceByName(ctx context.Context, name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient, err := sequenceDBClient(dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	// query the sequence first, so that deleting an unknown sequence is reported as not found
+	_, err = dbClient.CommandSequenceByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteCommandSequenceByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully deleted the command sequence: %s. Correlation-ID: %s", name, correlationId)
+	return nil
+}
+
+// ExecuteCommandSequenceByName runs the steps of the named command sequence in order and returns the step-by-step result.
+// A failed sequence is reported through the result rather than the returned error, which only covers failures to
+// start the execution.
+func ExecuteCommandSequenceByName(ctx context.Context, name string, dic *di.Container) (result dtos.CommandSequenceResult, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return result, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient, err := sequenceDBClient(dic)
+	if err != nil {
+		return result, errors.NewCommonEdgeXWrapper(err)
+	}
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	sequence, err := dbClient.CommandSequenceByName(ctx, name)
+	if err != nil {
+		return result, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// a client giving up on the response must not leave the devices half way through the sequence
+	result = executeCommandSequence(context.WithoutCancel(ctx), sequence, dic)
+
+	lc.Debugf("Command sequence %s executed with status %s. Correlation-ID: %s", name, result.Status, correlationId)
+	return result, nil
+}
+
+func executeCommandSequence(ctx context.Context, sequence models.CommandSequence, dic *di.Container) dtos.CommandSequenceResult {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	result := dtos.CommandSequenceResult{
+		SequenceName: sequence.Name,
+		Status:       constants.StatusSucceeded,
+		Started:      time.Now().UnixMilli(),
+		Steps:        make([]dtos.StepResult, 0, len(sequence.Steps)),
+	}
+
+	aborted := false
+	for _, step := range sequence.Steps {
+		if aborted {
+			result.Steps = append(result.Steps, dtos.StepResult{StepName: step.Name, Type: step.Type, Status: constants.StatusSkipped})
+			continue
+		}
+
+		stepResult := executeStep(ctx, step, dic)
+		if stepResult.Status == constants.StatusFailed {
+			lc.Warnf("Step %s of command sequence %s failed: %s", step.Name, sequence.Name, stepResult.Message)
+			result.Status = constants.StatusFailed
+
+			switch step.OnFailure {
+			case constants.OnFailureContinue:
+			case constants.OnFailureCompensate:
+				if step.Compensation != nil {
+					compensationResult := executeStep(ctx, *step.Compensation, dic)
+					stepResult.Compensation = &compensationResult
+				}
+				aborted = true
+			default:
+				aborted = true
+			}
+		}
+		result.Steps = append(result.Steps, stepResult)
+	}
+
+	result.Ended = time.Now().UnixMilli()
+	return result
+}
+
+func executeStep(ctx context.Context, step models.SequenceStep, dic *di.Container) dtos.StepResult {
+	result := dtos.StepResult{
+		StepName: step.Name,
+		Type:     step.Type,
+		Status:   constants.StatusSucceeded,
+		Started:  time.Now().UnixMilli(),
+	}
+
+	if err := runStep(ctx, step, dic); err != nil {
+		result.Status = constants.StatusFailed
+		result.Message = err.Error()
+	}
+
+	result.Ended = time.Now().UnixMilli()
+	return result
+}
+
+func runStep(ctx context.Context, step models.SequenceStep, dic *di.Container) errors.EdgeX {
+	if len(step.Timeout) > 0 {
+		timeout, err := time.ParseDuration(step.Timeout)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse timeout '%s'", step.Timeout), err)
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	switch step.Type {
+	case constants.StepTypeSet:
+		_, err := issueSetCommandByName(ctx, step.DeviceName, step.CommandName, encodeQueryParams(step.QueryParams), step.Parameters, dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		return nil
+	case constants.StepTypeGet:
+		// the assertions are always checked against a fresh reading rather than the event cache
+		res, err := issueGetCommandByName(ctx, step.DeviceName, step.CommandName, encodeQueryParams(step.QueryParams), dic)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		if len(step.Assertions) == 0 {
+			return nil
+		}
+		if res == nil {
+			return errors.NewCommonEdgeX(errors.KindServerError, "no event returned to check the assertions against", nil)
+		}
+		return checkAssertions(res.Event, step.Assertions)
+	case constants.StepTypeWait:
+		duration, err := time.ParseDuration(step.Duration)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse duration '%s'", step.Duration), err)
+		}
+		timer := time.NewTimer(duration)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			return errors.NewCommonEdgeX(errors.KindServerError, "wait interrupted", ctx.Err())
+		}
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown step type '%s'", step.Type), nil)
+	}
+}
+
+func encodeQueryParams(queryParams map[string]string) string {
+	if len(queryParams) == 0 {
+		return ""
+	}
+	values := url.Values{}
+	for k, v := range queryParams {
+		values.Set(k, v)
+	}
+	return values.Encode()
+}
+
+// checkAssertions verifies the readings of the event returned by a get step against all the assertions of the step
+func checkAssertions(event contractDTOs.Event, assertions []models.StepAssertion) errors.EdgeX {
+	for _, assertion := range assertions {
+		var reading *contractDTOs.BaseReading
+		for i := range event.Readings {
+			if event.Readings[i].ResourceName == assertion.ResourceName {
+				reading = &event.Readings[i]
+				break
+			}
+		}
+		if reading == nil {
+			return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no reading of resource '%s' in the returned event", assertion.ResourceName), nil)
+		}
+		if reading.ValueType == common.ValueTypeBinary || reading.ValueType == common.ValueTypeObject {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("assertion is not supported for the %s reading of resource '%s'", reading.ValueType, assertion.ResourceName), nil)
+		}
+
+		ok, err := compareValues(reading.Value, assertion.Operator, assertion.Value)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to check the assertion of resource '%s'", assertion.ResourceName), err)
+		}
+		if !ok {
+			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("assertion failed: resource '%s' value '%s' is not %s '%s'", assertion.ResourceName, reading.Value, assertion.Operator, assertion.Value), nil)
+		}
+	}
+	return nil
+}
+
+// compareValues compares the values numerically when both are numbers, otherwise only equality operators are supported
+func compareValues(actual string, operator string, expected string) (bool, error) {
+	actualNumber, actualErr := strconv.ParseFloat(actual, 64)
+	expectedNumber, expectedErr := strconv.ParseFloat(expected, 64)
+	numeric := actualErr == nil && expectedErr == nil
+
+	switch operator {
+	case constants.OperatorEqual:
+		if numeric {
+			return actualNumber == expectedNumber, nil
+		}
+		return actual == expected, nil
+	case constants.OperatorNotEqual:
+		if numeric {
+			return actualNumber != expectedNumber, nil
+		}
+		return actual != expected, nil
+	}
+
+	if !numeric {
+		return false, fmt.Errorf("operator '%s' requires numeric values, got '%s' and '%s'", operator, actual, expected)
+	}
+	switch operator {
+	case constants.OperatorGreaterThan:
+		return actualNumber > expectedNumber, nil
+	case constants.OperatorGreaterThanOrEqual:
+		return actualNumber >= expectedNumber, nil
+	case constants.OperatorLessThan:
+		return actualNumber < expectedNumber, nil
+	case constants.OperatorLessThanOrEqual:
+		return actualNumber <= expectedNumber, nil
+	default:
+		return false, fmt.Errorf("unknown operator '%s'", operator)
+	}
+}
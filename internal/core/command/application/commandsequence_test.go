@@ -0,0 +1,262 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	dbMocks "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+const (
+	testSequenceName      = "pump-skid-start"
+	testDeviceName        = "pump"
+	testDeviceServiceName = "pump-service"
+	testBaseAddress       = "http://localhost:59900"
+	testResourceName      = "pressure"
+	failedCommandName     = "failed"
+)
+
+func mockSequenceDic(sequence models.CommandSequence) *di.Container {
+	dcMock := &mocks.DeviceClient{}
+	dcMock.On("DeviceByName", mock.Anything, testDeviceName).
+		Return(responses.DeviceResponse{Device: dtos.Device{Name: testDeviceName, ServiceName: testDeviceServiceName}}, nil)
+	dscMock := &mocks.DeviceServiceClient{}
+	dscMock.On("DeviceServiceByName", mock.Anything, testDeviceServiceName).
+		Return(responses.DeviceServiceResponse{Service: dtos.DeviceService{Name: testDeviceServiceName, BaseAddress: testBaseAddress}}, nil)
+
+	event := responses.EventResponse{
+		Event: dtos.Event{
+			DeviceName: testDeviceName,
+			Readings: []dtos.BaseReading{
+				{ResourceName: testResourceName, ValueType: common.ValueTypeFloat64, SimpleReading: dtos.SimpleReading{Value: "10.5"}},
+			},
+		},
+	}
+	dsccMock := &mocks.DeviceServiceCommandClient{}
+	dsccMock.On("SetCommandWithObject", mock.Anything, testBaseAddress, testDeviceName, failedCommandName, mock.Anything, mock.Anything).
+		Return(commonDTO.BaseResponse{}, errors.NewCommonEdgeX(errors.KindServerError, "device unreachable", nil))
+	dsccMock.On("SetCommandWithObject", mock.Anything, testBaseAddress, testDeviceName, mock.Anything, mock.Anything, mock.Anything).
+		Return(commonDTO.NewBaseResponse("", "", 200), nil)
+	dsccMock.On("GetCommand", mock.Anything, testBaseAddress, testDeviceName, mock.Anything, mock.Anything).
+		Return(&event, nil)
+
+	dbClientMock := &dbMocks.DBClient{}
+	dbClientMock.On("CommandSequenceByName", mock.Anything, sequence.Name).Return(sequence, nil)
+	dbClientMock.On("CommandSequenceByName", mock.Anything, mock.Anything).
+		Return(models.CommandSequence{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+
+	return di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dcMock
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dscMock
+		},
+		bootstrapContainer.DeviceServiceCommandClientName: func(get di.Get) interface{} {
+			return dsccMock
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+}
+
+func setStep(name string, commandName string, onFailure string) models.SequenceStep {
+	return models.SequenceStep{
+		Name:        name,
+		Type:        constants.StepTypeSet,
+		DeviceName:  testDeviceName,
+		CommandName: commandName,
+		Parameters:  map[string]any{"speed": 1200},
+		OnFailure:   onFailure,
+	}
+}
+
+func getStep(name string, operator string, value string) models.SequenceStep {
+	return models.SequenceStep{
+		Name:        name,
+		Type:        constants.StepTypeGet,
+		DeviceName:  testDeviceName,
+		CommandName: "status",
+		Assertions:  []models.StepAssertion{{ResourceName: testResourceName, Operator: operator, Value: value}},
+	}
+}
+
+func TestExecuteCommandSequenceByName(t *testing.T) {
+	wait := models.SequenceStep{Name: "settle", Type: constants.StepTypeWait, Duration: "1ms"}
+	compensate := setStep("open-valve", failedCommandName, constants.OnFailureCompensate)
+	compensation := setStep("close-valve", "valve", "")
+	compensate.Compensation = &compensation
+
+	tests := []struct {
+		name             string
+		steps            []models.SequenceStep
+		expectedStatus   string
+		expectedStatuses []string
+	}{
+		{"all steps succeed",
+			[]models.SequenceStep{setStep("start", "start", ""), wait, getStep("check", constants.OperatorGreaterThan, "10")},
+			constants.StatusSucceeded,
+			[]string{constants.StatusSucceeded, constants.StatusSucceeded, constants.StatusSucceeded}},
+		{"abort skips the remaining steps",
+			[]models.SequenceStep{setStep("start", failedCommandName, ""), wait, getStep("check", constants.OperatorEqual, "10.5")},
+			constants.StatusFailed,
+			[]string{constants.StatusFailed, constants.StatusSkipped, constants.StatusSkipped}},
+		{"continue runs the next step",
+			[]models.SequenceStep{setStep("start", failedCommandName, constants.OnFailureContinue), getStep("check", constants.OperatorEqual, "10.5")},
+			constants.StatusFailed,
+			[]string{constants.StatusFailed, constants.StatusSucceeded}},
+		{"failed assertion aborts",
+			[]models.SequenceStep{getStep("check", constants.OperatorGreaterThanOrEqual, "20"), setStep("start", "start", "")},
+			constants.StatusFailed,
+			[]string{constants.StatusFailed, constants.StatusSkipped}},
+		{"compensate runs the compensation step and aborts",
+			[]models.SequenceStep{compensate, setStep("start", "start", "")},
+			constants.StatusFailed,
+			[]string{constants.StatusFailed, constants.StatusSkipped}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			sequence := models.CommandSequence{Name: testSequenceName, Steps: testCase.steps}
+			dic := mockSequenceDic(sequence)
+
+			result, err := ExecuteCommandSequenceByName(context.Background(), testSequenceName, dic)
+			require.NoError(t, err)
+
+			assert.Equal(t, testSequenceName, result.SequenceName)
+			assert.Equal(t, testCase.expectedStatus, result.Status)
+			require.Len(t, result.Steps, len(testCase.expectedStatuses))
+			for i, expected := range testCase.expectedStatuses {
+				assert.Equal(t, testCase.steps[i].Name, result.Steps[i].StepName)
+				assert.Equal(t, expected, result.Steps[i].Status, "unexpected status of step %s", result.Steps[i].StepName)
+				if expected == constants.StatusFailed {
+					assert.NotEmpty(t, result.Steps[i].Message)
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteCommandSequenceByName_Compensation(t *testing.T) {
+	step := setStep("open-valve", failedCommandName, constants.OnFailureCompensate)
+	compensation := setStep("close-valve", "valve", "")
+	step.Compensation = &compensation
+	sequence := models.CommandSequence{Name: testSequenceName, Steps: []models.SequenceStep{step}}
+	dic := mockSequenceDic(sequence)
+
+	result, err := ExecuteCommandSequenceByName(context.Background(), testSequenceName, dic)
+	require.NoError(t, err)
+
+	require.Len(t, result.Steps, 1)
+	require.NotNil(t, result.Steps[0].Compensation)
+	assert.Equal(t, "close-valve", result.Steps[0].Compensation.StepName)
+	assert.Equal(t, constants.StatusSucceeded, result.Steps[0].Compensation.Status)
+}
+
+func TestExecuteCommandSequenceByName_NotFound(t *testing.T) {
+	dic := mockSequenceDic(models.CommandSequence{Name: testSequenceName})
+
+	_, err := ExecuteCommandSequenceByName(context.Background(), "unknown", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+
+	_, err = ExecuteCommandSequenceByName(context.Background(), "", dic)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestExecuteStep_Timeout(t *testing.T) {
+	dic := mockSequenceDic(models.CommandSequence{Name: testSequenceName})
+	step := models.SequenceStep{Name: "settle", Type: constants.StepTypeWait, Duration: "1m", Timeout: "10ms"}
+
+	result := executeStep(context.Background(), step, dic)
+	assert.Equal(t, constants.StatusFailed, result.Status)
+	assert.Contains(t, result.Message, context.DeadlineExceeded.Error())
+}
+
+func TestCheckAssertions(t *testing.T) {
+	event := dtos.Event{
+		Readings: []dtos.BaseReading{
+			{ResourceName: "running", ValueType: common.ValueTypeBool, SimpleReading: dtos.SimpleReading{Value: "true"}},
+			{ResourceName: "image", ValueType: common.ValueTypeBinary},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		assertion     models.StepAssertion
+		expectedError bool
+	}{
+		{"string equal", models.StepAssertion{ResourceName: "running", Operator: constants.OperatorEqual, Value: "true"}, false},
+		{"string not equal", models.StepAssertion{ResourceName: "running", Operator: constants.OperatorNotEqual, Value: "true"}, true},
+		{"non-numeric comparison", models.StepAssertion{ResourceName: "running", Operator: constants.OperatorGreaterThan, Value: "1"}, true},
+		{"missing reading", models.StepAssertion{ResourceName: "unknown", Operator: constants.OperatorEqual, Value: "1"}, true},
+		{"binary reading", models.StepAssertion{ResourceName: "image", Operator: constants.OperatorEqual, Value: ""}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := checkAssertions(event, []models.StepAssertion{testCase.assertion})
+			if testCase.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCompareValues(t *testing.T) {
+	tests := []struct {
+		name          string
+		actual        string
+		operator      string
+		expected      string
+		expectedOk    bool
+		expectedError bool
+	}{
+		{"numeric equal with different format", "10.0", constants.OperatorEqual, "10", true, false},
+		{"numeric not equal", "10", constants.OperatorNotEqual, "11", true, false},
+		{"greater than", "10", constants.OperatorGreaterThan, "9.5", true, false},
+		{"greater than or equal", "10", constants.OperatorGreaterThanOrEqual, "10", true, false},
+		{"less than", "10", constants.OperatorLessThan, "10", false, false},
+		{"less than or equal", "-1", constants.OperatorLessThanOrEqual, "0", true, false},
+		{"string equal", "ON", constants.OperatorEqual, "ON", true, false},
+		{"string ordering", "ON", constants.OperatorLessThan, "OFF", false, true},
+		{"unknown operator", "1", "~", "1", false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ok, err := compareValues(testCase.actual, testCase.operator, testCase.expected)
+			if testCase.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedOk, ok)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// DeviceByName retrieves the device from the metadata cache, or through Metadata DeviceClient when the cache is disabled
+func DeviceByName(ctx context.Context, name string, dic *di.Container) (dtos.Device, errors.EdgeX) {
+	if metadataCache := commandContainer.MetadataCacheFrom(dic.Get); metadataCache != nil {
+		return metadataCache.DeviceByName(ctx, name)
+	}
+
+	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
+	if dc == nil {
+		return dtos.Device{}, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+	}
+	deviceResponse, err := dc.DeviceByName(ctx, name)
+	if err != nil {
+		return dtos.Device{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return deviceResponse.Device, nil
+}
+
+// DeviceServiceByName retrieves the device service from the metadata cache, or through Metadata DeviceServiceClient
+// when the cache is disabled
+func DeviceServiceByName(ctx context.Context, name string, dic *di.Container) (dtos.DeviceService, errors.EdgeX) {
+	if metadataCache := commandContainer.MetadataCacheFrom(dic.Get); metadataCache != nil {
+		return metadataCache.DeviceServiceByName(ctx, name)
+	}
+
+	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
+	if dsc == nil {
+		return dtos.DeviceService{}, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
+	}
+	deviceServiceResponse, err := dsc.DeviceServiceByName(ctx, name)
+	if err != nil {
+		return dtos.DeviceService{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return deviceServiceResponse.Service, nil
+}
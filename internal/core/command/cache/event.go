@@ -0,0 +1,166 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	gometrics "github.com/rcrowley/go-metrics"
+	"golang.org/x/sync/singleflight"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const (
+	eventCacheHitsMetricName   = "EventCacheHits"
+	eventCacheMissesMetricName = "EventCacheMisses"
+)
+
+// EventCache keeps the last EventResponse returned by each GET command so that pollers asking for the same
+// reading within MaxAge don't each turn into a device read. Concurrent identical requests are coalesced
+// into a single device service call.
+type EventCache interface {
+	// Get returns the cached EventResponse of the GET command if it is fresh enough, otherwise issues the
+	// command through load and caches its result. noCache forces the command to be issued to the device.
+	Get(deviceName, commandName, queryParams string, noCache bool, load func() (*responses.EventResponse, errors.EdgeX)) (*responses.EventResponse, errors.EdgeX)
+	// InvalidateDevice removes the cached responses of all the commands of the device
+	InvalidateDevice(deviceName string)
+}
+
+type cachedEvent struct {
+	response *responses.EventResponse
+	readAt   time.Time
+}
+
+type eventCache struct {
+	lc        logger.LoggingClient
+	maxAge    time.Duration
+	events    map[string]cachedEvent
+	sweepSize int
+	group     singleflight.Group
+	hits      gometrics.Counter
+	misses    gometrics.Counter
+	mutex     sync.RWMutex
+}
+
+// NewEventCache creates the EventCache and registers its hit/miss metrics
+func NewEventCache(dic *di.Container, maxAge time.Duration) EventCache {
+	cache := &eventCache{
+		lc:        bootstrapContainer.LoggingClientFrom(dic.Get),
+		maxAge:    maxAge,
+		events:    make(map[string]cachedEvent),
+		sweepSize: minSweepSize,
+		hits:      gometrics.NewCounter(),
+		misses:    gometrics.NewCounter(),
+	}
+
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		cache.lc.Error("Metric Manager not available. Event cache metrics will not be collected.")
+		return cache
+	}
+	for name, counter := range map[string]gometrics.Counter{
+		eventCacheHitsMetricName:   cache.hits,
+		eventCacheMissesMetricName: cache.misses,
+	} {
+		if err := metricsManager.Register(name, counter, nil); err != nil {
+			cache.lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+			continue
+		}
+		cache.lc.Infof("Registered metrics counter %s", name)
+	}
+
+	return cache
+}
+
+// minSweepSize is the number of cached events from which expired events start being swept on store
+const minSweepSize = 1024
+
+// keySeparator can't appear in an escaped device or command name, nor in an encoded query string
+const keySeparator = "\x00"
+
+func (c *eventCache) Get(deviceName, commandName, queryParams string, noCache bool, load func() (*responses.EventResponse, errors.EdgeX)) (*responses.EventResponse, errors.EdgeX) {
+	params, err := url.ParseQuery(queryParams)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse query parameters", err)
+	}
+	// a pushed event must be produced by every call, and a command not returning the event has nothing to cache
+	if params.Get(common.PushEvent) == common.ValueTrue || params.Get(common.ReturnEvent) == common.ValueFalse {
+		return load()
+	}
+
+	// the encoded query is sorted by key, so the same parameters in a different order share the cache entry
+	key := strings.Join([]string{deviceName, commandName, params.Encode()}, keySeparator)
+	if !noCache {
+		if response, ok := c.lookup(key); ok {
+			c.hits.Inc(1)
+			return response, nil
+		}
+	}
+	c.misses.Inc(1)
+
+	result, sfErr, _ := c.group.Do(key, func() (any, error) {
+		response, err := load()
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, response)
+		return response, nil
+	})
+	if sfErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(sfErr)
+	}
+	return result.(*responses.EventResponse), nil
+}
+
+func (c *eventCache) InvalidateDevice(deviceName string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	prefix := deviceName + keySeparator
+	for key := range c.events {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.events, key)
+		}
+	}
+}
+
+func (c *eventCache) lookup(key string) (*responses.EventResponse, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	event, ok := c.events[key]
+	if !ok || time.Since(event.readAt) > c.maxAge {
+		return nil, false
+	}
+	return event.response, true
+}
+
+func (c *eventCache) store(key string, response *responses.EventResponse) {
+	if response == nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.events[key] = cachedEvent{response: response, readAt: time.Now()}
+
+	if len(c.events) < c.sweepSize {
+		return
+	}
+	for k, event := range c.events {
+		if time.Since(event.readAt) > c.maxAge {
+			delete(c.events, k)
+		}
+	}
+	c.sweepSize = max(minSweepSize, len(c.events)*2)
+}
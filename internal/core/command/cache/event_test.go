@@ -0,0 +1,104 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCommandName = "test-command"
+
+func countingLoad(calls *atomic.Int32, delay time.Duration) func() (*responses.EventResponse, errors.EdgeX) {
+	return func() (*responses.EventResponse, errors.EdgeX) {
+		calls.Add(1)
+		time.Sleep(delay)
+		return &responses.EventResponse{}, nil
+	}
+}
+
+func TestEventCache_Get(t *testing.T) {
+	tests := []struct {
+		name              string
+		queryParams       string
+		secondQueryParams string
+		noCache           bool
+		expectedCalls     int32
+	}{
+		{"cached", "", "", false, 1},
+		{"cached regardless of query parameters order", "a=1&b=2", "b=2&a=1", false, 1},
+		{"different query parameters", "a=1", "a=2", false, 2},
+		{"no-cache", "", "", true, 2},
+		{"push event", common.PushEvent + "=" + common.ValueTrue, common.PushEvent + "=" + common.ValueTrue, false, 2},
+		{"no return event", common.ReturnEvent + "=" + common.ValueFalse, common.ReturnEvent + "=" + common.ValueFalse, false, 2},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := NewEventCache(mockDic(nil, nil), time.Minute)
+			var calls atomic.Int32
+
+			_, err := c.Get(testDeviceName, testCommandName, testCase.queryParams, false, countingLoad(&calls, 0))
+			require.NoError(t, err)
+			_, err = c.Get(testDeviceName, testCommandName, testCase.secondQueryParams, testCase.noCache, countingLoad(&calls, 0))
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expectedCalls, calls.Load())
+		})
+	}
+}
+
+func TestEventCache_MaxAge(t *testing.T) {
+	c := NewEventCache(mockDic(nil, nil), time.Millisecond)
+	var calls atomic.Int32
+
+	_, err := c.Get(testDeviceName, testCommandName, "", false, countingLoad(&calls, 0))
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = c.Get(testDeviceName, testCommandName, "", false, countingLoad(&calls, 0))
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestEventCache_Coalesce(t *testing.T) {
+	c := NewEventCache(mockDic(nil, nil), time.Minute)
+	var calls atomic.Int32
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.Get(testDeviceName, testCommandName, "", true, countingLoad(&calls, 50*time.Millisecond))
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestEventCache_InvalidateDevice(t *testing.T) {
+	c := NewEventCache(mockDic(nil, nil), time.Minute)
+	var calls atomic.Int32
+
+	_, err := c.Get(testDeviceName, testCommandName, "", false, countingLoad(&calls, 0))
+	require.NoError(t, err)
+	c.InvalidateDevice(testDeviceName)
+	_, err = c.Get(testDeviceName, testCommandName, "", false, countingLoad(&calls, 0))
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), calls.Load())
+}
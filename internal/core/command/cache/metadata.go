@@ -0,0 +1,219 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	gometrics "github.com/rcrowley/go-metrics"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const (
+	metadataCacheHitsMetricName   = "MetadataCacheHits"
+	metadataCacheMissesMetricName = "MetadataCacheMisses"
+
+	// DefaultMetadataCacheMaxEntries is the number of devices and of device services kept in the cache when the
+	// configured maximum is not positive
+	DefaultMetadataCacheMaxEntries = 10000
+)
+
+// MetadataCache keeps the devices and device services looked up from core-metadata so that each command
+// doesn't cost two extra HTTP round trips. Entries are invalidated by the metadata system events and expire
+// after the TTL in case an event was missed. The oldest entry is evicted once the entry limit is reached.
+type MetadataCache interface {
+	DeviceByName(ctx context.Context, name string) (dtos.Device, errors.EdgeX)
+	DeviceServiceByName(ctx context.Context, name string) (dtos.DeviceService, errors.EdgeX)
+	InvalidateDevice(name string)
+	InvalidateDeviceService(name string)
+	InvalidateAll()
+}
+
+type entry[T any] struct {
+	value     T
+	storedAt  time.Time
+	expiresAt time.Time
+}
+
+type metadataCache struct {
+	dic            *di.Container
+	lc             logger.LoggingClient
+	ttl            time.Duration
+	maxEntries     int
+	devices        map[string]entry[dtos.Device]
+	deviceServices map[string]entry[dtos.DeviceService]
+	hits           gometrics.Counter
+	misses         gometrics.Counter
+	// generation is bumped on every invalidation so that a lookup racing with a system event doesn't put the
+	// stale value it fetched back into the cache
+	generation uint64
+	mutex      sync.RWMutex
+}
+
+// NewMetadataCache creates the MetadataCache and registers its hit/miss metrics. A ttl <= 0 keeps the entries
+// until they are invalidated by a system event. maxEntries bounds the devices and the device services each,
+// values <= 0 fall back to DefaultMetadataCacheMaxEntries.
+func NewMetadataCache(dic *di.Container, ttl time.Duration, maxEntries int) MetadataCache {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMetadataCacheMaxEntries
+	}
+	cache := &metadataCache{
+		dic:            dic,
+		lc:             bootstrapContainer.LoggingClientFrom(dic.Get),
+		ttl:            ttl,
+		maxEntries:     maxEntries,
+		devices:        make(map[string]entry[dtos.Device]),
+		deviceServices: make(map[string]entry[dtos.DeviceService]),
+		hits:           gometrics.NewCounter(),
+		misses:         gometrics.NewCounter(),
+	}
+
+	metricsManager := bootstrapContainer.MetricsManagerFrom(dic.Get)
+	if metricsManager == nil {
+		cache.lc.Error("Metric Manager not available. Metadata cache metrics will not be collected.")
+		return cache
+	}
+	for name, counter := range map[string]gometrics.Counter{
+		metadataCacheHitsMetricName:   cache.hits,
+		metadataCacheMissesMetricName: cache.misses,
+	} {
+		if err := metricsManager.Register(name, counter, nil); err != nil {
+			cache.lc.Errorf("%s metrics will not be collected: %s", name, err.Error())
+			continue
+		}
+		cache.lc.Infof("Registered metrics counter %s", name)
+	}
+
+	return cache
+}
+
+// DeviceByName returns the cached device, or queries core-metadata and caches the result on a miss
+func (c *metadataCache) DeviceByName(ctx context.Context, name string) (dtos.Device, errors.EdgeX) {
+	device, generation, ok := lookup(c, c.devices, name)
+	if ok {
+		return device, nil
+	}
+
+	dc := bootstrapContainer.DeviceClientFrom(c.dic.Get)
+	if dc == nil {
+		return dtos.Device{}, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceClient returned", nil)
+	}
+	res, err := dc.DeviceByName(ctx, name)
+	if err != nil {
+		return dtos.Device{}, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	store(c, c.devices, name, res.Device, generation)
+	return res.Device, nil
+}
+
+// DeviceServiceByName returns the cached device service, or queries core-metadata and caches the result on a miss
+func (c *metadataCache) DeviceServiceByName(ctx context.Context, name string) (dtos.DeviceService, errors.EdgeX) {
+	deviceService, generation, ok := lookup(c, c.deviceServices, name)
+	if ok {
+		return deviceService, nil
+	}
+
+	dsc := bootstrapContainer.DeviceServiceClientFrom(c.dic.Get)
+	if dsc == nil {
+		return dtos.DeviceService{}, errors.NewCommonEdgeX(errors.KindServerError, "nil DeviceServiceClient returned", nil)
+	}
+	res, err := dsc.DeviceServiceByName(ctx, name)
+	if err != nil {
+		return dtos.DeviceService{}, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	store(c, c.deviceServices, name, res.Service, generation)
+	return res.Service, nil
+}
+
+// InvalidateDevice removes the device from the cache
+func (c *metadataCache) InvalidateDevice(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	if _, ok := c.devices[name]; ok {
+		c.lc.Debugf("removing device %s out of metadata cache...", name)
+		delete(c.devices, name)
+	}
+}
+
+// InvalidateDeviceService removes the device service from the cache
+func (c *metadataCache) InvalidateDeviceService(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	if _, ok := c.deviceServices[name]; ok {
+		c.lc.Debugf("removing device service %s out of metadata cache...", name)
+		delete(c.deviceServices, name)
+	}
+}
+
+// InvalidateAll removes all devices and device services from the cache
+func (c *metadataCache) InvalidateAll() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.generation++
+	clear(c.devices)
+	clear(c.deviceServices)
+}
+
+func lookup[T any](c *metadataCache, entries map[string]entry[T], name string) (T, uint64, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	e, ok := entries[name]
+	if !ok || (!e.expiresAt.IsZero() && time.Now().After(e.expiresAt)) {
+		c.misses.Inc(1)
+		var zero T
+		return zero, c.generation, false
+	}
+	c.hits.Inc(1)
+	return e.value, c.generation, true
+}
+
+func store[T any](c *metadataCache, entries map[string]entry[T], name string, value T, generation uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if generation != c.generation {
+		return
+	}
+	now := time.Now()
+	if _, ok := entries[name]; !ok && len(entries) >= c.maxEntries {
+		evict(entries, now)
+	}
+	e := entry[T]{value: value, storedAt: now}
+	if c.ttl > 0 {
+		e.expiresAt = now.Add(c.ttl)
+	}
+	entries[name] = e
+}
+
+// evict removes the expired entries, or the oldest entry if none has expired, to make room for a new entry
+func evict[T any](entries map[string]entry[T], now time.Time) {
+	var oldest string
+	var oldestStoredAt time.Time
+	expired := false
+	for name, e := range entries {
+		if !e.expiresAt.IsZero() && now.After(e.expiresAt) {
+			delete(entries, name)
+			expired = true
+			continue
+		}
+		if oldest == "" || e.storedAt.Before(oldestStoredAt) {
+			oldest, oldestStoredAt = name, e.storedAt
+		}
+	}
+	if !expired {
+		delete(entries, oldest)
+	}
+}
@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	clientMocks "github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDeviceName        = "test-device"
+	testDeviceServiceName = "test-service"
+)
+
+func mockDic(dc *clientMocks.DeviceClient, dsc *clientMocks.DeviceServiceClient) *di.Container {
+	mockMetricsManager := &mocks.MetricsManager{}
+	mockMetricsManager.On("Register", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	return di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.MetricsManagerInterfaceName: func(get di.Get) interface{} {
+			return mockMetricsManager
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.DeviceClientName: func(get di.Get) interface{} {
+			return dc
+		},
+		bootstrapContainer.DeviceServiceClientName: func(get di.Get) interface{} {
+			return dsc
+		},
+	})
+}
+
+func TestMetadataCache_DeviceByName(t *testing.T) {
+	dc := &clientMocks.DeviceClient{}
+	dc.On("DeviceByName", context.Background(), testDeviceName).
+		Return(responses.DeviceResponse{Device: dtos.Device{Name: testDeviceName, ServiceName: testDeviceServiceName}}, nil)
+	c := NewMetadataCache(mockDic(dc, nil), 0, 0).(*metadataCache)
+
+	device, err := c.DeviceByName(context.Background(), testDeviceName)
+	require.NoError(t, err)
+	assert.Equal(t, testDeviceServiceName, device.ServiceName)
+	_, err = c.DeviceByName(context.Background(), testDeviceName)
+	require.NoError(t, err)
+
+	dc.AssertNumberOfCalls(t, "DeviceByName", 1)
+	assert.Equal(t, int64(1), c.hits.Count())
+	assert.Equal(t, int64(1), c.misses.Count())
+
+	c.InvalidateDevice(testDeviceName)
+	_, err = c.DeviceByName(context.Background(), testDeviceName)
+	require.NoError(t, err)
+	dc.AssertNumberOfCalls(t, "DeviceByName", 2)
+}
+
+func TestMetadataCache_DeviceServiceByName(t *testing.T) {
+	dsc := &clientMocks.DeviceServiceClient{}
+	dsc.On("DeviceServiceByName", context.Background(), testDeviceServiceName).
+		Return(responses.DeviceServiceResponse{Service: dtos.DeviceService{Name: testDeviceServiceName}}, nil)
+	c := NewMetadataCache(mockDic(nil, dsc), 0, 0)
+
+	for i := 0; i < 3; i++ {
+		_, err := c.DeviceServiceByName(context.Background(), testDeviceServiceName)
+		require.NoError(t, err)
+	}
+	dsc.AssertNumberOfCalls(t, "DeviceServiceByName", 1)
+
+	c.InvalidateDeviceService(testDeviceServiceName)
+	_, err := c.DeviceServiceByName(context.Background(), testDeviceServiceName)
+	require.NoError(t, err)
+	dsc.AssertNumberOfCalls(t, "DeviceServiceByName", 2)
+}
+
+func TestMetadataCache_TTL(t *testing.T) {
+	dc := &clientMocks.DeviceClient{}
+	dc.On("DeviceByName", context.Background(), testDeviceName).
+		Return(responses.DeviceResponse{Device: dtos.Device{Name: testDeviceName}}, nil)
+	c := NewMetadataCache(mockDic(dc, nil), time.Millisecond, 0)
+
+	_, err := c.DeviceByName(context.Background(), testDeviceName)
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = c.DeviceByName(context.Background(), testDeviceName)
+	require.NoError(t, err)
+
+	dc.AssertNumberOfCalls(t, "DeviceByName", 2)
+}
+
+func TestMetadataCache_StaleLoadAfterInvalidation(t *testing.T) {
+	c := NewMetadataCache(mockDic(nil, nil), 0, 0).(*metadataCache)
+
+	_, generation, ok := lookup(c, c.devices, testDeviceName)
+	require.False(t, ok)
+	c.InvalidateDevice(testDeviceName)
+	store(c, c.devices, testDeviceName, dtos.Device{Name: testDeviceName}, generation)
+
+	_, _, ok = lookup(c, c.devices, testDeviceName)
+	assert.False(t, ok, "value loaded before the invalidation must not be cached")
+}
+
+func TestMetadataCache_MaxEntries(t *testing.T) {
+	c := NewMetadataCache(mockDic(nil, nil), 0, 2).(*metadataCache)
+
+	for _, name := range []string{"device-1", "device-2", "device-3"} {
+		store(c, c.devices, name, dtos.Device{Name: name}, c.generation)
+		time.Sleep(time.Millisecond)
+	}
+
+	require.Len(t, c.devices, 2)
+	assert.NotContains(t, c.devices, "device-1", "the oldest entry should be evicted")
+	assert.Contains(t, c.devices, "device-3")
+
+	// replacing an existing entry doesn't evict
+	store(c, c.devices, "device-2", dtos.Device{Name: "device-2"}, c.generation)
+	assert.Len(t, c.devices, 2)
+	assert.Contains(t, c.devices, "device-3")
+}
@@ -24,12 +24,16 @@ import (
 type ConfigurationStruct struct {
 	Writable        WritableInfo
 	Clients         bootstrapConfig.ClientsCollection
+	Database        bootstrapConfig.Database
 	Databases       map[string]bootstrapConfig.Database
 	Registry        bootstrapConfig.RegistryInfo
 	Service         bootstrapConfig.ServiceInfo
 	MessageBus      bootstrapConfig.MessageBusInfo
 	ExternalMQTT    bootstrapConfig.ExternalMQTTInfo
 	ExternalCommand ExternalCommandInfo
+	MetadataCache   MetadataCacheInfo
+	EventCache      EventCacheInfo
+	CommandSequence CommandSequenceInfo
 }
 
 // ExternalCommandInfo configures handling of inbound external (MQTT) command requests.
@@ -39,6 +43,31 @@ type ExternalCommandInfo struct {
 	MaxConcurrentRequests int
 }
 
+// MetadataCacheInfo configures the cache of devices and device services looked up from core-metadata.
+type MetadataCacheInfo struct {
+	Enabled bool
+	// TTL bounds how long an entry can be served when the system event that should invalidate it is missed.
+	// Empty or zero keeps the entries until they are invalidated.
+	TTL string
+	// MaxEntries caps the number of devices and of device services each kept in the cache, the oldest entry is
+	// evicted when the cap is reached. Values <= 0 fall back to the built-in default.
+	MaxEntries int
+}
+
+// EventCacheInfo configures the read-through cache of the events returned by GET commands.
+type EventCacheInfo struct {
+	Enabled bool
+	// MaxAge is how long a cached event is returned to subsequent GET commands before the device is read again
+	MaxAge string
+}
+
+// CommandSequenceInfo configures the command sequences, which are stored in the database.
+type CommandSequenceInfo struct {
+	// Enabled connects core-command to the database. The command sequence endpoints respond with
+	// 503 Service Unavailable when disabled.
+	Enabled bool
+}
+
 // WritableInfo contains configuration properties that can be updated and applied without restarting the service.
 type WritableInfo struct {
 	LogLevel        string
@@ -84,6 +113,7 @@ func (c *ConfigurationStruct) UpdateWritableFromRaw(rawWritable interface{}) boo
 func (c *ConfigurationStruct) GetBootstrap() bootstrapConfig.BootstrapConfiguration {
 	return bootstrapConfig.BootstrapConfiguration{
 		Clients:      &c.Clients,
+		Database:     &c.Database,
 		Service:      &c.Service,
 		Registry:     &c.Registry,
 		MessageBus:   &c.MessageBus,
@@ -103,7 +133,7 @@ func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
 
 // GetDatabaseInfo returns a database information map.
 func (c *ConfigurationStruct) GetDatabaseInfo() bootstrapConfig.Database {
-	return bootstrapConfig.Database{}
+	return c.Database
 }
 
 // GetInsecureSecrets returns the service's InsecureSecrets.
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// new constants relates to EdgeX Core Command service and will be added to go-mod-core-contracts in the future
+
+// Constants related to defined routes in the v3 service APIs
+const (
+	ApiCommandSequenceRoute              = common.ApiBase + "/commandsequence"
+	ApiAllCommandSequencesRoute          = ApiCommandSequenceRoute + "/" + common.All
+	ApiCommandSequenceByNameRoute        = ApiCommandSequenceRoute + "/" + common.Name + "/{" + common.Name + "}"
+	ApiExecuteCommandSequenceByNameRoute = ApiCommandSequenceByNameRoute + "/" + Execute
+)
+
+// Constants related to defined url path names and parameters in the v3 service APIs
+const (
+	Execute = "execute"
+)
+
+// Constants related to the command sequence steps
+const (
+	StepTypeSet  = "set"
+	StepTypeGet  = "get"
+	StepTypeWait = "wait"
+
+	OnFailureAbort      = "abort"
+	OnFailureContinue   = "continue"
+	OnFailureCompensate = "compensate"
+
+	OperatorEqual              = "=="
+	OperatorNotEqual           = "!="
+	OperatorGreaterThan        = ">"
+	OperatorGreaterThanOrEqual = ">="
+	OperatorLessThan           = "<"
+	OperatorLessThanOrEqual    = "<="
+
+	StatusSucceeded = "SUCCEEDED"
+	StatusFailed    = "FAILED"
+	StatusSkipped   = "SKIPPED"
+)
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+)
+
+// MetadataCacheInterfaceName contains the name of the cache.MetadataCache implementation in the DIC.
+var MetadataCacheInterfaceName = di.TypeInstanceToName((*cache.MetadataCache)(nil))
+
+// MetadataCacheFrom helper function queries the DIC and returns the cache.MetadataCache implementation,
+// or nil when the metadata cache is not enabled.
+func MetadataCacheFrom(get di.Get) cache.MetadataCache {
+	if c, ok := get(MetadataCacheInterfaceName).(cache.MetadataCache); ok {
+		return c
+	}
+	return nil
+}
+
+// EventCacheInterfaceName contains the name of the cache.EventCache implementation in the DIC.
+var EventCacheInterfaceName = di.TypeInstanceToName((*cache.EventCache)(nil))
+
+// EventCacheFrom helper function queries the DIC and returns the cache.EventCache implementation,
+// or nil when the event cache is not enabled.
+func EventCacheFrom(get di.Get) cache.EventCache {
+	if c, ok := get(EventCacheInterfaceName).(cache.EventCache); ok {
+		return c
+	}
+	return nil
+}
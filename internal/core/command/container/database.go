@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+)
+
+// DBClientInterfaceName contains the name of the interfaces.DBClient implementation in the DIC.
+var DBClientInterfaceName = di.TypeInstanceToName((*interfaces.DBClient)(nil))
+
+// DBClientFrom helper function queries the DIC and returns the interfaces.DBClient implementation,
+// or nil when the command sequences are not enabled.
+func DBClientFrom(get di.Get) interfaces.DBClient {
+	if c, ok := get(DBClientInterfaceName).(interfaces.DBClient); ok {
+		return c
+	}
+	return nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"strings"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -90,6 +91,22 @@ func validateGetCommandParameters(r *http.Request) (err errors.EdgeX) {
 	return nil
 }
 
+const (
+	cacheControlHeader = "Cache-Control"
+	noCacheDirective   = "no-cache"
+)
+
+func hasNoCacheDirective(cacheControl []string) bool {
+	for _, value := range cacheControl {
+		for _, directive := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(directive), noCacheDirective) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (cc *CommandController) IssueGetCommandByName(c echo.Context) error {
 	lc := container.LoggingClientFrom(cc.dic.Get)
 	r := c.Request()
@@ -107,7 +124,10 @@ func (cc *CommandController) IssueGetCommandByName(c echo.Context) error {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
-	response, err := application.IssueGetCommandByName(deviceName, commandName, queryParams, cc.dic)
+	// a caller that must have a fresh reading bypasses the event cache with the standard Cache-Control header
+	noCache := hasNoCacheDirective(r.Header.Values(cacheControlHeader))
+
+	response, err := application.IssueGetCommandByName(deviceName, commandName, queryParams, noCache, cc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
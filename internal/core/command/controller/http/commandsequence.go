@@ -0,0 +1,203 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/application"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	requestDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	responseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+type CommandSequenceController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewCommandSequenceController creates and initializes a CommandSequenceController
+func NewCommandSequenceController(dic *di.Container) *CommandSequenceController {
+	return &CommandSequenceController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// AddCommandSequence handles the POST request of adding new CommandSequence
+func (sc *CommandSequenceController) AddCommandSequence(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requestDTO.AddCommandSequenceRequest
+	err := sc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var addResponses []any
+	for _, req := range reqDTOs {
+		var response any
+		reqId := req.RequestId
+		newId, err := application.AddCommandSequence(ctx, dtos.ToCommandSequenceModel(req.CommandSequence), sc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(reqId, err.Message(), err.Code())
+		} else {
+			response = commonDTO.NewBaseWithIdResponse(reqId, "", http.StatusCreated, newId)
+		}
+		addResponses = append(addResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(addResponses, w, lc)
+}
+
+// CommandSequenceByName handles the GET request of querying CommandSequence by name
+func (sc *CommandSequenceController) CommandSequenceByName(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	sequence, err := application.CommandSequenceByName(ctx, name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewCommandSequenceResponse("", "", http.StatusOK, sequence)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// AllCommandSequences handles the GET request of querying all CommandSequences
+func (sc *CommandSequenceController) AllCommandSequences(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	config := commandContainer.ConfigurationFrom(sc.dic.Get)
+
+	// parse URL query string for offset and limit
+	offset, limit, labels, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	sequences, totalCount, err := application.AllCommandSequences(ctx, labels, offset, limit, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewMultiCommandSequencesResponse("", "", http.StatusOK, totalCount, sequences)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// PatchCommandSequence handles the PATCH request of updating CommandSequence
+func (sc *CommandSequenceController) PatchCommandSequence(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requestDTO.UpdateCommandSequenceRequest
+	err := sc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var responses []any
+	for _, dto := range reqDTOs {
+		var response any
+		reqId := dto.RequestId
+		err := application.PatchCommandSequence(ctx, dto.CommandSequence, sc.dic)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(reqId, err.Message(), err.Code())
+		} else {
+			response = commonDTO.NewBaseResponse(reqId, "", http.StatusOK)
+		}
+		responses = append(responses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(responses, w, lc)
+}
+
+// DeleteCommandSequenceByName handles the DELETE request of deleting CommandSequence by name
+func (sc *CommandSequenceController) DeleteCommandSequenceByName(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteCommandSequenceByName(ctx, name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ExecuteCommandSequenceByName handles the POST request of executing CommandSequence by name, the response contains
+// the result of every step even when the sequence failed
+func (sc *CommandSequenceController) ExecuteCommandSequenceByName(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	result, err := application.ExecuteCommandSequenceByName(ctx, name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responseDTO.NewCommandSequenceResultResponse("", "", http.StatusOK, result)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
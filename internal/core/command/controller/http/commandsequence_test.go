@@ -0,0 +1,421 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	commandContainer "github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	requestDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/requests"
+	commandResponseDTO "github.com/edgexfoundry/edgex-go/internal/core/command/dtos/responses"
+	dbMocks "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+const (
+	testSequenceName        = "pump-skid-start"
+	nonExistSequenceName    = "nonExistSequence"
+	duplicateSequenceName   = "duplicateSequence"
+	testSequenceStepName    = "settle"
+	testSequenceStepTimeout = "1ms"
+)
+
+func buildCommandSequence(name string) dtos.CommandSequence {
+	return dtos.CommandSequence{
+		Name:   name,
+		Labels: []string{"pump"},
+		Steps:  []dtos.SequenceStep{{Name: testSequenceStepName, Type: constants.StepTypeWait, Duration: testSequenceStepTimeout}},
+	}
+}
+
+// mockSequenceDIC returns the mock di Container with the DB client storing the command sequence of testSequenceName
+func mockSequenceDIC() (*di.Container, *dbMocks.DBClient) {
+	sequence := dtos.ToCommandSequenceModel(buildCommandSequence(testSequenceName))
+	sequence.Id = "1c3a8c1a-3a9b-4a88-b5a4-6cc7e0f9a3e1"
+	notFoundErr := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "command sequence doesn't exist in the database", nil)
+
+	dbClientMock := &dbMocks.DBClient{}
+	dbClientMock.On("AddCommandSequence", mock.Anything, mock.MatchedBy(func(s models.CommandSequence) bool { return s.Name == testSequenceName })).Return(sequence, nil)
+	dbClientMock.On("AddCommandSequence", mock.Anything, mock.MatchedBy(func(s models.CommandSequence) bool { return s.Name == duplicateSequenceName })).
+		Return(models.CommandSequence{}, errors.NewCommonEdgeX(errors.KindDuplicateName, "command sequence name exists", nil))
+	dbClientMock.On("CommandSequenceByName", mock.Anything, testSequenceName).Return(sequence, nil)
+	dbClientMock.On("CommandSequenceByName", mock.Anything, nonExistSequenceName).Return(models.CommandSequence{}, notFoundErr)
+	dbClientMock.On("CommandSequenceTotalCount", mock.Anything, mock.Anything).Return(int64(1), nil)
+	dbClientMock.On("AllCommandSequences", mock.Anything, mock.Anything, 0, 20).Return([]models.CommandSequence{sequence}, nil)
+	dbClientMock.On("UpdateCommandSequence", mock.Anything, mock.Anything).Return(nil)
+	dbClientMock.On("DeleteCommandSequenceByName", mock.Anything, testSequenceName).Return(nil)
+
+	dic := NewMockDIC()
+	dic.Update(di.ServiceConstructorMap{
+		commandContainer.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	return dic, dbClientMock
+}
+
+func TestAddCommandSequence(t *testing.T) {
+	dic, _ := mockSequenceDIC()
+	sc := NewCommandSequenceController(dic)
+	assert.NotNil(t, sc)
+
+	valid := requestDTO.AddCommandSequenceRequest{BaseRequest: commonDTO.NewBaseRequest(), CommandSequence: buildCommandSequence(testSequenceName)}
+	duplicate := requestDTO.AddCommandSequenceRequest{BaseRequest: commonDTO.NewBaseRequest(), CommandSequence: buildCommandSequence(duplicateSequenceName)}
+	noStep := valid
+	noStep.CommandSequence.Steps = nil
+
+	tests := []struct {
+		name               string
+		request            []requestDTO.AddCommandSequenceRequest
+		expectedStatusCode int
+		expectedItemCode   int
+	}{
+		{"Valid - add command sequence", []requestDTO.AddCommandSequenceRequest{valid}, http.StatusMultiStatus, http.StatusCreated},
+		{"Invalid - duplicate command sequence name", []requestDTO.AddCommandSequenceRequest{duplicate}, http.StatusMultiStatus, http.StatusConflict},
+		{"Invalid - command sequence without step", []requestDTO.AddCommandSequenceRequest{noStep}, http.StatusBadRequest, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, constants.ApiCommandSequenceRoute, bytes.NewReader(jsonData))
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			handler := echo.HandlerFunc(sc.AddCommandSequence)
+			err = handler(c)
+			assert.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusMultiStatus {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+				return
+			}
+			var res []commonDTO.BaseWithIdResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, common.ApiVersion, res[0].ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedItemCode, res[0].StatusCode, "Response status code not as expected")
+			if testCase.expectedItemCode == http.StatusCreated {
+				assert.NotEmpty(t, res[0].Id, "Response Id not as expected")
+			}
+		})
+	}
+}
+
+func TestCommandSequenceByName(t *testing.T) {
+	dic, _ := mockSequenceDIC()
+	sc := NewCommandSequenceController(dic)
+	assert.NotNil(t, sc)
+
+	tests := []struct {
+		name               string
+		sequenceName       string
+		expectedStatusCode int
+	}{
+		{"Valid - get command sequence by name", testSequenceName, http.StatusOK},
+		{"Invalid - get command sequence with empty name", "", http.StatusBadRequest},
+		{"Invalid - get command sequence with non exist name", nonExistSequenceName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, constants.ApiCommandSequenceByNameRoute, http.NoBody)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.sequenceName)
+			handler := echo.HandlerFunc(sc.CommandSequenceByName)
+			err := handler(c)
+			assert.NoError(t, err)
+
+			// Assert
+			var res commandResponseDTO.CommandSequenceResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, testSequenceName, res.CommandSequence.Name, "Command sequence name not as expected")
+			} else {
+				assert.NotEmpty(t, res.Message, "Response message doesn't contain the error message")
+			}
+		})
+	}
+}
+
+func TestAllCommandSequences(t *testing.T) {
+	dic, _ := mockSequenceDIC()
+	sc := NewCommandSequenceController(dic)
+	assert.NotNil(t, sc)
+
+	tests := []struct {
+		name               string
+		offset             string
+		limit              string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - get command sequences without offset and limit", "", "", 1, http.StatusOK},
+		{"Invalid - offset out of range", "2", "20", 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - invalid limit", "0", "invalid", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, constants.ApiAllCommandSequencesRoute, http.NoBody)
+			query := req.URL.Query()
+			if testCase.offset != "" {
+				query.Add(common.Offset, testCase.offset)
+			}
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			handler := echo.HandlerFunc(sc.AllCommandSequences)
+			err := handler(c)
+			assert.NoError(t, err)
+
+			// Assert
+			var res commandResponseDTO.MultiCommandSequencesResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Len(t, res.CommandSequences, testCase.expectedCount, "Command sequence count not as expected")
+		})
+	}
+}
+
+func TestPatchCommandSequence(t *testing.T) {
+	dic, dbClientMock := mockSequenceDIC()
+	sc := NewCommandSequenceController(dic)
+	assert.NotNil(t, sc)
+
+	name := testSequenceName
+	nonExistName := nonExistSequenceName
+	description := "start the pump skid"
+	valid := requestDTO.UpdateCommandSequenceRequest{
+		BaseRequest:     commonDTO.NewBaseRequest(),
+		CommandSequence: dtos.UpdateCommandSequence{Name: &name, Description: &description},
+	}
+	notFound := valid
+	notFound.CommandSequence.Name = &nonExistName
+
+	tests := []struct {
+		name             string
+		request          requestDTO.UpdateCommandSequenceRequest
+		expectedItemCode int
+	}{
+		{"Valid - patch command sequence", valid, http.StatusOK},
+		{"Invalid - patch command sequence with non exist name", notFound, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal([]requestDTO.UpdateCommandSequenceRequest{testCase.request})
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPatch, constants.ApiCommandSequenceRoute, bytes.NewReader(jsonData))
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			handler := echo.HandlerFunc(sc.PatchCommandSequence)
+			err = handler(c)
+			assert.NoError(t, err)
+
+			// Assert
+			var res []commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			require.Len(t, res, 1)
+			assert.Equal(t, testCase.expectedItemCode, res[0].StatusCode, "Response status code not as expected")
+		})
+	}
+	dbClientMock.AssertCalled(t, "UpdateCommandSequence", mock.Anything, mock.MatchedBy(func(s models.CommandSequence) bool {
+		return s.Name == testSequenceName && s.Description == description
+	}))
+}
+
+func TestDeleteCommandSequenceByName(t *testing.T) {
+	dic, dbClientMock := mockSequenceDIC()
+	sc := NewCommandSequenceController(dic)
+	assert.NotNil(t, sc)
+
+	tests := []struct {
+		name               string
+		sequenceName       string
+		expectedStatusCode int
+	}{
+		{"Valid - delete command sequence by name", testSequenceName, http.StatusOK},
+		{"Invalid - delete command sequence with empty name", "", http.StatusBadRequest},
+		{"Invalid - delete command sequence with non exist name", nonExistSequenceName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, constants.ApiCommandSequenceByNameRoute, http.NoBody)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.sequenceName)
+			handler := echo.HandlerFunc(sc.DeleteCommandSequenceByName)
+			err := handler(c)
+			assert.NoError(t, err)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "DeleteCommandSequenceByName", 1)
+}
+
+func TestExecuteCommandSequenceByName(t *testing.T) {
+	dic, _ := mockSequenceDIC()
+	sc := NewCommandSequenceController(dic)
+	assert.NotNil(t, sc)
+
+	tests := []struct {
+		name               string
+		sequenceName       string
+		expectedStatusCode int
+	}{
+		{"Valid - execute command sequence by name", testSequenceName, http.StatusOK},
+		{"Invalid - execute command sequence with empty name", "", http.StatusBadRequest},
+		{"Invalid - execute command sequence with non exist name", nonExistSequenceName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, constants.ApiExecuteCommandSequenceByNameRoute, http.NoBody)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.sequenceName)
+			handler := echo.HandlerFunc(sc.ExecuteCommandSequenceByName)
+			err := handler(c)
+			assert.NoError(t, err)
+
+			// Assert
+			var res commandResponseDTO.CommandSequenceResultResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, testSequenceName, res.Result.SequenceName, "Sequence name not as expected")
+				require.Len(t, res.Result.Steps, 1)
+				assert.Equal(t, testSequenceStepName, res.Result.Steps[0].StepName, "Step name not as expected")
+			}
+		})
+	}
+}
+
+// TestCommandSequenceWithoutDatabase verifies that the command sequence endpoints respond with 503 Service Unavailable
+// when core-command runs without the database
+func TestCommandSequenceWithoutDatabase(t *testing.T) {
+	sc := NewCommandSequenceController(NewMockDIC())
+	assert.NotNil(t, sc)
+
+	addData, err := json.Marshal([]requestDTO.AddCommandSequenceRequest{
+		{BaseRequest: commonDTO.NewBaseRequest(), CommandSequence: buildCommandSequence(testSequenceName)},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		method  string
+		route   string
+		body    []byte
+		handler echo.HandlerFunc
+	}{
+		{"get command sequence by name", http.MethodGet, constants.ApiCommandSequenceByNameRoute, nil, sc.CommandSequenceByName},
+		{"get all command sequences", http.MethodGet, constants.ApiAllCommandSequencesRoute, nil, sc.AllCommandSequences},
+		{"delete command sequence by name", http.MethodDelete, constants.ApiCommandSequenceByNameRoute, nil, sc.DeleteCommandSequenceByName},
+		{"execute command sequence by name", http.MethodPost, constants.ApiExecuteCommandSequenceByNameRoute, nil, sc.ExecuteCommandSequenceByName},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(testCase.method, testCase.route, http.NoBody)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testSequenceName)
+			err := testCase.handler(c)
+			assert.NoError(t, err)
+
+			// Assert
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusServiceUnavailable, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode, "Response status code not as expected")
+		})
+	}
+
+	t.Run("add command sequence", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, constants.ApiCommandSequenceRoute, bytes.NewReader(addData))
+
+		// Act
+		recorder := httptest.NewRecorder()
+		c := e.NewContext(req, recorder)
+		err := sc.AddCommandSequence(c)
+		assert.NoError(t, err)
+
+		// Assert
+		var res []commonDTO.BaseWithIdResponse
+		err = json.Unmarshal(recorder.Body.Bytes(), &res)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+		require.Len(t, res, 1)
+		assert.Equal(t, http.StatusServiceUnavailable, res[0].StatusCode, "Response status code not as expected")
+	})
+}
@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+)
+
+// SubscribeSystemEvents subscribes the device and device service system events published by core-metadata
+// and invalidates the related entries of the metadata cache
+func SubscribeSystemEvents(ctx context.Context, metadataCache cache.MetadataCache, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	messageBusInfo := configuration.MessageBus
+
+	messages := make(chan types.MessageEnvelope, 1)
+	messageErrors := make(chan error, 1)
+	var topics []types.TopicChannel
+	// system event topic scheme: edgex/system-events/core-metadata/<type>/<action>/<owner>/...
+	for _, eventType := range []string{common.DeviceSystemEventType, common.DeviceServiceSystemEventType} {
+		topic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+			SetPath(messageBusInfo.GetBaseTopicPrefix()).SetPath(common.SystemEventPublishTopic).SetPath(common.CoreMetaDataServiceKey).
+			SetPath(eventType).SetPath("#").BuildPath()
+		lc.Infof("Subscribing to System Events on topic: %s", topic)
+		topics = append(topics, types.TopicChannel{Topic: topic, Messages: messages})
+	}
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Info("Exiting waiting for MessageBus system event messages")
+				return
+			case err = <-messageErrors:
+				lc.Error(err.Error())
+				// an error on the subscription means events might have been lost, so nothing in the cache can be trusted
+				metadataCache.InvalidateAll()
+			case msgEnvelope := <-messages:
+				lc.Debugf("System event received on message queue. Topic: %s, Correlation-id: %s", msgEnvelope.ReceivedTopic, msgEnvelope.CorrelationID)
+				systemEvent, err := types.GetMsgPayload[dtos.SystemEvent](msgEnvelope)
+				if err != nil {
+					lc.Errorf("failed to JSON decoding system event: %s", err.Error())
+					continue
+				}
+				if err = systemEventAction(systemEvent, metadataCache); err != nil {
+					lc.Error(err.Error(), common.CorrelationHeader, msgEnvelope.CorrelationID)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func systemEventAction(systemEvent dtos.SystemEvent, metadataCache cache.MetadataCache) error {
+	switch systemEvent.Type {
+	case common.DeviceSystemEventType:
+		var device dtos.Device
+		if err := systemEvent.DecodeDetails(&device); err != nil {
+			return fmt.Errorf("failed to decode %s system event details: %s", systemEvent.Type, err.Error())
+		}
+		metadataCache.InvalidateDevice(device.Name)
+	case common.DeviceServiceSystemEventType:
+		var deviceService dtos.DeviceService
+		if err := systemEvent.DecodeDetails(&deviceService); err != nil {
+			return fmt.Errorf("failed to decode %s system event details: %s", systemEvent.Type, err.Error())
+		}
+		metadataCache.InvalidateDeviceService(deviceService.Name)
+	}
+	return nil
+}
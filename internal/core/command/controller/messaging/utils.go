@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2022-2025 IOTech Ltd
+// Copyright (C) 2022-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Inc.
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -8,12 +8,10 @@ package messaging
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 
-	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
@@ -26,26 +24,16 @@ import (
 // retrieveServiceNameByDevice validates the existence of device and device service,
 // returns the service name to which the command request will be sent.
 func retrieveServiceNameByDevice(deviceName string, dic *di.Container) (string, error) {
-	// retrieve device information through Metadata DeviceClient
-	dc := bootstrapContainer.DeviceClientFrom(dic.Get)
-	if dc == nil {
-		return "", errors.New("nil Device Client")
-	}
-	deviceResponse, err := dc.DeviceByName(context.Background(), deviceName)
+	// retrieve device and device service information through the metadata cache
+	device, err := application.DeviceByName(context.Background(), deviceName, dic)
 	if err != nil {
 		return "", fmt.Errorf("failed to get Device by name %s: %v", deviceName, err)
 	}
-
-	// retrieve device service information through Metadata DeviceClient
-	dsc := bootstrapContainer.DeviceServiceClientFrom(dic.Get)
-	if dsc == nil {
-		return "", errors.New("nil DeviceService Client")
-	}
-	deviceServiceResponse, err := dsc.DeviceServiceByName(context.Background(), deviceResponse.Device.ServiceName)
+	deviceService, err := application.DeviceServiceByName(context.Background(), device.ServiceName, dic)
 	if err != nil {
-		return "", fmt.Errorf("failed to get DeviceService by name %s: %v", deviceResponse.Device.ServiceName, err)
+		return "", fmt.Errorf("failed to get DeviceService by name %s: %v", device.ServiceName, err)
 	}
-	return deviceServiceResponse.Service.Name, nil
+	return deviceService.Name, nil
 }
 
 // validateGetCommandQueryParameters validates the value is valid for device service's reserved query parameters
@@ -0,0 +1,230 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+var assertionOperators = []string{
+	constants.OperatorEqual,
+	constants.OperatorNotEqual,
+	constants.OperatorGreaterThan,
+	constants.OperatorGreaterThanOrEqual,
+	constants.OperatorLessThan,
+	constants.OperatorLessThanOrEqual,
+}
+
+// CommandSequence is an ordered list of steps issued against one or more devices as a single operation
+type CommandSequence struct {
+	dtos.DBTimestamp `json:",inline"`
+	Id               string         `json:"id,omitempty" validate:"omitempty,uuid"`
+	Name             string         `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description      string         `json:"description,omitempty"`
+	Labels           []string       `json:"labels,omitempty"`
+	Steps            []SequenceStep `json:"steps" validate:"required,gt=0,dive"`
+}
+
+// UpdateCommandSequence contains the CommandSequence properties which can be patched, the sequence is identified by Id or Name
+type UpdateCommandSequence struct {
+	Id          *string        `json:"id" validate:"required_without=Name,edgex-dto-uuid"`
+	Name        *string        `json:"name" validate:"required_without=Id,edgex-dto-none-empty-string"`
+	Description *string        `json:"description"`
+	Labels      []string       `json:"labels"`
+	Steps       []SequenceStep `json:"steps" validate:"omitempty,gt=0,dive"`
+}
+
+// SequenceStep is a set, get or wait step of a CommandSequence
+type SequenceStep struct {
+	Name         string            `json:"name" validate:"required,edgex-dto-none-empty-string"`
+	Type         string            `json:"type" validate:"required,oneof='set' 'get' 'wait'"`
+	DeviceName   string            `json:"deviceName,omitempty"`
+	CommandName  string            `json:"commandName,omitempty"`
+	Parameters   map[string]any    `json:"parameters,omitempty"`
+	QueryParams  map[string]string `json:"queryParams,omitempty"`
+	Assertions   []StepAssertion   `json:"assertions,omitempty" validate:"dive"`
+	Duration     string            `json:"duration,omitempty" validate:"omitempty,edgex-dto-duration"`
+	Timeout      string            `json:"timeout,omitempty" validate:"omitempty,edgex-dto-duration"`
+	OnFailure    string            `json:"onFailure,omitempty" validate:"omitempty,oneof='abort' 'continue' 'compensate'"`
+	Compensation *SequenceStep     `json:"compensation,omitempty"`
+}
+
+// StepAssertion compares the value of the named reading returned by a get step with the expected value
+type StepAssertion struct {
+	ResourceName string `json:"resourceName" validate:"required,edgex-dto-none-empty-string"`
+	Operator     string `json:"operator" validate:"required"`
+	Value        string `json:"value"`
+}
+
+// CommandSequenceResult is the step-by-step log of a command sequence execution
+type CommandSequenceResult struct {
+	SequenceName string       `json:"sequenceName"`
+	Status       string       `json:"status"`
+	Started      int64        `json:"started"`
+	Ended        int64        `json:"ended"`
+	Steps        []StepResult `json:"steps"`
+}
+
+// StepResult is the outcome of a single command sequence step
+type StepResult struct {
+	StepName     string      `json:"stepName"`
+	Type         string      `json:"type"`
+	Status       string      `json:"status"`
+	Message      string      `json:"message,omitempty"`
+	Started      int64       `json:"started,omitempty"`
+	Ended        int64       `json:"ended,omitempty"`
+	Compensation *StepResult `json:"compensation,omitempty"`
+}
+
+// ValidateSteps checks the step properties which depend on the step type, and that the step names are unique
+func ValidateSteps(steps []SequenceStep) error {
+	names := make(map[string]struct{}, len(steps))
+	for _, step := range steps {
+		if _, exists := names[step.Name]; exists {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("duplicate step name '%s'", step.Name), nil)
+		}
+		names[step.Name] = struct{}{}
+
+		if err := step.validate(); err != nil {
+			return err
+		}
+		if step.OnFailure == constants.OnFailureCompensate {
+			if step.Compensation == nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("step '%s' must define a compensation step when onFailure is '%s'", step.Name, constants.OnFailureCompensate), nil)
+			}
+			if step.Compensation.Compensation != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("compensation step of step '%s' cannot define another compensation step", step.Name), nil)
+			}
+			if err := step.Compensation.validate(); err != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid compensation step of step '%s'", step.Name), err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s SequenceStep) validate() error {
+	if err := common.Validate(s); err != nil {
+		return err
+	}
+
+	switch s.Type {
+	case constants.StepTypeSet, constants.StepTypeGet:
+		if len(s.DeviceName) == 0 || len(s.CommandName) == 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s step '%s' requires deviceName and commandName", s.Type, s.Name), nil)
+		}
+		if s.Type == constants.StepTypeSet && len(s.Parameters) == 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("set step '%s' requires parameters", s.Name), nil)
+		}
+		if s.Type == constants.StepTypeSet && len(s.Assertions) > 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("set step '%s' cannot define assertions", s.Name), nil)
+		}
+	case constants.StepTypeWait:
+		if len(s.Duration) == 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("wait step '%s' requires duration", s.Name), nil)
+		}
+	}
+
+	for _, assertion := range s.Assertions {
+		if !slices.Contains(assertionOperators, assertion.Operator) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("step '%s' has invalid assertion operator '%s', must be one of %v", s.Name, assertion.Operator, assertionOperators), nil)
+		}
+	}
+	return nil
+}
+
+// ToCommandSequenceModel transforms the CommandSequence DTO to the CommandSequence Model
+func ToCommandSequenceModel(dto CommandSequence) models.CommandSequence {
+	var model models.CommandSequence
+	model.Id = dto.Id
+	model.Name = dto.Name
+	model.Description = dto.Description
+	model.Labels = dto.Labels
+	model.Steps = ToSequenceStepModels(dto.Steps)
+	return model
+}
+
+// FromCommandSequenceModelToDTO transforms the CommandSequence Model to the CommandSequence DTO
+func FromCommandSequenceModelToDTO(model models.CommandSequence) CommandSequence {
+	var dto CommandSequence
+	dto.DBTimestamp = dtos.DBTimestamp(model.DBTimestamp)
+	dto.Id = model.Id
+	dto.Name = model.Name
+	dto.Description = model.Description
+	dto.Labels = model.Labels
+	dto.Steps = FromSequenceStepModelsToDTOs(model.Steps)
+	return dto
+}
+
+// ToSequenceStepModels transforms the SequenceStep DTOs to the SequenceStep Models
+func ToSequenceStepModels(stepDTOs []SequenceStep) []models.SequenceStep {
+	steps := make([]models.SequenceStep, len(stepDTOs))
+	for i, dto := range stepDTOs {
+		steps[i] = ToSequenceStepModel(dto)
+	}
+	return steps
+}
+
+// ToSequenceStepModel transforms the SequenceStep DTO to the SequenceStep Model
+func ToSequenceStepModel(dto SequenceStep) models.SequenceStep {
+	var model models.SequenceStep
+	model.Name = dto.Name
+	model.Type = dto.Type
+	model.DeviceName = dto.DeviceName
+	model.CommandName = dto.CommandName
+	model.Parameters = dto.Parameters
+	model.QueryParams = dto.QueryParams
+	model.Duration = dto.Duration
+	model.Timeout = dto.Timeout
+	model.OnFailure = dto.OnFailure
+	for _, assertion := range dto.Assertions {
+		model.Assertions = append(model.Assertions, models.StepAssertion(assertion))
+	}
+	if dto.Compensation != nil {
+		compensation := ToSequenceStepModel(*dto.Compensation)
+		model.Compensation = &compensation
+	}
+	return model
+}
+
+// FromSequenceStepModelsToDTOs transforms the SequenceStep Models to the SequenceStep DTOs
+func FromSequenceStepModelsToDTOs(steps []models.SequenceStep) []SequenceStep {
+	stepDTOs := make([]SequenceStep, len(steps))
+	for i, model := range steps {
+		stepDTOs[i] = FromSequenceStepModelToDTO(model)
+	}
+	return stepDTOs
+}
+
+// FromSequenceStepModelToDTO transforms the SequenceStep Model to the SequenceStep DTO
+func FromSequenceStepModelToDTO(model models.SequenceStep) SequenceStep {
+	var dto SequenceStep
+	dto.Name = model.Name
+	dto.Type = model.Type
+	dto.DeviceName = model.DeviceName
+	dto.CommandName = model.CommandName
+	dto.Parameters = model.Parameters
+	dto.QueryParams = model.QueryParams
+	dto.Duration = model.Duration
+	dto.Timeout = model.Timeout
+	dto.OnFailure = model.OnFailure
+	for _, assertion := range model.Assertions {
+		dto.Assertions = append(dto.Assertions, StepAssertion(assertion))
+	}
+	if model.Compensation != nil {
+		compensation := FromSequenceStepModelToDTO(*model.Compensation)
+		dto.Compensation = &compensation
+	}
+	return dto
+}
@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+// AddCommandSequenceRequest defines the Request Content for POST CommandSequence DTO.
+type AddCommandSequenceRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	CommandSequence       dtos.CommandSequence `json:"commandSequence"`
+}
+
+// Validate satisfies the Validator interface
+func (a AddCommandSequenceRequest) Validate() error {
+	err := common.Validate(a)
+	if err != nil {
+		return err
+	}
+	return dtos.ValidateSteps(a.CommandSequence.Steps)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddCommandSequenceRequest type
+func (a *AddCommandSequenceRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		CommandSequence dtos.CommandSequence
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*a = AddCommandSequenceRequest(alias)
+
+	// validate AddCommandSequenceRequest DTO
+	if err := a.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateCommandSequenceRequest defines the Request Content for PATCH CommandSequence DTO.
+type UpdateCommandSequenceRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	CommandSequence       dtos.UpdateCommandSequence `json:"commandSequence"`
+}
+
+// Validate satisfies the Validator interface
+func (u UpdateCommandSequenceRequest) Validate() error {
+	err := common.Validate(u)
+	if err != nil {
+		return err
+	}
+	return dtos.ValidateSteps(u.CommandSequence.Steps)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateCommandSequenceRequest type
+func (u *UpdateCommandSequenceRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		CommandSequence dtos.UpdateCommandSequence
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*u = UpdateCommandSequenceRequest(alias)
+
+	// validate UpdateCommandSequenceRequest DTO
+	if err := u.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReplaceCommandSequenceModelFieldsWithDTO replace existing CommandSequence's fields with DTO patch
+func ReplaceCommandSequenceModelFieldsWithDTO(sequence *models.CommandSequence, patch dtos.UpdateCommandSequence) {
+	if patch.Description != nil {
+		sequence.Description = *patch.Description
+	}
+	if patch.Labels != nil {
+		sequence.Labels = patch.Labels
+	}
+	if patch.Steps != nil {
+		sequence.Steps = dtos.ToSequenceStepModels(patch.Steps)
+	}
+}
@@ -0,0 +1,180 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+const (
+	testSequenceName = "pump-skid-start"
+	testDeviceName   = "pump"
+)
+
+func addCommandSequenceRequestData() AddCommandSequenceRequest {
+	return AddCommandSequenceRequest{
+		BaseRequest: dtoCommon.NewBaseRequest(),
+		CommandSequence: dtos.CommandSequence{
+			Name: testSequenceName,
+			Steps: []dtos.SequenceStep{
+				{Name: "start", Type: constants.StepTypeSet, DeviceName: testDeviceName, CommandName: "start", Parameters: map[string]any{"speed": 1200}, Timeout: "5s"},
+				{Name: "settle", Type: constants.StepTypeWait, Duration: "2s"},
+				{Name: "check", Type: constants.StepTypeGet, DeviceName: testDeviceName, CommandName: "status",
+					Assertions: []dtos.StepAssertion{{ResourceName: "pressure", Operator: constants.OperatorGreaterThan, Value: "10"}}},
+			},
+		},
+	}
+}
+
+func TestAddCommandSequenceRequest_Validate(t *testing.T) {
+	valid := addCommandSequenceRequestData()
+
+	noSteps := addCommandSequenceRequestData()
+	noSteps.CommandSequence.Steps = nil
+	emptyName := addCommandSequenceRequestData()
+	emptyName.CommandSequence.Name = ""
+	invalidType := addCommandSequenceRequestData()
+	invalidType.CommandSequence.Steps[0].Type = "reboot"
+	duplicateStepName := addCommandSequenceRequestData()
+	duplicateStepName.CommandSequence.Steps[1].Name = "start"
+	setWithoutParameters := addCommandSequenceRequestData()
+	setWithoutParameters.CommandSequence.Steps[0].Parameters = nil
+	getWithoutDevice := addCommandSequenceRequestData()
+	getWithoutDevice.CommandSequence.Steps[2].DeviceName = ""
+	waitWithoutDuration := addCommandSequenceRequestData()
+	waitWithoutDuration.CommandSequence.Steps[1].Duration = ""
+	invalidTimeout := addCommandSequenceRequestData()
+	invalidTimeout.CommandSequence.Steps[0].Timeout = "5 seconds"
+	invalidOperator := addCommandSequenceRequestData()
+	invalidOperator.CommandSequence.Steps[2].Assertions[0].Operator = "=~"
+	invalidOnFailure := addCommandSequenceRequestData()
+	invalidOnFailure.CommandSequence.Steps[0].OnFailure = "retry"
+	compensateWithoutStep := addCommandSequenceRequestData()
+	compensateWithoutStep.CommandSequence.Steps[0].OnFailure = constants.OnFailureCompensate
+	withCompensation := addCommandSequenceRequestData()
+	withCompensation.CommandSequence.Steps[0].OnFailure = constants.OnFailureCompensate
+	withCompensation.CommandSequence.Steps[0].Compensation = &dtos.SequenceStep{Name: "stop", Type: constants.StepTypeSet, DeviceName: testDeviceName, CommandName: "stop", Parameters: map[string]any{"speed": 0}}
+	nestedCompensation := addCommandSequenceRequestData()
+	nestedCompensation.CommandSequence.Steps[0].OnFailure = constants.OnFailureCompensate
+	nestedCompensation.CommandSequence.Steps[0].Compensation = &dtos.SequenceStep{Name: "stop", Type: constants.StepTypeWait, Duration: "1s",
+		Compensation: &dtos.SequenceStep{Name: "again", Type: constants.StepTypeWait, Duration: "1s"}}
+
+	tests := []struct {
+		name          string
+		request       AddCommandSequenceRequest
+		expectedError bool
+	}{
+		{"valid", valid, false},
+		{"valid with compensation", withCompensation, false},
+		{"invalid, no steps", noSteps, true},
+		{"invalid, empty name", emptyName, true},
+		{"invalid, unknown step type", invalidType, true},
+		{"invalid, duplicate step name", duplicateStepName, true},
+		{"invalid, set step without parameters", setWithoutParameters, true},
+		{"invalid, get step without device name", getWithoutDevice, true},
+		{"invalid, wait step without duration", waitWithoutDuration, true},
+		{"invalid, timeout", invalidTimeout, true},
+		{"invalid, assertion operator", invalidOperator, true},
+		{"invalid, onFailure", invalidOnFailure, true},
+		{"invalid, compensate without compensation step", compensateWithoutStep, true},
+		{"invalid, nested compensation step", nestedCompensation, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.request.Validate()
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAddCommandSequenceRequest_UnmarshalJSON(t *testing.T) {
+	valid := addCommandSequenceRequestData()
+	validData, err := json.Marshal(valid)
+	require.NoError(t, err)
+
+	var result AddCommandSequenceRequest
+	err = result.UnmarshalJSON(validData)
+	require.NoError(t, err)
+	assert.Equal(t, valid.CommandSequence.Name, result.CommandSequence.Name)
+	assert.Len(t, result.CommandSequence.Steps, len(valid.CommandSequence.Steps))
+
+	err = result.UnmarshalJSON([]byte("?"))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestUpdateCommandSequenceRequest_Validate(t *testing.T) {
+	name := testSequenceName
+	emptyString := ""
+	description := "start the pump skid"
+
+	valid := UpdateCommandSequenceRequest{
+		BaseRequest:     dtoCommon.NewBaseRequest(),
+		CommandSequence: dtos.UpdateCommandSequence{Name: &name, Description: &description},
+	}
+	noIdAndName := valid
+	noIdAndName.CommandSequence.Name = nil
+	emptyName := valid
+	emptyName.CommandSequence.Name = &emptyString
+	invalidSteps := valid
+	invalidSteps.CommandSequence.Steps = []dtos.SequenceStep{{Name: "settle", Type: constants.StepTypeWait}}
+
+	tests := []struct {
+		name          string
+		request       UpdateCommandSequenceRequest
+		expectedError bool
+	}{
+		{"valid", valid, false},
+		{"invalid, no id and name", noIdAndName, true},
+		{"invalid, empty name", emptyName, true},
+		{"invalid, steps", invalidSteps, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.request.Validate()
+			if testCase.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReplaceCommandSequenceModelFieldsWithDTO(t *testing.T) {
+	description := "start the pump skid"
+	sequence := models.CommandSequence{
+		Name:   testSequenceName,
+		Labels: []string{"pump"},
+		Steps:  []models.SequenceStep{{Name: "settle", Type: constants.StepTypeWait, Duration: "1s"}},
+	}
+	patch := dtos.UpdateCommandSequence{
+		Description: &description,
+		Steps:       []dtos.SequenceStep{{Name: "settle", Type: constants.StepTypeWait, Duration: "2s"}},
+	}
+
+	ReplaceCommandSequenceModelFieldsWithDTO(&sequence, patch)
+
+	assert.Equal(t, description, sequence.Description)
+	assert.Equal(t, []string{"pump"}, sequence.Labels)
+	require.Len(t, sequence.Steps, 1)
+	assert.Equal(t, "2s", sequence.Steps[0].Duration)
+}
@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/dtos"
+)
+
+// CommandSequenceResponse defines the Response Content for GET CommandSequence DTO.
+type CommandSequenceResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	CommandSequence        dtos.CommandSequence `json:"commandSequence"`
+}
+
+func NewCommandSequenceResponse(requestId string, message string, statusCode int, sequence dtos.CommandSequence) CommandSequenceResponse {
+	return CommandSequenceResponse{
+		BaseResponse:    dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		CommandSequence: sequence,
+	}
+}
+
+// MultiCommandSequencesResponse defines the Response Content for GET multiple CommandSequence DTOs.
+type MultiCommandSequencesResponse struct {
+	dtoCommon.BaseWithTotalCountResponse `json:",inline"`
+	CommandSequences                     []dtos.CommandSequence `json:"commandSequences"`
+}
+
+func NewMultiCommandSequencesResponse(requestId string, message string, statusCode int, totalCount int64, sequences []dtos.CommandSequence) MultiCommandSequencesResponse {
+	return MultiCommandSequencesResponse{
+		BaseWithTotalCountResponse: dtoCommon.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		CommandSequences:           sequences,
+	}
+}
+
+// CommandSequenceResultResponse defines the Response Content for executing a CommandSequence.
+type CommandSequenceResultResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Result                 dtos.CommandSequenceResult `json:"result"`
+}
+
+func NewCommandSequenceResultResponse(requestId string, message string, statusCode int, result dtos.CommandSequenceResult) CommandSequenceResultResponse {
+	return CommandSequenceResultResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Result:       result,
+	}
+}
@@ -0,0 +1,10 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_command.sequence is used to store the command sequence information
+CREATE TABLE IF NOT EXISTS core_command.sequence (
+    id UUID PRIMARY KEY,
+    content JSONB NOT NULL
+);
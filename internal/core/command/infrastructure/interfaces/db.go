@@ -0,0 +1,26 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+)
+
+type DBClient interface {
+	CloseSession()
+
+	AddCommandSequence(ctx context.Context, sequence models.CommandSequence) (models.CommandSequence, errors.EdgeX)
+	AllCommandSequences(ctx context.Context, labels []string, offset, limit int) ([]models.CommandSequence, errors.EdgeX)
+	UpdateCommandSequence(ctx context.Context, sequence models.CommandSequence) errors.EdgeX
+	DeleteCommandSequenceByName(ctx context.Context, name string) errors.EdgeX
+	CommandSequenceById(ctx context.Context, id string) (models.CommandSequence, errors.EdgeX)
+	CommandSequenceByName(ctx context.Context, name string) (models.CommandSequence, errors.EdgeX)
+	CommandSequenceTotalCount(ctx context.Context, labels []string) (int64, errors.EdgeX)
+}
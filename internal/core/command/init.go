@@ -19,8 +19,11 @@ package command
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/command/cache"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging"
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/secret"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
@@ -67,5 +70,70 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		},
 	})
 
+	if config.MetadataCache.Enabled {
+		if !initMetadataCache(ctx, dic) {
+			return false
+		}
+	}
+
+	if config.EventCache.Enabled {
+		if !initEventCache(dic) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// initEventCache creates the read-through cache of the events returned by GET commands
+func initEventCache(dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	maxAge, err := time.ParseDuration(config.EventCache.MaxAge)
+	if err != nil {
+		lc.Errorf("Failed to parse EventCache.MaxAge configuration value: %v", err)
+		return false
+	}
+
+	eventCache := cache.NewEventCache(dic, maxAge)
+	dic.Update(di.ServiceConstructorMap{
+		container.EventCacheInterfaceName: func(get di.Get) interface{} {
+			return eventCache
+		},
+	})
+	lc.Infof("Event cache enabled with max age '%s'", maxAge)
+
+	return true
+}
+
+// initMetadataCache creates the metadata cache and subscribes the system events used to invalidate it
+func initMetadataCache(ctx context.Context, dic *di.Container) bool {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	var ttl time.Duration
+	if len(config.MetadataCache.TTL) > 0 {
+		var err error
+		ttl, err = time.ParseDuration(config.MetadataCache.TTL)
+		if err != nil {
+			lc.Errorf("Failed to parse MetadataCache.TTL configuration value: %v", err)
+			return false
+		}
+	}
+
+	metadataCache := cache.NewMetadataCache(dic, ttl, config.MetadataCache.MaxEntries)
+	if err := messaging.SubscribeSystemEvents(ctx, metadataCache, dic); err != nil {
+		lc.Errorf("Failed to subscribe system events from message bus, %v", err)
+		return false
+	}
+
+	dic.Update(di.ServiceConstructorMap{
+		container.MetadataCacheInterfaceName: func(get di.Get) interface{} {
+			return metadataCache
+		},
+	})
+	lc.Infof("Metadata cache enabled with TTL '%s'", ttl)
+
 	return true
 }
@@ -1,6 +1,6 @@
 /*******************************************************************************
  * Copyright 2020 Dell Inc.
- * Copyright 2022-2023 IOTech Ltd.
+ * Copyright 2022-2026 IOTech Ltd.
  * Copyright 2023 Intel Corporation
  *
  * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
@@ -34,6 +34,8 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/command/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/container"
 	"github.com/edgexfoundry/edgex-go/internal/core/command/controller/messaging"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/embed"
+	pkgHandlers "github.com/edgexfoundry/edgex-go/internal/pkg/bootstrap/handlers"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
@@ -61,6 +63,8 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *echo.Echo, arg
 	})
 
 	httpServer := handlers.NewHttpServer(router, true, common.CoreCommandServiceKey)
+	dbHandler := pkgHandlers.NewDatabase(httpServer, configuration, container.DBClientInterfaceName, embed.SchemaName,
+		common.CoreCommandServiceKey, edgex.Version, embed.SQLFiles)
 
 	bootstrap.Run(
 		ctx,
@@ -75,6 +79,7 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *echo.Echo, arg
 		bootstrapConfig.ServiceTypeOther,
 		[]interfaces.BootstrapHandler{
 			handlers.NewClientsBootstrap().BootstrapHandler,
+			commandSequenceDBBootstrapHandler(dbHandler.BootstrapHandler),
 			MessagingBootstrapHandler,
 			handlers.NewServiceMetrics(common.CoreCommandServiceKey).BootstrapHandler, // Must be after Messaging
 			NewBootstrap(router, common.CoreCommandServiceKey).BootstrapHandler,
@@ -85,6 +90,18 @@ func Main(ctx context.Context, cancel context.CancelFunc, router *echo.Echo, arg
 	// code here!
 }
 
+// commandSequenceDBBootstrapHandler connects to the database storing the command sequences only if the command
+// sequences are enabled, so that core-command runs without the database by default
+func commandSequenceDBBootstrapHandler(dbBootstrapHandler interfaces.BootstrapHandler) interfaces.BootstrapHandler {
+	return func(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
+		if !container.ConfigurationFrom(dic.Get).CommandSequence.Enabled {
+			bootstrapContainer.LoggingClientFrom(dic.Get).Info("Command sequences are not enabled, skip connecting to the database")
+			return true
+		}
+		return dbBootstrapHandler(ctx, wg, startupTimer, dic)
+	}
+}
+
 // MessagingBootstrapHandler sets up the MessageBus and External MQTT connections as well as subscriptions
 func MessagingBootstrapHandler(ctx context.Context, wg *sync.WaitGroup, startupTimer startup.Timer, dic *di.Container) bool {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
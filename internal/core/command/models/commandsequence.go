@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// CommandSequence is an ordered list of steps issued against one or more devices as a single operation
+type CommandSequence struct {
+	models.DBTimestamp
+	Id          string
+	Name        string
+	Description string
+	Labels      []string
+	Steps       []SequenceStep
+}
+
+// SequenceStep is a single step of a CommandSequence, which either issues a set command, issues a get command and
+// asserts the returned readings, or waits for the given duration
+type SequenceStep struct {
+	Name        string
+	Type        string
+	DeviceName  string
+	CommandName string
+	Parameters  map[string]any
+	QueryParams map[string]string
+	Assertions  []StepAssertion
+	Duration    string
+	Timeout     string
+	OnFailure   string
+	// Compensation is the step to run when this step fails and OnFailure is compensate
+	Compensation *SequenceStep
+}
+
+// StepAssertion compares the value of the named reading returned by a get step with the expected value
+type StepAssertion struct {
+	ResourceName string
+	Operator     string
+	Value        string
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2025 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -8,6 +8,7 @@ package command
 
 import (
 	"github.com/edgexfoundry/edgex-go"
+	"github.com/edgexfoundry/edgex-go/internal/core/command/constants"
 	commandController "github.com/edgexfoundry/edgex-go/internal/core/command/controller/http"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/controller"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/handlers"
@@ -29,4 +30,13 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiDeviceByNameRoute, cmd.CommandsByDeviceName, authenticationHook)
 	r.GET(common.ApiDeviceNameCommandNameRoute, cmd.IssueGetCommandByName, authenticationHook)
 	r.PUT(common.ApiDeviceNameCommandNameRoute, cmd.IssueSetCommandByName, authenticationHook)
+
+	// CommandSequence
+	seq := commandController.NewCommandSequenceController(dic)
+	r.POST(constants.ApiCommandSequenceRoute, seq.AddCommandSequence, authenticationHook)
+	r.PATCH(constants.ApiCommandSequenceRoute, seq.PatchCommandSequence, authenticationHook)
+	r.GET(constants.ApiAllCommandSequencesRoute, seq.AllCommandSequences, authenticationHook)
+	r.GET(constants.ApiCommandSequenceByNameRoute, seq.CommandSequenceByName, authenticationHook)
+	r.DELETE(constants.ApiCommandSequenceByNameRoute, seq.DeleteCommandSequenceByName, authenticationHook)
+	r.POST(constants.ApiExecuteCommandSequenceByNameRoute, seq.ExecuteCommandSequenceByName, authenticationHook)
 }
@@ -0,0 +1,245 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common_config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/environment"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/flags"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/secret"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// the subcommands to export the keys of a running core-keeper to a file and import the file into a running core-keeper,
+// e.g. core-common-config-bootstrapper export -cp -key edgex/v4/core-data -file core-data.yaml. The subcommands don't
+// work on the files without core-keeper.
+const (
+	exportCommand = "export"
+	importCommand = "import"
+)
+
+// kvCommandOptions holds the flags of the export and import subcommands
+type kvCommandOptions struct {
+	key            string
+	file           string
+	mode           string
+	dryRun         bool
+	excludeSecrets bool
+}
+
+// kvClient sends the export and import requests to core-keeper
+type kvClient struct {
+	baseUrl      string
+	authInjector interfaces.AuthenticationInjector
+	httpClient   *http.Client
+}
+
+// isKVCommand checks if the first argument is the export or import subcommand
+func isKVCommand(args []string) bool {
+	return len(args) > 0 && (args[0] == exportCommand || args[0] == importCommand)
+}
+
+// runKVCommand runs the export or import subcommand with the remaining arguments and returns the exit code
+func runKVCommand(ctx context.Context, command string, args []string) int {
+	startupTimer := startup.NewStartUpTimer(common.CoreCommonConfigServiceKey)
+
+	var opts kvCommandOptions
+	f := flags.New()
+	f.FlagSet.StringVar(&opts.key, "key", "", "The key prefix to export or import, e.g. edgex/v4/core-data")
+	f.FlagSet.StringVar(&opts.file, "file", "", "The YAML file to write the exported keys to or read the imported keys from, defaults to stdout for export")
+	f.FlagSet.BoolVar(&opts.excludeSecrets, "exclude-secrets", false, "Exclude the secret-looking keys, e.g. the keys containing password or token")
+	if command == importCommand {
+		f.FlagSet.StringVar(&opts.mode, "mode", constants.ImportModeMerge, "The import mode, merge leaves the keys not imported untouched while replace deletes them")
+		f.FlagSet.BoolVar(&opts.dryRun, "dry-run", false, "Print the changes without applying them")
+	}
+	f.Parse(args)
+
+	lc := logger.NewClient(common.CoreCommonConfigServiceKey, models.InfoLog)
+	if opts.key == "" {
+		lc.Errorf("the -key flag is required by the %s command", command)
+		return 1
+	}
+	if command == importCommand && opts.file == "" {
+		lc.Errorf("the -file flag is required by the %s command", command)
+		return 1
+	}
+
+	client, err := newKVClient(ctx, f, startupTimer, lc)
+	if err != nil {
+		lc.Errorf("failed to create the client of core-keeper: %s", err.Error())
+		return 1
+	}
+
+	switch command {
+	case exportCommand:
+		err = runExport(client, opts)
+	case importCommand:
+		err = runImport(client, opts, os.Stdout)
+	}
+	if err != nil {
+		lc.Errorf("failed to %s the keys of %s: %s", command, opts.key, err.Error())
+		return 1
+	}
+	return 0
+}
+
+// newKVClient creates the client of core-keeper from the configuration provider flag, the requests are authenticated
+// with the JWT of the secret provider in secure mode
+func newKVClient(ctx context.Context, f *flags.Default, startupTimer startup.Timer, lc logger.LoggingClient) (*kvClient, error) {
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return lc
+		},
+	})
+	envVars := environment.NewVariables(lc)
+	secretProvider, err := secret.NewSecretProvider(nil, envVars, ctx, startupTimer, dic, common.CoreCommonConfigServiceKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Secret Provider: %w", err)
+	}
+	secretProvider.SetHttpTransport(http.DefaultTransport)
+
+	configProviderInfo, err := config.NewProviderInfo(envVars, f.ConfigProviderUrl())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Provider Info: %w", err)
+	}
+	serviceConfig := configProviderInfo.ServiceConfig()
+
+	return &kvClient{
+		baseUrl:      fmt.Sprintf("%s://%s:%d", serviceConfig.Protocol, serviceConfig.Host, serviceConfig.Port),
+		authInjector: secret.NewJWTSecretProvider(secretProvider),
+		httpClient:   &http.Client{},
+	}, nil
+}
+
+// runExport writes the exported keys to the file or stdout
+func runExport(client *kvClient, opts kvCommandOptions) error {
+	contents, err := client.exportKeys(opts.key, opts.excludeSecrets)
+	if err != nil {
+		return err
+	}
+	if opts.file == "" {
+		_, err = os.Stdout.Write(contents)
+		return err
+	}
+	return os.WriteFile(opts.file, contents, 0600)
+}
+
+// runImport imports the keys from the file and prints the changes to out
+func runImport(client *kvClient, opts kvCommandOptions, out io.Writer) error {
+	contents, err := os.ReadFile(opts.file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.file, err)
+	}
+	changes, err := client.importKeys(opts.key, contents, opts.mode, opts.dryRun, opts.excludeSecrets)
+	if err != nil {
+		return err
+	}
+	printKeyChanges(out, changes, opts.dryRun)
+	return nil
+}
+
+// exportKeys returns the YAML document of the key prefix exported by core-keeper
+func (c *kvClient) exportKeys(key string, excludeSecrets bool) ([]byte, error) {
+	query := url.Values{}
+	query.Set(constants.ExcludeSecrets, strconv.FormatBool(excludeSecrets))
+	req, err := http.NewRequest(http.MethodGet, c.kvUrl(constants.ApiKVExportRoute, key, query), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(common.Accept, common.ContentTypeYAML)
+
+	res, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(res), nil
+}
+
+// importKeys sends the YAML document to core-keeper to be imported under the key prefix and returns the changes
+func (c *kvClient) importKeys(key string, contents []byte, mode string, dryRun bool, excludeSecrets bool) ([]dtos.KVChange, error) {
+	query := url.Values{}
+	query.Set(constants.Mode, mode)
+	query.Set(constants.DryRun, strconv.FormatBool(dryRun))
+	query.Set(constants.ExcludeSecrets, strconv.FormatBool(excludeSecrets))
+	req, err := http.NewRequest(http.MethodPut, c.kvUrl(constants.ApiKVImportRoute, key, query), strings.NewReader(string(contents)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(common.ContentType, common.ContentTypeYAML)
+
+	res, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	var response responses.MultiKVChangesResponse
+	err = json.Unmarshal([]byte(res), &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the import response: %w", err)
+	}
+	return response.Changes, nil
+}
+
+// kvUrl returns the url of the route with the key path parameter and the query, the key is escaped so that a key with
+// slashes matches the single path segment of the key path parameter
+func (c *kvClient) kvUrl(route string, key string, query url.Values) string {
+	path := strings.Replace(route, ":"+constants.Key, url.PathEscape(key), 1)
+	return c.baseUrl + path + "?" + query.Encode()
+}
+
+// send sends the request with the authentication data and returns the response body
+func (c *kvClient) send(req *http.Request) (string, error) {
+	if c.authInjector != nil {
+		if err := c.authInjector.AddAuthenticationData(req); err != nil {
+			return "", err
+		}
+	}
+	res, err := utils.SendRequestAndGetResponse(c.httpClient, req)
+	if err != nil {
+		return "", err
+	}
+	return res, nil
+}
+
+// printKeyChanges prints a line for each change, prefixed with + for the added keys, - for the removed keys and ~ for
+// the modified keys
+func printKeyChanges(out io.Writer, changes []dtos.KVChange, dryRun bool) {
+	if dryRun {
+		_, _ = fmt.Fprintf(out, "dry run, %d change(s) not applied\n", len(changes))
+	} else {
+		_, _ = fmt.Fprintf(out, "%d change(s) applied\n", len(changes))
+	}
+	for _, change := range changes {
+		switch change.Change {
+		case constants.KVChangeAdded:
+			_, _ = fmt.Fprintf(out, "+ %s: %s\n", change.Key, change.ToValue)
+		case constants.KVChangeRemoved:
+			_, _ = fmt.Fprintf(out, "- %s: %s\n", change.Key, change.FromValue)
+		default:
+			_, _ = fmt.Fprintf(out, "~ %s: %s -> %s\n", change.Key, change.FromValue, change.ToValue)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common_config
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/responses"
+)
+
+const (
+	testKey       = "edgex/v4/core-data"
+	testExportDoc = "Writable:\n  LogLevel: INFO\n"
+)
+
+func TestIsKVCommand(t *testing.T) {
+	assert.True(t, isKVCommand([]string{exportCommand, "-key", testKey}))
+	assert.True(t, isKVCommand([]string{importCommand}))
+	assert.False(t, isKVCommand([]string{"-cp"}))
+	assert.False(t, isKVCommand(nil))
+}
+
+func TestKVClient_KvUrl(t *testing.T) {
+	e := echo.New()
+	var routedKey string
+	e.GET(constants.ApiKVExportRoute, func(c echo.Context) error {
+		// the path parameters are decoded by the UrlDecodeMiddleware of the bootstrap in core-keeper
+		var err error
+		routedKey, err = url.PathUnescape(c.Param(constants.Key))
+		if err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusOK)
+	})
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	client := &kvClient{baseUrl: server.URL, httpClient: server.Client()}
+	req, err := http.NewRequest(http.MethodGet, client.kvUrl(constants.ApiKVExportRoute, testKey, url.Values{}), http.NoBody)
+	require.NoError(t, err)
+	res, err := server.Client().Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, testKey, routedKey)
+}
+
+func TestKVClient_ExportKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != common.ApiBase+"/kvs/export/key/"+testKey {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, common.ContentTypeYAML, r.Header.Get(common.Accept))
+		assert.Equal(t, "true", r.URL.Query().Get(constants.ExcludeSecrets))
+		w.Header().Set(common.ContentType, common.ContentTypeYAML)
+		_, _ = w.Write([]byte(testExportDoc))
+	}))
+	defer server.Close()
+
+	client := &kvClient{baseUrl: server.URL, httpClient: server.Client()}
+
+	contents, err := client.exportKeys(testKey, true)
+	require.NoError(t, err)
+	assert.Equal(t, testExportDoc, string(contents))
+
+	_, err = client.exportKeys("not-found", true)
+	assert.Error(t, err)
+}
+
+func TestRunImport(t *testing.T) {
+	changes := []dtos.KVChange{
+		{Key: testKey + "/MaxEventSize", Change: constants.KVChangeRemoved, FromValue: "25000"},
+		{Key: testKey + "/Writable/LogLevel", Change: constants.KVChangeModified, FromValue: "DEBUG", ToValue: "INFO"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, common.ApiBase+"/kvs/import/key/"+testKey, r.URL.Path)
+		assert.Equal(t, common.ContentTypeYAML, r.Header.Get(common.ContentType))
+		assert.Equal(t, constants.ImportModeReplace, r.URL.Query().Get(constants.Mode))
+		assert.Equal(t, "true", r.URL.Query().Get(constants.DryRun))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, testExportDoc, string(body))
+
+		response := responses.NewMultiKVChangesResponse("", "", http.StatusOK, changes)
+		w.Header().Set(common.ContentType, common.ContentTypeJSON)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "core-data.yaml")
+	err := os.WriteFile(file, []byte(testExportDoc), 0600)
+	require.NoError(t, err)
+
+	client := &kvClient{baseUrl: server.URL, httpClient: server.Client()}
+	opts := kvCommandOptions{key: testKey, file: file, mode: constants.ImportModeReplace, dryRun: true}
+	var out bytes.Buffer
+	err = runImport(client, opts, &out)
+	require.NoError(t, err)
+
+	expected := "dry run, 2 change(s) not applied\n" +
+		"- edgex/v4/core-data/MaxEventSize: 25000\n" +
+		"~ edgex/v4/core-data/Writable/LogLevel: DEBUG -> INFO\n"
+	assert.Equal(t, expected, out.String())
+
+	opts.file = filepath.Join(t.TempDir(), "not-found.yaml")
+	err = runImport(client, opts, &out)
+	assert.Error(t, err)
+}
@@ -45,6 +45,11 @@ const (
 )
 
 func Main(ctx context.Context, cancel context.CancelFunc, args []string) {
+	// run the export or import subcommand instead of bootstrapping the common configuration
+	if isKVCommand(args) {
+		os.Exit(runKVCommand(ctx, args[0], args[1:]))
+	}
+
 	startupTimer := startup.NewStartUpTimer(common.CoreCommonConfigServiceKey)
 
 	// All common command-line flags have been moved to DefaultCommonFlags. Service specific flags can be added here,
@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// DiscoveryQuery is the filter of the service instances to discover, the instance must match all the specified
+// conditions
+type DiscoveryQuery struct {
+	// ServiceNamePrefix matches the service name of the instance by prefix, all instances match if it's empty
+	ServiceNamePrefix string
+	// Tags must all be contained in the tags of the instance
+	Tags []string
+	// Metadata must all be contained in the metadata of the instance
+	Metadata map[string]string
+	// Statuses contains the health statuses of the instance to match
+	Statuses []string
+	// Order is either round-robin or random
+	Order string
+}
+
+// maxDiscoveryCursors bounds the round-robin positions kept for the distinct service name prefixes, all the positions
+// are reset once the bound is exceeded, which only restarts the rotation
+const maxDiscoveryCursors = 1024
+
+var (
+	// discoveryCursors keeps the round-robin position of the discovery queries by the service name prefix
+	discoveryCursors     sync.Map
+	discoveryCursorCount atomic.Int64
+)
+
+// DiscoverServiceInstances returns the registered service instances matching the query, in the round-robin or random
+// order for the client-side load balancing
+func DiscoverServiceInstances(query DiscoveryQuery, dic *di.Container) ([]dtos.ServiceInstance, errors.EdgeX) {
+	if query.Order != constants.DiscoveryOrderRoundRobin && query.Order != constants.DiscoveryOrderRandom {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid discovery order %s, must be %s or %s", query.Order, constants.DiscoveryOrderRoundRobin, constants.DiscoveryOrderRandom), nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	registrations, err := dbClient.Registrations()
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	instances, err := dbClient.ServiceInstances()
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	instanceMap := make(map[string]keeperModels.ServiceInstance, len(instances))
+	for _, i := range instances {
+		instanceMap[i.ServiceId] = i
+	}
+
+	var res []dtos.ServiceInstance
+	for _, r := range registrations {
+		// the registration without the discovery information is the only instance of the service named by the serviceId
+		instance, ok := instanceMap[r.ServiceId]
+		if !ok {
+			instance = keeperModels.ServiceInstance{ServiceId: r.ServiceId, ServiceName: r.ServiceId}
+		}
+		if query.matches(r, instance) {
+			res = append(res, dtos.FromServiceInstanceModelToDTO(r, instance))
+		}
+	}
+	if len(res) == 0 {
+		return []dtos.ServiceInstance{}, nil
+	}
+
+	switch query.Order {
+	case constants.DiscoveryOrderRandom:
+		rand.Shuffle(len(res), func(i, j int) {
+			res[i], res[j] = res[j], res[i]
+		})
+	default:
+		// sort the instances first so that the rotation doesn't depend on the order returned from the database
+		slices.SortFunc(res, func(a, b dtos.ServiceInstance) int {
+			return strings.Compare(a.InstanceId, b.InstanceId)
+		})
+		cursor, loaded := discoveryCursors.LoadOrStore(query.ServiceNamePrefix, &atomic.Uint64{})
+		if !loaded && discoveryCursorCount.Add(1) > maxDiscoveryCursors {
+			discoveryCursors.Clear()
+			discoveryCursorCount.Store(0)
+		}
+		start := int((cursor.(*atomic.Uint64).Add(1) - 1) % uint64(len(res)))
+		res = slices.Concat(res[start:], res[:start])
+	}
+
+	return res, nil
+}
+
+func (q DiscoveryQuery) matches(r models.Registration, instance keeperModels.ServiceInstance) bool {
+	if !strings.HasPrefix(instance.ServiceName, q.ServiceNamePrefix) {
+		return false
+	}
+	if !slices.Contains(q.Statuses, r.Status) {
+		return false
+	}
+	for _, tag := range q.Tags {
+		if !slices.Contains(instance.Tags, tag) {
+			return false
+		}
+	}
+	for k, v := range q.Metadata {
+		if value, ok := instance.Metadata[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
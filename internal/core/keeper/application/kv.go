@@ -7,8 +7,11 @@ package application
 
 import (
 	"context"
+	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/utils"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 
@@ -35,34 +38,122 @@ func Keys(key string, keysOnly bool, isRaw bool, dic *di.Container) (configs []m
 	return configs, nil
 }
 
-func AddKeys(kv models.KVS, isFlatten bool, dic *di.Container) (keys []models.KeyOnly, err errors.EdgeX) {
+// AddKeys stores the value in the specified key, if cas is not nil, the value is only stored when the modify index of
+// the key equals to cas
+func AddKeys(kv models.KVS, isFlatten bool, cas *uint64, dic *di.Container) (keys []models.KeyOnly, err errors.EdgeX) {
 	err = utils.ValidateKeys(kv.Key)
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
 
 	dbClient := container.DBClientFrom(dic.Get)
-	keys, err = dbClient.AddKeeperKeys(kv, isFlatten)
+	if cas != nil {
+		keys, err = dbClient.KeeperTxn([]keeperModels.KVTxnOp{
+			{Verb: constants.TxnVerbCheckIndex, Key: kv.Key, Index: *cas},
+			{Verb: constants.TxnVerbSet, Key: kv.Key, Value: kv.Value, Flatten: isFlatten},
+		})
+	} else {
+		keys, err = dbClient.AddKeeperKeys(kv, isFlatten)
+	}
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
 	}
+	notifyKeyChange(dic)
 	return keys, nil
 }
 
-func DeleteKeys(key string, prefixMatch bool, dic *di.Container) (keys []models.KeyOnly, err errors.EdgeX) {
+// DeleteKeys deletes the specified key or the keys with the same prefix, if cas is not nil, the key is only deleted
+// when the modify index of the key equals to cas
+func DeleteKeys(key string, prefixMatch bool, cas *uint64, dic *di.Container) (keys []models.KeyOnly, err errors.EdgeX) {
 	err = utils.ValidateKeys(key)
 	if err != nil {
 		return keys, errors.NewCommonEdgeXWrapper(err)
 	}
 
 	dbClient := container.DBClientFrom(dic.Get)
-	keys, err = dbClient.DeleteKeeperKeys(key, prefixMatch)
+	if cas != nil {
+		keys, err = dbClient.KeeperTxn([]keeperModels.KVTxnOp{
+			{Verb: constants.TxnVerbCheckIndex, Key: key, Index: *cas},
+			{Verb: constants.TxnVerbDelete, Key: key, PrefixMatch: prefixMatch},
+		})
+	} else {
+		keys, err = dbClient.DeleteKeeperKeys(key, prefixMatch)
+	}
 	if err != nil {
 		return keys, errors.NewCommonEdgeXWrapper(err)
 	}
+	notifyKeyChange(dic)
+	return keys, nil
+}
+
+// ApplyTxn applies the operations atomically, none of the operations is applied if any of them fails
+func ApplyTxn(ops []keeperModels.KVTxnOp, dic *di.Container) (keys []models.KeyOnly, err errors.EdgeX) {
+	for _, op := range ops {
+		err = utils.ValidateKeys(op.Key)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	keys, err = dbClient.KeeperTxn(ops)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	notifyKeyChange(dic)
 	return keys, nil
 }
 
+// WaitKeys blocks until the modify index of the specified key or the keys with the same key prefix differs from the
+// passed index, the wait time elapses or the ctx is done, and returns the current modify index
+func WaitKeys(ctx context.Context, key string, index uint64, wait time.Duration, dic *di.Container) (uint64, errors.EdgeX) {
+	err := utils.ValidateKeys(key)
+	if err != nil {
+		return 0, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	watcher := container.KVWatcherFrom(dic.Get)
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	ticker := time.NewTicker(constants.WatchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// get the change channel before querying the index, so that a change in between is not missed
+		var changed <-chan struct{}
+		if watcher != nil {
+			changed = watcher.Changed()
+		}
+
+		current, err := dbClient.KeeperKeysIndex(key)
+		if err != nil {
+			return 0, errors.NewCommonEdgeXWrapper(err)
+		}
+		// the index moving backwards means the keys were restored from elsewhere, which is also a change to the caller
+		if current != index {
+			return current, nil
+		}
+
+		select {
+		case <-changed:
+		case <-ticker.C:
+		case <-timer.C:
+			return current, nil
+		case <-ctx.Done():
+			return current, nil
+		}
+	}
+}
+
+// notifyKeyChange wakes up the blocking queries waiting for the key changes
+func notifyKeyChange(dic *di.Container) {
+	if watcher := container.KVWatcherFrom(dic.Get); watcher != nil {
+		watcher.Notify()
+	}
+}
+
 // PublishKeyChange publishes any key value changes through MessageClient
 func PublishKeyChange(data models.KVS, key string, ctx context.Context, dic *di.Container) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
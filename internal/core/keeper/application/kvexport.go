@@ -0,0 +1,193 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/spf13/cast"
+)
+
+// ExportKeys returns the values of the specified key or the keys with the same key prefix as a nested map relative to
+// the key, which is the same shape of the value to be stored with flatten enabled. The value of the key itself is
+// returned if the key has no child keys.
+func ExportKeys(key string, excludeSecrets bool, dic *di.Container) (any, errors.EdgeX) {
+	err := utils.ValidateKeys(key)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	values, err := currentKeyValues(key, excludeSecrets, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(values) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("no key starting with '%s' found", key), nil)
+	}
+	return buildKeyTree(key, values)
+}
+
+// ImportKeys stores the nested map value under the specified key prefix and returns the changes of the keys. With the
+// replace mode, the keys with the same key prefix which are not imported are deleted. With dryRun enabled, the changes
+// are returned without being applied. All the changes are applied atomically.
+func ImportKeys(key string, value any, replace bool, dryRun bool, excludeSecrets bool, dic *di.Container) ([]keeperModels.KVChange, errors.EdgeX) {
+	err := utils.ValidateKeys(key)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	imported := make(map[string]string)
+	err = flattenKeyValues(key, value, imported)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	for k := range imported {
+		if excludeSecrets && isSecretKey(k) {
+			delete(imported, k)
+			continue
+		}
+		err = utils.ValidateKeys(k)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
+	current, err := currentKeyValues(key, excludeSecrets, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	changes := diffKeyValues(current, imported)
+	if !replace {
+		merged := make([]keeperModels.KVChange, 0, len(changes))
+		for _, change := range changes {
+			if change.Change != constants.KVChangeRemoved {
+				merged = append(merged, change)
+			}
+		}
+		changes = merged
+	}
+	if dryRun || len(changes) == 0 {
+		return changes, nil
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	_, err = dbClient.KeeperTxn(changeTxnOps(changes))
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	notifyKeyChange(dic)
+	return changes, nil
+}
+
+// currentKeyValues returns the raw values of the specified key and the keys with the same key prefix, an empty map is
+// returned if no key is found
+func currentKeyValues(key string, excludeSecrets bool, dic *di.Container) (map[string]string, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	kvs, err := dbClient.KeeperKeys(key, false, true)
+	if err != nil {
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			return map[string]string{}, nil
+		}
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	values := make(map[string]string, len(kvs))
+	for _, kvResp := range kvs {
+		kv, ok := kvResp.(*models.KVS)
+		if !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("unexpected type %T of key '%s'", kvResp, key), nil)
+		}
+		if excludeSecrets && isSecretKey(kv.Key) {
+			continue
+		}
+		values[kv.Key] = cast.ToString(kv.Value)
+	}
+	return values, nil
+}
+
+// isSecretKey checks if any part of the key looks like a secret, e.g. Writable/InsecureSecrets/DB/password
+func isSecretKey(key string) bool {
+	return constants.SecretKeyRegex.MatchString(key)
+}
+
+// buildKeyTree converts the values of the keys to a nested map relative to the key prefix
+func buildKeyTree(prefix string, values map[string]string) (any, errors.EdgeX) {
+	if value, ok := values[prefix]; ok {
+		if len(values) > 1 {
+			return nil, errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("key '%s' has both a value and child keys", prefix), nil)
+		}
+		return value, nil
+	}
+
+	tree := make(map[string]any)
+	for k, value := range values {
+		segments := strings.Split(strings.TrimPrefix(k, prefix+constants.KeyDelimiter), constants.KeyDelimiter)
+		node := tree
+		for i, segment := range segments {
+			if i == len(segments)-1 {
+				if _, exists := node[segment]; exists {
+					return nil, errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("key '%s' has both a value and child keys", k), nil)
+				}
+				node[segment] = value
+				break
+			}
+			child, exists := node[segment]
+			if !exists {
+				child = make(map[string]any)
+				node[segment] = child
+			}
+			childMap, ok := child.(map[string]any)
+			if !ok {
+				return nil, errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("key '%s' has both a value and child keys", path.Join(append([]string{prefix}, segments[:i+1]...)...)), nil)
+			}
+			node = childMap
+		}
+	}
+	return tree, nil
+}
+
+// flattenKeyValues converts the nested map value to the raw values of the keys under the key prefix, the same as
+// storing the value with flatten enabled
+func flattenKeyValues(prefix string, value any, values map[string]string) errors.EdgeX {
+	switch v := value.(type) {
+	case map[string]any:
+		for innerKey, element := range v {
+			// if the element type is an empty map, do not add the inner key
+			if eleMap, ok := element.(map[string]any); ok && len(eleMap) == 0 {
+				continue
+			}
+			err := flattenKeyValues(path.Join(prefix, innerKey), element, values)
+			if err != nil {
+				return err
+			}
+		}
+	case []any:
+		// the same as storing a key with array data type, covert the array to string with brackets and commas
+		valueBytes, err := json.Marshal(v)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unable to encode the value of key '%s'", prefix), err)
+		}
+		values[prefix] = string(valueBytes)
+	case nil, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, string:
+		values[prefix] = cast.ToString(v)
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported value type %T of key '%s'", value, prefix), nil)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sort"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/utils"
+	pkgUtils "github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+// KeysHistory returns the revisions of the specified key or the keys with the same key prefix within the time range,
+// the newest revision comes first
+func KeysHistory(key string, start int64, end int64, offset int, limit int, dic *di.Container) (revisions []keeperModels.KVRevision, totalCount int64, err errors.EdgeX) {
+	err = utils.ValidateKeys(key)
+	if err != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err = dbClient.KeeperKeysHistoryCount(key, start, end)
+	if err != nil {
+		return nil, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := pkgUtils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []keeperModels.KVRevision{}, totalCount, err
+	}
+
+	revisions, err = dbClient.KeeperKeysHistory(key, start, end, offset, limit)
+	if err != nil {
+		return nil, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return revisions, totalCount, nil
+}
+
+// DiffKeys returns the changes of the specified key or the keys with the same key prefix between the from and to
+// timestamps, the changes are sorted by key
+func DiffKeys(key string, from int64, to int64, dic *di.Container) ([]keeperModels.KVChange, errors.EdgeX) {
+	err := utils.ValidateKeys(key)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if to < from {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "to must be greater than from", nil)
+	}
+
+	fromState, err := keysStateAt(key, from, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	toState, err := keysStateAt(key, to, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return diffKeysState(fromState, toState), nil
+}
+
+// RestoreKeys atomically rolls the specified key or the keys with the same key prefix back to the values at the
+// timestamp, and returns the applied changes. The keys never changed since the history started are left untouched.
+// The restore fails with a status conflict error if any of the keys is changed by another request in between.
+func RestoreKeys(key string, timestamp int64, dic *di.Container) ([]keeperModels.KVChange, errors.EdgeX) {
+	err := utils.ValidateKeys(key)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	targetState, err := keysStateAt(key, timestamp, dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	currentState, err := keysStateAt(key, time.Now().UnixMilli(), dic)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	changes := diffKeysState(currentState, targetState)
+	if len(changes) == 0 {
+		return changes, nil
+	}
+
+	// guard every changed key with its current modify index
+	var ops []keeperModels.KVTxnOp
+	for _, change := range changes {
+		var index uint64
+		if current, ok := currentState[change.Key]; ok && current.Action == constants.TxnVerbSet {
+			index = current.ModifyIndex
+		}
+		ops = append(ops, keeperModels.KVTxnOp{Verb: constants.TxnVerbCheckIndex, Key: change.Key, Index: index})
+	}
+	ops = append(ops, changeTxnOps(changes)...)
+
+	dbClient := container.DBClientFrom(dic.Get)
+	_, err = dbClient.KeeperTxn(ops)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	notifyKeyChange(dic)
+	return changes, nil
+}
+
+// changeTxnOps returns the set and delete operations to apply the changes, the keys are deleted before setting the
+// others so that an upper level key removed by the changes can be set again
+func changeTxnOps(changes []keeperModels.KVChange) []keeperModels.KVTxnOp {
+	var deletes, sets []keeperModels.KVTxnOp
+	for _, change := range changes {
+		if change.Change == constants.KVChangeRemoved {
+			deletes = append(deletes, keeperModels.KVTxnOp{Verb: constants.TxnVerbDelete, Key: change.Key})
+		} else {
+			sets = append(sets, keeperModels.KVTxnOp{Verb: constants.TxnVerbSet, Key: change.Key, Value: change.ToValue})
+		}
+	}
+	return append(deletes, sets...)
+}
+
+// keysStateAt returns the latest revision at the timestamp of each key which equals to or starts with the key prefix,
+// the keys without any revision at the timestamp are not included
+func keysStateAt(key string, timestamp int64, dic *di.Container) (map[string]keeperModels.KVRevision, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	revisions, err := dbClient.KeeperKeysHistory(key, 0, timestamp, 0, -1)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	state := make(map[string]keeperModels.KVRevision)
+	// the newest revision comes first
+	for _, revision := range revisions {
+		if _, ok := state[revision.Key]; !ok {
+			state[revision.Key] = revision
+		}
+	}
+	return state, nil
+}
+
+// diffKeysState returns the changes of the keys from the from state to the to state, a key with the latest revision
+// of delete is regarded as not existing
+func diffKeysState(from map[string]keeperModels.KVRevision, to map[string]keeperModels.KVRevision) []keeperModels.KVChange {
+	return diffKeyValues(stateValues(from), stateValues(to))
+}
+
+// stateValues returns the values of the existing keys in the state
+func stateValues(state map[string]keeperModels.KVRevision) map[string]string {
+	values := make(map[string]string, len(state))
+	for k, revision := range state {
+		if revision.Action == constants.TxnVerbSet {
+			values[k] = revision.Value
+		}
+	}
+	return values
+}
+
+// diffKeyValues returns the changes of the keys from the from values to the to values, the changes are sorted by key
+func diffKeyValues(from map[string]string, to map[string]string) []keeperModels.KVChange {
+	changes := make([]keeperModels.KVChange, 0)
+	for k, fromValue := range from {
+		toValue, ok := to[k]
+		if !ok {
+			changes = append(changes, keeperModels.KVChange{Key: k, Change: constants.KVChangeRemoved, FromValue: fromValue})
+		} else if fromValue != toValue {
+			changes = append(changes, keeperModels.KVChange{Key: k, Change: constants.KVChangeModified, FromValue: fromValue, ToValue: toValue})
+		}
+	}
+	for k, toValue := range to {
+		if _, ok := from[k]; !ok {
+			changes = append(changes, keeperModels.KVChange{Key: k, Change: constants.KVChangeAdded, ToValue: toValue})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
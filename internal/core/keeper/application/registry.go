@@ -1,23 +1,55 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package application
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 )
 
-func AddRegistration(r models.Registration, dic *di.Container) errors.EdgeX {
+// validateHealthCheck rejects the exec health check running an executable not allowed by HealthCheck.ExecAllowlist
+func validateHealthCheck(r models.Registration, dic *di.Container) errors.EdgeX {
+	if !strings.EqualFold(r.HealthCheck.Type, constants.HealthCheckTypeExec) {
+		return nil
+	}
+	config := container.ConfigurationFrom(dic.Get)
+	if !config.HealthCheck.ExecCommandAllowed(r.HealthCheck.Path) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the exec health check command '%s' is not allowed by HealthCheck.ExecAllowlist", r.HealthCheck.Path), nil)
+	}
+	return nil
+}
+
+func AddRegistration(r models.Registration, instance keeperModels.ServiceInstance, dic *di.Container) errors.EdgeX {
+	err := validateHealthCheck(r, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
 	dbClient := container.DBClientFrom(dic.Get)
-	r, err := dbClient.AddRegistration(r)
+	r, err = dbClient.AddRegistration(r)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.UpsertServiceInstance(instance)
 	if err != nil {
+		// roll back the registration so that the service can register again
+		if rollbackErr := dbClient.DeleteRegistrationByServiceId(r.ServiceId); rollbackErr != nil {
+			bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("failed to roll back the registration of service %s: %v", r.ServiceId, rollbackErr)
+		}
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
@@ -27,12 +59,30 @@ func AddRegistration(r models.Registration, dic *di.Container) errors.EdgeX {
 	return nil
 }
 
-func UpdateRegistration(r models.Registration, dic *di.Container) errors.EdgeX {
+func UpdateRegistration(r models.Registration, instance keeperModels.ServiceInstance, dic *di.Container) errors.EdgeX {
+	err := validateHealthCheck(r, dic)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
 	dbClient := container.DBClientFrom(dic.Get)
-	err := dbClient.UpdateRegistration(r)
+	old, err := dbClient.RegistrationByServiceId(r.ServiceId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.UpdateRegistration(r)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.UpsertServiceInstance(instance)
 	if err != nil {
+		// roll back the registration so that it stays consistent with the discovery information
+		if rollbackErr := dbClient.UpdateRegistration(old); rollbackErr != nil {
+			bootstrapContainer.LoggingClientFrom(dic.Get).Errorf("failed to roll back the registration of service %s: %v", r.ServiceId, rollbackErr)
+		}
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	RecordStatusTransition(r.ServiceId, old.Status, r.Status, context.Background(), dic)
 
 	registry := container.RegistryFrom(dic.Get)
 	// remove the old service health check runner first, and then create a new one based on the updated registry
@@ -51,10 +101,20 @@ func DeleteRegistration(id string, dic *di.Container) errors.EdgeX {
 	}
 
 	dbClient := container.DBClientFrom(dic.Get)
-	err := dbClient.DeleteRegistrationByServiceId(id)
+	r, err := dbClient.RegistrationByServiceId(id)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	err = dbClient.DeleteRegistrationByServiceId(id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteServiceInstanceByServiceId(id)
+	if err != nil {
+		// the service instance without the registration is never discovered, so only log the error
+		bootstrapContainer.LoggingClientFrom(dic.Get).Warnf("failed to delete the discovery information of service %s: %v", id, err)
+	}
+	RecordStatusTransition(id, r.Status, models.Halt, context.Background(), dic)
 
 	registry := container.RegistryFrom(dic.Get)
 	registry.DeregisterByServiceId(id)
@@ -104,3 +164,23 @@ func RegistrationByServiceId(id string, dic *di.Container) (dtos.Registration, e
 
 	return dtos.FromRegistrationModelToDTO(r), nil
 }
+
+// Heartbeat passes the heartbeat with the reported status to the TTL health check of a registered service
+func Heartbeat(id string, status string, dic *di.Container) errors.EdgeX {
+	if id == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "serviceId is empty", nil)
+	}
+	switch status {
+	case models.Up, constants.Warning, models.Down:
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid heartbeat status %s, must be one of %s, %s and %s", status, models.Up, constants.Warning, models.Down), nil)
+	}
+
+	registry := container.RegistryFrom(dic.Get)
+	err := registry.Heartbeat(id, status)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	contractDTOs "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+	pkgUtils "github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+)
+
+// RecordStatusTransition records the health status transition of a registered service into the registry history,
+// publishes it as a system event and sends a notification asynchronously if enabled, so that the health checks are not
+// blocked by support-notifications, nothing is done if the status is not changed
+func RecordStatusTransition(serviceId, fromStatus, toStatus string, ctx context.Context, dic *di.Container) {
+	if fromStatus == toStatus {
+		return
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	dbClient := container.DBClientFrom(dic.Get)
+	transition, err := dbClient.AddStatusTransition(keeperModels.StatusTransition{
+		ServiceId:  serviceId,
+		FromStatus: fromStatus,
+		ToStatus:   toStatus,
+	})
+	if err != nil {
+		lc.Errorf("failed to record the status transition of service %s from %s to %s: %v", serviceId, fromStatus, toStatus, err)
+		return
+	}
+	lc.Infof("service %s status changed from %s to %s", serviceId, fromStatus, toStatus)
+
+	dto := dtos.FromStatusTransitionModelToDTO(transition)
+	publishStatusTransition(dto, ctx, dic)
+
+	configuration := container.ConfigurationFrom(dic.Get)
+	if configuration.HealthCheck.SendNotifications {
+		go sendStatusTransitionNotification(dto, ctx, dic)
+	}
+}
+
+// publishStatusTransition publishes the status transition as a system event to the topic
+// <SystemEventBaseTopic>/system-events/core-keeper/registration/<action>/<serviceId>
+func publishStatusTransition(transition dtos.StatusTransition, ctx context.Context, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	messagingClient := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if messagingClient == nil {
+		lc.Errorf("unable to publish the status transition of service %s: messaging client is not available", transition.ServiceId)
+		return
+	}
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	// a service changed to HALT has been deregistered
+	action := common.SystemEventActionUpdate
+	if transition.ToStatus == models.Halt {
+		action = common.SystemEventActionDelete
+	}
+	systemEvent := contractDTOs.NewSystemEvent(constants.RegistrationSystemEventType, action, constants.CoreKeeperServiceKey, transition.ServiceId, nil, transition)
+
+	publishTopic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+		SetPath(configuration.HealthCheck.SystemEventBaseTopic).SetPath(common.SystemEventPublishTopic).
+		SetPath(systemEvent.Source).SetPath(systemEvent.Type).SetPath(systemEvent.Action).SetNameFieldPath(systemEvent.Owner).BuildPath()
+
+	// make sure the Content Type is set appropriate if payload is required to be encoded
+	ctx = context.WithValue(ctx, common.ContentType, common.ContentTypeJSON) //nolint: staticcheck
+	envelope := msgTypes.NewMessageEnvelope(systemEvent, ctx)
+	if err := messagingClient.Publish(envelope, publishTopic); err != nil {
+		lc.Errorf("unable to publish the status transition of service %s to topic %s: %v", transition.ServiceId, publishTopic, err)
+		return
+	}
+	lc.Debugf("published the status transition of service %s to topic %s", transition.ServiceId, publishTopic)
+}
+
+// sendStatusTransitionNotification sends the status transition to support-notifications, the severity is critical
+// when the service is down
+func sendStatusTransitionNotification(transition dtos.StatusTransition, ctx context.Context, dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	client := bootstrapContainer.NotificationClientFrom(dic.Get)
+	if client == nil {
+		lc.Errorf("unable to send the status transition notification of service %s: support-notifications is not configured in Clients", transition.ServiceId)
+		return
+	}
+	configuration := container.ConfigurationFrom(dic.Get)
+
+	severity := models.Normal
+	if transition.ToStatus == models.Down {
+		severity = models.Critical
+	}
+	content := fmt.Sprintf("service %s status changed from %s to %s", transition.ServiceId, transition.FromStatus, transition.ToStatus)
+	notification := contractDTOs.NewNotification([]string{constants.RegistrationSystemEventType, transition.ServiceId, transition.ToStatus},
+		configuration.HealthCheck.NotificationCategory, content, constants.CoreKeeperServiceKey, severity)
+
+	if _, err := client.SendNotification(ctx, []requests.AddNotificationRequest{requests.NewAddNotificationRequest(notification)}); err != nil {
+		lc.Errorf("failed to send the status transition notification of service %s: %v", transition.ServiceId, err)
+	}
+}
+
+// StatusTransitions returns the health status transitions of a registered service within the time range, the newest
+// transition comes first
+func StatusTransitions(serviceId string, start, end int64, offset, limit int, dic *di.Container) ([]dtos.StatusTransition, int64, errors.EdgeX) {
+	if serviceId == "" {
+		return nil, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "serviceId is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err := dbClient.StatusTransitionCountByServiceId(serviceId, start, end)
+	if err != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := pkgUtils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dtos.StatusTransition{}, totalCount, err
+	}
+
+	transitions, err := dbClient.StatusTransitionsByServiceId(serviceId, start, end, offset, limit)
+	if err != nil {
+		return nil, 0, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return dtos.FromStatusTransitionModelsToDTOs(transitions), totalCount, nil
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+)
+
+var asyncPurgeHistoryOnce sync.Once
+
+// AsyncPurgeHistory purges the expired history by the interval until the context is done
+func AsyncPurgeHistory(ctx context.Context, dic *di.Container, interval time.Duration) {
+	asyncPurgeHistoryOnce.Do(func() {
+		go func() {
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			timer := time.NewTimer(interval)
+			for {
+				timer.Reset(interval)
+				select {
+				case <-ctx.Done():
+					lc.Info("Exiting history retention")
+					return
+				case <-timer.C:
+					lc.Info("Start purging the expired history according to the retention settings")
+					err := purgeHistory(dic)
+					if err != nil {
+						lc.Errorf("Failed to purge the expired history, %v", err)
+					}
+				}
+			}
+		}()
+	})
+}
+
+// purgeHistory deletes the history older than the max age of the retention settings
+func purgeHistory(dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	kvMaxAge, err := time.ParseDuration(config.Retention.KVMaxAge)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the KV history max age '%s'", config.Retention.KVMaxAge), err)
+	}
+	edgeXerr := dbClient.DeleteKeeperKeysHistoryByAge(kvMaxAge.Milliseconds())
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("failed to delete the KV history by age '%d'", kvMaxAge.Milliseconds()), edgeXerr)
+	}
+
+	statusMaxAge, err := time.ParseDuration(config.Retention.StatusMaxAge)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the registry history max age '%s'", config.Retention.StatusMaxAge), err)
+	}
+	edgeXerr = dbClient.DeleteStatusTransitionsByAge(statusMaxAge.Milliseconds())
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("failed to delete the registry history by age '%d'", statusMaxAge.Milliseconds()), edgeXerr)
+	}
+	return nil
+}
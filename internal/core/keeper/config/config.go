@@ -1,20 +1,25 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package config
 
 import (
+	"slices"
+	"strings"
+
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
 )
 
 type ConfigurationStruct struct {
-	Writable   WritableInfo
-	MessageBus bootstrapConfig.MessageBusInfo
-	Clients    bootstrapConfig.ClientsCollection
-	Database   bootstrapConfig.Database
-	Service    bootstrapConfig.ServiceInfo
+	Writable    WritableInfo
+	MessageBus  bootstrapConfig.MessageBusInfo
+	Clients     bootstrapConfig.ClientsCollection
+	Database    bootstrapConfig.Database
+	Service     bootstrapConfig.ServiceInfo
+	HealthCheck HealthCheckInfo
+	Retention   HistoryRetention
 }
 
 type WritableInfo struct {
@@ -23,6 +28,45 @@ type WritableInfo struct {
 	Telemetry       bootstrapConfig.TelemetryInfo
 }
 
+// HealthCheckInfo defines the health check behavior of the registered services
+type HealthCheckInfo struct {
+	// FailureThreshold is the number of consecutive failed health checks before an UP service is flipped to DOWN
+	FailureThreshold int
+	// SuccessThreshold is the number of consecutive successful health checks before a DOWN service is flipped to UP
+	SuccessThreshold int
+	// WarningStatusCodes are the HTTP status codes which indicate the service is up but degraded
+	WarningStatusCodes []int
+	// ExecAllowlist are the executables allowed to be run by the exec health check on the host of core-keeper, e.g.
+	// /usr/local/bin/check-db. The exec health check is disabled if empty, and the registrations with an exec health
+	// check running any other executable are rejected.
+	ExecAllowlist []string
+	// SystemEventBaseTopic is the base topic to publish the status changes of the registered services as system
+	// events, which differs from MessageBus.BaseTopicPrefix used for the key changes
+	SystemEventBaseTopic string
+	// SendNotifications also sends the status changes to support-notifications, which must be configured in Clients
+	SendNotifications bool
+	// NotificationCategory is the category of the notifications sent for the status changes
+	NotificationCategory string
+}
+
+// ExecCommandAllowed checks if the executable of the exec health check command is in the ExecAllowlist
+func (h HealthCheckInfo) ExecCommandAllowed(command string) bool {
+	args := strings.Fields(command)
+	return len(args) > 0 && slices.Contains(h.ExecAllowlist, args[0])
+}
+
+// HistoryRetention defines the purging of the expired KV history and registry history
+type HistoryRetention struct {
+	Enabled bool
+	// Interval is the interval of purging the expired history, e.g. "1h"
+	Interval string
+	// KVMaxAge is the age of the revisions of the keys to be purged, e.g. "720h". The latest revision of each existing
+	// key is kept so that the keys can be restored to any time within the age.
+	KVMaxAge string
+	// StatusMaxAge is the age of the health status transitions of the registered services to be purged, e.g. "720h"
+	StatusMaxAge string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
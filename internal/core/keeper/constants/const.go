@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024-2025 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -7,6 +7,7 @@ package constants
 
 import (
 	"regexp"
+	"time"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 )
@@ -17,26 +18,112 @@ const CoreKeeperServiceKey = "core-keeper"
 // KeyAllowedCharsRegexString defined the characters allowed in the key name
 const KeyAllowedCharsRegexString = "^[a-zA-Z0-9-_~;=./%]+$"
 
+// SecretKeyRegexString defined the key names regarded as secrets, which can be excluded from the KV export and import
+const SecretKeyRegexString = "(?i)(password|passwd|secret|token|credential|apikey|api_key|privatekey|private_key)"
+
 var (
 	KeyAllowedCharsRegex = regexp.MustCompile(KeyAllowedCharsRegexString)
+	SecretKeyRegex       = regexp.MustCompile(SecretKeyRegexString)
 )
 
 // key delimiter for edgex keeper
 const KeyDelimiter = "/"
 
 // Constants related to defined routes in the v3 service APIs
-const ApiKVRoute = common.ApiBase + "/kvs/" + Key + "/{" + Key + ":.*}"
+const ApiKVTxnRoute = common.ApiBase + "/kvs/txn"
+const ApiKVHistoryRoute = common.ApiBase + "/kvs/history/" + Key + "/:" + Key
+const ApiKVDiffRoute = common.ApiBase + "/kvs/diff/" + Key + "/:" + Key
+const ApiKVRestoreRoute = common.ApiBase + "/kvs/restore/" + Key + "/:" + Key
+const ApiKVExportRoute = common.ApiBase + "/kvs/export/" + Key + "/:" + Key
+const ApiKVImportRoute = common.ApiBase + "/kvs/import/" + Key + "/:" + Key
 const ApiRegisterRoute = common.ApiBase + "/registry"
 const ApiAllRegistrationsRoute = ApiRegisterRoute + "/" + common.All
-const ApiRegistrationByServiceIdRoute = ApiRegisterRoute + "/" + ServiceId + "/{" + ServiceId + "}"
+const ApiRegistrationDiscoveryRoute = ApiRegisterRoute + "/discovery"
+const ApiRegistrationHeartbeatRoute = ApiRegisterRoute + "/:" + ServiceId + "/heartbeat"
+const ApiRegistrationHistoryRoute = ApiRegisterRoute + "/history/" + ServiceId + "/:" + ServiceId
 
 // Constants related to defined url path names and parameters in the v2 service APIs
 const (
-	Flatten      = "flatten"
-	Key          = "key"
-	KeyOnly      = "keyOnly"
-	Plaintext    = "plaintext"
-	PrefixMatch  = "prefixMatch"
-	ServiceId    = "serviceId"
-	Deregistered = "deregistered"
+	CAS            = "cas"
+	DryRun         = "dryRun"
+	ExcludeSecrets = "excludeSecrets"
+	Flatten        = "flatten"
+	From           = "from"
+	Index          = "index"
+	Key            = "key"
+	KeyOnly        = "keyOnly"
+	Metadata       = "metadata"
+	Mode           = "mode"
+	Order          = "order"
+	Plaintext      = "plaintext"
+	PrefixMatch    = "prefixMatch"
+	ServiceId      = "serviceId"
+	ServiceName    = "serviceName"
+	Status         = "status"
+	Deregistered   = "deregistered"
+	Tags           = "tags"
+	Timestamp      = "timestamp"
+	To             = "to"
+	Wait           = "wait"
+)
+
+// Constants related to the operation verbs of a KV transaction
+const (
+	TxnVerbSet        = "set"
+	TxnVerbDelete     = "delete"
+	TxnVerbCheckIndex = "check-index"
+)
+
+// Constants related to the changes of a key between two points in time
+const (
+	KVChangeAdded    = "added"
+	KVChangeRemoved  = "removed"
+	KVChangeModified = "modified"
+)
+
+// Constants related to the modes of importing the keys
+const (
+	// ImportModeMerge adds and updates the imported keys and leaves the other keys untouched
+	ImportModeMerge = "merge"
+	// ImportModeReplace also deletes the keys with the same key prefix which are not imported
+	ImportModeReplace = "replace"
+)
+
+// Constants related to watching the key changes with blocking queries or server-sent events
+const (
+	IndexHeader            = "X-Keeper-Index"
+	LastEventIdHeader      = "Last-Event-ID"
+	ContentTypeEventStream = "text/event-stream"
+	KVChangeEvent          = "kv"
+	// WatchResponseMargin is reserved from the service request timeout so that a watch responds before being timed out
+	WatchResponseMargin = time.Second
+	// WatchPollInterval is the interval to recheck the modify index in case the key is changed by another keeper instance
+	WatchPollInterval = time.Second
+	// KVTombstoneRetention is how long the modify index of a deleted key is kept, which is far beyond the wait time of
+	// a blocking query
+	KVTombstoneRetention = time.Hour
+)
+
+// Constants related to the health check types and statuses of the registered services
+const (
+	HealthCheckTypeHttp  = "http"
+	HealthCheckTypeHttps = "https"
+	HealthCheckTypeTcp   = "tcp"
+	HealthCheckTypeGrpc  = "grpc"
+	HealthCheckTypeExec  = "exec"
+	// HealthCheckTypeTtl marks the service DOWN if no heartbeat arrives within the TTL, which is the health check interval
+	HealthCheckTypeTtl = "ttl"
+	// Warning indicates that the service is up but degraded
+	Warning = "WARNING"
+	// RegistrationSystemEventType is the system event type of the status changes of the registered services
+	RegistrationSystemEventType = "registration"
+)
+
+// Constants related to the order of the service instances returned by the discovery query
+const (
+	// DiscoveryOrderRoundRobin rotates the instances by one position on each query of the same service name
+	DiscoveryOrderRoundRobin = "roundrobin"
+	DiscoveryOrderRandom     = "random"
+	// MetadataSeparator separates the key and value of a metadata filter of the discovery query
+	MetadataSeparator = ":"
 )
@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/watch"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+)
+
+// KVWatcherName contains the name of the watch.Watcher implementation in the DIC.
+var KVWatcherName = di.TypeInstanceToName((*watch.Watcher)(nil))
+
+// KVWatcherFrom helper function queries the DIC and returns the watch.Watcher implementation, nil is returned if
+// the watcher is not registered.
+func KVWatcherFrom(get di.Get) *watch.Watcher {
+	watcher, ok := get(KVWatcherName).(*watch.Watcher)
+	if !ok {
+		return nil
+	}
+	return watcher
+}
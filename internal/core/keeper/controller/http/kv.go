@@ -6,20 +6,36 @@
 package http
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+	keeperRequests "github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/requests"
+	keeperResponses "github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/responses"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 	kpContrUtils "github.com/edgexfoundry/edgex-go/internal/core/keeper/utils"
 	edgexIO "github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/labstack/echo/v4"
@@ -54,6 +70,28 @@ func (rc *KVController) Keys(c echo.Context) error {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
 
+	// stream the key changes as server-sent events if requested by the client
+	if strings.Contains(r.Header.Get(common.Accept), constants.ContentTypeEventStream) {
+		return rc.streamKeys(c, key, keysOnly, isRaw)
+	}
+
+	// parse URL query string for index and wait of a blocking query
+	index, wait, isWatch, err := kpContrUtils.ParseWatchKeyRequestQueryString(r)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if isWatch {
+		limit := rc.watchWaitLimit()
+		if wait == 0 || wait > limit {
+			wait = limit
+		}
+		current, err := application.WaitKeys(ctx, key, index, wait, rc.dic)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+		w.Header().Set(constants.IndexHeader, strconv.FormatUint(current, 10))
+	}
+
 	resp, err := application.Keys(key, keysOnly, isRaw, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -64,6 +102,100 @@ func (rc *KVController) Keys(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// streamKeys sends the values of the specified key prefix as a server-sent event whenever the modify index changes.
+// The stream ends before the service request timeout, and the client is expected to reconnect with the Last-Event-ID
+// header, which carries the modify index of the last received event.
+func (rc *KVController) streamKeys(c echo.Context, key string, keysOnly bool, isRaw bool) error {
+	r := c.Request()
+	w := c.Response()
+
+	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	err := kpContrUtils.ValidateKeys(key)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	// resume from the last received event, otherwise from the index in the query string
+	var index uint64
+	if lastEventId := r.Header.Get(constants.LastEventIdHeader); lastEventId != "" {
+		index, _ = strconv.ParseUint(lastEventId, 10, 64)
+	} else {
+		index, _, _, err = kpContrUtils.ParseWatchKeyRequestQueryString(r)
+		if err != nil {
+			return utils.WriteErrorResponse(w, ctx, lc, err, "")
+		}
+	}
+
+	limit := rc.watchWaitLimit()
+	deadline := time.Now().Add(limit)
+
+	w.Header().Set(common.CorrelationHeader, correlation.FromContext(ctx))
+	w.Header().Set(common.ContentType, constants.ContentTypeEventStream)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	// ask the client to reconnect right after the stream ends
+	_, _ = fmt.Fprint(w, "retry: 0\n\n")
+	flushEvents(w)
+
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil
+		}
+
+		var event any
+		current, err := application.WaitKeys(ctx, key, index, remaining, rc.dic)
+		if err != nil {
+			event = commonDTO.NewBaseResponse("", err.Message(), err.Code())
+		} else {
+			if ctx.Err() != nil || current == index {
+				return nil
+			}
+			index = current
+
+			// the keys not found is also sent as an event since all the keys might be deleted
+			resp, keysErr := application.Keys(key, keysOnly, isRaw, rc.dic)
+			if keysErr != nil {
+				event = commonDTO.NewBaseResponse("", keysErr.Message(), keysErr.Code())
+			} else {
+				event = responses.NewMultiKVResponse("", "", http.StatusOK, resp)
+			}
+		}
+
+		data, encodeErr := json.Marshal(event)
+		if encodeErr != nil {
+			lc.Errorf("failed to encode the key change event of key %s: %v", key, encodeErr)
+			return nil
+		}
+		_, _ = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", index, constants.KVChangeEvent, data)
+		flushEvents(w)
+
+		// the stream ends on the watch error since the client will reconnect to retry
+		if err != nil {
+			return nil
+		}
+	}
+}
+
+// watchWaitLimit returns the longest time a blocking query or an event stream can last, which is kept below the
+// service request timeout
+func (rc *KVController) watchWaitLimit() time.Duration {
+	config := container.ConfigurationFrom(rc.dic.Get)
+	timeout, err := time.ParseDuration(config.Service.RequestTimeout)
+	if err != nil || timeout <= 2*constants.WatchResponseMargin {
+		return constants.WatchResponseMargin
+	}
+	return timeout - constants.WatchResponseMargin
+}
+
+// flushEvents sends the buffered events to the client, the events are sent when the response completes if the
+// underlying writer doesn't support flushing
+func flushEvents(w *echo.Response) {
+	_ = http.NewResponseController(w.Writer).Flush()
+}
+
 func (rc *KVController) AddKeys(c echo.Context) error {
 	r := c.Request()
 	w := c.Response()
@@ -83,11 +215,19 @@ func (rc *KVController) AddKeys(c echo.Context) error {
 	// URL parameters
 	key := c.Param(constants.Key)
 
-	// parse URL query string for flatten
+	// parse URL query string for flatten and cas
 	isFlatten, err := kpContrUtils.ParseAddKeyRequestQueryString(r)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cas, err := kpContrUtils.ParseCASQueryString(r)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if cas != nil && isFlatten {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is only supported when updating a single key without %s", constants.CAS, constants.Flatten), nil)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
 
 	var reqDTO requests.UpdateKeysRequest
 	err = rc.reader.Read(r.Body, &reqDTO)
@@ -101,7 +241,7 @@ func (rc *KVController) AddKeys(c echo.Context) error {
 	}
 
 	kvModel := requests.UpdateKeysReqToKVModels(reqDTO, key)
-	keys, err := application.AddKeys(kvModel, isFlatten, rc.dic)
+	keys, err := application.AddKeys(kvModel, isFlatten, cas, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -124,13 +264,21 @@ func (rc *KVController) DeleteKeys(c echo.Context) error {
 	// URL parameters
 	key := c.Param(constants.Key)
 
-	// parse URL query string for prefixMatch
+	// parse URL query string for prefixMatch and cas
 	prefixMatch, err := kpContrUtils.ParseDeleteKeyRequestQueryString(r)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
+	cas, err := kpContrUtils.ParseCASQueryString(r)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	if cas != nil && prefixMatch {
+		err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is only supported when deleting a single key without %s", constants.CAS, constants.PrefixMatch), nil)
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
 
-	resp, err := application.DeleteKeys(key, prefixMatch, rc.dic)
+	resp, err := application.DeleteKeys(key, prefixMatch, cas, rc.dic)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
@@ -142,3 +290,225 @@ func (rc *KVController) DeleteKeys(c echo.Context) error {
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+// Txn handles the PUT request of applying a batch of set, delete and check-index operations atomically
+func (rc *KVController) Txn(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	var reqDTO keeperRequests.KVTxnRequest
+	err := rc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	ops := dtos.ToKVTxnOpModels(reqDTO.Operations)
+	keys, err := application.ApplyTxn(ops, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	// publish the key change events
+	for _, op := range ops {
+		switch op.Verb {
+		case constants.TxnVerbSet:
+			go application.PublishKeyChange(models.KVS{Key: op.Key, StoredData: models.StoredData{Value: op.Value}}, op.Key, ctx, rc.dic)
+		case constants.TxnVerbDelete:
+			go application.PublishKeyChange(models.KVS{Key: op.Key}, op.Key, ctx, rc.dic)
+		}
+	}
+
+	response := responses.NewKeysResponse("", "", http.StatusOK, keys)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// History handles the GET request of querying the revisions of the specified key or the keys with the same key prefix
+// within the time range
+func (rc *KVController) History(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+
+	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	config := container.ConfigurationFrom(rc.dic.Get)
+
+	// URL parameters
+	key := c.Param(constants.Key)
+
+	// parse URL query string for start, end, offset and limit
+	start, end, offset, limit, err := utils.ParseQueryStringTimeRangeOffsetLimit(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	revisions, totalCount, err := application.KeysHistory(key, start, end, offset, limit, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := keeperResponses.NewMultiKVRevisionsResponse("", "", http.StatusOK, totalCount, dtos.FromKVRevisionModelsToDTOs(revisions))
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// Diff handles the GET request of querying the changes of the specified key or the keys with the same key prefix
+// between two timestamps
+func (rc *KVController) Diff(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+
+	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	key := c.Param(constants.Key)
+
+	// parse URL query string for from and to
+	from, to, err := kpContrUtils.ParseDiffKeysRequestQueryString(r)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	changes, err := application.DiffKeys(key, from, to, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := keeperResponses.NewMultiKVChangesResponse("", "", http.StatusOK, dtos.FromKVChangeModelsToDTOs(changes))
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// Restore handles the PUT request of rolling the specified key or the keys with the same key prefix back to the
+// values at the timestamp
+func (rc *KVController) Restore(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+
+	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	key := c.Param(constants.Key)
+
+	// parse URL query string for timestamp
+	timestamp, err := kpContrUtils.ParseRestoreKeysRequestQueryString(r)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	changes, err := application.RestoreKeys(key, timestamp, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	// publish the key change events
+	rc.publishKeyChanges(ctx, changes)
+
+	response := keeperResponses.NewMultiKVChangesResponse("", "", http.StatusOK, dtos.FromKVChangeModelsToDTOs(changes))
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// Export handles the GET request of exporting the specified key or the keys with the same key prefix as a nested
+// object, which is returned as YAML if the request accepts YAML
+func (rc *KVController) Export(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+
+	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	key := c.Param(constants.Key)
+
+	// parse URL query string for excludeSecrets
+	excludeSecrets, err := kpContrUtils.ParseQueryStringToBool(r, constants.ExcludeSecrets)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	value, err := application.ExportKeys(key, excludeSecrets, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	switch r.Header.Get(common.Accept) {
+	case common.ContentTypeYAML:
+		return pkg.EncodeAndWriteYamlResponse(value, w, lc)
+	default:
+		response := keeperResponses.NewKVExportResponse("", "", http.StatusOK, value)
+		return pkg.EncodeAndWriteResponse(response, w, lc)
+	}
+}
+
+// Import handles the PUT request of importing a nested object under the specified key prefix. The request body is
+// either a YAML document of the object or the same JSON request of storing the keys with flatten enabled.
+func (rc *KVController) Import(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	key := c.Param(constants.Key)
+
+	// parse URL query string for mode, dryRun and excludeSecrets
+	replace, dryRun, excludeSecrets, err := kpContrUtils.ParseImportKeysRequestQueryString(r)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var value any
+	switch r.Header.Get(common.ContentType) {
+	case common.ContentTypeYAML:
+		err = edgexIO.NewYamlDtoReader().Read(r.Body, &value)
+	default:
+		var reqDTO requests.UpdateKeysRequest
+		err = rc.reader.Read(r.Body, &reqDTO)
+		if err == nil {
+			err = reqDTO.Validate()
+		}
+		value = reqDTO.Value
+	}
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	changes, err := application.ImportKeys(key, value, replace, dryRun, excludeSecrets, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	// publish the key change events
+	if !dryRun {
+		rc.publishKeyChanges(ctx, changes)
+	}
+
+	response := keeperResponses.NewMultiKVChangesResponse("", "", http.StatusOK, dtos.FromKVChangeModelsToDTOs(changes))
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// publishKeyChanges publishes the key change event of each applied change
+func (rc *KVController) publishKeyChanges(ctx context.Context, changes []keeperModels.KVChange) {
+	for _, change := range changes {
+		if change.Change == constants.KVChangeRemoved {
+			go application.PublishKeyChange(models.KVS{Key: change.Key}, change.Key, ctx, rc.dic)
+		} else {
+			go application.PublishKeyChange(models.KVS{Key: change.Key, StoredData: models.StoredData{Value: change.ToValue}}, change.Key, ctx, rc.dic)
+		}
+	}
+}
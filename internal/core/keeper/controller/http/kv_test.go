@@ -6,6 +6,7 @@
 package http
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -13,10 +14,15 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	keeperDtos "github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+	keeperRequests "github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/requests"
+	keeperResponses "github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/infrastructure/interfaces/mocks"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -31,6 +37,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/labstack/echo/v4"
+	"gopkg.in/yaml.v3"
 )
 
 var flattenValue = map[string]interface{}{
@@ -387,7 +394,7 @@ func TestDeleteKeys(t *testing.T) {
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
 			e := echo.New()
-			req, err := http.NewRequest(http.MethodDelete, constants.ApiKVRoute, http.NoBody)
+			req, err := http.NewRequest(http.MethodDelete, common.ApiKVSByKeyRoute, http.NoBody)
 			query := req.URL.Query()
 			query.Add(constants.PrefixMatch, testCase.prefixMatch)
 
@@ -427,3 +434,765 @@ func TestDeleteKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestKeys_Watch(t *testing.T) {
+	key := "test-key"
+	kvModel := models.KVS{
+		Key: key,
+		StoredData: models.StoredData{
+			Value: encodeToBase64Str("INFO"),
+		},
+	}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("KeeperKeysIndex", key).Return(uint64(5), nil)
+	dbClientMock.On("KeeperKeys", key, false, false).Return([]models.KVResponse{&kvModel}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewKVController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		index              string
+		wait               string
+		expectedStatusCode int
+		expectedIndex      string
+	}{
+		{"Valid - index changed", "3", "", http.StatusOK, "5"},
+		{"Valid - index unchanged until wait elapses", "5", "10ms", http.StatusOK, "5"},
+		{"Invalid - index is not a number", "abc", "", http.StatusBadRequest, ""},
+		{"Invalid - wait is not a positive duration", "3", "-1s", http.StatusBadRequest, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, common.ApiKVSByKeyRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.Index, testCase.index)
+			if testCase.wait != "" {
+				query.Add(constants.Wait, testCase.wait)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.Key)
+			c.SetParamValues(key)
+			err = controller.Keys(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedIndex, recorder.Header().Get(constants.IndexHeader), "Index header not as expected")
+		})
+	}
+}
+
+func TestKeys_Stream(t *testing.T) {
+	key := "test-key"
+	kvModel := models.KVS{
+		Key: key,
+		StoredData: models.StoredData{
+			Value: encodeToBase64Str("INFO"),
+		},
+	}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("KeeperKeysIndex", key).Return(uint64(5), nil)
+	dbClientMock.On("KeeperKeys", key, false, false).Return([]models.KVResponse{&kvModel}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewKVController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name          string
+		lastEventId   string
+		expectedEvent bool
+	}{
+		{"Valid - send the keys from the beginning", "", true},
+		{"Valid - resume from the last event", "5", false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			e := echo.New()
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, common.ApiKVSByKeyRoute, http.NoBody)
+			require.NoError(t, err)
+			req.Header.Set(common.Accept, constants.ContentTypeEventStream)
+			if testCase.lastEventId != "" {
+				req.Header.Set(constants.LastEventIdHeader, testCase.lastEventId)
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.Key)
+			c.SetParamValues(key)
+			err = controller.Keys(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, constants.ContentTypeEventStream, recorder.Header().Get(common.ContentType), "Content type not as expected")
+			body := recorder.Body.String()
+			if testCase.expectedEvent {
+				assert.Contains(t, body, "id: 5\n")
+				assert.Contains(t, body, "event: "+constants.KVChangeEvent+"\n")
+				assert.Contains(t, body, key)
+			} else {
+				assert.NotContains(t, body, "event: ")
+			}
+		})
+	}
+}
+
+func TestAddKeys_CAS(t *testing.T) {
+	key := "casKey"
+	kvRequest := buildTestKVRequest()
+	kvRequest.Value = "test"
+	conflictKey := "conflictKey"
+
+	casOps := func(key string, index uint64) []keeperModels.KVTxnOp {
+		return []keeperModels.KVTxnOp{
+			{Verb: constants.TxnVerbCheckIndex, Key: key, Index: index},
+			{Verb: constants.TxnVerbSet, Key: key, Value: "test"},
+		}
+	}
+
+	msgClientMock := &messageClientMocks.MessageClient{}
+	msgClientMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("KeeperTxn", casOps(key, 3)).Return([]models.KeyOnly{models.KeyOnly(key)}, nil)
+	dbClientMock.On("KeeperTxn", casOps(conflictKey, 3)).
+		Return(nil, errors.NewCommonEdgeX(errors.KindStatusConflict, "the modify index of key conflictKey is 4 rather than 3", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClientMock
+		},
+	})
+
+	controller := NewKVController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		key                string
+		flatten            string
+		cas                string
+		expectedStatusCode int
+	}{
+		{"Valid - modify index matches", key, "false", "3", http.StatusOK},
+		{"Invalid - modify index changed", conflictKey, "false", "3", http.StatusConflict},
+		{"Invalid - cas is not a number", key, "false", "abc", http.StatusBadRequest},
+		{"Invalid - cas with flatten", key, "true", "3", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(kvRequest)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPut, common.ApiKVSByKeyRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.Flatten, testCase.flatten)
+			query.Add(constants.CAS, testCase.cas)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.Key)
+			c.SetParamValues(testCase.key)
+			err = controller.AddKeys(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
+
+func TestDeleteKeys_CAS(t *testing.T) {
+	key := "casKey"
+	conflictKey := "conflictKey"
+
+	casOps := func(key string, index uint64) []keeperModels.KVTxnOp {
+		return []keeperModels.KVTxnOp{
+			{Verb: constants.TxnVerbCheckIndex, Key: key, Index: index},
+			{Verb: constants.TxnVerbDelete, Key: key},
+		}
+	}
+
+	msgClientMock := &messageClientMocks.MessageClient{}
+	msgClientMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("KeeperTxn", casOps(key, 3)).Return([]models.KeyOnly{models.KeyOnly(key)}, nil)
+	dbClientMock.On("KeeperTxn", casOps(conflictKey, 3)).
+		Return(nil, errors.NewCommonEdgeX(errors.KindStatusConflict, "the modify index of key conflictKey is 4 rather than 3", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClientMock
+		},
+	})
+
+	controller := NewKVController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		key                string
+		prefixMatch        string
+		cas                string
+		expectedStatusCode int
+	}{
+		{"Valid - modify index matches", key, "false", "3", http.StatusOK},
+		{"Invalid - modify index changed", conflictKey, "false", "3", http.StatusConflict},
+		{"Invalid - cas with prefixMatch", key, "true", "3", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodDelete, common.ApiKVSByKeyRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.PrefixMatch, testCase.prefixMatch)
+			query.Add(constants.CAS, testCase.cas)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.Key)
+			c.SetParamValues(testCase.key)
+			err = controller.DeleteKeys(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
+
+func TestTxn(t *testing.T) {
+	validOps := []keeperDtos.KVTxnOp{
+		{Verb: constants.TxnVerbCheckIndex, Key: "core-data/Writable/LogLevel", Index: 3},
+		{Verb: constants.TxnVerbSet, Key: "core-data/Writable/LogLevel", Value: "DEBUG"},
+		{Verb: constants.TxnVerbDelete, Key: "core-data/Writable/Telemetry", PrefixMatch: true},
+	}
+	conflictOps := []keeperDtos.KVTxnOp{
+		{Verb: constants.TxnVerbCheckIndex, Key: "core-data/Writable/LogLevel", Index: 2},
+	}
+	invalidKeyOps := []keeperDtos.KVTxnOp{
+		{Verb: constants.TxnVerbDelete, Key: "invalidChar:"},
+	}
+	invalidVerbOps := []keeperDtos.KVTxnOp{
+		{Verb: "get", Key: "core-data/Writable/LogLevel"},
+	}
+
+	msgClientMock := &messageClientMocks.MessageClient{}
+	msgClientMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("KeeperTxn", keeperDtos.ToKVTxnOpModels(validOps)).
+		Return([]models.KeyOnly{"core-data/Writable/LogLevel", "core-data/Writable/Telemetry/Interval"}, nil)
+	dbClientMock.On("KeeperTxn", keeperDtos.ToKVTxnOpModels(conflictOps)).
+		Return(nil, errors.NewCommonEdgeX(errors.KindStatusConflict, "operation 0 of key 'core-data/Writable/LogLevel' failed", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClientMock
+		},
+	})
+
+	controller := NewKVController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name                 string
+		ops                  []keeperDtos.KVTxnOp
+		expectedRespKeyCount int
+		expectedStatusCode   int
+	}{
+		{"Valid - apply operations", validOps, 2, http.StatusOK},
+		{"Invalid - check index failed", conflictOps, 0, http.StatusConflict},
+		{"Invalid - key contains invalid character", invalidKeyOps, 0, http.StatusBadRequest},
+		{"Invalid - unknown verb", invalidVerbOps, 0, http.StatusBadRequest},
+		{"Invalid - no operations", nil, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			request := keeperRequests.KVTxnRequest{BaseRequest: commonDTO.NewBaseRequest(), Operations: testCase.ops}
+			jsonData, err := json.Marshal(request)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPut, constants.ApiKVTxnRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.Txn(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res struct {
+					commonDTO.BaseResponse
+					Response []models.KeyOnly
+				}
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedRespKeyCount, len(res.Response), "Update key count from response not as expected")
+			}
+		})
+	}
+}
+
+func TestHistory(t *testing.T) {
+	key := "core-data/Writable"
+	revisions := []keeperModels.KVRevision{
+		{Key: "core-data/Writable/LogLevel", Value: "DEBUG", Action: constants.TxnVerbSet, ModifyIndex: 8, Timestamp: 1500},
+		{Key: "core-data/Writable/LogLevel", Value: "INFO", Action: constants.TxnVerbSet, ModifyIndex: 5, Timestamp: 900},
+	}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("KeeperKeysHistoryCount", key, int64(0), int64(2000)).Return(int64(len(revisions)), nil)
+	dbClientMock.On("KeeperKeysHistory", key, int64(0), int64(2000), 0, 20).Return(revisions, nil)
+	dbClientMock.On("KeeperKeysHistoryCount", notFoundKey, int64(0), int64(2000)).Return(int64(0), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewKVController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name                  string
+		key                   string
+		start                 string
+		end                   string
+		expectedRevisionCount int
+		expectedTotalCount    int64
+		expectedStatusCode    int
+	}{
+		{"Valid - query history", key, "0", "2000", 2, 2, http.StatusOK},
+		{"Valid - no history", notFoundKey, "0", "2000", 0, 0, http.StatusOK},
+		{"Invalid - end before start", key, "2000", "0", 0, 0, http.StatusBadRequest},
+		{"Invalid - key contains invalid character", "invalidChar:", "0", "2000", 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiKVHistoryRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Start, testCase.start)
+			query.Add(common.End, testCase.end)
+			query.Add(common.Limit, "20")
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.Key)
+			c.SetParamValues(testCase.key)
+			err = controller.History(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res keeperResponses.MultiKVRevisionsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+				assert.Equal(t, testCase.expectedRevisionCount, len(res.Revisions), "Revision count not as expected")
+			}
+		})
+	}
+}
+
+// testHistoryRevisions returns the revisions of the core-data/Writable keys, where LogLevel is modified, Telemetry is
+// removed and Interval is added after the timestamp 1000
+func testHistoryRevisions() (atTimestamp []keeperModels.KVRevision, atNow []keeperModels.KVRevision) {
+	atTimestamp = []keeperModels.KVRevision{
+		{Key: "core-data/Writable/LogLevel", Value: "INFO", Action: constants.TxnVerbSet, ModifyIndex: 5, Timestamp: 900},
+		{Key: "core-data/Writable/Telemetry", Value: "true", Action: constants.TxnVerbSet, ModifyIndex: 4, Timestamp: 800},
+	}
+	atNow = append([]keeperModels.KVRevision{
+		{Key: "core-data/Writable/Interval", Value: "30s", Action: constants.TxnVerbSet, ModifyIndex: 9, Timestamp: 2000},
+		{Key: "core-data/Writable/LogLevel", Value: "DEBUG", Action: constants.TxnVerbSet, ModifyIndex: 8, Timestamp: 1500},
+		{Key: "core-data/Writable/Telemetry", Action: constants.TxnVerbDelete, ModifyIndex: 7, Timestamp: 1200},
+	}, atTimestamp...)
+	return atTimestamp, atNow
+}
+
+func TestDiff(t *testing.T) {
+	key := "core-data/Writable"
+	atTimestamp, atNow := testHistoryRevisions()
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("KeeperKeysHistory", key, int64(0), int64(1000), 0, -1).Return(atTimestamp, nil)
+	dbClientMock.On("KeeperKeysHistory", key, int64(0), int64(3000), 0, -1).Return(atNow, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewKVController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		from               string
+		to                 string
+		expectedChanges    []keeperDtos.KVChange
+		expectedStatusCode int
+	}{
+		{"Valid - diff between timestamps", "1000", "3000", []keeperDtos.KVChange{
+			{Key: "core-data/Writable/Interval", Change: constants.KVChangeAdded, ToValue: "30s"},
+			{Key: "core-data/Writable/LogLevel", Change: constants.KVChangeModified, FromValue: "INFO", ToValue: "DEBUG"},
+			{Key: "core-data/Writable/Telemetry", Change: constants.KVChangeRemoved, FromValue: "true"},
+		}, http.StatusOK},
+		{"Valid - no change", "1000", "1000", []keeperDtos.KVChange{}, http.StatusOK},
+		{"Invalid - to before from", "3000", "1000", nil, http.StatusBadRequest},
+		{"Invalid - from is not a timestamp", "yesterday", "1000", nil, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiKVDiffRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(constants.From, testCase.from)
+			query.Add(constants.To, testCase.to)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.Key)
+			c.SetParamValues(key)
+			err = controller.Diff(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res keeperResponses.MultiKVChangesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedChanges, res.Changes, "Changes not as expected")
+			}
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	key := "core-data/Writable"
+	conflictKey := "core-metadata/Writable"
+	atTimestamp, atNow := testHistoryRevisions()
+	expectedOps := []keeperModels.KVTxnOp{
+		{Verb: constants.TxnVerbCheckIndex, Key: "core-data/Writable/Interval", Index: 9},
+		{Verb: constants.TxnVerbCheckIndex, Key: "core-data/Writable/LogLevel", Index: 8},
+		{Verb: constants.TxnVerbCheckIndex, Key: "core-data/Writable/Telemetry", Index: 0},
+		{Verb: constants.TxnVerbDelete, Key: "core-data/Writable/Interval"},
+		{Verb: constants.TxnVerbSet, Key: "core-data/Writable/LogLevel", Value: "INFO"},
+		{Verb: constants.TxnVerbSet, Key: "core-data/Writable/Telemetry", Value: "true"},
+	}
+	conflictRevisions := []keeperModels.KVRevision{
+		{Key: "core-metadata/Writable/LogLevel", Value: "DEBUG", Action: constants.TxnVerbSet, ModifyIndex: 3, Timestamp: 1500},
+	}
+	afterTimestamp := mock.MatchedBy(func(end int64) bool { return end > 1000 })
+
+	msgClientMock := &messageClientMocks.MessageClient{}
+	msgClientMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("KeeperKeysHistory", key, int64(0), int64(1000), 0, -1).Return(atTimestamp, nil)
+	dbClientMock.On("KeeperKeysHistory", key, int64(0), afterTimestamp, 0, -1).Return(atNow, nil)
+	dbClientMock.On("KeeperTxn", expectedOps).Return([]models.KeyOnly{"core-data/Writable/Interval", "core-data/Writable/LogLevel", "core-data/Writable/Telemetry"}, nil)
+	dbClientMock.On("KeeperKeysHistory", conflictKey, int64(0), int64(1000), 0, -1).Return([]keeperModels.KVRevision{}, nil)
+	dbClientMock.On("KeeperKeysHistory", conflictKey, int64(0), afterTimestamp, 0, -1).Return(conflictRevisions, nil)
+	dbClientMock.On("KeeperTxn", mock.Anything).
+		Return(nil, errors.NewCommonEdgeX(errors.KindStatusConflict, "operation 0 of key 'core-metadata/Writable/LogLevel' failed", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClientMock
+		},
+	})
+
+	controller := NewKVController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name                string
+		key                 string
+		timestamp           string
+		expectedChangeCount int
+		expectedStatusCode  int
+	}{
+		{"Valid - restore to timestamp", key, "1000", 3, http.StatusOK},
+		{"Invalid - changed by another request", conflictKey, "1000", 0, http.StatusConflict},
+		{"Invalid - no timestamp", key, "", 0, http.StatusBadRequest},
+		{"Invalid - negative timestamp", key, "-1", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPut, constants.ApiKVRestoreRoute, http.NoBody)
+			require.NoError(t, err)
+			if testCase.timestamp != "" {
+				query := req.URL.Query()
+				query.Add(constants.Timestamp, testCase.timestamp)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.Key)
+			c.SetParamValues(testCase.key)
+			err = controller.Restore(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res keeperResponses.MultiKVChangesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedChangeCount, len(res.Changes), "Change count not as expected")
+			}
+		})
+	}
+}
+
+func exportTestKeys() []models.KVResponse {
+	return []models.KVResponse{
+		&models.KVS{Key: "core-data/Writable/LogLevel", StoredData: models.StoredData{Value: "INFO"}},
+		&models.KVS{Key: "core-data/Writable/InsecureSecrets/DB/password", StoredData: models.StoredData{Value: "pwd"}},
+		&models.KVS{Key: "core-data/MaxEventSize", StoredData: models.StoredData{Value: "25000"}},
+	}
+}
+
+func TestExport(t *testing.T) {
+	key := "core-data"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("KeeperKeys", key, false, true).Return(exportTestKeys(), nil)
+	dbClientMock.On("KeeperKeys", notFoundKey, false, true).Return(nil, notFoundErr)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewKVController(dic)
+	assert.NotNil(t, controller)
+
+	fullTree := map[string]any{
+		"Writable": map[string]any{
+			"LogLevel":        "INFO",
+			"InsecureSecrets": map[string]any{"DB": map[string]any{"password": "pwd"}},
+		},
+		"MaxEventSize": "25000",
+	}
+	noSecretsTree := map[string]any{
+		"Writable":     map[string]any{"LogLevel": "INFO"},
+		"MaxEventSize": "25000",
+	}
+
+	tests := []struct {
+		name               string
+		key                string
+		accept             string
+		excludeSecrets     string
+		expectedValue      any
+		expectedStatusCode int
+	}{
+		{"Valid - export as JSON", key, common.ContentTypeJSON, "false", fullTree, http.StatusOK},
+		{"Valid - export as YAML", key, common.ContentTypeYAML, "false", fullTree, http.StatusOK},
+		{"Valid - export without secrets", key, common.ContentTypeJSON, "true", noSecretsTree, http.StatusOK},
+		{"Invalid - key not found", notFoundKey, common.ContentTypeJSON, "false", nil, http.StatusNotFound},
+		{"Invalid - key contains invalid character", "invalidChar:", common.ContentTypeJSON, "false", nil, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiKVExportRoute, http.NoBody)
+			require.NoError(t, err)
+			req.Header.Set(common.Accept, testCase.accept)
+			query := req.URL.Query()
+			query.Add(constants.ExcludeSecrets, testCase.excludeSecrets)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.Key)
+			c.SetParamValues(testCase.key)
+			err = controller.Export(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode != http.StatusOK {
+				return
+			}
+			var value any
+			if testCase.accept == common.ContentTypeYAML {
+				assert.Equal(t, common.ContentTypeYAML, recorder.Header().Get(common.ContentType))
+				err = yaml.Unmarshal(recorder.Body.Bytes(), &value)
+			} else {
+				var res keeperResponses.KVExportResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				value = res.Value
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedValue, value, "Exported value not as expected")
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	key := "core-data"
+	yamlBody := `
+Writable:
+  LogLevel: DEBUG
+  InsecureSecrets:
+    DB:
+      password: changed
+MaxEventSize: 25000
+`
+	jsonRequest := requests.UpdateKeysRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		Value:       map[string]any{"Writable": map[string]any{"LogLevel": "DEBUG"}},
+	}
+	jsonData, err := json.Marshal(jsonRequest)
+	require.NoError(t, err)
+
+	mergeOps := []keeperModels.KVTxnOp{
+		{Verb: constants.TxnVerbSet, Key: "core-data/Writable/InsecureSecrets/DB/password", Value: "changed"},
+		{Verb: constants.TxnVerbSet, Key: "core-data/Writable/LogLevel", Value: "DEBUG"},
+	}
+	replaceOps := []keeperModels.KVTxnOp{
+		{Verb: constants.TxnVerbDelete, Key: "core-data/MaxEventSize"},
+		{Verb: constants.TxnVerbSet, Key: "core-data/Writable/LogLevel", Value: "DEBUG"},
+	}
+
+	msgClientMock := &messageClientMocks.MessageClient{}
+	msgClientMock.On("Publish", mock.Anything, mock.Anything).Return(nil)
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("KeeperKeys", key, false, true).Return(exportTestKeys(), nil)
+	dbClientMock.On("KeeperTxn", mergeOps).Return([]models.KeyOnly{"core-data/Writable/InsecureSecrets/DB/password", "core-data/Writable/LogLevel"}, nil)
+	dbClientMock.On("KeeperTxn", replaceOps).Return([]models.KeyOnly{"core-data/MaxEventSize", "core-data/Writable/LogLevel"}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return msgClientMock
+		},
+	})
+
+	controller := NewKVController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		contentType        string
+		body               string
+		mode               string
+		dryRun             string
+		excludeSecrets     string
+		expectedChanges    []keeperDtos.KVChange
+		expectedStatusCode int
+	}{
+		{"Valid - merge YAML", common.ContentTypeYAML, yamlBody, constants.ImportModeMerge, "false", "false", []keeperDtos.KVChange{
+			{Key: "core-data/Writable/InsecureSecrets/DB/password", Change: constants.KVChangeModified, FromValue: "pwd", ToValue: "changed"},
+			{Key: "core-data/Writable/LogLevel", Change: constants.KVChangeModified, FromValue: "INFO", ToValue: "DEBUG"},
+		}, http.StatusOK},
+		{"Valid - replace JSON without secrets", common.ContentTypeJSON, string(jsonData), constants.ImportModeReplace, "false", "true", []keeperDtos.KVChange{
+			{Key: "core-data/MaxEventSize", Change: constants.KVChangeRemoved, FromValue: "25000"},
+			{Key: "core-data/Writable/LogLevel", Change: constants.KVChangeModified, FromValue: "INFO", ToValue: "DEBUG"},
+		}, http.StatusOK},
+		{"Valid - dry run replace", common.ContentTypeJSON, string(jsonData), constants.ImportModeReplace, "true", "false", []keeperDtos.KVChange{
+			{Key: "core-data/MaxEventSize", Change: constants.KVChangeRemoved, FromValue: "25000"},
+			{Key: "core-data/Writable/InsecureSecrets/DB/password", Change: constants.KVChangeRemoved, FromValue: "pwd"},
+			{Key: "core-data/Writable/LogLevel", Change: constants.KVChangeModified, FromValue: "INFO", ToValue: "DEBUG"},
+		}, http.StatusOK},
+		{"Invalid - unknown mode", common.ContentTypeJSON, string(jsonData), "overwrite", "false", "false", nil, http.StatusBadRequest},
+		{"Invalid - malformed YAML", common.ContentTypeYAML, "Writable: [", constants.ImportModeMerge, "false", "false", nil, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPut, constants.ApiKVImportRoute, strings.NewReader(testCase.body))
+			require.NoError(t, err)
+			req.Header.Set(common.ContentType, testCase.contentType)
+			query := req.URL.Query()
+			query.Add(constants.Mode, testCase.mode)
+			query.Add(constants.DryRun, testCase.dryRun)
+			query.Add(constants.ExcludeSecrets, testCase.excludeSecrets)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.Key)
+			c.SetParamValues(key)
+			err = controller.Import(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res keeperResponses.MultiKVChangesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedChanges, res.Changes, "Changes not as expected")
+			}
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "KeeperTxn", 2)
+}
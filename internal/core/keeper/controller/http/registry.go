@@ -6,19 +6,29 @@
 package http
 
 import (
+	"bytes"
+	"fmt"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
 	goio "io"
+	"math"
 	"net/http"
+	"strings"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	keeperDtos "github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+	keeperRequests "github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/requests"
+	keeperResponses "github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/responses"
 	httpUtils "github.com/edgexfoundry/edgex-go/internal/core/keeper/utils"
 	"github.com/edgexfoundry/edgex-go/internal/io"
 	"github.com/edgexfoundry/edgex-go/internal/pkg"
@@ -55,8 +65,7 @@ func (rc *RegistryController) Register(c echo.Context) error {
 	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
 	ctx := r.Context()
 
-	var reqDTO requests.AddRegistrationRequest
-	edgexErr := rc.reader.Read(r.Body, &reqDTO)
+	reqDTO, instanceReq, edgexErr := rc.readRegistrationRequest(r.Body)
 	if edgexErr != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, edgexErr, "")
 	}
@@ -68,7 +77,8 @@ func (rc *RegistryController) Register(c echo.Context) error {
 	}
 
 	registry := dtos.ToRegistrationModel(reqDTO.Registration)
-	edgexErr = application.AddRegistration(registry, rc.dic)
+	instance := keeperDtos.ToServiceInstanceModel(registry.ServiceId, instanceReq.Registration)
+	edgexErr = application.AddRegistration(registry, instance, rc.dic)
 	if edgexErr != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, edgexErr, "")
 	}
@@ -81,6 +91,28 @@ func (rc *RegistryController) Register(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// readRegistrationRequest reads the registration request defined in go-mod-core-contracts and the discovery fields
+// extending the registration from the request body
+func (rc *RegistryController) readRegistrationRequest(body goio.Reader) (requests.AddRegistrationRequest, keeperRequests.RegistrationInstanceRequest, errors.EdgeX) {
+	var reqDTO requests.AddRegistrationRequest
+	var instanceReq keeperRequests.RegistrationInstanceRequest
+
+	bodyBytes, err := goio.ReadAll(body)
+	if err != nil {
+		return reqDTO, instanceReq, errors.NewCommonEdgeX(errors.KindIOError, "failed to read the request body", err)
+	}
+	edgexErr := rc.reader.Read(bytes.NewReader(bodyBytes), &reqDTO)
+	if edgexErr != nil {
+		return reqDTO, instanceReq, edgexErr
+	}
+	edgexErr = rc.reader.Read(bytes.NewReader(bodyBytes), &instanceReq)
+	if edgexErr != nil {
+		return reqDTO, instanceReq, edgexErr
+	}
+
+	return reqDTO, instanceReq, nil
+}
+
 func (rc *RegistryController) UpdateRegister(c echo.Context) error {
 	r := c.Request()
 	w := c.Response()
@@ -97,8 +129,7 @@ func (rc *RegistryController) UpdateRegister(c echo.Context) error {
 	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
 	ctx := r.Context()
 
-	var reqDTO requests.AddRegistrationRequest
-	edgexErr := rc.reader.Read(r.Body, &reqDTO)
+	reqDTO, instanceReq, edgexErr := rc.readRegistrationRequest(r.Body)
 	if edgexErr != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, edgexErr, "")
 	}
@@ -110,7 +141,8 @@ func (rc *RegistryController) UpdateRegister(c echo.Context) error {
 	}
 
 	registry := dtos.ToRegistrationModel(reqDTO.Registration)
-	edgexErr = application.UpdateRegistration(registry, rc.dic)
+	instance := keeperDtos.ToServiceInstanceModel(registry.ServiceId, instanceReq.Registration)
+	edgexErr = application.UpdateRegistration(registry, instance, rc.dic)
 	if edgexErr != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, edgexErr, "")
 	}
@@ -179,3 +211,109 @@ func (rc *RegistryController) RegistrationByServiceId(c echo.Context) error {
 	utils.WriteHttpHeader(w, ctx, http.StatusOK)
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
+
+// Heartbeat handles the PUT request of the heartbeat from a service registered with the TTL health check, the status
+// query parameter reports the status of the service, which is UP by default
+func (rc *RegistryController) Heartbeat(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+
+	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	id := c.Param(constants.ServiceId)
+	status := strings.ToUpper(utils.ParseQueryStringToString(r, constants.Status, models.Up))
+
+	err := application.Heartbeat(id, status, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusNoContent)
+	return nil
+}
+
+// StatusHistory handles the GET request of querying the health status transitions of a registered service
+func (rc *RegistryController) StatusHistory(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+
+	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+	config := container.ConfigurationFrom(rc.dic.Get)
+
+	// URL parameters
+	id := c.Param(constants.ServiceId)
+
+	// parse URL query string for start, end, offset and limit
+	start, end, offset, limit, err := utils.ParseQueryStringTimeRangeOffsetLimit(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	transitions, totalCount, err := application.StatusTransitions(id, start, end, offset, limit, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := keeperResponses.NewMultiStatusTransitionsResponse("", "", http.StatusOK, totalCount, transitions)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// Discovery handles the GET request of discovering the registered service instances filtered by the service name
+// prefix, tags, metadata and status, the instances are returned in the round-robin or random order
+func (rc *RegistryController) Discovery(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+
+	lc := bootstrapContainer.LoggingClientFrom(rc.dic.Get)
+	ctx := r.Context()
+
+	query, err := parseDiscoveryQuery(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	instances, err := application.DiscoverServiceInstances(query, rc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := keeperResponses.NewMultiServiceInstancesResponse("", "", http.StatusOK, int64(len(instances)), instances)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// parseDiscoveryQuery parses the discovery query from the query parameters, the status defaults to UP and the order
+// defaults to round-robin
+func parseDiscoveryQuery(c echo.Context) (application.DiscoveryQuery, errors.EdgeX) {
+	r := c.Request()
+	query := application.DiscoveryQuery{
+		ServiceNamePrefix: utils.ParseQueryStringToString(r, constants.ServiceName, ""),
+		Tags:              utils.ParseQueryStringToStrings(c, constants.Tags, common.CommaSeparator),
+		Statuses:          utils.ParseQueryStringToStrings(c, constants.Status, common.CommaSeparator),
+		Order:             strings.ToLower(utils.ParseQueryStringToString(r, constants.Order, constants.DiscoveryOrderRoundRobin)),
+	}
+	if len(query.Statuses) == 0 {
+		query.Statuses = []string{models.Up}
+	}
+	for i, status := range query.Statuses {
+		query.Statuses[i] = strings.ToUpper(strings.TrimSpace(status))
+	}
+
+	metadata := utils.ParseQueryStringToStrings(c, constants.Metadata, common.CommaSeparator)
+	if len(metadata) > 0 {
+		query.Metadata = make(map[string]string, len(metadata))
+	}
+	for _, m := range metadata {
+		k, v, found := strings.Cut(m, constants.MetadataSeparator)
+		if !found || k == "" {
+			return query, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid metadata filter %s, must be in the format of key%svalue", m, constants.MetadataSeparator), nil)
+		}
+		query.Metadata[k] = v
+	}
+
+	return query, nil
+}
@@ -7,6 +7,8 @@ package http
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -15,7 +17,10 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	keeperDtos "github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+	keeperResponses "github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/responses"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/infrastructure/interfaces/mocks"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
@@ -28,14 +33,20 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	v2Models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	messagingMocks "github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	msgTypes "github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/labstack/echo/v4"
 )
 
-const testServiceId = "test-service"
+const (
+	testServiceId    = "test-service"
+	testTransitionId = "fb3b2c0c-1bd4-4c6f-9a3c-f42fc1f4e1a9"
+)
 
 func mockDic() *di.Container {
 	return di.NewContainer(di.ServiceConstructorMap{
@@ -47,6 +58,9 @@ func mockDic() *di.Container {
 				Service: bootstrapConfig.ServiceInfo{
 					MaxResultCount: 30,
 				},
+				HealthCheck: config.HealthCheckInfo{
+					ExecAllowlist: []string{"/usr/local/bin/check"},
+				},
 			}
 		},
 		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
@@ -87,10 +101,22 @@ func TestRegistryController_Register(t *testing.T) {
 	invalidInterval.Registration.HealthCheck.Interval = "10t"
 	emptyHealthCheckType := validReq
 	emptyHealthCheckType.Registration.HealthCheck.Type = ""
+	notAllowedExec := validReq
+	notAllowedExec.Registration.HealthCheck.Type = constants.HealthCheckTypeExec
+	notAllowedExec.Registration.HealthCheck.Path = "/bin/sh -c true"
+	instanceFailed := validReq
+	instanceFailed.Registration.ServiceId = "instance-failed"
+	instanceFailedModel := dtos.ToRegistrationModel(instanceFailed.Registration)
+	instanceFailedModel.Status = v2Models.Unknown
 	dic := mockDic()
 	dbClientMock := &mocks.DBClient{}
 	dbClientMock.On("AddRegistration", validRegistrationModel).Return(validRegistrationModel, nil)
+	dbClientMock.On("AddRegistration", instanceFailedModel).Return(instanceFailedModel, nil)
+	dbClientMock.On("UpsertServiceInstance", keeperModels.ServiceInstance{ServiceId: instanceFailedModel.ServiceId, ServiceName: instanceFailedModel.ServiceId}).
+		Return(errors.NewCommonEdgeX(errors.KindDatabaseError, "upsert failed", nil))
+	dbClientMock.On("DeleteRegistrationByServiceId", instanceFailedModel.ServiceId).Return(nil)
 	dbClientMock.On("AddRegistration", dtos.ToRegistrationModel(duplicateServiceId.Registration)).Return(v2Models.Registration{}, errors.NewCommonEdgeX(errors.KindDuplicateName, "duplicated", nil))
+	dbClientMock.On("UpsertServiceInstance", keeperModels.ServiceInstance{ServiceId: testServiceId, ServiceName: testServiceId}).Return(nil)
 	registryMock := &mocks.Registry{}
 	registryMock.On("Register", validRegistrationModel)
 	dic.Update(di.ServiceConstructorMap{
@@ -113,7 +139,9 @@ func TestRegistryController_Register(t *testing.T) {
 		{"invalid - empty serviceId", emptyServiceId, http.StatusBadRequest},
 		{"invalid - invalid interval format", invalidInterval, http.StatusBadRequest},
 		{"invalid - empty health check type", emptyHealthCheckType, http.StatusBadRequest},
+		{"invalid - exec health check not allowed", notAllowedExec, http.StatusBadRequest},
 		{"invalid - duplicated serviceId", duplicateServiceId, http.StatusConflict},
+		{"invalid - registration rolled back", instanceFailed, http.StatusInternalServerError},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -138,6 +166,58 @@ func TestRegistryController_Register(t *testing.T) {
 			}
 		})
 	}
+	dbClientMock.AssertCalled(t, "DeleteRegistrationByServiceId", instanceFailedModel.ServiceId)
+}
+
+func TestRegistryController_RegisterWithServiceInstance(t *testing.T) {
+	validReq := buildTestRegistrationRequest()
+	validRegistrationModel := dtos.ToRegistrationModel(validReq.Registration)
+	validRegistrationModel.Status = v2Models.Unknown
+	instance := keeperModels.ServiceInstance{
+		ServiceId:   testServiceId,
+		ServiceName: "core-data",
+		Tags:        []string{"primary"},
+		Metadata:    map[string]string{"zone": "a"},
+	}
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("AddRegistration", validRegistrationModel).Return(validRegistrationModel, nil)
+	dbClientMock.On("UpsertServiceInstance", instance).Return(nil)
+	registryMock := &mocks.Registry{}
+	registryMock.On("Register", validRegistrationModel)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.RegistryInterfaceName: func(get di.Get) interface{} {
+			return registryMock
+		},
+	})
+	controller := NewRegistryController(dic)
+	assert.NotNil(t, controller)
+
+	// the discovery fields extend the registration in the request body
+	var body map[string]any
+	jsonData, err := json.Marshal(validReq)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(jsonData, &body))
+	registration := body["registration"].(map[string]any)
+	registration[constants.ServiceName] = instance.ServiceName
+	registration[constants.Tags] = instance.Tags
+	registration[constants.Metadata] = instance.Metadata
+	jsonData, err = json.Marshal(body)
+	require.NoError(t, err)
+
+	e := echo.New()
+	req, err := http.NewRequest(http.MethodPost, constants.ApiRegisterRoute, strings.NewReader(string(jsonData)))
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	c := e.NewContext(req, recorder)
+	err = controller.Register(c)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusCreated, recorder.Result().StatusCode, "HTTP status code not as expected")
+	dbClientMock.AssertCalled(t, "UpsertServiceInstance", instance)
 }
 
 func TestRegistryController_UpdateRegister(t *testing.T) {
@@ -154,9 +234,16 @@ func TestRegistryController_UpdateRegister(t *testing.T) {
 	emptyHealthCheckType := validReq
 	emptyHealthCheckType.Registration.HealthCheck.Type = ""
 	dic := mockDic()
+	upRegistrationModel := validRegistrationModel
+	upRegistrationModel.Status = v2Models.Up
 	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("RegistrationByServiceId", validRegistrationModel.ServiceId).Return(upRegistrationModel, nil)
+	dbClientMock.On("RegistrationByServiceId", notFoundServiceId.Registration.ServiceId).Return(v2Models.Registration{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
 	dbClientMock.On("UpdateRegistration", validRegistrationModel).Return(nil)
+	dbClientMock.On("UpsertServiceInstance", keeperModels.ServiceInstance{ServiceId: testServiceId, ServiceName: testServiceId}).Return(nil)
 	dbClientMock.On("UpdateRegistration", dtos.ToRegistrationModel(notFoundServiceId.Registration)).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("AddStatusTransition", mock.Anything).Return(keeperModels.StatusTransition{
+		Id: testTransitionId, ServiceId: validRegistrationModel.ServiceId, FromStatus: v2Models.Up, ToStatus: v2Models.Unknown}, nil)
 	registryMock := &mocks.Registry{}
 	registryMock.On("Register", validRegistrationModel)
 	registryMock.On("DeregisterByServiceId", validReq.Registration.ServiceId)
@@ -202,6 +289,8 @@ func TestRegistryController_UpdateRegister(t *testing.T) {
 			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
 			if testCase.expectedStatusCode == http.StatusNoContent {
 				registryMock.AssertNumberOfCalls(t, "Register", 1)
+				dbClientMock.AssertCalled(t, "AddStatusTransition", keeperModels.StatusTransition{
+					ServiceId: validRegistrationModel.ServiceId, FromStatus: v2Models.Up, ToStatus: v2Models.Unknown})
 			}
 		})
 	}
@@ -211,18 +300,37 @@ func TestRegistryController_Deregister(t *testing.T) {
 	notFound := "notFound"
 	emptyServiceId := ""
 	dic := mockDic()
+	upRegistrationModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	upRegistrationModel.Status = v2Models.Up
+	transition := keeperModels.StatusTransition{Id: testTransitionId, ServiceId: testServiceId, FromStatus: v2Models.Up, ToStatus: v2Models.Halt, Timestamp: 1}
 	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("RegistrationByServiceId", testServiceId).Return(upRegistrationModel, nil)
+	dbClientMock.On("RegistrationByServiceId", notFound).Return(v2Models.Registration{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
 	dbClientMock.On("DeleteRegistrationByServiceId", testServiceId).Return(nil)
 	dbClientMock.On("DeleteRegistrationByServiceId", notFound).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("DeleteServiceInstanceByServiceId", testServiceId).Return(nil)
+	dbClientMock.On("AddStatusTransition", keeperModels.StatusTransition{ServiceId: testServiceId, FromStatus: v2Models.Up, ToStatus: v2Models.Halt}).Return(transition, nil)
 	registryMock := &mocks.Registry{}
 	registryMock.On("DeregisterByServiceId", testServiceId)
+	messagingMock := &messagingMocks.MessageClient{}
+	messagingMock.On("Publish", mock.Anything, "edgex/system-events/core-keeper/registration/delete/"+testServiceId).Return(nil)
 	dic.Update(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{
+				HealthCheck: config.HealthCheckInfo{
+					SystemEventBaseTopic: "edgex",
+				},
+			}
+		},
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
 		},
 		container.RegistryInterfaceName: func(get di.Get) interface{} {
 			return registryMock
 		},
+		bootstrapContainer.MessagingClientName: func(get di.Get) interface{} {
+			return messagingMock
+		},
 	})
 	controller := NewRegistryController(dic)
 	assert.NotNil(t, controller)
@@ -254,7 +362,16 @@ func TestRegistryController_Deregister(t *testing.T) {
 			assert.Equal(t, recorder.Result().StatusCode, testCase.expectedStatusCode)
 			if testCase.expectedStatusCode == http.StatusNoContent {
 				registryMock.AssertNumberOfCalls(t, "DeregisterByServiceId", 1)
-
+				messagingMock.AssertNumberOfCalls(t, "Publish", 1)
+				envelope := messagingMock.Calls[0].Arguments.Get(0).(msgTypes.MessageEnvelope)
+				systemEvent, err := msgTypes.GetMsgPayload[dtos.SystemEvent](envelope)
+				require.NoError(t, err)
+				assert.Equal(t, constants.RegistrationSystemEventType, systemEvent.Type)
+				assert.Equal(t, common.SystemEventActionDelete, systemEvent.Action)
+				assert.Equal(t, testServiceId, systemEvent.Owner)
+				var details keeperDtos.StatusTransition
+				require.NoError(t, systemEvent.DecodeDetails(&details))
+				assert.Equal(t, keeperDtos.FromStatusTransitionModelToDTO(transition), details)
 			}
 		})
 	}
@@ -355,3 +472,233 @@ func TestRegistryController_Registrations(t *testing.T) {
 	assert.Equal(t, 1, len(res.Registrations), "Device count not as expected")
 	assert.Empty(t, res.Message, "Message should be empty when it is successful")
 }
+
+func TestRegistryController_Heartbeat(t *testing.T) {
+	notFound := "notFound"
+	notTTL := "notTTL"
+	dic := mockDic()
+	registryMock := &mocks.Registry{}
+	registryMock.On("Heartbeat", testServiceId, v2Models.Up).Return(nil)
+	registryMock.On("Heartbeat", testServiceId, constants.Warning).Return(nil)
+	registryMock.On("Heartbeat", notFound, v2Models.Up).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	registryMock.On("Heartbeat", notTTL, v2Models.Up).Return(errors.NewCommonEdgeX(errors.KindContractInvalid, "not ttl", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.RegistryInterfaceName: func(get di.Get) interface{} {
+			return registryMock
+		},
+	})
+	controller := NewRegistryController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		serviceId          string
+		status             string
+		expectedStatusCode int
+	}{
+		{"valid - default status", testServiceId, "", http.StatusNoContent},
+		{"valid - lowercase status", testServiceId, "warning", http.StatusNoContent},
+		{"invalid - unknown status", testServiceId, "sleeping", http.StatusBadRequest},
+		{"invalid - halt status", testServiceId, v2Models.Halt, http.StatusBadRequest},
+		{"invalid - empty serviceId", "", "", http.StatusBadRequest},
+		{"invalid - not a ttl health check", notTTL, "", http.StatusBadRequest},
+		{"not found", notFound, "", http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodPut, constants.ApiRegistrationHeartbeatRoute, http.NoBody)
+			require.NoError(t, err)
+			if testCase.status != "" {
+				query := req.URL.Query()
+				query.Add(constants.Status, testCase.status)
+				req.URL.RawQuery = query.Encode()
+			}
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.ServiceId)
+			c.SetParamValues(testCase.serviceId)
+			err = controller.Heartbeat(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
+
+func TestRegistryController_Discovery(t *testing.T) {
+	registrations := []v2Models.Registration{
+		{ServiceId: "core-data-2", Host: "host2", Port: 59880, Status: v2Models.Up},
+		{ServiceId: "core-data-1", Host: "host1", Port: 59880, Status: v2Models.Up},
+		{ServiceId: "core-data-3", Host: "host3", Port: 59880, Status: v2Models.Down},
+		{ServiceId: "core-metadata", Host: "host4", Port: 59881, Status: constants.Warning},
+	}
+	instances := []keeperModels.ServiceInstance{
+		{ServiceId: "core-data-1", ServiceName: "core-data", Tags: []string{"primary", "edge"}, Metadata: map[string]string{"zone": "a"}},
+		{ServiceId: "core-data-2", ServiceName: "core-data", Tags: []string{"edge"}, Metadata: map[string]string{"zone": "b"}},
+		{ServiceId: "core-data-3", ServiceName: "core-data", Tags: []string{"edge"}, Metadata: map[string]string{"zone": "a"}},
+	}
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("Registrations").Return(registrations, nil)
+	dbClientMock.On("ServiceInstances").Return(instances, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewRegistryController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		query              map[string]string
+		expectedIds        []string
+		expectedStatusCode int
+	}{
+		{"valid - healthy instances", map[string]string{constants.ServiceName: "core-data"}, []string{"core-data-1", "core-data-2"}, http.StatusOK},
+		{"valid - round-robin rotation", map[string]string{constants.ServiceName: "core-data"}, []string{"core-data-2", "core-data-1"}, http.StatusOK},
+		{"valid - service name prefix", map[string]string{constants.ServiceName: "core-", constants.Status: "up,warning"}, []string{"core-data-1", "core-data-2", "core-metadata"}, http.StatusOK},
+		{"valid - service name defaults to serviceId", map[string]string{constants.ServiceName: "core-metadata", constants.Status: constants.Warning}, []string{"core-metadata"}, http.StatusOK},
+		{"valid - tags", map[string]string{constants.Tags: "edge,primary"}, []string{"core-data-1"}, http.StatusOK},
+		{"valid - metadata", map[string]string{constants.Metadata: "zone:a", constants.Status: v2Models.Down}, []string{"core-data-3"}, http.StatusOK},
+		{"valid - no instance matched", map[string]string{constants.ServiceName: "support-"}, []string{}, http.StatusOK},
+		{"invalid - metadata format", map[string]string{constants.Metadata: "zone"}, nil, http.StatusBadRequest},
+		{"invalid - order", map[string]string{constants.Order: "weighted"}, nil, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiRegistrationDiscoveryRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			for k, v := range testCase.query {
+				query.Add(k, v)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.Discovery(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res keeperResponses.MultiServiceInstancesResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				ids := make([]string, len(res.Instances))
+				for i, instance := range res.Instances {
+					ids[i] = instance.InstanceId
+				}
+				assert.Equal(t, testCase.expectedIds, ids)
+			}
+		})
+	}
+}
+
+func TestRegistryController_DiscoveryRandomOrder(t *testing.T) {
+	var registrations []v2Models.Registration
+	for i := range 10 {
+		registrations = append(registrations, v2Models.Registration{ServiceId: fmt.Sprintf("core-data-%d", i), Status: v2Models.Up})
+	}
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("Registrations").Return(registrations, nil)
+	dbClientMock.On("ServiceInstances").Return([]keeperModels.ServiceInstance{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewRegistryController(dic)
+
+	e := echo.New()
+	req, err := http.NewRequest(http.MethodGet, constants.ApiRegistrationDiscoveryRoute+"?"+constants.ServiceName+"=core-data&"+constants.Order+"="+constants.DiscoveryOrderRandom, http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	err = controller.Discovery(e.NewContext(req, recorder))
+	require.NoError(t, err)
+
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	var res keeperResponses.MultiServiceInstancesResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Len(t, res.Instances, len(registrations))
+	assert.Equal(t, int64(len(registrations)), res.TotalCount)
+}
+
+func TestRegistryController_StatusHistory(t *testing.T) {
+	transitions := []keeperModels.StatusTransition{
+		{Id: testTransitionId, ServiceId: testServiceId, FromStatus: v2Models.Up, ToStatus: v2Models.Down, Timestamp: 2},
+		{Id: "5d2f3c8e-7c4a-4d59-8f0e-0c1a2b3c4d5e", ServiceId: testServiceId, FromStatus: v2Models.Unknown, ToStatus: v2Models.Up, Timestamp: 1},
+	}
+	noHistory := "noHistory"
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("StatusTransitionCountByServiceId", testServiceId, int64(0), int64(math.MaxInt32)).Return(int64(len(transitions)), nil)
+	dbClientMock.On("StatusTransitionsByServiceId", testServiceId, int64(0), int64(math.MaxInt32), 0, 30).Return(transitions, nil)
+	dbClientMock.On("StatusTransitionsByServiceId", testServiceId, int64(0), int64(math.MaxInt32), 1, 1).Return(transitions[1:], nil)
+	dbClientMock.On("StatusTransitionCountByServiceId", noHistory, int64(0), int64(math.MaxInt32)).Return(int64(0), nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewRegistryController(dic)
+	assert.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		serviceId          string
+		offset             string
+		limit              string
+		expectedCount      int
+		expectedTotalCount int64
+		expectedStatusCode int
+	}{
+		{"valid", testServiceId, "", "", 2, 2, http.StatusOK},
+		{"valid - with offset and limit", testServiceId, "1", "1", 1, 2, http.StatusOK},
+		{"valid - no history", noHistory, "", "", 0, 0, http.StatusOK},
+		{"invalid - offset out of range", testServiceId, "3", "", 0, 0, http.StatusRequestedRangeNotSatisfiable},
+		{"invalid - invalid limit", testServiceId, "", "abc", 0, 0, http.StatusBadRequest},
+		{"invalid - empty serviceId", "", "", "", 0, 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiRegistrationHistoryRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			if testCase.offset != "" {
+				query.Add(common.Offset, testCase.offset)
+			}
+			if testCase.limit != "" {
+				query.Add(common.Limit, testCase.limit)
+			}
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(constants.ServiceId)
+			c.SetParamValues(testCase.serviceId)
+			err = controller.StatusHistory(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res keeperResponses.MultiStatusTransitionsResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedTotalCount, res.TotalCount, "Total count not as expected")
+				assert.Len(t, res.Transitions, testCase.expectedCount, "Transition count not as expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// KVRevision and its properties are defined in the APIv3 specification:
+// openapi/core-keeper.yaml
+type KVRevision struct {
+	Key         string `json:"key"`
+	Value       string `json:"value,omitempty"`
+	Action      string `json:"action"`
+	ModifyIndex uint64 `json:"modifyIndex"`
+	Timestamp   int64  `json:"timestamp"`
+}
+
+// KVChange and its properties are defined in the APIv3 specification:
+// openapi/core-keeper.yaml
+type KVChange struct {
+	Key       string `json:"key"`
+	Change    string `json:"change"`
+	FromValue string `json:"fromValue,omitempty"`
+	ToValue   string `json:"toValue,omitempty"`
+}
+
+// FromKVRevisionModelsToDTOs transforms the KVRevision models to the KVRevision DTOs
+func FromKVRevisionModelsToDTOs(revisions []models.KVRevision) []KVRevision {
+	dtos := make([]KVRevision, len(revisions))
+	for i, r := range revisions {
+		dtos[i] = KVRevision{
+			Key:         r.Key,
+			Value:       r.Value,
+			Action:      r.Action,
+			ModifyIndex: r.ModifyIndex,
+			Timestamp:   r.Timestamp,
+		}
+	}
+	return dtos
+}
+
+// FromKVChangeModelsToDTOs transforms the KVChange models to the KVChange DTOs
+func FromKVChangeModelsToDTOs(changes []models.KVChange) []KVChange {
+	dtos := make([]KVChange, len(changes))
+	for i, c := range changes {
+		dtos[i] = KVChange{
+			Key:       c.Key,
+			Change:    c.Change,
+			FromValue: c.FromValue,
+			ToValue:   c.ToValue,
+		}
+	}
+	return dtos
+}
@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// KVTxnOp and its properties are defined in the APIv3 specification:
+// openapi/core-keeper.yaml
+type KVTxnOp struct {
+	Verb        string `json:"verb" validate:"required,oneof='set' 'delete' 'check-index'"`
+	Key         string `json:"key" validate:"required"`
+	Value       any    `json:"value,omitempty"`
+	Flatten     bool   `json:"flatten,omitempty"`
+	PrefixMatch bool   `json:"prefixMatch,omitempty"`
+	Index       uint64 `json:"index,omitempty"`
+}
+
+// ValidateTxnOps validates the properties of the operations which depend on the verb
+func ValidateTxnOps(ops []KVTxnOp) error {
+	for i, op := range ops {
+		if op.Verb == constants.TxnVerbSet && op.Value == nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("value of operation %d is required by the %s verb", i, op.Verb), nil)
+		}
+	}
+	return nil
+}
+
+// ToKVTxnOpModels transforms the KVTxnOp DTOs to the KVTxnOp models
+func ToKVTxnOpModels(dtos []KVTxnOp) []models.KVTxnOp {
+	ops := make([]models.KVTxnOp, len(dtos))
+	for i, dto := range dtos {
+		ops[i] = models.KVTxnOp{
+			Verb:        dto.Verb,
+			Key:         dto.Key,
+			Value:       dto.Value,
+			Flatten:     dto.Flatten,
+			PrefixMatch: dto.PrefixMatch,
+			Index:       dto.Index,
+		}
+	}
+	return ops
+}
@@ -0,0 +1,50 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+)
+
+// KVTxnRequest defines the Request Content for PUT KV transaction DTO.
+type KVTxnRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Operations            []dtos.KVTxnOp `json:"operations" validate:"required,gt=0,dive"`
+}
+
+// Validate satisfies the Validator interface
+func (k KVTxnRequest) Validate() error {
+	err := common.Validate(k)
+	if err != nil {
+		return err
+	}
+	return dtos.ValidateTxnOps(k.Operations)
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the KVTxnRequest type
+func (k *KVTxnRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Operations []dtos.KVTxnOp
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*k = KVTxnRequest(alias)
+
+	// validate KVTxnRequest DTO
+	if err := k.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
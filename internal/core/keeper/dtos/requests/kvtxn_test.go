@@ -0,0 +1,82 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+)
+
+func kvTxnRequestData() KVTxnRequest {
+	return KVTxnRequest{
+		BaseRequest: dtoCommon.NewBaseRequest(),
+		Operations: []dtos.KVTxnOp{
+			{Verb: constants.TxnVerbCheckIndex, Key: "edgex/v4/core-data/Writable/LogLevel", Index: 3},
+			{Verb: constants.TxnVerbSet, Key: "edgex/v4/core-data/Writable/LogLevel", Value: "DEBUG"},
+			{Verb: constants.TxnVerbDelete, Key: "edgex/v4/core-data/Writable/Telemetry", PrefixMatch: true},
+		},
+	}
+}
+
+func TestKVTxnRequest_Validate(t *testing.T) {
+	valid := kvTxnRequestData()
+	noOperations := kvTxnRequestData()
+	noOperations.Operations = nil
+	invalidVerb := kvTxnRequestData()
+	invalidVerb.Operations[0].Verb = "get"
+	emptyKey := kvTxnRequestData()
+	emptyKey.Operations[2].Key = ""
+	setWithoutValue := kvTxnRequestData()
+	setWithoutValue.Operations[1].Value = nil
+
+	tests := []struct {
+		name          string
+		request       KVTxnRequest
+		expectedError bool
+	}{
+		{"valid", valid, false},
+		{"invalid, no operations", noOperations, true},
+		{"invalid, unknown verb", invalidVerb, true},
+		{"invalid, empty key", emptyKey, true},
+		{"invalid, set without value", setWithoutValue, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.request.Validate()
+			if testCase.expectedError {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKVTxnRequest_UnmarshalJSON(t *testing.T) {
+	valid := kvTxnRequestData()
+	validData, err := json.Marshal(valid)
+	require.NoError(t, err)
+
+	var result KVTxnRequest
+	err = result.UnmarshalJSON(validData)
+	require.NoError(t, err)
+	require.Len(t, result.Operations, len(valid.Operations))
+	assert.Equal(t, uint64(3), result.Operations[0].Index)
+	assert.Equal(t, "DEBUG", result.Operations[1].Value)
+
+	err = result.UnmarshalJSON([]byte("?"))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
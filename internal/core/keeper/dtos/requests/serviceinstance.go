@@ -0,0 +1,16 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+)
+
+// RegistrationInstanceRequest holds the discovery fields in the body of the POST and PUT registration requests, which
+// extend the registration DTO defined in go-mod-core-contracts
+type RegistrationInstanceRequest struct {
+	Registration dtos.ServiceInstanceInfo `json:"registration"`
+}
@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+)
+
+// KVExportResponse defines the Response Content for exporting the keys, the Value is in the same shape of the value to
+// be stored with flatten enabled.
+type KVExportResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Value                  any `json:"value"`
+}
+
+func NewKVExportResponse(requestId string, message string, statusCode int, value any) KVExportResponse {
+	return KVExportResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Value:        value,
+	}
+}
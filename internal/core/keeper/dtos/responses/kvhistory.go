@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+)
+
+// MultiKVRevisionsResponse defines the Response Content for GET the KV history.
+type MultiKVRevisionsResponse struct {
+	dtoCommon.BaseWithTotalCountResponse `json:",inline"`
+	Revisions                            []dtos.KVRevision `json:"revisions"`
+}
+
+func NewMultiKVRevisionsResponse(requestId string, message string, statusCode int, totalCount int64, revisions []dtos.KVRevision) MultiKVRevisionsResponse {
+	return MultiKVRevisionsResponse{
+		BaseWithTotalCountResponse: dtoCommon.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Revisions:                  revisions,
+	}
+}
+
+// MultiKVChangesResponse defines the Response Content for the KV diff and restore.
+type MultiKVChangesResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Changes                []dtos.KVChange `json:"changes"`
+}
+
+func NewMultiKVChangesResponse(requestId string, message string, statusCode int, changes []dtos.KVChange) MultiKVChangesResponse {
+	return MultiKVChangesResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Changes:      changes,
+	}
+}
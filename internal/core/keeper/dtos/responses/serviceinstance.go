@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+)
+
+// MultiServiceInstancesResponse defines the Response Content for GET the discovered service instances.
+type MultiServiceInstancesResponse struct {
+	dtoCommon.BaseWithTotalCountResponse `json:",inline"`
+	Instances                            []dtos.ServiceInstance `json:"instances"`
+}
+
+func NewMultiServiceInstancesResponse(requestId string, message string, statusCode int, totalCount int64, instances []dtos.ServiceInstance) MultiServiceInstancesResponse {
+	return MultiServiceInstancesResponse{
+		BaseWithTotalCountResponse: dtoCommon.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Instances:                  instances,
+	}
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+)
+
+// MultiStatusTransitionsResponse defines the Response Content for GET the status history of a registered service.
+type MultiStatusTransitionsResponse struct {
+	dtoCommon.BaseWithTotalCountResponse `json:",inline"`
+	Transitions                          []dtos.StatusTransition `json:"transitions"`
+}
+
+func NewMultiStatusTransitionsResponse(requestId string, message string, statusCode int, totalCount int64, transitions []dtos.StatusTransition) MultiStatusTransitionsResponse {
+	return MultiStatusTransitionsResponse{
+		BaseWithTotalCountResponse: dtoCommon.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Transitions:                transitions,
+	}
+}
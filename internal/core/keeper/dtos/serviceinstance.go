@@ -0,0 +1,61 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	contractModels "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// ServiceInstanceInfo holds the optional discovery fields of a registration, the instances of the same service share
+// the ServiceName, which defaults to the serviceId of the registration
+type ServiceInstanceInfo struct {
+	ServiceName string            `json:"serviceName,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// ServiceInstance and its properties are defined in the APIv3 specification:
+// openapi/core-keeper.yaml
+type ServiceInstance struct {
+	InstanceId    string            `json:"instanceId"`
+	ServiceName   string            `json:"serviceName"`
+	Host          string            `json:"host"`
+	Port          int               `json:"port"`
+	Status        string            `json:"status"`
+	Tags          []string          `json:"tags,omitempty"`
+	Metadata      map[string]string `json:"metadata,omitempty"`
+	LastConnected int64             `json:"lastConnected,omitempty"`
+}
+
+// ToServiceInstanceModel transforms the ServiceInstanceInfo DTO of the registration to the ServiceInstance model
+func ToServiceInstanceModel(serviceId string, info ServiceInstanceInfo) models.ServiceInstance {
+	serviceName := info.ServiceName
+	if serviceName == "" {
+		serviceName = serviceId
+	}
+	return models.ServiceInstance{
+		ServiceId:   serviceId,
+		ServiceName: serviceName,
+		Tags:        info.Tags,
+		Metadata:    info.Metadata,
+	}
+}
+
+// FromServiceInstanceModelToDTO transforms the Registration and ServiceInstance models to the ServiceInstance DTO
+func FromServiceInstanceModelToDTO(r contractModels.Registration, i models.ServiceInstance) ServiceInstance {
+	return ServiceInstance{
+		InstanceId:    r.ServiceId,
+		ServiceName:   i.ServiceName,
+		Host:          r.Host,
+		Port:          r.Port,
+		Status:        r.Status,
+		Tags:          i.Tags,
+		Metadata:      i.Metadata,
+		LastConnected: r.LastConnected,
+	}
+}
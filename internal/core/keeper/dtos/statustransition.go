@@ -0,0 +1,40 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// StatusTransition and its properties are defined in the APIv3 specification:
+// openapi/core-keeper.yaml
+type StatusTransition struct {
+	Id         string `json:"id"`
+	ServiceId  string `json:"serviceId"`
+	FromStatus string `json:"fromStatus"`
+	ToStatus   string `json:"toStatus"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+// FromStatusTransitionModelToDTO transforms the StatusTransition model to the StatusTransition DTO
+func FromStatusTransitionModelToDTO(t models.StatusTransition) StatusTransition {
+	return StatusTransition{
+		Id:         t.Id,
+		ServiceId:  t.ServiceId,
+		FromStatus: t.FromStatus,
+		ToStatus:   t.ToStatus,
+		Timestamp:  t.Timestamp,
+	}
+}
+
+// FromStatusTransitionModelsToDTOs transforms the StatusTransition models to the StatusTransition DTOs
+func FromStatusTransitionModelsToDTOs(transitions []models.StatusTransition) []StatusTransition {
+	dtos := make([]StatusTransition, len(transitions))
+	for i, t := range transitions {
+		dtos[i] = FromStatusTransitionModelToDTO(t)
+	}
+	return dtos
+}
@@ -0,0 +1,20 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_keeper.config_modify_index is the global counter used to stamp every change of the config keys
+CREATE SEQUENCE IF NOT EXISTS core_keeper.config_modify_index;
+
+-- modify_index records the counter value of the last change of each key, the existing keys are stamped on creation of the column
+ALTER TABLE core_keeper.config ADD COLUMN IF NOT EXISTS modify_index BIGINT NOT NULL DEFAULT nextval('core_keeper.config_modify_index');
+
+-- core_keeper.config_tombstone records the counter value when a key is deleted so that the watchers of the key prefix can be notified,
+-- the tombstone is deleted when the key is created again or pruned after the retention elapses
+CREATE TABLE IF NOT EXISTS core_keeper.config_tombstone (
+    key TEXT PRIMARY KEY,
+    modify_index BIGINT NOT NULL DEFAULT nextval('core_keeper.config_modify_index')
+);
+
+ALTER TABLE core_keeper.config_tombstone ADD COLUMN IF NOT EXISTS deleted timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'utc');
+CREATE INDEX IF NOT EXISTS idx_config_tombstone_deleted ON core_keeper.config_tombstone(deleted);
@@ -0,0 +1,23 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_keeper.config_history is the append-only history of the changes of the config keys,
+-- the value is NULL when the action is delete
+CREATE TABLE IF NOT EXISTS core_keeper.config_history (
+    id BIGSERIAL PRIMARY KEY,
+    key TEXT NOT NULL,
+    value TEXT,
+    action TEXT NOT NULL,
+    modify_index BIGINT NOT NULL,
+    created timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'utc')
+);
+
+CREATE INDEX IF NOT EXISTS idx_config_history_key ON core_keeper.config_history(key);
+CREATE INDEX IF NOT EXISTS idx_config_history_created ON core_keeper.config_history(created);
+
+-- seed the history with the current value of the existing keys so that they can be restored
+INSERT INTO core_keeper.config_history(key, value, action, modify_index, created)
+SELECT key, value, 'set', modify_index, modified FROM core_keeper.config
+WHERE NOT EXISTS (SELECT 1 FROM core_keeper.config_history);
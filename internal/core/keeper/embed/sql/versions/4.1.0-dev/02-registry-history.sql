@@ -0,0 +1,16 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_keeper.registry_history is the append-only history of the health status transitions of the registered services
+CREATE TABLE IF NOT EXISTS core_keeper.registry_history (
+    id UUID PRIMARY KEY,
+    service_id TEXT NOT NULL,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    created timestamp NOT NULL DEFAULT (now() AT TIME ZONE 'utc')
+);
+
+CREATE INDEX IF NOT EXISTS idx_registry_history_service_id ON core_keeper.registry_history(service_id);
+CREATE INDEX IF NOT EXISTS idx_registry_history_created ON core_keeper.registry_history(created);
@@ -0,0 +1,11 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- core_keeper.registry_instance is used to store the discovery information of the registered services, which are the
+-- service name shared by the instances of the same service, tags and metadata
+CREATE TABLE IF NOT EXISTS core_keeper.registry_instance (
+    service_id TEXT PRIMARY KEY,
+    content JSONB NOT NULL
+);
@@ -8,16 +8,30 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 )
 
 type DBClient interface {
 	KeeperKeys(key string, keyOnly bool, isRaw bool) ([]models.KVResponse, errors.EdgeX)
 	AddKeeperKeys(kv models.KVS, isFlatten bool) ([]models.KeyOnly, errors.EdgeX)
 	DeleteKeeperKeys(key string, isRecurse bool) ([]models.KeyOnly, errors.EdgeX)
+	KeeperKeysIndex(key string) (uint64, errors.EdgeX)
+	KeeperTxn(ops []keeperModels.KVTxnOp) ([]models.KeyOnly, errors.EdgeX)
+	KeeperKeysHistory(key string, start int64, end int64, offset int, limit int) ([]keeperModels.KVRevision, errors.EdgeX)
+	KeeperKeysHistoryCount(key string, start int64, end int64) (int64, errors.EdgeX)
+	DeleteKeeperKeysHistoryByAge(age int64) errors.EdgeX
 
 	AddRegistration(r models.Registration) (models.Registration, errors.EdgeX)
 	DeleteRegistrationByServiceId(id string) errors.EdgeX
 	Registrations() ([]models.Registration, errors.EdgeX)
 	RegistrationByServiceId(id string) (models.Registration, errors.EdgeX)
 	UpdateRegistration(r models.Registration) errors.EdgeX
+	AddStatusTransition(t keeperModels.StatusTransition) (keeperModels.StatusTransition, errors.EdgeX)
+	StatusTransitionsByServiceId(serviceId string, start int64, end int64, offset int, limit int) ([]keeperModels.StatusTransition, errors.EdgeX)
+	StatusTransitionCountByServiceId(serviceId string, start int64, end int64) (int64, errors.EdgeX)
+	DeleteStatusTransitionsByAge(age int64) errors.EdgeX
+	UpsertServiceInstance(i keeperModels.ServiceInstance) errors.EdgeX
+	ServiceInstances() ([]keeperModels.ServiceInstance, errors.EdgeX)
+	DeleteServiceInstanceByServiceId(id string) errors.EdgeX
 }
@@ -5,6 +5,8 @@ package mocks
 import (
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
+	keepermodels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
@@ -77,6 +79,36 @@ func (_m *DBClient) AddRegistration(r models.Registration) (models.Registration,
 	return r0, r1
 }
 
+// AddStatusTransition provides a mock function with given fields: t
+func (_m *DBClient) AddStatusTransition(t keepermodels.StatusTransition) (keepermodels.StatusTransition, errors.EdgeX) {
+	ret := _m.Called(t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddStatusTransition")
+	}
+
+	var r0 keepermodels.StatusTransition
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(keepermodels.StatusTransition) (keepermodels.StatusTransition, errors.EdgeX)); ok {
+		return rf(t)
+	}
+	if rf, ok := ret.Get(0).(func(keepermodels.StatusTransition) keepermodels.StatusTransition); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(keepermodels.StatusTransition)
+	}
+
+	if rf, ok := ret.Get(1).(func(keepermodels.StatusTransition) errors.EdgeX); ok {
+		r1 = rf(t)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteKeeperKeys provides a mock function with given fields: key, isRecurse
 func (_m *DBClient) DeleteKeeperKeys(key string, isRecurse bool) ([]models.KeyOnly, errors.EdgeX) {
 	ret := _m.Called(key, isRecurse)
@@ -109,6 +141,26 @@ func (_m *DBClient) DeleteKeeperKeys(key string, isRecurse bool) ([]models.KeyOn
 	return r0, r1
 }
 
+// DeleteKeeperKeysHistoryByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteKeeperKeysHistoryByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteKeeperKeysHistoryByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteRegistrationByServiceId provides a mock function with given fields: id
 func (_m *DBClient) DeleteRegistrationByServiceId(id string) errors.EdgeX {
 	ret := _m.Called(id)
@@ -129,6 +181,46 @@ func (_m *DBClient) DeleteRegistrationByServiceId(id string) errors.EdgeX {
 	return r0
 }
 
+// DeleteServiceInstanceByServiceId provides a mock function with given fields: id
+func (_m *DBClient) DeleteServiceInstanceByServiceId(id string) errors.EdgeX {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServiceInstanceByServiceId")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteStatusTransitionsByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteStatusTransitionsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStatusTransitionsByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // KeeperKeys provides a mock function with given fields: key, keyOnly, isRaw
 func (_m *DBClient) KeeperKeys(key string, keyOnly bool, isRaw bool) ([]models.KVResponse, errors.EdgeX) {
 	ret := _m.Called(key, keyOnly, isRaw)
@@ -161,6 +253,130 @@ func (_m *DBClient) KeeperKeys(key string, keyOnly bool, isRaw bool) ([]models.K
 	return r0, r1
 }
 
+// KeeperKeysHistory provides a mock function with given fields: key, start, end, offset, limit
+func (_m *DBClient) KeeperKeysHistory(key string, start int64, end int64, offset int, limit int) ([]keepermodels.KVRevision, errors.EdgeX) {
+	ret := _m.Called(key, start, end, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeeperKeysHistory")
+	}
+
+	var r0 []keepermodels.KVRevision
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) ([]keepermodels.KVRevision, errors.EdgeX)); ok {
+		return rf(key, start, end, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) []keepermodels.KVRevision); ok {
+		r0 = rf(key, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]keepermodels.KVRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64, int, int) errors.EdgeX); ok {
+		r1 = rf(key, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// KeeperKeysHistoryCount provides a mock function with given fields: key, start, end
+func (_m *DBClient) KeeperKeysHistoryCount(key string, start int64, end int64) (int64, errors.EdgeX) {
+	ret := _m.Called(key, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeeperKeysHistoryCount")
+	}
+
+	var r0 int64
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64, int64) (int64, errors.EdgeX)); ok {
+		return rf(key, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64) int64); ok {
+		r0 = rf(key, start, end)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64) errors.EdgeX); ok {
+		r1 = rf(key, start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// KeeperKeysIndex provides a mock function with given fields: key
+func (_m *DBClient) KeeperKeysIndex(key string) (uint64, errors.EdgeX) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeeperKeysIndex")
+	}
+
+	var r0 uint64
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (uint64, errors.EdgeX)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint64); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(key)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// KeeperTxn provides a mock function with given fields: ops
+func (_m *DBClient) KeeperTxn(ops []keepermodels.KVTxnOp) ([]models.KeyOnly, errors.EdgeX) {
+	ret := _m.Called(ops)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeeperTxn")
+	}
+
+	var r0 []models.KeyOnly
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]keepermodels.KVTxnOp) ([]models.KeyOnly, errors.EdgeX)); ok {
+		return rf(ops)
+	}
+	if rf, ok := ret.Get(0).(func([]keepermodels.KVTxnOp) []models.KeyOnly); ok {
+		r0 = rf(ops)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.KeyOnly)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]keepermodels.KVTxnOp) errors.EdgeX); ok {
+		r1 = rf(ops)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // RegistrationByServiceId provides a mock function with given fields: id
 func (_m *DBClient) RegistrationByServiceId(id string) (models.Registration, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -223,6 +439,100 @@ func (_m *DBClient) Registrations() ([]models.Registration, errors.EdgeX) {
 	return r0, r1
 }
 
+// ServiceInstances provides a mock function with no fields
+func (_m *DBClient) ServiceInstances() ([]keepermodels.ServiceInstance, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ServiceInstances")
+	}
+
+	var r0 []keepermodels.ServiceInstance
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() ([]keepermodels.ServiceInstance, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []keepermodels.ServiceInstance); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]keepermodels.ServiceInstance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// StatusTransitionCountByServiceId provides a mock function with given fields: serviceId, start, end
+func (_m *DBClient) StatusTransitionCountByServiceId(serviceId string, start int64, end int64) (int64, errors.EdgeX) {
+	ret := _m.Called(serviceId, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StatusTransitionCountByServiceId")
+	}
+
+	var r0 int64
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64, int64) (int64, errors.EdgeX)); ok {
+		return rf(serviceId, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64) int64); ok {
+		r0 = rf(serviceId, start, end)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64) errors.EdgeX); ok {
+		r1 = rf(serviceId, start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// StatusTransitionsByServiceId provides a mock function with given fields: serviceId, start, end, offset, limit
+func (_m *DBClient) StatusTransitionsByServiceId(serviceId string, start int64, end int64, offset int, limit int) ([]keepermodels.StatusTransition, errors.EdgeX) {
+	ret := _m.Called(serviceId, start, end, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StatusTransitionsByServiceId")
+	}
+
+	var r0 []keepermodels.StatusTransition
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) ([]keepermodels.StatusTransition, errors.EdgeX)); ok {
+		return rf(serviceId, start, end, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int, int) []keepermodels.StatusTransition); ok {
+		r0 = rf(serviceId, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]keepermodels.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64, int, int) errors.EdgeX); ok {
+		r1 = rf(serviceId, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // UpdateRegistration provides a mock function with given fields: r
 func (_m *DBClient) UpdateRegistration(r models.Registration) errors.EdgeX {
 	ret := _m.Called(r)
@@ -243,6 +553,26 @@ func (_m *DBClient) UpdateRegistration(r models.Registration) errors.EdgeX {
 	return r0
 }
 
+// UpsertServiceInstance provides a mock function with given fields: i
+func (_m *DBClient) UpsertServiceInstance(i keepermodels.ServiceInstance) errors.EdgeX {
+	ret := _m.Called(i)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertServiceInstance")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(keepermodels.ServiceInstance) errors.EdgeX); ok {
+		r0 = rf(i)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDBClient(t interface {
@@ -3,8 +3,11 @@
 package mocks
 
 import (
-	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	errors "github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
 	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
 // Registry is an autogenerated mock type for the Registry type
@@ -17,6 +20,26 @@ func (_m *Registry) DeregisterByServiceId(id string) {
 	_m.Called(id)
 }
 
+// Heartbeat provides a mock function with given fields: id, status
+func (_m *Registry) Heartbeat(id string, status string) errors.EdgeX {
+	ret := _m.Called(id, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Heartbeat")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) errors.EdgeX); ok {
+		r0 = rf(id, status)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // Register provides a mock function with given fields: r
 func (_m *Registry) Register(r models.Registration) {
 	_m.Called(r)
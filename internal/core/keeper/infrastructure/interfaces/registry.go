@@ -5,7 +5,10 @@
 
 package interfaces
 
-import "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
 
 // Registry defines the functionalities of a registry service
 type Registry interface {
@@ -15,4 +18,7 @@ type Registry interface {
 	// DeregisterByServiceId de-registers a service by its id and stops
 	// health checking its status
 	DeregisterByServiceId(id string)
+	// Heartbeat passes the heartbeat with the reported status to the TTL
+	// health check of a registered service
+	Heartbeat(id string, status string) errors.EdgeX
 }
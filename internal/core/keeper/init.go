@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,10 +8,16 @@ package keeper
 import (
 	"context"
 	"sync"
+	"time"
 
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/watch"
+
 	"github.com/labstack/echo/v4"
 )
 
@@ -31,7 +37,25 @@ func NewBootstrap(router *echo.Echo, serviceName string) *Bootstrap {
 
 // BootstrapHandler fulfills the BootstrapHandler contract and performs initialization needed by the command service.
 func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _ startup.Timer, dic *di.Container) bool {
+	watcher := watch.NewWatcher()
+	dic.Update(di.ServiceConstructorMap{
+		container.KVWatcherName: func(get di.Get) interface{} {
+			return watcher
+		},
+	})
+
 	LoadRestRoutes(b.router, dic, b.serviceName)
 
+	config := container.ConfigurationFrom(dic.Get)
+	if config.Retention.Enabled {
+		retentionInterval, err := time.ParseDuration(config.Retention.Interval)
+		if err != nil {
+			lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+			lc.Errorf("Failed to parse history retention interval, %v", err)
+			return false
+		}
+		application.AsyncPurgeHistory(ctx, dic, retentionInterval)
+	}
+
 	return true
 }
@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// KVRevision is a single change of a key recorded in the append-only KV history
+type KVRevision struct {
+	Key string
+	// Value is the raw value stored by the change, empty when the key is deleted
+	Value string
+	// Action is either the set or delete verb
+	Action      string
+	ModifyIndex uint64
+	// Timestamp is the time of the change in milliseconds
+	Timestamp int64
+}
+
+// KVChange is the difference of a key between two points in time
+type KVChange struct {
+	Key string
+	// Change is one of added, removed or modified
+	Change string
+	// FromValue is the raw value at the earlier point in time, empty when the key was added
+	FromValue string
+	// ToValue is the raw value at the later point in time, empty when the key was removed
+	ToValue string
+}
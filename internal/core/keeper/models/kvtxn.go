@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+)
+
+// KVTxnOp is a single operation of a KV transaction, all the operations of a transaction are applied atomically
+type KVTxnOp struct {
+	// Verb is one of set, delete or check-index
+	Verb string
+	Key  string
+	// Value is stored by the set operation
+	Value any
+	// Flatten stores the map Value of the set operation as multiple keys
+	Flatten bool
+	// PrefixMatch deletes the keys with the same key prefix by the delete operation
+	PrefixMatch bool
+	// Index is the expected modify index of the key by the check-index operation, 0 expects the key not exist
+	Index uint64
+}
+
+// CheckTxnIndexes evaluates all the check-index operations of a KV transaction against the keys before the transaction,
+// regardless of their positions among the set and delete operations, so that every database client applies the same
+// rule. The modify index of a key is returned by indexOf, and the position of the failed operation is returned.
+func CheckTxnIndexes(ops []KVTxnOp, indexOf func(key string) (uint64, errors.EdgeX)) (int, errors.EdgeX) {
+	for i, op := range ops {
+		if op.Verb != constants.TxnVerbCheckIndex {
+			continue
+		}
+		index, edgeXerr := indexOf(op.Key)
+		if edgeXerr != nil {
+			return i, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if index != op.Index {
+			return i, errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("the modify index is %d rather than %d", index, op.Index), nil)
+		}
+	}
+	return -1, nil
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+)
+
+func TestCheckTxnIndexes(t *testing.T) {
+	// the indexes of the keys before the transaction
+	indexes := map[string]uint64{"a": 3}
+	indexOf := func(key string) (uint64, errors.EdgeX) {
+		return indexes[key], nil
+	}
+
+	tests := []struct {
+		name           string
+		ops            []KVTxnOp
+		expectedFailed int
+		errorExpected  bool
+	}{
+		{"check before set", []KVTxnOp{
+			{Verb: constants.TxnVerbCheckIndex, Key: "a", Index: 3},
+			{Verb: constants.TxnVerbSet, Key: "a", Value: "v"},
+		}, -1, false},
+		{"check after set sees the index before the transaction", []KVTxnOp{
+			{Verb: constants.TxnVerbSet, Key: "a", Value: "v"},
+			{Verb: constants.TxnVerbCheckIndex, Key: "a", Index: 3},
+		}, -1, false},
+		{"check after set of a new key expects it not to exist", []KVTxnOp{
+			{Verb: constants.TxnVerbSet, Key: "b", Value: "v"},
+			{Verb: constants.TxnVerbCheckIndex, Key: "b", Index: 0},
+		}, -1, false},
+		{"check after delete sees the index before the transaction", []KVTxnOp{
+			{Verb: constants.TxnVerbDelete, Key: "a"},
+			{Verb: constants.TxnVerbCheckIndex, Key: "a", Index: 0},
+		}, 1, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			failed, err := CheckTxnIndexes(testCase.ops, indexOf)
+			assert.Equal(t, testCase.expectedFailed, failed)
+			if testCase.errorExpected {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ServiceInstance is the discovery information of a registered service, the ServiceId of the registration identifies
+// the instance and the instances of the same service share the ServiceName
+type ServiceInstance struct {
+	ServiceId   string
+	ServiceName string
+	Tags        []string
+	Metadata    map[string]string
+}
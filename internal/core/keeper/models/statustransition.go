@@ -0,0 +1,16 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// StatusTransition is a change of the health status of a registered service
+type StatusTransition struct {
+	Id         string
+	ServiceId  string
+	FromStatus string
+	ToStatus   string
+	// Timestamp is the time of the transition in milliseconds
+	Timestamp int64
+}
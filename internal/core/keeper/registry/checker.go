@@ -0,0 +1,234 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	stdErrs "errors"
+	"io"
+	"net"
+	"net/http"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+)
+
+// maxHealthBodySize limits the response body read from an HTTP health check
+const maxHealthBodySize = 64 * 1024
+
+// healthChecker probes the registered services with the check selected by the health check type:
+//   - http/https: GET the health check path, 2xx is UP and the configured warning status codes are WARNING
+//   - tcp: connect to the service host and port, the health check path is ignored
+//   - grpc: call the gRPC health protocol, the health check path is the name of the gRPC service to check
+//   - exec: run the health check path as a command, exit code 0 is UP, 1 is WARNING and others are DOWN
+type healthChecker struct {
+	lc        logger.LoggingClient
+	timeout   time.Duration
+	config    config.HealthCheckInfo
+	transport http.RoundTripper
+}
+
+func newHealthChecker(lc logger.LoggingClient, timeout time.Duration, config config.HealthCheckInfo) *healthChecker {
+	return &healthChecker{
+		lc:      lc,
+		timeout: timeout,
+		config:  config,
+	}
+}
+
+// check returns the health status of the registered service, which is UP, WARNING or DOWN
+func (c *healthChecker) check(r models.Registration) string {
+	switch strings.ToLower(r.HealthCheck.Type) {
+	case constants.HealthCheckTypeHttp, constants.HealthCheckTypeHttps:
+		return c.httpCheck(r)
+	case constants.HealthCheckTypeTcp:
+		return c.tcpCheck(r)
+	case constants.HealthCheckTypeGrpc:
+		return c.grpcCheck(r)
+	case constants.HealthCheckTypeExec:
+		return c.execCheck(r)
+	default:
+		c.lc.Errorf("unsupported health check type '%s' of service %s", r.HealthCheck.Type, r.ServiceId)
+		return models.Down
+	}
+}
+
+func (c *healthChecker) httpCheck(r models.Registration) string {
+	client := http.Client{
+		Timeout:   c.timeout,
+		Transport: c.transport,
+	}
+	path := strings.ToLower(r.HealthCheck.Type) + "://" + hostPort(r) + r.HealthCheck.Path
+	req, err := http.NewRequest(http.MethodGet, path, http.NoBody)
+	if err != nil {
+		c.lc.Errorf("failed to create get request for %s: %v", path, err)
+		return models.Down
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		c.lc.Errorf("Failed to health check service %s: %s", r.ServiceId, err.Error())
+		return models.Down
+	}
+
+	// Ensure response body is always closed to prevent resource leaks
+	defer func() {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+	}()
+
+	bodyBytes, err := io.ReadAll(io.LimitReader(resp.Body, maxHealthBodySize))
+	if err != nil {
+		c.lc.Errorf("Failed to read %s response body: %s", path, err.Error())
+	}
+
+	switch {
+	case slices.Contains(c.config.WarningStatusCodes, resp.StatusCode):
+		c.lc.Warnf("service %s is degraded with status code %d: %s", r.ServiceId, resp.StatusCode, string(bodyBytes))
+		return constants.Warning
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		if isDegradedBody(bodyBytes) {
+			c.lc.Warnf("service %s is degraded: %s", r.ServiceId, string(bodyBytes))
+			return constants.Warning
+		}
+		c.lc.Debugf("service %s status healthy", r.ServiceId)
+		return models.Up
+	default:
+		c.lc.Errorf("service %s is unhealthy: %s", r.ServiceId, string(bodyBytes))
+		return models.Down
+	}
+}
+
+// isDegradedBody checks whether the response body is a JSON object with the status field of warning or degraded
+func isDegradedBody(body []byte) bool {
+	var health struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(body, &health); err != nil {
+		return false
+	}
+	return strings.EqualFold(health.Status, "warning") || strings.EqualFold(health.Status, "degraded")
+}
+
+func (c *healthChecker) tcpCheck(r models.Registration) string {
+	conn, err := net.DialTimeout("tcp", hostPort(r), c.timeout)
+	if err != nil {
+		c.lc.Errorf("Failed to health check service %s: %s", r.ServiceId, err.Error())
+		return models.Down
+	}
+	_ = conn.Close()
+
+	c.lc.Debugf("service %s status healthy", r.ServiceId)
+	return models.Up
+}
+
+func (c *healthChecker) grpcCheck(r models.Registration) string {
+	conn, err := grpc.NewClient(hostPort(r), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		c.lc.Errorf("failed to create gRPC client for service %s: %v", r.ServiceId, err)
+		return models.Down
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{
+		Service: strings.TrimPrefix(r.HealthCheck.Path, "/"),
+	})
+	if err != nil {
+		c.lc.Errorf("Failed to health check service %s: %s", r.ServiceId, err.Error())
+		return models.Down
+	}
+
+	if resp.GetStatus() == healthpb.HealthCheckResponse_SERVING {
+		c.lc.Debugf("service %s status healthy", r.ServiceId)
+		return models.Up
+	}
+	c.lc.Errorf("service %s is unhealthy: %s", r.ServiceId, resp.GetStatus().String())
+	return models.Down
+}
+
+func (c *healthChecker) execCheck(r models.Registration) string {
+	// the registrations are validated on registration, but the allowlist may be changed since then
+	if !c.config.ExecCommandAllowed(r.HealthCheck.Path) {
+		c.lc.Errorf("exec health check of service %s is not allowed, please add the executable to HealthCheck.ExecAllowlist first", r.ServiceId)
+		return models.Down
+	}
+	args := strings.Fields(r.HealthCheck.Path)
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	// the command is run without a shell to avoid the injection of shell expressions
+	output, err := exec.CommandContext(ctx, args[0], args[1:]...).CombinedOutput()
+	if err == nil {
+		c.lc.Debugf("service %s status healthy", r.ServiceId)
+		return models.Up
+	}
+
+	var exitErr *exec.ExitError
+	if stdErrs.As(err, &exitErr) && exitErr.ExitCode() == 1 && ctx.Err() == nil {
+		c.lc.Warnf("service %s is degraded: %s", r.ServiceId, string(output))
+		return constants.Warning
+	}
+	c.lc.Errorf("service %s is unhealthy: %v %s", r.ServiceId, err, string(output))
+	return models.Down
+}
+
+func hostPort(r models.Registration) string {
+	return net.JoinHostPort(r.Host, strconv.Itoa(r.Port))
+}
+
+// statusTracker applies the consecutive failure and success thresholds to the health check results, so that a single
+// slow or failed probe doesn't flap the status of the service
+type statusTracker struct {
+	failureThreshold int
+	successThreshold int
+	failures         int
+	successes        int
+}
+
+func newStatusTracker(config config.HealthCheckInfo) *statusTracker {
+	return &statusTracker{
+		failureThreshold: max(config.FailureThreshold, 1),
+		successThreshold: max(config.SuccessThreshold, 1),
+	}
+}
+
+// next returns the new status of the service from the current status and the latest health check result
+func (s *statusTracker) next(current, result string) string {
+	healthy := current == models.Up || current == constants.Warning
+	if result == models.Down {
+		s.failures++
+		s.successes = 0
+		if !healthy || s.failures >= s.failureThreshold {
+			return models.Down
+		}
+		return current
+	}
+
+	s.successes++
+	s.failures = 0
+	if healthy || s.successes >= s.successThreshold {
+		// switching between UP and WARNING doesn't need to reach the threshold as the service is reachable anyway
+		return result
+	}
+	return current
+}
@@ -0,0 +1,198 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+)
+
+func testRegistration(t *testing.T, checkType, path, address string) models.Registration {
+	host, port, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	return models.Registration{
+		ServiceId: "test-service",
+		Host:      host,
+		Port:      portNum,
+		HealthCheck: models.HealthCheck{
+			Type:     checkType,
+			Path:     path,
+			Interval: "10s",
+		},
+	}
+}
+
+func TestHealthChecker_Http(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+			_, _ = w.Write([]byte(`{"apiVersion":"v3","serviceName":"test-service"}`))
+		case "/degraded":
+			_, _ = w.Write([]byte(`{"status":"Degraded"}`))
+		case "/busy":
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	address := server.Listener.Addr().String()
+	checker := newHealthChecker(logger.NewMockClient(), time.Second, config.HealthCheckInfo{WarningStatusCodes: []int{http.StatusTooManyRequests}})
+
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{"Up", "/ok", models.Up},
+		{"Warning - degraded body", "/degraded", constants.Warning},
+		{"Warning - warning status code", "/busy", constants.Warning},
+		{"Down", "/unavailable", models.Down},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := checker.check(testRegistration(t, "HTTP", testCase.path, address))
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestHealthChecker_Tcp(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	checker := newHealthChecker(logger.NewMockClient(), time.Second, config.HealthCheckInfo{})
+
+	assert.Equal(t, models.Up, checker.check(testRegistration(t, constants.HealthCheckTypeTcp, "/", address)))
+
+	require.NoError(t, listener.Close())
+	assert.Equal(t, models.Down, checker.check(testRegistration(t, constants.HealthCheckTypeTcp, "/", address)))
+}
+
+func TestHealthChecker_Grpc(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("serving", healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("notServing", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	defer server.Stop()
+	address := listener.Addr().String()
+	checker := newHealthChecker(logger.NewMockClient(), time.Second, config.HealthCheckInfo{})
+
+	tests := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{"Up - overall health", "/", models.Up},
+		{"Up - service health", "/serving", models.Up},
+		{"Down - not serving", "notServing", models.Down},
+		{"Down - unknown service", "unknown", models.Down},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := checker.check(testRegistration(t, constants.HealthCheckTypeGrpc, testCase.path, address))
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestHealthChecker_Exec(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+	enabled := newHealthChecker(logger.NewMockClient(), time.Second, config.HealthCheckInfo{ExecAllowlist: []string{"sh"}})
+	disabled := newHealthChecker(logger.NewMockClient(), time.Second, config.HealthCheckInfo{})
+
+	tests := []struct {
+		name     string
+		checker  *healthChecker
+		path     string
+		expected string
+	}{
+		{"Up", enabled, "sh -c true", models.Up},
+		{"Warning - exit code 1", enabled, "sh -c false", constants.Warning},
+		{"Down - other exit code", enabled, "sh not-existing-script.sh", models.Down},
+		{"Down - command not allowed", enabled, "not-existing-command", models.Down},
+		{"Down - no command", enabled, " ", models.Down},
+		{"Down - exec disabled", disabled, "sh -c true", models.Down},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := testCase.checker.check(testRegistration(t, constants.HealthCheckTypeExec, testCase.path, "localhost:0"))
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestHealthChecker_UnsupportedType(t *testing.T) {
+	checker := newHealthChecker(logger.NewMockClient(), time.Second, config.HealthCheckInfo{})
+	assert.Equal(t, models.Down, checker.check(testRegistration(t, "udp", "/", "localhost:0")))
+}
+
+func TestStatusTracker(t *testing.T) {
+	tracker := newStatusTracker(config.HealthCheckInfo{FailureThreshold: 3, SuccessThreshold: 2})
+
+	steps := []struct {
+		result   string
+		expected string
+	}{
+		// the first failure of a service not yet up flips it to DOWN immediately
+		{models.Down, models.Down},
+		// two consecutive successes are required before flipping to UP
+		{models.Up, models.Down},
+		{models.Down, models.Down},
+		{models.Up, models.Down},
+		{models.Up, models.Up},
+		// degradation is reported immediately
+		{constants.Warning, constants.Warning},
+		{models.Up, models.Up},
+		// three consecutive failures are required before flipping to DOWN
+		{models.Down, models.Up},
+		{models.Down, models.Up},
+		{models.Up, models.Up},
+		{models.Down, models.Up},
+		{models.Down, models.Up},
+		{models.Down, models.Down},
+	}
+	status := models.Unknown
+	for i, step := range steps {
+		status = tracker.next(status, step.result)
+		assert.Equal(t, step.expected, status, "unexpected status at step %d", i)
+	}
+}
+
+func TestStatusTracker_DefaultThresholds(t *testing.T) {
+	tracker := newStatusTracker(config.HealthCheckInfo{})
+
+	assert.Equal(t, models.Up, tracker.next(models.Unknown, models.Up))
+	assert.Equal(t, models.Down, tracker.next(models.Up, models.Down))
+	assert.Equal(t, constants.Warning, tracker.next(models.Down, constants.Warning))
+}
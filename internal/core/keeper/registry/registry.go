@@ -7,17 +7,18 @@ package registry
 
 import (
 	"context"
-	"io"
-	"net/http"
-	"strconv"
+	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
-	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
 )
 
@@ -62,25 +63,59 @@ func (r *Registry) DeregisterByServiceId(id string) {
 	delete(r.table, id)
 }
 
+// Heartbeat passes the heartbeat with the reported status to the TTL health check of a registered service
+func (r *Registry) Heartbeat(id string, status string) errors.EdgeX {
+	r.mutex.Lock()
+	runner, ok := r.table[id]
+	r.mutex.Unlock()
+	if !ok {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("service %s is not registered", id), nil)
+	}
+	if !runner.isTTL() {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("service %s is not registered with the %s health check type", id, constants.HealthCheckTypeTtl), nil)
+	}
+
+	select {
+	case runner.heartbeat <- status:
+	default:
+		// the runner is still handling the previous heartbeat, which already resets the TTL
+	}
+	return nil
+}
+
 type healthCheckRunner struct {
-	done     chan struct{}
-	registry models.Registration
-	dic      *di.Container
+	done      chan struct{}
+	heartbeat chan string
+	registry  models.Registration
+	dic       *di.Container
 }
 
 func newHealthCheckRunner(r models.Registration, dic *di.Container) *healthCheckRunner {
 	return &healthCheckRunner{
-		done:     make(chan struct{}, 1),
-		registry: r,
-		dic:      dic,
+		done:      make(chan struct{}, 1),
+		heartbeat: make(chan string, 1),
+		registry:  r,
+		dic:       dic,
 	}
 }
 
+func (h *healthCheckRunner) isTTL() bool {
+	return strings.EqualFold(h.registry.HealthCheck.Type, constants.HealthCheckTypeTtl)
+}
+
 func (h *healthCheckRunner) start(ctx context.Context, wg *sync.WaitGroup) {
 	wg.Add(1)
 	defer wg.Done()
 
-	dbClient := container.DBClientFrom(h.dic.Get)
+	if h.isTTL() {
+		h.runTTLCheck(ctx)
+	} else {
+		h.runActiveCheck(ctx)
+	}
+}
+
+// runActiveCheck probes the service health periodically with the health check interval
+func (h *healthCheckRunner) runActiveCheck(ctx context.Context) {
 	lc := bootstrapContainer.LoggingClientFrom(h.dic.Get)
 	duration, _ := time.ParseDuration(h.registry.HealthCheck.Interval)
 	// set a ticker using the 1/2 health check interval
@@ -92,6 +127,9 @@ func (h *healthCheckRunner) start(ctx context.Context, wg *sync.WaitGroup) {
 		lc.Errorf("Unable to parse RequestTimeout value of '%s' duration: %v", configuration.Service.RequestTimeout, err)
 	}
 
+	checker := newHealthChecker(lc, reqTimeout, configuration.HealthCheck)
+	tracker := newStatusTracker(configuration.HealthCheck)
+
 	// use 1/2 health check interval to check the service health repeatedly before the status is UP
 preServiceUpLoop:
 	for {
@@ -104,14 +142,11 @@ preServiceUpLoop:
 			lc.Infof("Deregistered service: %s", h.registry.ServiceId)
 			return
 		case <-preSvcUpTicker.C:
-			h.registry.Status = healthCheck(h.registry, lc, reqTimeout)
-			err := dbClient.UpdateRegistration(h.registry)
-			if err != nil {
-				lc.Error("Failed to update health check status for %s: %s", h.registry.ServiceId, err.Error())
+			if !h.updateStatus(ctx, tracker.next(h.registry.Status, checker.check(h.registry))) {
 				continue
 			}
 
-			if h.registry.Status == models.Up {
+			if h.registry.Status == models.Up || h.registry.Status == constants.Warning {
 				break preServiceUpLoop
 			}
 		}
@@ -131,52 +166,66 @@ preServiceUpLoop:
 			lc.Infof("Deregistered service: %s", h.registry.ServiceId)
 			return
 		case <-ticker.C:
-			h.registry.Status = healthCheck(h.registry, lc, reqTimeout)
-			err := dbClient.UpdateRegistration(h.registry)
-			if err != nil {
-				lc.Error("Failed to update health check status for %s: %s", h.registry.ServiceId, err.Error())
-			}
+			h.updateStatus(ctx, tracker.next(h.registry.Status, checker.check(h.registry)))
 		}
 	}
 }
 
-func (h *healthCheckRunner) stop() {
-	close(h.done)
-}
+// runTTLCheck waits for the heartbeats of the service and marks the service DOWN if no heartbeat arrives within the TTL,
+// which is the health check interval
+func (h *healthCheckRunner) runTTLCheck(ctx context.Context) {
+	lc := bootstrapContainer.LoggingClientFrom(h.dic.Get)
+	ttl, _ := time.ParseDuration(h.registry.HealthCheck.Interval)
 
-func healthCheck(r models.Registration, lc logger.LoggingClient, timeout time.Duration) string {
-	client := http.Client{
-		Timeout: timeout,
-	}
-	path := r.HealthCheck.Type + "://" + r.Host + ":" + strconv.Itoa(r.Port) + r.HealthCheck.Path
-	req, err := http.NewRequest(http.MethodGet, path, http.NoBody)
-	if err != nil {
-		lc.Errorf("failed to create get request for %s: %v", path, err)
-		return models.Down
+	// the TTL is counted from the last heartbeat persisted in the registration, so that it survives the restart of
+	// core-keeper, or from now if the service hasn't sent any heartbeat yet
+	remaining := ttl
+	if h.registry.LastConnected > 0 {
+		remaining = time.Until(time.UnixMilli(h.registry.LastConnected).Add(ttl))
 	}
+	timer := time.NewTimer(max(remaining, 0))
+	defer timer.Stop()
 
-	resp, err := client.Do(req)
-	if err != nil {
-		lc.Errorf("Failed to health check service %s: %s", r.ServiceId, err.Error())
-		return models.Down
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.done:
+			lc.Infof("Deregistered service: %s", h.registry.ServiceId)
+			return
+		case status := <-h.heartbeat:
+			lc.Debugf("received heartbeat from service %s with status %s", h.registry.ServiceId, status)
+			h.registry.LastConnected = time.Now().UnixMilli()
+			h.updateStatus(ctx, status)
+			timer.Reset(ttl)
+		case <-timer.C:
+			if h.registry.Status != models.Down {
+				lc.Errorf("no heartbeat received from service %s within the TTL %s", h.registry.ServiceId, ttl)
+				h.updateStatus(ctx, models.Down)
+			}
+		}
 	}
+}
 
-	// Ensure response body is always closed to prevent resource leaks
-	defer func() {
-		if resp.Body != nil {
-			_ = resp.Body.Close()
-		}
-	}()
+// updateStatus persists the new status of the service and records the status transition, false is returned if the
+// status fails to be persisted
+func (h *healthCheckRunner) updateStatus(ctx context.Context, status string) bool {
+	lc := bootstrapContainer.LoggingClientFrom(h.dic.Get)
+	dbClient := container.DBClientFrom(h.dic.Get)
 
-	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
-		lc.Debugf("service %s status healthy", r.ServiceId)
-		return models.Up
-	} else {
-		bodyBytes, err := io.ReadAll(resp.Body)
-		if err != nil {
-			lc.Error("Failed to read %s response body: %s", path, err.Error())
-		}
-		lc.Errorf("service %s is unhealthy: %s", r.ServiceId, string(bodyBytes))
-		return models.Down
+	oldStatus := h.registry.Status
+	h.registry.Status = status
+	err := dbClient.UpdateRegistration(h.registry)
+	if err != nil {
+		lc.Error("Failed to update health check status for %s: %s", h.registry.ServiceId, err.Error())
+		// keep the old status so that the status transition is recorded after the status is updated successfully
+		h.registry.Status = oldStatus
+		return false
 	}
+	application.RecordStatusTransition(h.registry.ServiceId, oldStatus, h.registry.Status, ctx, h.dic)
+	return true
+}
+
+func (h *healthCheckRunner) stop() {
+	close(h.done)
 }
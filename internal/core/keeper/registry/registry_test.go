@@ -93,7 +93,7 @@ func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error)
 	}, nil
 }
 
-// healthCheckWithTransport is a test helper that mirrors the logic of healthCheck
+// healthCheckWithTransport is a test helper that mirrors the logic of healthChecker.httpCheck
 // but allows injecting a custom http.RoundTripper for testing.
 // This version matches the fixed behavior where resp.Body is properly closed.
 func healthCheckWithTransport(r models.Registration, lc logger.LoggingClient, timeout time.Duration, transport http.RoundTripper) string {
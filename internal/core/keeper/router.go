@@ -12,6 +12,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
 	keeperController "github.com/edgexfoundry/edgex-go/internal/core/keeper/controller/http"
 
 	"github.com/labstack/echo/v4"
@@ -28,12 +29,21 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiKVSByKeyRoute, kv.Keys, authenticationHook)
 	r.PUT(common.ApiKVSByKeyRoute, kv.AddKeys, authenticationHook)
 	r.DELETE(common.ApiKVSByKeyRoute, kv.DeleteKeys, authenticationHook)
+	r.PUT(constants.ApiKVTxnRoute, kv.Txn, authenticationHook)
+	r.GET(constants.ApiKVHistoryRoute, kv.History, authenticationHook)
+	r.GET(constants.ApiKVDiffRoute, kv.Diff, authenticationHook)
+	r.PUT(constants.ApiKVRestoreRoute, kv.Restore, authenticationHook)
+	r.GET(constants.ApiKVExportRoute, kv.Export, authenticationHook)
+	r.PUT(constants.ApiKVImportRoute, kv.Import, authenticationHook)
 
 	// Registry
 	rc := keeperController.NewRegistryController(dic)
 	r.POST(common.ApiRegisterRoute, rc.Register, authenticationHook)
 	r.PUT(common.ApiRegisterRoute, rc.UpdateRegister, authenticationHook)
 	r.GET(common.ApiAllRegistrationsRoute, rc.Registrations, authenticationHook)
+	r.GET(constants.ApiRegistrationDiscoveryRoute, rc.Discovery, authenticationHook)
 	r.GET(common.ApiRegistrationByServiceIdRoute, rc.RegistrationByServiceId, authenticationHook)
 	r.DELETE(common.ApiRegistrationByServiceIdRoute, rc.Deregister, authenticationHook)
+	r.PUT(constants.ApiRegistrationHeartbeatRoute, rc.Heartbeat, authenticationHook)
+	r.GET(constants.ApiRegistrationHistoryRoute, rc.StatusHistory, authenticationHook)
 }
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
 
@@ -47,6 +48,102 @@ func ParseDeleteKeyRequestQueryString(r *http.Request) (prefixMatch bool, err er
 	return prefixMatch, nil
 }
 
+// ParseCASQueryString parses cas from the query parameters, nil is returned if cas is not specified.
+func ParseCASQueryString(r *http.Request) (*uint64, errors.EdgeX) {
+	query := r.URL.Query()
+	if !query.Has(constants.CAS) {
+		return nil, nil
+	}
+
+	cas, parsingErr := strconv.ParseUint(strings.TrimSpace(query.Get(constants.CAS)), 10, 64)
+	if parsingErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse querystring %s into uint64", constants.CAS), parsingErr)
+	}
+	return &cas, nil
+}
+
+// ParseWatchKeyRequestQueryString parses index and wait from the query parameters. isWatch is true if the index is
+// specified, and wait is zero if not specified.
+func ParseWatchKeyRequestQueryString(r *http.Request) (index uint64, wait time.Duration, isWatch bool, err errors.EdgeX) {
+	query := r.URL.Query()
+	if !query.Has(constants.Index) {
+		return 0, 0, false, nil
+	}
+
+	index, parsingErr := strconv.ParseUint(strings.TrimSpace(query.Get(constants.Index)), 10, 64)
+	if parsingErr != nil {
+		return 0, 0, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse querystring %s into uint64", constants.Index), parsingErr)
+	}
+
+	if waitStr := strings.TrimSpace(query.Get(constants.Wait)); waitStr != "" {
+		wait, parsingErr = time.ParseDuration(waitStr)
+		if parsingErr != nil || wait <= 0 {
+			return 0, 0, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s must be a positive duration", constants.Wait), parsingErr)
+		}
+	}
+	return index, wait, true, nil
+}
+
+// ParseDiffKeysRequestQueryString parses from and to from the query parameters, from defaults to 0 and to defaults to
+// the current time in milliseconds.
+func ParseDiffKeysRequestQueryString(r *http.Request) (from int64, to int64, err errors.EdgeX) {
+	from, err = parseQueryStringToTimestamp(r, constants.From, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	to, err = parseQueryStringToTimestamp(r, constants.To, time.Now().UnixMilli())
+	if err != nil {
+		return 0, 0, err
+	}
+	if to < from {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s's value %v is not allowed to be less than %s's value %v", constants.To, to, constants.From, from), nil)
+	}
+	return from, to, nil
+}
+
+// ParseRestoreKeysRequestQueryString parses the required timestamp from the query parameters.
+func ParseRestoreKeysRequestQueryString(r *http.Request) (int64, errors.EdgeX) {
+	if !r.URL.Query().Has(constants.Timestamp) {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s is required", constants.Timestamp), nil)
+	}
+	return parseQueryStringToTimestamp(r, constants.Timestamp, 0)
+}
+
+// parseQueryStringToTimestamp parses the specified query string key to a non-negative timestamp in milliseconds, the
+// default value is returned if the query string key is not specified.
+func parseQueryStringToTimestamp(r *http.Request, queryStringKey string, defaultValue int64) (int64, errors.EdgeX) {
+	param := strings.TrimSpace(r.URL.Query().Get(queryStringKey))
+	if param == "" {
+		return defaultValue, nil
+	}
+	timestamp, parsingErr := strconv.ParseInt(param, 10, 64)
+	if parsingErr != nil || timestamp < 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s must be a non-negative timestamp in milliseconds", queryStringKey), parsingErr)
+	}
+	return timestamp, nil
+}
+
+// ParseImportKeysRequestQueryString parses mode, dryRun and excludeSecrets from the query parameters, the mode defaults
+// to merge.
+func ParseImportKeysRequestQueryString(r *http.Request) (replace bool, dryRun bool, excludeSecrets bool, err errors.EdgeX) {
+	switch mode := strings.TrimSpace(r.URL.Query().Get(constants.Mode)); mode {
+	case "", constants.ImportModeMerge:
+	case constants.ImportModeReplace:
+		replace = true
+	default:
+		return false, false, false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("querystring %s must be %s or %s", constants.Mode, constants.ImportModeMerge, constants.ImportModeReplace), nil)
+	}
+	dryRun, err = ParseQueryStringToBool(r, constants.DryRun)
+	if err != nil {
+		return false, false, false, err
+	}
+	excludeSecrets, err = ParseQueryStringToBool(r, constants.ExcludeSecrets)
+	if err != nil {
+		return false, false, false, err
+	}
+	return replace, dryRun, excludeSecrets, nil
+}
+
 // ParseQueryStringToBool parses the specified query string key to a bool.  If specified query string key is found more than once in the
 // http request, only the first specified query string will be parsed and converted to a bool.  If no specified
 // query string key could be found in the http request, specified default value will be returned.  EdgeX error will be
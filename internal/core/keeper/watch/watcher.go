@@ -0,0 +1,34 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import "sync"
+
+// Watcher wakes up the blocking queries waiting for the key changes made through this keeper instance
+type Watcher struct {
+	mutex   sync.Mutex
+	changed chan struct{}
+}
+
+// NewWatcher creates and initializes a Watcher
+func NewWatcher() *Watcher {
+	return &Watcher{changed: make(chan struct{})}
+}
+
+// Changed returns a channel which is closed on the next key change
+func (w *Watcher) Changed() <-chan struct{} {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.changed
+}
+
+// Notify wakes up all the queries waiting on the channel returned by Changed
+func (w *Watcher) Notify() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	close(w.changed)
+	w.changed = make(chan struct{})
+}
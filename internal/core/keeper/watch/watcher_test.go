@@ -0,0 +1,33 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcher_Notify(t *testing.T) {
+	w := NewWatcher()
+	changed := w.Changed()
+
+	select {
+	case <-changed:
+		assert.Fail(t, "channel should not be closed before Notify")
+	default:
+	}
+
+	w.Notify()
+
+	select {
+	case <-changed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "channel should be closed after Notify")
+	}
+	assert.NotEqual(t, changed, w.Changed(), "a new channel should be returned after Notify")
+}
@@ -1,11 +1,12 @@
 //
-// Copyright (C) 2024-2025 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package postgres
 
 import (
+	commandInterfaces "github.com/edgexfoundry/edgex-go/internal/core/command/infrastructure/interfaces"
 	dataInterfaces "github.com/edgexfoundry/edgex-go/internal/core/data/infrastructure/interfaces"
 	metadataInterfaces "github.com/edgexfoundry/edgex-go/internal/core/metadata/infrastructure/interfaces"
 	notificationsInterfaces "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces"
@@ -13,6 +14,7 @@ import (
 )
 
 // Check the implementation of Postgres satisfies the DB client
+var _ commandInterfaces.DBClient = &Client{}
 var _ dataInterfaces.DBClient = &Client{}
 var _ metadataInterfaces.DBClient = &Client{}
 var _ schedulerInterfaces.DBClient = &Client{}
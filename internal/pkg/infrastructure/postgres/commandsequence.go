@@ -0,0 +1,193 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+)
+
+// AddCommandSequence adds a new command sequence to the database
+func (c *Client) AddCommandSequence(ctx context.Context, s models.CommandSequence) (models.CommandSequence, errors.EdgeX) {
+	if len(s.Id) == 0 {
+		s.Id = uuid.New().String()
+	}
+
+	s, err := addCommandSequence(ctx, c.ConnPool, s)
+	if err != nil {
+		return s, errors.NewCommonEdgeXWrapper(err)
+	}
+	return s, nil
+}
+
+// AllCommandSequences queries the command sequences with the given range, offset, and limit
+func (c *Client) AllCommandSequences(ctx context.Context, labels []string, offset, limit int) (sequences []models.CommandSequence, err errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	if len(labels) > 0 {
+		c.loggingClient.Debugf("Querying command sequences by labels: %v", labels)
+		queryObj := map[string]any{labelsField: labels}
+		sequences, err = queryCommandSequences(ctx, c.ConnPool, sqlQueryContentByJSONFieldWithPaginationAsNamedArgs(commandSequenceTableName),
+			pgx.NamedArgs{jsonContentCondition: queryObj, offsetCondition: offset, limitCondition: validLimit})
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), "failed to query all command sequences by labels", err)
+		}
+	} else {
+		sequences, err = queryCommandSequences(ctx, c.ConnPool, sqlQueryContentWithPaginationAsNamedArgs(commandSequenceTableName), pgx.NamedArgs{offsetCondition: offset, limitCondition: validLimit})
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), "failed to query all command sequences", err)
+		}
+	}
+
+	return sequences, nil
+}
+
+// UpdateCommandSequence updates the command sequence
+func (c *Client) UpdateCommandSequence(ctx context.Context, s models.CommandSequence) errors.EdgeX {
+	err := updateCommandSequence(ctx, c.ConnPool, s)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// DeleteCommandSequenceByName deletes the command sequence by name
+func (c *Client) DeleteCommandSequenceByName(ctx context.Context, name string) errors.EdgeX {
+	if err := deleteCommandSequenceByName(ctx, c.ConnPool, name); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// CommandSequenceById queries the command sequence by id
+func (c *Client) CommandSequenceById(ctx context.Context, id string) (models.CommandSequence, errors.EdgeX) {
+	commandSequence, err := queryCommandSequence(ctx, c.ConnPool, sqlQueryContentById(commandSequenceTableName), id)
+	if err != nil {
+		return commandSequence, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query command sequence by id '%s'", id), err)
+	}
+
+	return commandSequence, nil
+}
+
+// CommandSequenceByName queries the command sequence by name
+func (c *Client) CommandSequenceByName(ctx context.Context, name string) (models.CommandSequence, errors.EdgeX) {
+	queryObj := map[string]any{nameField: name}
+	commandSequence, err := queryCommandSequence(ctx, c.ConnPool, sqlQueryContentByJSONField(commandSequenceTableName), queryObj)
+	if err != nil {
+		return commandSequence, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query command sequence by name '%s'", name), err)
+	}
+
+	return commandSequence, nil
+}
+
+// CommandSequenceTotalCount returns the total count of command sequences
+func (c *Client) CommandSequenceTotalCount(ctx context.Context, labels []string) (int64, errors.EdgeX) {
+	if len(labels) > 0 {
+		queryObj := map[string]any{labelsField: labels}
+		return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCountByJSONField(commandSequenceTableName), queryObj)
+	}
+	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryCount(commandSequenceTableName))
+}
+
+func addCommandSequence(ctx context.Context, connPool *pgxpool.Pool, s models.CommandSequence) (models.CommandSequence, errors.EdgeX) {
+	exists, edgexErr := checkCommandSequenceExists(ctx, connPool, s.Name)
+	if edgexErr != nil {
+		return s, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	if exists {
+		return s, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command sequence name '%s' already exists", s.Name), nil)
+	}
+
+	timestamp := time.Now().UTC().UnixMilli()
+	s.Created = timestamp
+	s.Modified = timestamp
+	dataBytes, err := json.Marshal(s)
+	if err != nil {
+		return s, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal CommandSequence model", err)
+	}
+
+	_, err = connPool.Exec(ctx, sqlInsert(commandSequenceTableName, idCol, contentCol), s.Id, dataBytes)
+	if err != nil {
+		return s, pgClient.WrapDBError("failed to insert row to core_command.sequence table", err)
+	}
+
+	return s, nil
+}
+
+func updateCommandSequence(ctx context.Context, connPool *pgxpool.Pool, s models.CommandSequence) errors.EdgeX {
+	modified := time.Now().UTC().UnixMilli()
+	s.Modified = modified
+
+	dataBytes, err := json.Marshal(s)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal CommandSequence model", err)
+	}
+
+	queryObj := map[string]any{nameField: s.Name}
+	_, err = connPool.Exec(ctx, sqlUpdateColsByJSONCondCol(commandSequenceTableName, contentCol), dataBytes, queryObj)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to update row by command sequence name '%s' from core_command.sequence table", s.Name), err)
+	}
+
+	return nil
+}
+
+func deleteCommandSequenceByName(ctx context.Context, connPool *pgxpool.Pool, name string) errors.EdgeX {
+	queryObj := map[string]any{nameField: name}
+	_, err := connPool.Exec(ctx, sqlDeleteByJSONField(commandSequenceTableName), queryObj)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete command sequence by name %s", name), err)
+	}
+	return nil
+}
+
+func checkCommandSequenceExists(ctx context.Context, connPool *pgxpool.Pool, name string) (bool, errors.EdgeX) {
+	var exists bool
+	queryObj := map[string]any{nameField: name}
+	err := connPool.QueryRow(ctx, sqlCheckExistsByJSONField(commandSequenceTableName), queryObj).Scan(&exists)
+	if err != nil {
+		return false, pgClient.WrapDBError(fmt.Sprintf("failed to query row by name '%s' from core_command.sequence table", name), err)
+	}
+	return exists, nil
+}
+
+func queryCommandSequence(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) (models.CommandSequence, errors.EdgeX) {
+	var sequence models.CommandSequence
+	row := connPool.QueryRow(ctx, sql, args...)
+
+	if err := row.Scan(&sequence); err != nil {
+		return sequence, pgClient.WrapDBError("failed to query command sequence", err)
+	}
+	return sequence, nil
+}
+
+func queryCommandSequences(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]models.CommandSequence, errors.EdgeX) {
+	rows, err := connPool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from core_command.sequence table", err)
+	}
+
+	sequences, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.CommandSequence, error) {
+		var s models.CommandSequence
+		scanErr := row.Scan(&s)
+		return s, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to CommandSequence model", err)
+	}
+
+	return sequences, nil
+}
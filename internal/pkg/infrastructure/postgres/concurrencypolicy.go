@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// UpsertConcurrencyPolicy adds the concurrency policy setting of a schedule job, or replaces the existing one
+func (c *Client) UpsertConcurrencyPolicy(ctx context.Context, policy schedulerModels.ConcurrencyPolicy) errors.EdgeX {
+	dataBytes, err := json.Marshal(policy)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal ConcurrencyPolicy model", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlUpsertContentByCol(concurrencyPolicyTableName, jobNameCol), policy.JobName, dataBytes)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to upsert row with job name '%s' to concurrency_policy table", policy.JobName), err)
+	}
+	return nil
+}
+
+// ConcurrencyPolicyByJobName queries the concurrency policy setting of a schedule job
+func (c *Client) ConcurrencyPolicyByJobName(ctx context.Context, name string) (schedulerModels.ConcurrencyPolicy, errors.EdgeX) {
+	var policy schedulerModels.ConcurrencyPolicy
+	err := c.ConnPool.QueryRow(ctx, sqlQueryFieldsByCol(concurrencyPolicyTableName, []string{contentCol}, jobNameCol), name).Scan(&policy)
+	if err != nil {
+		return policy, pgClient.WrapDBError(fmt.Sprintf("failed to query row with job name '%s' from concurrency_policy table", name), err)
+	}
+	return policy, nil
+}
+
+// AllConcurrencyPolicies queries the concurrency policy settings of all schedule jobs
+func (c *Client) AllConcurrencyPolicies(ctx context.Context) ([]schedulerModels.ConcurrencyPolicy, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(ctx, sqlQueryContent(concurrencyPolicyTableName))
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from concurrency_policy table", err)
+	}
+
+	policies, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (schedulerModels.ConcurrencyPolicy, error) {
+		var policy schedulerModels.ConcurrencyPolicy
+		scanErr := row.Scan(&policy)
+		return policy, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to ConcurrencyPolicy model", err)
+	}
+	return policies, nil
+}
+
+// DeleteConcurrencyPolicyByJobName deletes the concurrency policy setting of a schedule job, nothing is deleted if the
+// concurrency policy doesn't exist
+func (c *Client) DeleteConcurrencyPolicyByJobName(ctx context.Context, name string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByColumns(concurrencyPolicyTableName, jobNameCol), name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete row with job name '%s' from concurrency_policy table", name), err)
+	}
+	return nil
+}
@@ -1,11 +1,12 @@
 //
-// Copyright (C) 2024-2025 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package postgres
 
 import (
+	command "github.com/edgexfoundry/edgex-go/internal/core/command/embed"
 	data "github.com/edgexfoundry/edgex-go/internal/core/data/embed"
 	keeper "github.com/edgexfoundry/edgex-go/internal/core/keeper/embed"
 	metadata "github.com/edgexfoundry/edgex-go/internal/core/metadata/embed"
@@ -17,21 +18,39 @@ import (
 
 // constants relate to the postgres db table names
 const (
-	configTableName               = keeper.SchemaName + ".config"
-	eventTableName                = data.SchemaName + ".event"
-	deviceInfoTableName           = data.SchemaName + ".device_info"
-	deviceServiceTableName        = metadata.SchemaName + ".device_service"
-	deviceProfileTableName        = metadata.SchemaName + ".device_profile"
-	deviceTableName               = metadata.SchemaName + ".device"
-	provisionWatcherTableName     = metadata.SchemaName + ".provision_watcher"
-	notificationTableName         = notifications.SchemaName + ".notification"
-	readingTableName              = data.SchemaName + ".reading"
-	registryTableName             = keeper.SchemaName + ".registry"
-	scheduleActionRecordTableName = scheduler.SchemaName + ".record"
-	scheduleJobTableName          = scheduler.SchemaName + ".job"
-	subscriptionTableName         = notifications.SchemaName + ".subscription"
-	transmissionTableName         = notifications.SchemaName + ".transmission"
-	keyStoreTableName             = proxyauth.SchemaName + ".key_store"
+	commandSequenceTableName       = command.SchemaName + ".sequence"
+	configTableName                = keeper.SchemaName + ".config"
+	configHistoryTableName         = keeper.SchemaName + ".config_history"
+	configTombstoneTableName       = keeper.SchemaName + ".config_tombstone"
+	eventTableName                 = data.SchemaName + ".event"
+	deviceInfoTableName            = data.SchemaName + ".device_info"
+	deviceServiceTableName         = metadata.SchemaName + ".device_service"
+	deviceProfileTableName         = metadata.SchemaName + ".device_profile"
+	deviceTableName                = metadata.SchemaName + ".device"
+	provisionWatcherTableName      = metadata.SchemaName + ".provision_watcher"
+	notificationTableName          = notifications.SchemaName + ".notification"
+	readingTableName               = data.SchemaName + ".reading"
+	registryTableName              = keeper.SchemaName + ".registry"
+	registryHistoryTableName       = keeper.SchemaName + ".registry_history"
+	registryInstanceTableName      = keeper.SchemaName + ".registry_instance"
+	scheduleActionRecordTableName  = scheduler.SchemaName + ".record"
+	scheduleJobTableName           = scheduler.SchemaName + ".job"
+	eventTriggerTableName          = scheduler.SchemaName + ".event_trigger"
+	workflowTableName              = scheduler.SchemaName + ".workflow"
+	retryPolicyTableName           = scheduler.SchemaName + ".retry_policy"
+	recordAttemptTableName         = scheduler.SchemaName + ".record_attempt"
+	concurrencyPolicyTableName     = scheduler.SchemaName + ".concurrency_policy"
+	subscriptionTableName          = notifications.SchemaName + ".subscription"
+	transmissionTableName          = notifications.SchemaName + ".transmission"
+	resendTaskTableName            = notifications.SchemaName + ".resend_task"
+	templateTableName              = notifications.SchemaName + ".template"
+	digestItemTableName            = notifications.SchemaName + ".digest_item"
+	occurrenceTableName            = notifications.SchemaName + ".occurrence"
+	heldNotificationTableName      = notifications.SchemaName + ".held_notification"
+	escalationPolicyTableName      = notifications.SchemaName + ".escalation_policy"
+	subscriptionExtensionTableName = notifications.SchemaName + ".subscription_extension"
+	escalationTaskTableName        = notifications.SchemaName + ".escalation_task"
+	keyStoreTableName              = proxyauth.SchemaName + ".key_store"
 )
 
 // constants relate to the common db table column names
@@ -53,6 +72,7 @@ const (
 	jsonContentCondition = "jsonContent"
 	categoryCondition    = "category"
 	labelsCondition      = "labels"
+	intervalCondition    = "interval"
 )
 
 // constants relate to the event/reading postgres db table column names
@@ -77,20 +97,35 @@ const (
 
 // constants relate to the keeper postgres db table column names
 const (
-	keyCol = "key"
+	keyCol         = "key"
+	deletedCol     = "deleted"
+	modifyIndexCol = "modify_index"
+	serviceIdCol   = "service_id"
+	fromStatusCol  = "from_status"
+	toStatusCol    = "to_status"
 )
 
+// configModifyIndexSequence is the postgres sequence used to stamp the modify index of the keeper keys
+const configModifyIndexSequence = keeper.SchemaName + ".config_modify_index"
+
 // constants relate to the schedule action record postgres db table column names
 const (
 	actionCol      = "action"
 	actionIdCol    = "action_id"
 	jobNameCol     = "job_name"
 	scheduledAtCol = "scheduled_at"
+	recordIdCol    = "record_id"
+	attemptCol     = "attempt"
+	maxAttemptsCol = "max_attempts"
 )
 
 // constants relate to the notification postgres db table column names
 const (
-	notificationIdCol = "notification_id"
+	notificationIdCol   = "notification_id"
+	transmissionIdCol   = "transmission_id"
+	nextAttemptCol      = "next_attempt"
+	subscriptionNameCol = "subscription_name"
+	fingerprintCol      = "fingerprint"
 )
 
 // constants relate to the field names in the content column
@@ -106,8 +141,12 @@ const (
 	notificationIdField   = "NotificationId"
 	profileNameField      = "ProfileName"
 	receiverField         = "Receiver"
+	recordsField          = "Records"
+	resendCountField      = "ResendCount"
+	sentField             = "Sent"
 	serviceIdField        = "ServiceId"
 	serviceNameField      = "ServiceName"
+	severityField         = "Severity"
 	statusField           = "Status"
 	subscriptionNameField = "SubscriptionName"
 	acknowledgedField     = "Acknowledged"
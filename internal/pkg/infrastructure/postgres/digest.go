@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddDigestItem adds the notification accumulated in the pending digest of a subscription
+func (c *Client) AddDigestItem(item notificationsModels.DigestItem) (notificationsModels.DigestItem, errors.EdgeX) {
+	if len(item.Id) == 0 {
+		item.Id = uuid.New().String()
+	}
+	if item.Created == 0 {
+		item.Created = time.Now().UTC().UnixMilli()
+	}
+	dataBytes, err := json.Marshal(item)
+	if err != nil {
+		return item, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal DigestItem model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlInsert(digestItemTableName, idCol, subscriptionNameCol, contentCol), item.Id, item.SubscriptionName, dataBytes)
+	if err != nil {
+		return item, pgClient.WrapDBError(fmt.Sprintf("failed to insert the digest item of subscription '%s'", item.SubscriptionName), err)
+	}
+	return item, nil
+}
+
+// DigestItemsBySubscriptionName queries the notifications accumulated in the pending digest of a subscription, the
+// earliest item comes first
+func (c *Client) DigestItemsBySubscriptionName(name string) ([]notificationsModels.DigestItem, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryDigestItemsBySubscriptionName(), name)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query the digest items of subscription '%s'", name), err)
+	}
+
+	items, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.DigestItem, error) {
+		var item notificationsModels.DigestItem
+		scanErr := row.Scan(&item)
+		return item, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to DigestItem model", err)
+	}
+	return items, nil
+}
+
+// DeleteDigestItemsByIds deletes the digest items by ids
+func (c *Client) DeleteDigestItemsByIds(ids []string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByIds(digestItemTableName), ids)
+	if err != nil {
+		return pgClient.WrapDBError("failed to delete the digest items", err)
+	}
+	return nil
+}
+
+// DeleteDigestItemsBySubscriptionName deletes the pending digest items of a subscription
+func (c *Client) DeleteDigestItemsBySubscriptionName(name string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(digestItemTableName, subscriptionNameCol), name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete the digest items of subscription '%s'", name), err)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddEscalationPolicy adds a new escalation policy to the database
+func (c *Client) AddEscalationPolicy(p notificationsModels.EscalationPolicy) (notificationsModels.EscalationPolicy, errors.EdgeX) {
+	timestamp := time.Now().UTC().UnixMilli()
+	p.Created = timestamp
+	p.Modified = timestamp
+	dataBytes, err := json.Marshal(p)
+	if err != nil {
+		return p, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal EscalationPolicy model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlInsert(escalationPolicyTableName, nameCol, contentCol), p.Name, dataBytes)
+	if err != nil {
+		return p, pgClient.WrapDBError(fmt.Sprintf("failed to insert row with name '%s' to escalation policy table", p.Name), err)
+	}
+	return p, nil
+}
+
+// UpdateEscalationPolicy updates the escalation policy
+func (c *Client) UpdateEscalationPolicy(p notificationsModels.EscalationPolicy) errors.EdgeX {
+	old, edgeXerr := c.EscalationPolicyByName(p.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	p.Created = old.Created
+	p.Modified = time.Now().UTC().UnixMilli()
+	dataBytes, err := json.Marshal(p)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal EscalationPolicy model", err)
+	}
+
+	commandTag, err := c.ConnPool.Exec(context.Background(), sqlUpdateColsByCondCol(escalationPolicyTableName, nameCol, contentCol), dataBytes, p.Name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to update row with name '%s' from escalation policy table", p.Name), err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("escalation policy %s does not exist", p.Name), nil)
+	}
+	return nil
+}
+
+// EscalationPolicyByName queries the escalation policy by name
+func (c *Client) EscalationPolicyByName(name string) (notificationsModels.EscalationPolicy, errors.EdgeX) {
+	var p notificationsModels.EscalationPolicy
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(escalationPolicyTableName, []string{contentCol}, nameCol), name).Scan(&p)
+	if err != nil {
+		return p, pgClient.WrapDBError(fmt.Sprintf("failed to query row with name '%s' from escalation policy table", name), err)
+	}
+	return p, nil
+}
+
+// AllEscalationPolicies queries the escalation policies with offset and limit, all the policies are returned if limit
+// is negative
+func (c *Client) AllEscalationPolicies(offset, limit int) ([]notificationsModels.EscalationPolicy, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContentWithPagination(escalationPolicyTableName), offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from escalation policy table", err)
+	}
+
+	policies, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.EscalationPolicy, error) {
+		var p notificationsModels.EscalationPolicy
+		scanErr := row.Scan(&p)
+		return p, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to EscalationPolicy model", err)
+	}
+	return policies, nil
+}
+
+// EscalationPoliciesByCategories queries the escalation policies escalating any of the categories
+func (c *Client) EscalationPoliciesByCategories(categories []string) ([]notificationsModels.EscalationPolicy, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryEscalationPoliciesByCategories(), categories)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query rows by categories %v from escalation policy table", categories), err)
+	}
+
+	policies, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.EscalationPolicy, error) {
+		var p notificationsModels.EscalationPolicy
+		scanErr := row.Scan(&p)
+		return p, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to EscalationPolicy model", err)
+	}
+	return policies, nil
+}
+
+// EscalationPolicyTotalCount returns the total count of the escalation policies
+func (c *Client) EscalationPolicyTotalCount() (int64, errors.EdgeX) {
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCount(escalationPolicyTableName))
+}
+
+// DeleteEscalationPolicyByName deletes the escalation policy by name
+func (c *Client) DeleteEscalationPolicyByName(name string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(escalationPolicyTableName, nameCol), name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete row with name '%s' from escalation policy table", name), err)
+	}
+	return nil
+}
+
+// UpsertEscalationTask adds the escalation task of a notification, or replaces the existing task
+func (c *Client) UpsertEscalationTask(task notificationsModels.EscalationTask) errors.EdgeX {
+	dataBytes, err := json.Marshal(task)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal EscalationTask model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlUpsertEscalationTask(), task.NotificationId, getUTCTime(task.NextAttempt), dataBytes)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to upsert the escalation task of notification %s", task.NotificationId), err)
+	}
+	return nil
+}
+
+// EscalationTaskByNotificationId queries the escalation task of a notification
+func (c *Client) EscalationTaskByNotificationId(id string) (notificationsModels.EscalationTask, errors.EdgeX) {
+	var task notificationsModels.EscalationTask
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(escalationTaskTableName, []string{contentCol}, notificationIdCol), id).Scan(&task)
+	if err != nil {
+		return task, pgClient.WrapDBError(fmt.Sprintf("failed to query the escalation task of notification %s", id), err)
+	}
+	return task, nil
+}
+
+// EscalationTasksByNextAttempt queries the escalation tasks due before the given time in milliseconds, the earliest
+// task comes first and all the due tasks are returned if limit is negative
+func (c *Client) EscalationTasksByNextAttempt(before int64, limit int) ([]notificationsModels.EscalationTask, errors.EdgeX) {
+	_, validLimit := getValidOffsetAndLimit(0, limit)
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryEscalationTasksByNextAttempt(), getUTCTime(before), validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query the due escalation tasks", err)
+	}
+
+	tasks, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.EscalationTask, error) {
+		var task notificationsModels.EscalationTask
+		scanErr := row.Scan(&task)
+		return task, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to EscalationTask model", err)
+	}
+	return tasks, nil
+}
+
+// DeleteEscalationTaskByNotificationId deletes the escalation task of a notification, nothing is deleted if the task
+// doesn't exist
+func (c *Client) DeleteEscalationTaskByNotificationId(id string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(escalationTaskTableName, notificationIdCol), id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete the escalation task of notification %s", id), err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// UpsertEventTrigger adds the event trigger setting of a schedule job, or replaces the existing one
+func (c *Client) UpsertEventTrigger(ctx context.Context, trigger schedulerModels.EventTrigger) errors.EdgeX {
+	dataBytes, err := json.Marshal(trigger)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal EventTrigger model", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlUpsertContentByCol(eventTriggerTableName, jobNameCol), trigger.JobName, dataBytes)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to upsert row with job name '%s' to event_trigger table", trigger.JobName), err)
+	}
+	return nil
+}
+
+// EventTriggerByJobName queries the event trigger setting of a schedule job
+func (c *Client) EventTriggerByJobName(ctx context.Context, name string) (schedulerModels.EventTrigger, errors.EdgeX) {
+	var trigger schedulerModels.EventTrigger
+	err := c.ConnPool.QueryRow(ctx, sqlQueryFieldsByCol(eventTriggerTableName, []string{contentCol}, jobNameCol), name).Scan(&trigger)
+	if err != nil {
+		return trigger, pgClient.WrapDBError(fmt.Sprintf("failed to query row with job name '%s' from event_trigger table", name), err)
+	}
+	return trigger, nil
+}
+
+// AllEventTriggers queries the event trigger settings of all schedule jobs
+func (c *Client) AllEventTriggers(ctx context.Context) ([]schedulerModels.EventTrigger, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(ctx, sqlQueryContent(eventTriggerTableName))
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from event_trigger table", err)
+	}
+
+	triggers, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (schedulerModels.EventTrigger, error) {
+		var trigger schedulerModels.EventTrigger
+		scanErr := row.Scan(&trigger)
+		return trigger, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to EventTrigger model", err)
+	}
+	return triggers, nil
+}
+
+// DeleteEventTriggerByJobName deletes the event trigger setting of a schedule job, nothing is deleted if the event
+// trigger doesn't exist
+func (c *Client) DeleteEventTriggerByJobName(ctx context.Context, name string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByColumns(eventTriggerTableName, jobNameCol), name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete row with job name '%s' from event_trigger table", name), err)
+	}
+	return nil
+}
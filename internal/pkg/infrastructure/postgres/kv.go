@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -9,17 +9,19 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	stdErrs "errors"
 	"fmt"
 	"path"
 	"time"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/spf13/cast"
 )
 
@@ -37,7 +39,7 @@ func (c *Client) KeeperKeys(key string, keyOnly bool, isRaw bool) ([]models.KVRe
 	// Query the exact match key and all child level keys
 	// e.g., key='edgex/v4/core-data' || key='edgex/v4/core-data/%'
 	sqlStatement += fmt.Sprintf(" OR %s = $2", keyCol)
-	rows, err := c.ConnPool.Query(context.Background(), sqlStatement, key+"/%", key)
+	rows, err := c.ConnPool.Query(context.Background(), sqlStatement, keyPrefixLikePattern(key), key)
 	if err != nil {
 		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query rows by key '%s'", key), err)
 	}
@@ -102,9 +104,11 @@ func (c *Client) AddKeeperKeys(kv models.KVS, isFlatten bool) ([]models.KeyOnly,
 		}
 	} else {
 		// store the value in a single key
-		err := updateKVS(c.ConnPool, kv.Key, kv.Value)
-		if err != nil {
-			return nil, errors.NewCommonEdgeXWrapper(err)
+		txErr := pgx.BeginFunc(context.Background(), c.ConnPool, func(tx pgx.Tx) error {
+			return updateKVS(tx, kv.Key, kv.Value)
+		})
+		if txErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(txErr)
 		}
 
 		keyReps = []models.KeyOnly{models.KeyOnly(kv.Key)}
@@ -114,14 +118,28 @@ func (c *Client) AddKeeperKeys(kv models.KVS, isFlatten bool) ([]models.KeyOnly,
 
 // DeleteKeeperKeys deletes one key or multiple keys(with isRecurse enabled)
 func (c *Client) DeleteKeeperKeys(key string, isRecurse bool) ([]models.KeyOnly, errors.EdgeX) {
+	var resp []models.KeyOnly
+	txErr := pgx.BeginFunc(context.Background(), c.ConnPool, func(tx pgx.Tx) error {
+		var err error
+		resp, err = deleteKeeperKeysInTx(tx, key, isRecurse)
+		return err
+	})
+	if txErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(txErr)
+	}
+	return resp, nil
+}
+
+// deleteKeeperKeysInTx deletes one key or multiple keys(with isRecurse enabled) within a transaction
+func deleteKeeperKeysInTx(tx pgx.Tx, key string, isRecurse bool) ([]models.KeyOnly, errors.EdgeX) {
 	var exists bool
 	var resp []models.KeyOnly
 	var childKeyCount uint32
 	ctx := context.Background()
-	queryPattern := key + "/%"
+	queryPattern := keyPrefixLikePattern(key)
 
 	// check if the exact same key exists
-	err := c.ConnPool.QueryRow(
+	err := tx.QueryRow(
 		context.Background(),
 		sqlCheckExistsByCol(configTableName, keyCol),
 		key,
@@ -131,7 +149,7 @@ func (c *Client) DeleteKeeperKeys(key string, isRecurse bool) ([]models.KeyOnly,
 	}
 
 	// check if the key(s) start with the keyPrefix exist and get the count of the result
-	err = c.ConnPool.QueryRow(
+	err = tx.QueryRow(
 		context.Background(),
 		sqlQueryCountByColAndLikePat(configTableName, keyCol),
 		queryPattern,
@@ -142,7 +160,7 @@ func (c *Client) DeleteKeeperKeys(key string, isRecurse bool) ([]models.KeyOnly,
 
 	if exists {
 		// delete the exact same key
-		_, err = c.ConnPool.Exec(ctx, sqlDeleteByColumns(configTableName, keyCol), key)
+		_, err = tx.Exec(ctx, sqlDeleteByColumns(configTableName, keyCol), key)
 		if err != nil {
 			return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query row by key '%s'", key), err)
 		}
@@ -158,7 +176,7 @@ func (c *Client) DeleteKeeperKeys(key string, isRecurse bool) ([]models.KeyOnly,
 		if isRecurse {
 			// also delete the keys starts with the same key (e.g., edgex/v3/core-data/Writable, edgex/v3/core-data/Database all starts with edgex/v3/core-data)
 			sqlStatement := sqlDeleteByColAndLikePat(configTableName, keyCol, keyCol)
-			rows, err := c.ConnPool.Query(ctx, sqlStatement, queryPattern)
+			rows, err := tx.Query(ctx, sqlStatement, queryPattern)
 			if err != nil {
 				return nil, pgClient.WrapDBError(fmt.Sprintf("failed to delete row by key starts with '%s'", key), err)
 			}
@@ -177,11 +195,190 @@ func (c *Client) DeleteKeeperKeys(key string, isRecurse bool) ([]models.KeyOnly,
 		}
 	}
 
+	// record the deleted keys so that the modify index of the key prefix moves forward
+	deletedKeys := make([]string, len(resp))
+	for i, k := range resp {
+		deletedKeys[i] = string(k)
+	}
+	_, err = tx.Exec(ctx, sqlUpsertConfigTombstones(), deletedKeys)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to record the deleted keys of '%s'", key), err)
+	}
+	// the expired tombstones are pruned, which may move the modify index of a key prefix backwards and wakes up the
+	// watchers of the key prefix, the same as any other change
+	_, err = tx.Exec(ctx, sqlDeleteConfigTombstonesByDeletedTime(), time.Now().UTC().Add(-constants.KVTombstoneRetention))
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to prune the expired tombstones of the deleted keys", err)
+	}
+	_, err = tx.Exec(ctx, sqlInsertConfigHistoryOfDeletedKeys(), deletedKeys, constants.TxnVerbDelete)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to record the history of the deleted keys of '%s'", key), err)
+	}
+
+	return resp, nil
+}
+
+// KeeperTxn applies the set, delete and check-index operations within a transaction, the transaction is rolled back
+// if any operation fails. The check-index operations are evaluated against the keys before the transaction regardless
+// of their positions.
+func (c *Client) KeeperTxn(ops []keeperModels.KVTxnOp) ([]models.KeyOnly, errors.EdgeX) {
+	var resp []models.KeyOnly
+	txErr := pgx.BeginFunc(context.Background(), c.ConnPool, func(tx pgx.Tx) error {
+		i, err := keeperModels.CheckTxnIndexes(ops, func(key string) (uint64, errors.EdgeX) {
+			return keyIndexInTx(tx, key)
+		})
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("operation %d of key '%s' failed", i, ops[i].Key), err)
+		}
+
+		for i, op := range ops {
+			var keys []models.KeyOnly
+			var err errors.EdgeX
+			switch op.Verb {
+			case constants.TxnVerbCheckIndex:
+				// already evaluated against the keys before the transaction
+			case constants.TxnVerbSet:
+				if op.Flatten {
+					keys, err = updateMultiKVSInTx(tx, op.Key, op.Value)
+				} else {
+					err = updateKVS(tx, op.Key, op.Value)
+					keys = []models.KeyOnly{models.KeyOnly(op.Key)}
+				}
+			case constants.TxnVerbDelete:
+				keys, err = deleteKeeperKeysInTx(tx, op.Key, op.PrefixMatch)
+			default:
+				err = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown verb %s", op.Verb), nil)
+			}
+			if err != nil {
+				return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("operation %d of key '%s' failed", i, op.Key), err)
+			}
+			resp = append(resp, keys...)
+		}
+		return nil
+	})
+	if txErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(txErr)
+	}
 	return resp, nil
 }
 
-// updateKVS insert or update a single key-value pair with value is simply a string or a map
-func updateKVS(connPool *pgxpool.Pool, key string, value any) errors.EdgeX {
+// KeeperKeysIndex returns the modify index of the specified key or the keys with the same key prefix,
+// which is the largest modify index of the existing and deleted keys
+func (c *Client) KeeperKeysIndex(key string) (uint64, errors.EdgeX) {
+	var index int64
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryMaxModifyIndexByKey(), key, keyPrefixLikePattern(key)).Scan(&index)
+	if err != nil {
+		return 0, pgClient.WrapDBError(fmt.Sprintf("failed to query modify index by key '%s'", key), err)
+	}
+	return uint64(index), nil
+}
+
+// KeeperKeysHistory returns the revisions of the specified key or the keys with the same key prefix within the time range,
+// the newest revision comes first
+func (c *Client) KeeperKeysHistory(key string, start int64, end int64, offset int, limit int) ([]keeperModels.KVRevision, errors.EdgeX) {
+	startTime, endTime, offset, validLimit, edgeXErr := getValidTimeRangeParameters(start, end, offset, limit)
+	if edgeXErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXErr)
+	}
+
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryConfigHistoryByKeyAndTimeRange(), key, keyPrefixLikePattern(key), startTime, endTime, offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query history by key '%s'", key), err)
+	}
+
+	var revision keeperModels.KVRevision
+	var value *string
+	var modifyIndex int64
+	var created time.Time
+	result := make([]keeperModels.KVRevision, 0)
+	_, err = pgx.ForEachRow(rows, []any{&revision.Key, &value, &revision.Action, &modifyIndex, &created}, func() error {
+		revision.Value = ""
+		if value != nil {
+			decodeValue, decErr := base64.StdEncoding.DecodeString(*value)
+			if decErr != nil {
+				return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("decode the value of key %s failed", revision.Key), decErr)
+			}
+			revision.Value = string(decodeValue)
+		}
+		revision.ModifyIndex = uint64(modifyIndex)
+		revision.Timestamp = created.UnixMilli()
+		result = append(result, revision)
+		return nil
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to scan row to models.KVRevision", err)
+	}
+	return result, nil
+}
+
+// KeeperKeysHistoryCount returns the count of the revisions of the specified key or the keys with the same key prefix
+// within the time range
+func (c *Client) KeeperKeysHistoryCount(key string, start int64, end int64) (int64, errors.EdgeX) {
+	startTime, endTime, edgeXErr := getValidStartAndEndTime(start, end)
+	if edgeXErr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXErr)
+	}
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountConfigHistoryByKeyAndTimeRange(), key, keyPrefixLikePattern(key), startTime, endTime)
+}
+
+// DeleteKeeperKeysHistoryByAge deletes the revisions of the keys older than the age in milliseconds, the latest
+// revision of each existing key is kept
+func (c *Client) DeleteKeeperKeysHistoryByAge(age int64) errors.EdgeX {
+	expireTime := time.Now().UTC().Add(-time.Duration(age) * time.Millisecond)
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteConfigHistoryByCreatedTime(), expireTime, constants.TxnVerbDelete)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete the history of keys older than %d milliseconds", age), err)
+	}
+	return nil
+}
+
+// appendSetHistoryInTx appends the current value and modify index of the key to the history within a transaction
+func appendSetHistoryInTx(tx pgx.Tx, key string) errors.EdgeX {
+	_, err := tx.Exec(context.Background(), sqlInsertConfigHistoryOfSetKeys(), []string{key}, constants.TxnVerbSet)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to record the history of key '%s'", key), err)
+	}
+	return nil
+}
+
+// deleteConfigTombstoneInTx deletes the tombstone of the re-created key within a transaction, the tombstone is no longer
+// needed as the new row of the key is stamped with a larger modify index
+func deleteConfigTombstoneInTx(tx pgx.Tx, key string) errors.EdgeX {
+	_, err := tx.Exec(context.Background(), sqlDeleteByColumns(configTombstoneTableName, keyCol), key)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete the tombstone of key '%s'", key), err)
+	}
+	return nil
+}
+
+// lockKeyInTx obtains the transaction level lock of the key, so that the concurrent transactions on the same key,
+// including the insert of a new key, are serialized
+func lockKeyInTx(tx pgx.Tx, key string) errors.EdgeX {
+	_, err := tx.Exec(context.Background(), sqlAdvisoryXactLockByText(), key)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to lock key '%s'", key), err)
+	}
+	return nil
+}
+
+// keyIndexInTx returns the modify index of the key within a transaction, the index of a key not exists is 0, and the
+// key is locked so that it can't be changed by the concurrent transactions before this transaction ends
+func keyIndexInTx(tx pgx.Tx, key string) (uint64, errors.EdgeX) {
+	edgeXErr := lockKeyInTx(tx, key)
+	if edgeXErr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXErr)
+	}
+
+	var index int64
+	err := tx.QueryRow(context.Background(), sqlQueryFieldsByCol(configTableName, []string{modifyIndexCol}, keyCol), key).Scan(&index)
+	if err != nil && !stdErrs.Is(err, pgx.ErrNoRows) {
+		return 0, pgClient.WrapDBError(fmt.Sprintf("failed to query modify index by key '%s'", key), err)
+	}
+	return uint64(index), nil
+}
+
+// updateKVS insert or update a single key-value pair with value is simply a string or a map within a transaction
+func updateKVS(tx pgx.Tx, key string, value any) errors.EdgeX {
 	ctx := context.Background()
 	var storedValueBytes []byte
 
@@ -200,15 +397,20 @@ func updateKVS(connPool *pgxpool.Pool, key string, value any) errors.EdgeX {
 	// encode the value to a base64 string
 	storedValue := base64.StdEncoding.EncodeToString(storedValueBytes)
 
+	edgeXErr := lockKeyInTx(tx, key)
+	if edgeXErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXErr)
+	}
+
 	var exists bool
-	err := connPool.QueryRow(ctx, sqlCheckExistsByCol(configTableName, keyCol), key).Scan(&exists)
+	err := tx.QueryRow(ctx, sqlCheckExistsByCol(configTableName, keyCol), key).Scan(&exists)
 	if err != nil {
 		return pgClient.WrapDBError(fmt.Sprintf("failed to query value by key '%s'", key), err)
 	}
 
 	if exists {
 		// update the key
-		_, err = connPool.Exec(ctx, sqlUpdateColsByCondCol(configTableName, keyCol, valueCol, modifiedCol),
+		_, err = tx.Exec(ctx, sqlUpdateKVByKey(),
 			storedValue,
 			time.Now().UTC(),
 			key,
@@ -218,15 +420,19 @@ func updateKVS(connPool *pgxpool.Pool, key string, value any) errors.EdgeX {
 		}
 	} else {
 		// insert the key
-		_, err = connPool.Exec(ctx, sqlInsert(configTableName, keyCol, valueCol),
+		_, err = tx.Exec(ctx, sqlInsert(configTableName, keyCol, valueCol),
 			key,
 			storedValue,
 		)
 		if err != nil {
 			return pgClient.WrapDBError(fmt.Sprintf("failed to insert value by key '%s'", key), err)
 		}
+		edgeXErr = deleteConfigTombstoneInTx(tx, key)
+		if edgeXErr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXErr)
+		}
 	}
-	return nil
+	return appendSetHistoryInTx(tx, key)
 }
 
 // updateMultiKVSInTx insert or update the key-value pairs in a map within a transaction
@@ -239,6 +445,11 @@ func updateMultiKVSInTx(tx pgx.Tx, currentKey string, value any) ([]models.KeyOn
 		var exists bool
 		var sqlStatement string
 
+		edgeXErr := lockKeyInTx(tx, currentKey)
+		if edgeXErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXErr)
+		}
+
 		err := tx.QueryRow(
 			context.Background(),
 			sqlCheckExistsByCol(configTableName, keyCol),
@@ -251,7 +462,7 @@ func updateMultiKVSInTx(tx pgx.Tx, currentKey string, value any) ([]models.KeyOn
 		storedValueStr := cast.ToString(v)
 		encStr := base64.StdEncoding.EncodeToString([]byte(storedValueStr))
 		if exists {
-			sqlStatement = sqlUpdateColsByCondCol(configTableName, keyCol, valueCol, modifiedCol)
+			sqlStatement = sqlUpdateKVByKey()
 			_, err = tx.Exec(ctx, sqlStatement, encStr, time.Now().UTC(), currentKey)
 			if err != nil {
 				return nil, pgClient.WrapDBError(fmt.Sprintf("failed to update row by key '%s'", currentKey), err)
@@ -262,6 +473,14 @@ func updateMultiKVSInTx(tx pgx.Tx, currentKey string, value any) ([]models.KeyOn
 			if err != nil {
 				return nil, pgClient.WrapDBError(fmt.Sprintf("failed to insert row by key '%s'", currentKey), err)
 			}
+			edgeXErr = deleteConfigTombstoneInTx(tx, currentKey)
+			if edgeXErr != nil {
+				return nil, errors.NewCommonEdgeXWrapper(edgeXErr)
+			}
+		}
+		edgeXErr = appendSetHistoryInTx(tx, currentKey)
+		if edgeXErr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXErr)
 		}
 		keyReps = append(keyReps, models.KeyOnly(currentKey))
 	case map[string]any:
@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// UpsertNotificationOccurrence adds the occurrence of a fingerprint, or replaces the existing one
+func (c *Client) UpsertNotificationOccurrence(o notificationsModels.NotificationOccurrence) errors.EdgeX {
+	dataBytes, err := json.Marshal(o)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal NotificationOccurrence model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlUpsertNotificationOccurrence(), o.Fingerprint, o.NotificationId, dataBytes)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to upsert the occurrence of notification '%s'", o.NotificationId), err)
+	}
+	return nil
+}
+
+// NotificationOccurrenceByFingerprint queries the occurrence of a fingerprint
+func (c *Client) NotificationOccurrenceByFingerprint(fingerprint string) (notificationsModels.NotificationOccurrence, errors.EdgeX) {
+	var o notificationsModels.NotificationOccurrence
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(occurrenceTableName, []string{contentCol}, fingerprintCol), fingerprint).Scan(&o)
+	if err != nil {
+		return o, pgClient.WrapDBError(fmt.Sprintf("failed to query row with fingerprint '%s' from occurrence table", fingerprint), err)
+	}
+	return o, nil
+}
+
+// NotificationOccurrenceByNotificationId queries the occurrence of a notification
+func (c *Client) NotificationOccurrenceByNotificationId(id string) (notificationsModels.NotificationOccurrence, errors.EdgeX) {
+	var o notificationsModels.NotificationOccurrence
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(occurrenceTableName, []string{contentCol}, notificationIdCol), id).Scan(&o)
+	if err != nil {
+		return o, pgClient.WrapDBError(fmt.Sprintf("failed to query row with notification id '%s' from occurrence table", id), err)
+	}
+	return o, nil
+}
@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddHeldNotification adds the notification held in the quiet hours of a subscription
+func (c *Client) AddHeldNotification(held notificationsModels.HeldNotification) (notificationsModels.HeldNotification, errors.EdgeX) {
+	if len(held.Id) == 0 {
+		held.Id = uuid.New().String()
+	}
+	if held.Created == 0 {
+		held.Created = time.Now().UTC().UnixMilli()
+	}
+	dataBytes, err := json.Marshal(held)
+	if err != nil {
+		return held, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal HeldNotification model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlInsert(heldNotificationTableName, idCol, subscriptionNameCol, contentCol), held.Id, held.SubscriptionName, dataBytes)
+	if err != nil {
+		return held, pgClient.WrapDBError(fmt.Sprintf("failed to insert the held notification of subscription '%s'", held.SubscriptionName), err)
+	}
+	return held, nil
+}
+
+// HeldNotificationsBySubscriptionName queries the notifications held in the quiet hours of a subscription, the earliest
+// held notification comes first
+func (c *Client) HeldNotificationsBySubscriptionName(name string) ([]notificationsModels.HeldNotification, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryHeldNotificationsBySubscriptionName(), name)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query the held notifications of subscription '%s'", name), err)
+	}
+
+	heldNotifications, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.HeldNotification, error) {
+		var held notificationsModels.HeldNotification
+		scanErr := row.Scan(&held)
+		return held, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to HeldNotification model", err)
+	}
+	return heldNotifications, nil
+}
+
+// DeleteHeldNotificationsByIds deletes the held notifications by ids
+func (c *Client) DeleteHeldNotificationsByIds(ids []string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByIds(heldNotificationTableName), ids)
+	if err != nil {
+		return pgClient.WrapDBError("failed to delete the held notifications", err)
+	}
+	return nil
+}
+
+// DeleteHeldNotificationsBySubscriptionName deletes the held notifications of a subscription
+func (c *Client) DeleteHeldNotificationsBySubscriptionName(name string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(heldNotificationTableName, subscriptionNameCol), name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete the held notifications of subscription '%s'", name), err)
+	}
+	return nil
+}
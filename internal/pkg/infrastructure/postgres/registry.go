@@ -12,11 +12,13 @@ import (
 	"fmt"
 	"time"
 
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -128,6 +130,120 @@ func (c *Client) DeleteRegistrationByServiceId(serviceId string) errors.EdgeX {
 	return nil
 }
 
+// AddStatusTransition appends the health status transition of a registered service to the registry history
+func (c *Client) AddStatusTransition(t keeperModels.StatusTransition) (keeperModels.StatusTransition, errors.EdgeX) {
+	if len(t.Id) == 0 {
+		t.Id = uuid.New().String()
+	}
+	if t.Timestamp == 0 {
+		t.Timestamp = time.Now().UTC().UnixMilli()
+	}
+
+	_, err := c.ConnPool.Exec(context.Background(),
+		sqlInsert(registryHistoryTableName, idCol, serviceIdCol, fromStatusCol, toStatusCol, createdCol),
+		t.Id, t.ServiceId, t.FromStatus, t.ToStatus, getUTCTime(t.Timestamp),
+	)
+	if err != nil {
+		return keeperModels.StatusTransition{}, pgClient.WrapDBError("failed to insert row to registry history table", err)
+	}
+
+	return t, nil
+}
+
+// StatusTransitionsByServiceId queries the health status transitions of a registered service within the time range,
+// the newest transition comes first
+func (c *Client) StatusTransitionsByServiceId(serviceId string, start int64, end int64, offset int, limit int) ([]keeperModels.StatusTransition, errors.EdgeX) {
+	startTime, endTime, offset, validLimit, edgeXErr := getValidTimeRangeParameters(start, end, offset, limit)
+	if edgeXErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXErr)
+	}
+
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryAllByColWithPaginationAndTimeRangeDesc(registryHistoryTableName, serviceIdCol),
+		pgx.NamedArgs{serviceIdCol: serviceId, startTimeCondition: startTime, endTimeCondition: endTime, offsetCondition: offset, limitCondition: validLimit})
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query registry history by service id '%s'", serviceId), err)
+	}
+
+	transitions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (keeperModels.StatusTransition, error) {
+		var t keeperModels.StatusTransition
+		var created time.Time
+		scanErr := row.Scan(&t.Id, &t.ServiceId, &t.FromStatus, &t.ToStatus, &created)
+		t.Timestamp = created.UnixMilli()
+		return t, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to StatusTransition model", err)
+	}
+
+	return transitions, nil
+}
+
+// StatusTransitionCountByServiceId returns the count of the health status transitions of a registered service within
+// the time range
+func (c *Client) StatusTransitionCountByServiceId(serviceId string, start int64, end int64) (int64, errors.EdgeX) {
+	startTime, endTime, edgeXErr := getValidStartAndEndTime(start, end)
+	if edgeXErr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXErr)
+	}
+
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCountByTimeRangeCol(registryHistoryTableName, createdCol, nil, serviceIdCol),
+		pgx.NamedArgs{serviceIdCol: serviceId, startTimeCondition: startTime, endTimeCondition: endTime})
+}
+
+// DeleteStatusTransitionsByAge deletes the health status transitions older than the age in milliseconds
+func (c *Client) DeleteStatusTransitionsByAge(age int64) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByAge(registryHistoryTableName), age)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete the registry history older than %d milliseconds", age), err)
+	}
+	return nil
+}
+
+// UpsertServiceInstance adds the discovery information of a registered service instance, or replaces the existing one
+func (c *Client) UpsertServiceInstance(i keeperModels.ServiceInstance) errors.EdgeX {
+	dataBytes, err := json.Marshal(i)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal service instance model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlUpsertContentByCol(registryInstanceTableName, serviceIdCol), i.ServiceId, dataBytes)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to upsert row with service id '%s' to registry instance table", i.ServiceId), err)
+	}
+
+	return nil
+}
+
+// ServiceInstances retrieves the discovery information of all the registered service instances from database
+func (c *Client) ServiceInstances() ([]keeperModels.ServiceInstance, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContent(registryInstanceTableName))
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from registry instance table", err)
+	}
+
+	instances, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (keeperModels.ServiceInstance, error) {
+		var i keeperModels.ServiceInstance
+		scanErr := row.Scan(&i)
+		return i, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to ServiceInstance model", err)
+	}
+
+	return instances, nil
+}
+
+// DeleteServiceInstanceByServiceId deletes the discovery information of a registered service instance from database,
+// nothing is deleted if the service instance doesn't exist
+func (c *Client) DeleteServiceInstanceByServiceId(serviceId string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(registryInstanceTableName, serviceIdCol), serviceId)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete row with service id '%s' from registry instance table", serviceId), err)
+	}
+
+	return nil
+}
+
 // checkRegistrationExists checks if registration exists by service id
 func checkRegistrationExists(connPool *pgxpool.Pool, ctx context.Context, serviceId string) (bool, errors.EdgeX) {
 	var exists bool
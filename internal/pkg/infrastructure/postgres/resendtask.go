@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// UpsertResendTask adds the resend task of a transmission, or updates the next attempt time of the existing task
+func (c *Client) UpsertResendTask(task notificationsModels.ResendTask) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlUpsertResendTask(), task.TransmissionId, getUTCTime(task.NextAttempt))
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to upsert the resend task of transmission %s", task.TransmissionId), err)
+	}
+	return nil
+}
+
+// ResendTasksByNextAttempt queries the resend tasks due before the given time in milliseconds, the earliest task comes
+// first and all the due tasks are returned if limit is negative
+func (c *Client) ResendTasksByNextAttempt(before int64, limit int) ([]notificationsModels.ResendTask, errors.EdgeX) {
+	_, validLimit := getValidOffsetAndLimit(0, limit)
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryResendTasksByNextAttempt(), getUTCTime(before), validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query the due resend tasks", err)
+	}
+
+	tasks, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.ResendTask, error) {
+		var task notificationsModels.ResendTask
+		var nextAttempt time.Time
+		scanErr := row.Scan(&task.TransmissionId, &nextAttempt)
+		task.NextAttempt = nextAttempt.UnixMilli()
+		return task, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to ResendTask model", err)
+	}
+	return tasks, nil
+}
+
+// ResendTaskByTransmissionId queries the resend task of a transmission
+func (c *Client) ResendTaskByTransmissionId(id string) (notificationsModels.ResendTask, errors.EdgeX) {
+	task := notificationsModels.ResendTask{TransmissionId: id}
+	var nextAttempt time.Time
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(resendTaskTableName, []string{nextAttemptCol}, transmissionIdCol), id).Scan(&nextAttempt)
+	if err != nil {
+		return task, pgClient.WrapDBError(fmt.Sprintf("failed to query the resend task of transmission %s", id), err)
+	}
+	task.NextAttempt = nextAttempt.UnixMilli()
+	return task, nil
+}
+
+// DeleteResendTaskByTransmissionId deletes the resend task of a transmission, nothing is deleted if the task doesn't exist
+func (c *Client) DeleteResendTaskByTransmissionId(id string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(resendTaskTableName, transmissionIdCol), id)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete the resend task of transmission %s", id), err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// UpsertRetryPolicy adds the retry policy setting of a schedule job, or replaces the existing one
+func (c *Client) UpsertRetryPolicy(ctx context.Context, policy schedulerModels.RetryPolicy) errors.EdgeX {
+	dataBytes, err := json.Marshal(policy)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal RetryPolicy model", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlUpsertContentByCol(retryPolicyTableName, jobNameCol), policy.JobName, dataBytes)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to upsert row with job name '%s' to retry_policy table", policy.JobName), err)
+	}
+	return nil
+}
+
+// RetryPolicyByJobName queries the retry policy setting of a schedule job
+func (c *Client) RetryPolicyByJobName(ctx context.Context, name string) (schedulerModels.RetryPolicy, errors.EdgeX) {
+	var policy schedulerModels.RetryPolicy
+	err := c.ConnPool.QueryRow(ctx, sqlQueryFieldsByCol(retryPolicyTableName, []string{contentCol}, jobNameCol), name).Scan(&policy)
+	if err != nil {
+		return policy, pgClient.WrapDBError(fmt.Sprintf("failed to query row with job name '%s' from retry_policy table", name), err)
+	}
+	return policy, nil
+}
+
+// AllRetryPolicies queries the retry policy settings of all schedule jobs
+func (c *Client) AllRetryPolicies(ctx context.Context) ([]schedulerModels.RetryPolicy, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(ctx, sqlQueryContent(retryPolicyTableName))
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from retry_policy table", err)
+	}
+
+	policies, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (schedulerModels.RetryPolicy, error) {
+		var policy schedulerModels.RetryPolicy
+		scanErr := row.Scan(&policy)
+		return policy, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to RetryPolicy model", err)
+	}
+	return policies, nil
+}
+
+// DeleteRetryPolicyByJobName deletes the retry policy setting of a schedule job, nothing is deleted if the retry policy
+// doesn't exist
+func (c *Client) DeleteRetryPolicyByJobName(ctx context.Context, name string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByColumns(retryPolicyTableName, jobNameCol), name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete row with job name '%s' from retry_policy table", name), err)
+	}
+	return nil
+}
+
+// AddScheduleActionAttempt adds the schedule action record of an attempt with its attempt number in a transaction, the
+// attempt number is deleted along with the record
+func (c *Client) AddScheduleActionAttempt(ctx context.Context, attempt schedulerModels.ScheduleActionAttempt) (schedulerModels.ScheduleActionAttempt, errors.EdgeX) {
+	if len(attempt.Record.Id) == 0 {
+		attempt.Record.Id = uuid.New().String()
+	}
+	args, edgeXerr := scheduleActionRecordInsertArgs(attempt.Record)
+	if edgeXerr != nil {
+		return attempt, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	pgxErr := pgx.BeginFunc(ctx, c.ConnPool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, sqlInsert(scheduleActionRecordTableName, idCol, actionIdCol, jobNameCol, actionCol, statusCol, scheduledAtCol), args...)
+		if err != nil {
+			return pgClient.WrapDBError("failed to insert schedule action record", err)
+		}
+		_, err = tx.Exec(ctx, sqlInsert(recordAttemptTableName, recordIdCol, attemptCol, maxAttemptsCol), attempt.Record.Id, attempt.Attempt, attempt.MaxAttempts)
+		if err != nil {
+			return pgClient.WrapDBError("failed to insert the attempt number of schedule action record", err)
+		}
+		return nil
+	})
+	if pgxErr != nil {
+		return attempt, errors.NewCommonEdgeXWrapper(pgxErr)
+	}
+	return attempt, nil
+}
+
+// ScheduleActionAttemptsByJobName queries the schedule action records with attempt numbers by job name with the given
+// range, offset, and limit, the newest record comes first
+func (c *Client) ScheduleActionAttemptsByJobName(ctx context.Context, jobName string, start, end int64, offset, limit int) ([]schedulerModels.ScheduleActionAttempt, errors.EdgeX) {
+	startTime, endTime, offset, validLimit, err := getValidTimeRangeParameters(start, end, offset, limit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	sqlQueryAttempts := fmt.Sprintf(`
+	SELECT r.%[3]s, r.%[4]s, r.%[5]s, r.%[6]s, r.%[7]s, r.%[8]s, r.%[9]s, a.%[10]s, a.%[11]s
+	FROM %[1]s r JOIN %[2]s a ON r.%[3]s = a.%[12]s
+	WHERE r.%[5]s = @%[5]s AND r.%[9]s >= @%[13]s AND r.%[9]s <= @%[14]s
+	ORDER BY r.%[9]s DESC OFFSET @%[15]s LIMIT @%[16]s
+	`, scheduleActionRecordTableName, recordAttemptTableName, idCol, actionIdCol, jobNameCol, actionCol, statusCol, scheduledAtCol, createdCol,
+		attemptCol, maxAttemptsCol, recordIdCol, startTimeCondition, endTimeCondition, offsetCondition, limitCondition)
+
+	rows, pgxErr := c.ConnPool.Query(ctx, sqlQueryAttempts,
+		pgx.NamedArgs{jobNameCol: jobName, startTimeCondition: startTime, endTimeCondition: endTime, offsetCondition: offset, limitCondition: validLimit})
+	if pgxErr != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query schedule action attempts by job name %s", jobName), pgxErr)
+	}
+	defer rows.Close()
+
+	var attempts []schedulerModels.ScheduleActionAttempt
+	for rows.Next() {
+		var actionId string
+		var attempt schedulerModels.ScheduleActionAttempt
+		var created, scheduledAt time.Time
+		var actionJSONBytes []byte
+		scanErr := rows.Scan(&attempt.Record.Id, &actionId, &attempt.Record.JobName, &actionJSONBytes, &attempt.Record.Status, &scheduledAt, &created,
+			&attempt.Attempt, &attempt.MaxAttempts)
+		if scanErr != nil {
+			return nil, pgClient.WrapDBError("failed to scan schedule action attempt", scanErr)
+		}
+
+		action, unmarshalErr := model.UnmarshalScheduleAction(actionJSONBytes)
+		if unmarshalErr != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON unmarshal schedule action record", unmarshalErr)
+		}
+		attempt.Record.Action = action.WithId(actionId)
+		attempt.Record.Created = created.UnixMilli()
+		attempt.Record.ScheduledAt = scheduledAt.UnixMilli()
+		attempts = append(attempts, attempt)
+	}
+
+	if readErr := rows.Err(); readErr != nil {
+		return nil, pgClient.WrapDBError("error occurred while query support_scheduler.record_attempt table", readErr)
+	}
+	return attempts, nil
+}
+
+// ScheduleActionAttemptCountByJobName returns the total count of the schedule action records with attempt numbers by
+// job name
+func (c *Client) ScheduleActionAttemptCountByJobName(ctx context.Context, jobName string, start, end int64) (int64, errors.EdgeX) {
+	startTime, endTime := getUTCStartAndEndTime(start, end)
+	sqlCountAttempts := fmt.Sprintf(
+		"SELECT COUNT(*) FROM %[1]s r JOIN %[2]s a ON r.%[3]s = a.%[4]s WHERE r.%[5]s = @%[5]s AND r.%[6]s >= @%[7]s AND r.%[6]s <= @%[8]s",
+		scheduleActionRecordTableName, recordAttemptTableName, idCol, recordIdCol, jobNameCol, createdCol, startTimeCondition, endTimeCondition)
+	return getTotalRowsCount(ctx, c.ConnPool, sqlCountAttempts, pgx.NamedArgs{jobNameCol: jobName, startTimeCondition: startTime, endTimeCondition: endTime})
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -179,6 +179,22 @@ func (c *Client) DeleteScheduleActionRecordByAge(ctx context.Context, age int64)
 }
 
 func addScheduleActionRecord(ctx context.Context, connPool *pgxpool.Pool, scheduleActionRecord model.ScheduleActionRecord) (model.ScheduleActionRecord, errors.EdgeX) {
+	args, edgeXerr := scheduleActionRecordInsertArgs(scheduleActionRecord)
+	if edgeXerr != nil {
+		return scheduleActionRecord, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_, err := connPool.Exec(ctx, sqlInsert(scheduleActionRecordTableName, idCol, actionIdCol, jobNameCol, actionCol, statusCol, scheduledAtCol), args...)
+	if err != nil {
+		return scheduleActionRecord, pgClient.WrapDBError("failed to insert schedule action record", err)
+	}
+
+	return scheduleActionRecord, nil
+}
+
+// scheduleActionRecordInsertArgs returns the values of the id, action_id, job_name, action, status and scheduled_at
+// columns to insert the schedule action record
+func scheduleActionRecordInsertArgs(scheduleActionRecord model.ScheduleActionRecord) ([]any, errors.EdgeX) {
 	actionId := scheduleActionRecord.Action.GetBaseScheduleAction().Id
 	// Remove the payload from the action before storing it in the database to reduce the size of the record
 	copiedScheduleAction := scheduleActionRecord.Action.WithEmptyPayloadAndId()
@@ -186,23 +202,17 @@ func addScheduleActionRecord(ctx context.Context, connPool *pgxpool.Pool, schedu
 	// Marshal the action to store it in the database
 	actionJSONBytes, err := json.Marshal(copiedScheduleAction)
 	if err != nil {
-		return scheduleActionRecord, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal schedule action record for Postgres persistence", err)
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal schedule action record for Postgres persistence", err)
 	}
 
-	_, err = connPool.Exec(
-		ctx,
-		sqlInsert(scheduleActionRecordTableName, idCol, actionIdCol, jobNameCol, actionCol, statusCol, scheduledAtCol),
+	return []any{
 		scheduleActionRecord.Id,
 		actionId,
 		scheduleActionRecord.JobName,
 		actionJSONBytes,
 		scheduleActionRecord.Status,
-		time.UnixMilli(scheduleActionRecord.ScheduledAt).UTC())
-	if err != nil {
-		return scheduleActionRecord, pgClient.WrapDBError("failed to insert schedule action record", err)
-	}
-
-	return scheduleActionRecord, nil
+		time.UnixMilli(scheduleActionRecord.ScheduledAt).UTC(),
+	}, nil
 }
 
 func queryScheduleActionRecords(ctx context.Context, connPool *pgxpool.Pool, sql string, args ...any) ([]model.ScheduleActionRecord, errors.EdgeX) {
@@ -39,6 +39,50 @@ func sqlInsert(table string, columns ...string) string {
 	return fmt.Sprintf("INSERT INTO %s(%s) VALUES (%s)", table, columnNames, valueNames)
 }
 
+// sqlUpsertConfigTombstones returns the SQL statement for recording the deleted keeper keys passed as a text array,
+// each deleted key is stamped with the next modify index and the deleted time
+func sqlUpsertConfigTombstones() string {
+	return fmt.Sprintf("INSERT INTO %s(%s) SELECT unnest($1::text[]) ON CONFLICT (%s) DO UPDATE SET %s = nextval('%s'), %s = EXCLUDED.%s",
+		configTombstoneTableName, keyCol, keyCol, modifyIndexCol, configModifyIndexSequence, deletedCol, deletedCol)
+}
+
+// sqlUpsertContentByCol returns the SQL statement for inserting a new row with the given column $1 and content $2
+// into the table, or updating the content of the row if the column value already exists
+func sqlUpsertContentByCol(table string, column string) string {
+	return fmt.Sprintf("INSERT INTO %s(%s, %s) VALUES ($1, $2) ON CONFLICT (%s) DO UPDATE SET %s = EXCLUDED.%s",
+		table, column, contentCol, column, contentCol, contentCol)
+}
+
+// sqlInsertConfigHistoryOfSetKeys returns the SQL statement for appending the current value and modify index of the
+// keeper keys passed as a text array $1 to the config history with the action $2
+func sqlInsertConfigHistoryOfSetKeys() string {
+	return fmt.Sprintf("INSERT INTO %s(%s, %s, %s, %s) SELECT %s, %s, $2, %s FROM %s WHERE %s = ANY($1::text[])",
+		configHistoryTableName, keyCol, valueCol, actionCol, modifyIndexCol,
+		keyCol, valueCol, modifyIndexCol, configTableName, keyCol)
+}
+
+// sqlInsertConfigHistoryOfDeletedKeys returns the SQL statement for appending the deletion of the keeper keys passed as
+// a text array $1 to the config history with the action $2, the modify index is taken from the tombstone of the key
+func sqlInsertConfigHistoryOfDeletedKeys() string {
+	return fmt.Sprintf("INSERT INTO %s(%s, %s, %s) SELECT %s, $2, %s FROM %s WHERE %s = ANY($1::text[])",
+		configHistoryTableName, keyCol, actionCol, modifyIndexCol,
+		keyCol, modifyIndexCol, configTombstoneTableName, keyCol)
+}
+
+// sqlUpsertResendTask returns the SQL statement for adding the resend task of the transmission $1 with the next attempt
+// time $2, or updating the next attempt time if the task already exists
+func sqlUpsertResendTask() string {
+	return fmt.Sprintf("INSERT INTO %s(%s, %s) VALUES ($1, $2) ON CONFLICT (%s) DO UPDATE SET %s = EXCLUDED.%s",
+		resendTaskTableName, transmissionIdCol, nextAttemptCol, transmissionIdCol, nextAttemptCol, nextAttemptCol)
+}
+
+// sqlUpsertEscalationTask returns the SQL statement for inserting the escalation task $3 of the notification $1 with
+// the next attempt time $2, or replacing the existing task of the notification
+func sqlUpsertEscalationTask() string {
+	return fmt.Sprintf("INSERT INTO %s(%s, %s, %s) VALUES ($1, $2, $3) ON CONFLICT (%s) DO UPDATE SET %s = EXCLUDED.%s, %s = EXCLUDED.%s",
+		escalationTaskTableName, notificationIdCol, nextAttemptCol, contentCol, notificationIdCol, nextAttemptCol, nextAttemptCol, contentCol, contentCol)
+}
+
 // ----------------------------------------------------------------------------------
 // SQL statements for SELECT operations
 // ----------------------------------------------------------------------------------
@@ -208,6 +252,31 @@ func sqlQueryAllByColWithPaginationAndTimeRange(table string, columns ...string)
 		offsetCondition, limitCondition)
 }
 
+// sqlQueryAllByColWithPaginationAndTimeRangeDesc returns the SQL statement for selecting all rows from the table by the given columns with pagination and a time range,
+// the newest row comes first.
+func sqlQueryAllByColWithPaginationAndTimeRangeDesc(table string, columns ...string) string {
+	whereCondition := constructWhereNamedArgCondWithTimeRange(createdCol, createdCol, nil, columns...)
+
+	return fmt.Sprintf(
+		"SELECT * FROM %s WHERE %s ORDER BY %s DESC OFFSET @%s LIMIT @%s",
+		table, whereCondition, createdCol,
+		offsetCondition, limitCondition)
+}
+
+// sqlQueryWorkflowRunRecords returns the SQL statement for selecting the schedule action records of the workflow runs,
+// the runs are the distinct scheduled_at of the records of the step actions within the time range, paginated with the
+// newest run first.
+func sqlQueryWorkflowRunRecords() string {
+	runCondition := constructWhereNamedArgCondWithTimeRange(scheduledAtCol, scheduledAtCol, []string{actionIdCol}, jobNameCol, actionIdCol)
+	recordCondition := constructWhereNamedArgCondWithTimeRange("", "", []string{actionIdCol}, jobNameCol, actionIdCol)
+
+	return fmt.Sprintf(
+		"SELECT * FROM %s WHERE %s AND %s IN (SELECT DISTINCT %s FROM %s WHERE %s ORDER BY %s DESC OFFSET @%s LIMIT @%s) ORDER BY %s DESC, %s",
+		scheduleActionRecordTableName, recordCondition, scheduledAtCol,
+		scheduledAtCol, scheduleActionRecordTableName, runCondition, scheduledAtCol, offsetCondition, limitCondition,
+		scheduledAtCol, createdCol)
+}
+
 // sqlQueryAllById returns the SQL statement for selecting all rows from the table by id.
 func sqlQueryAllById(table string) string {
 	return fmt.Sprintf("SELECT * FROM %s WHERE %s = $1", table, idCol)
@@ -255,6 +324,53 @@ func sqlQueryContentByJSONFieldWithPaginationAsNamedArgs(table string) string {
 		table, jsonContentCondition, createdField, offsetCondition, limitCondition)
 }
 
+// sqlQueryResendTasksByNextAttempt returns the SQL statement for selecting the resend tasks due before the time $1,
+// the earliest task comes first and the count is limited by $2
+func sqlQueryResendTasksByNextAttempt() string {
+	return fmt.Sprintf("SELECT %s, %s FROM %s WHERE %s <= $1 ORDER BY %s LIMIT $2",
+		transmissionIdCol, nextAttemptCol, resendTaskTableName, nextAttemptCol, nextAttemptCol)
+}
+
+// sqlQueryTemplatesByCategory returns the SQL statement for selecting the content of the templates of the category $1
+// and the generic templates without category
+func sqlQueryTemplatesByCategory() string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE COALESCE(content->>'%s', '') IN ('', $1)", templateTableName, categoryField)
+}
+
+// sqlQueryEscalationPoliciesByCategories returns the SQL statement for selecting the content of the escalation policies
+// escalating any of the categories $1
+func sqlQueryEscalationPoliciesByCategories() string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE (content -> '%s') ?| $1::text[]", escalationPolicyTableName, categoriesField)
+}
+
+// sqlQueryDigestItemsBySubscriptionName returns the SQL statement for selecting the content of the digest items of the
+// subscription $1, the earliest item comes first
+func sqlQueryDigestItemsBySubscriptionName() string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE %s = $1 ORDER BY COALESCE((content->>'%s')::bigint, 0)",
+		digestItemTableName, subscriptionNameCol, createdField)
+}
+
+// sqlQueryHeldNotificationsBySubscriptionName returns the SQL statement for selecting the content of the held
+// notifications of the subscription $1, the earliest held notification comes first
+func sqlQueryHeldNotificationsBySubscriptionName() string {
+	return fmt.Sprintf("SELECT content FROM %s WHERE %s = $1 ORDER BY COALESCE((content->>'%s')::bigint, 0)",
+		heldNotificationTableName, subscriptionNameCol, createdField)
+}
+
+// sqlUpsertNotificationOccurrence returns the SQL statement for inserting the occurrence $3 with the fingerprint $1 and
+// the notification id $2, or replacing the existing occurrence of the fingerprint
+func sqlUpsertNotificationOccurrence() string {
+	return fmt.Sprintf("INSERT INTO %s(%s, %s, %s) VALUES ($1, $2, $3) ON CONFLICT (%s) DO UPDATE SET %s = EXCLUDED.%s, %s = EXCLUDED.%s",
+		occurrenceTableName, fingerprintCol, notificationIdCol, contentCol, fingerprintCol, notificationIdCol, notificationIdCol, contentCol, contentCol)
+}
+
+// sqlQueryEscalationTasksByNextAttempt returns the SQL statement for selecting the content of the escalation tasks due
+// before $1 with the limit $2, the earliest task comes first
+func sqlQueryEscalationTasksByNextAttempt() string {
+	return fmt.Sprintf("SELECT %s FROM %s WHERE %s <= $1 ORDER BY %s LIMIT $2",
+		contentCol, escalationTaskTableName, nextAttemptCol, nextAttemptCol)
+}
+
 // sqlCheckExistsById returns the SQL statement for checking if a row exists in the table by id.
 func sqlCheckExistsById(table string) string {
 	return fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE %s = $1)", table, idCol)
@@ -305,6 +421,13 @@ func sqlQueryCountByTimeRangeCol(table string, timeRangeCol string, arrayColName
 	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, whereCondition)
 }
 
+// sqlQueryWorkflowRunCount returns the SQL statement for counting the workflow runs, which are the distinct scheduled_at
+// of the records of the step actions within the time range
+func sqlQueryWorkflowRunCount() string {
+	whereCondition := constructWhereNamedArgCondWithTimeRange(scheduledAtCol, scheduledAtCol, []string{actionIdCol}, jobNameCol, actionIdCol)
+	return fmt.Sprintf("SELECT COUNT(DISTINCT %s) FROM %s WHERE %s", scheduledAtCol, scheduleActionRecordTableName, whereCondition)
+}
+
 // sqlQueryCountEventByTimeRangeCol returns the SQL statement for counting the number of rows in the event table
 // by the given time range of the specified column
 func sqlQueryCountEventByTimeRangeCol(timeRangeCol string, arrayColNames []string, columns ...string) string {
@@ -354,6 +477,34 @@ func sqlQueryEventIdFieldByTimeRangeAndConditions(timeRangeCol string, cols ...s
 	return fmt.Sprintf("SELECT event.id FROM %s JOIN %s on event.device_info_id = device_info.id WHERE %s", eventTableName, deviceInfoTableName, whereCondition)
 }
 
+// sqlAdvisoryXactLockByText returns the SQL statement for obtaining a transaction level advisory lock on the hash of $1
+func sqlAdvisoryXactLockByText() string {
+	return "SELECT pg_advisory_xact_lock(hashtext($1))"
+}
+
+// sqlQueryMaxModifyIndexByKey returns the SQL statement for selecting the largest modify index of the keeper keys and
+// the deleted keeper keys which equal to $1 or match the escaped LIKE pattern $2
+func sqlQueryMaxModifyIndexByKey() string {
+	return fmt.Sprintf(`SELECT GREATEST(
+		  (SELECT COALESCE(MAX(%s), 0) FROM %s WHERE %s = $1 OR %s LIKE $2),
+		  (SELECT COALESCE(MAX(%s), 0) FROM %s WHERE %s = $1 OR %s LIKE $2)
+		)`, modifyIndexCol, configTableName, keyCol, keyCol, modifyIndexCol, configTombstoneTableName, keyCol, keyCol)
+}
+
+// sqlQueryConfigHistoryByKeyAndTimeRange returns the SQL statement for selecting the config history of the keeper keys
+// which equal to $1 or match the LIKE pattern $2 within the time range $3 and $4, the newest revision comes first
+func sqlQueryConfigHistoryByKeyAndTimeRange() string {
+	return fmt.Sprintf("SELECT %s, %s, %s, %s, %s FROM %s WHERE (%s = $1 OR %s LIKE $2) AND %s >= $3 AND %s <= $4 ORDER BY %s DESC OFFSET $5 LIMIT $6",
+		keyCol, valueCol, actionCol, modifyIndexCol, createdCol, configHistoryTableName, keyCol, keyCol, createdCol, createdCol, modifyIndexCol)
+}
+
+// sqlQueryCountConfigHistoryByKeyAndTimeRange returns the SQL statement for counting the config history of the keeper keys
+// which equal to $1 or match the LIKE pattern $2 within the time range $3 and $4
+func sqlQueryCountConfigHistoryByKeyAndTimeRange() string {
+	return fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE (%s = $1 OR %s LIKE $2) AND %s >= $3 AND %s <= $4",
+		configHistoryTableName, keyCol, keyCol, createdCol, createdCol)
+}
+
 // ----------------------------------------------------------------------------------
 // SQL statements for UPDATE operations
 // ----------------------------------------------------------------------------------
@@ -377,6 +528,13 @@ func sqlUpdateContentById(table string) string {
 	return fmt.Sprintf("UPDATE %s SET %s = $1 WHERE %s = $2", table, contentCol, idCol)
 }
 
+// sqlUpdateKVByKey returns the SQL statement for updating the value and modified timestamp of a keeper key,
+// the key is stamped with the next modify index
+func sqlUpdateKVByKey() string {
+	return fmt.Sprintf("UPDATE %s SET %s = $1, %s = $2, %s = nextval('%s') WHERE %s = $3",
+		configTableName, valueCol, modifiedCol, modifyIndexCol, configModifyIndexSequence, keyCol)
+}
+
 // ----------------------------------------------------------------------------------
 // SQL statements for DELETE operations
 // ----------------------------------------------------------------------------------
@@ -386,6 +544,20 @@ func sqlDeleteById(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE %s = $1", table, idCol)
 }
 
+// sqlDeleteConfigTombstonesByDeletedTime returns the SQL statement for deleting the tombstones of the keeper keys
+// deleted before $1
+func sqlDeleteConfigTombstonesByDeletedTime() string {
+	return fmt.Sprintf("DELETE FROM %s WHERE %s < $1", configTombstoneTableName, deletedCol)
+}
+
+// sqlDeleteConfigHistoryByCreatedTime returns the SQL statement for deleting the config history created before $1, the
+// latest revision of each key before $1 is kept unless its action is $2 (delete), so that the state of the keys at any
+// time after $1 is not changed
+func sqlDeleteConfigHistoryByCreatedTime() string {
+	return fmt.Sprintf("DELETE FROM %s h WHERE h.%s < $1 AND (h.%s = $2 OR EXISTS (SELECT 1 FROM %s n WHERE n.%s = h.%s AND n.%s < $1 AND n.%s > h.%s))",
+		configHistoryTableName, createdCol, actionCol, configHistoryTableName, keyCol, keyCol, createdCol, modifyIndexCol, modifyIndexCol)
+}
+
 // sqlDeleteByAge returns the SQL statement for deleting rows from the table by created timestamp.
 func sqlDeleteByAge(table string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE %s < NOW() - INTERVAL '1 millisecond' * $1", table, createdCol)
@@ -425,6 +597,11 @@ func sqlDeleteEventsByTimeRangeAndColumn(upperLimitTimeRangeCol string, cols ...
 	return fmt.Sprintf("DELETE FROM %s USING %s WHERE event.device_info_id = device_info.id AND %s", eventTableName, deviceInfoTableName, whereCondition)
 }
 
+// sqlDeleteByIds returns the SQL statement for deleting rows from the table by the ids passed as a uuid array
+func sqlDeleteByIds(table string) string {
+	return fmt.Sprintf("DELETE FROM %s WHERE %s = ANY($1::uuid[])", table, idCol)
+}
+
 // sqlDeleteByColumn returns the SQL statement for deleting rows from the table by the specified column
 func sqlDeleteByColumns(table string, cols ...string) string {
 	return fmt.Sprintf("DELETE FROM %s WHERE %s", table, constructWhereCondition(cols...))
@@ -0,0 +1,127 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// NotificationStatistics counts the notifications created within the time range by the group and the time bucket of
+// the interval, the buckets start from the start time
+func (c *Client) NotificationStatistics(groupBy string, start, end, interval int64, category, severity string) ([]notificationsModels.NotificationStatistic, errors.EdgeX) {
+	var groupExpr, fromExpr string
+	switch groupBy {
+	case notificationsModels.GroupByCategory:
+		groupExpr = fmt.Sprintf("COALESCE(content->>'%s', '')", categoryField)
+	case notificationsModels.GroupBySeverity:
+		groupExpr = fmt.Sprintf("COALESCE(content->>'%s', '')", severityField)
+	case notificationsModels.GroupByStatus:
+		groupExpr = fmt.Sprintf("COALESCE(content->>'%s', '')", statusField)
+	case notificationsModels.GroupByLabel:
+		// a notification with multiple labels is counted in the group of each label
+		groupExpr = "label"
+		fromExpr = fmt.Sprintf(", jsonb_array_elements_text(COALESCE(content->'%s', '[]'::jsonb)) AS label", labelsField)
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported group by %s", groupBy), nil)
+	}
+	if interval <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the interval of the time bucket must be positive", nil)
+	}
+
+	sql := fmt.Sprintf(`
+	SELECT (COALESCE((content->>'%s')::bigint, 0) - @%s::bigint) / @%s::bigint * @%s::bigint + @%s::bigint AS bucket,
+		%s AS grp, COUNT(*)
+	FROM %s%s
+	WHERE COALESCE((content->>'%s')::bigint, 0) BETWEEN @%s AND @%s
+	AND content @> @%s::jsonb
+	GROUP BY bucket, grp
+	ORDER BY bucket, grp;
+	`, createdField, startTimeCondition, intervalCondition, intervalCondition, startTimeCondition,
+		groupExpr, notificationTableName, fromExpr,
+		createdField, startTimeCondition, endTimeCondition,
+		jsonContentCondition)
+
+	jsonContent := map[string]any{}
+	if len(category) != 0 {
+		jsonContent[categoryField] = category
+	}
+	if len(severity) != 0 {
+		jsonContent[severityField] = severity
+	}
+	args := pgx.NamedArgs{startTimeCondition: start, endTimeCondition: end, intervalCondition: interval, jsonContentCondition: jsonContent}
+
+	rows, err := c.ConnPool.Query(context.Background(), sql, args)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query the notification statistics", err)
+	}
+	statistics, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.NotificationStatistic, error) {
+		var s notificationsModels.NotificationStatistic
+		scanErr := row.Scan(&s.Bucket, &s.Group, &s.Count)
+		return s, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to scan the notification statistics", err)
+	}
+	return statistics, nil
+}
+
+// SubscriptionDeliveryStatistics calculates the delivery statistics of the transmissions created within the time range
+// by the subscription, the latency of a transmission is from the notification being created to the first SENT record
+func (c *Client) SubscriptionDeliveryStatistics(start, end int64) ([]notificationsModels.SubscriptionDeliveryStatistic, errors.EdgeX) {
+	sql := fmt.Sprintf(`
+	SELECT t.content->>'%s' AS subscription_name,
+		COUNT(*),
+		COUNT(*) FILTER (WHERE t.content->>'%s' IN ('%s', '%s')),
+		COUNT(*) FILTER (WHERE t.content->>'%s' IN ('%s', '%s', '%s')),
+		COUNT(*) FILTER (WHERE t.content->>'%s' = '%s'),
+		COUNT(*) FILTER (WHERE t.content->>'%s' = '%s'),
+		COALESCE(AVG(COALESCE((t.content->>'%s')::int, 0)), 0)::float8,
+		COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY d.latency), 0)::float8
+	FROM %s t
+	JOIN %s n ON n.%s = t.%s
+	LEFT JOIN LATERAL (
+		SELECT MIN((r->>'%s')::bigint) - COALESCE((n.content->>'%s')::bigint, 0) AS latency
+		FROM jsonb_array_elements(COALESCE(t.content->'%s', '[]'::jsonb)) AS r
+		WHERE r->>'%s' = '%s'
+	) d ON true
+	WHERE COALESCE((t.content->>'%s')::bigint, 0) BETWEEN @%s AND @%s
+	GROUP BY subscription_name
+	ORDER BY subscription_name;
+	`, subscriptionNameField,
+		statusField, models.Sent, models.Acknowledged,
+		statusField, models.Failed, notificationsModels.RateLimited, notificationsModels.CircuitOpen,
+		statusField, models.Resending,
+		statusField, models.Escalated,
+		resendCountField,
+		transmissionTableName, notificationTableName, idCol, notificationIdCol,
+		sentField, createdField,
+		recordsField,
+		statusField, models.Sent,
+		createdField, startTimeCondition, endTimeCondition)
+
+	rows, err := c.ConnPool.Query(context.Background(), sql, pgx.NamedArgs{startTimeCondition: start, endTimeCondition: end})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query the subscription delivery statistics", err)
+	}
+	statistics, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.SubscriptionDeliveryStatistic, error) {
+		var s notificationsModels.SubscriptionDeliveryStatistic
+		scanErr := row.Scan(&s.SubscriptionName, &s.Total, &s.Sent, &s.Failed, &s.Resending, &s.Escalated, &s.MeanResendCount, &s.P95Latency)
+		return s, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to scan the subscription delivery statistics", err)
+	}
+	return statistics, nil
+}
@@ -0,0 +1,71 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// UpsertSubscriptionExtension adds the extension settings of a subscription, or replaces the existing ones
+func (c *Client) UpsertSubscriptionExtension(ext notificationsModels.SubscriptionExtension) errors.EdgeX {
+	dataBytes, err := json.Marshal(ext)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal subscription extension model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlUpsertContentByCol(subscriptionExtensionTableName, subscriptionNameCol), ext.SubscriptionName, dataBytes)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to upsert row with subscription name '%s' to subscription extension table", ext.SubscriptionName), err)
+	}
+	return nil
+}
+
+// SubscriptionExtensionBySubscriptionName queries the extension settings of a subscription
+func (c *Client) SubscriptionExtensionBySubscriptionName(name string) (notificationsModels.SubscriptionExtension, errors.EdgeX) {
+	var ext notificationsModels.SubscriptionExtension
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(subscriptionExtensionTableName, []string{contentCol}, subscriptionNameCol), name).Scan(&ext)
+	if err != nil {
+		return ext, pgClient.WrapDBError(fmt.Sprintf("failed to query row with subscription name '%s' from subscription extension table", name), err)
+	}
+	return ext, nil
+}
+
+// AllSubscriptionExtensions queries the extension settings of all subscriptions
+func (c *Client) AllSubscriptionExtensions() ([]notificationsModels.SubscriptionExtension, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContent(subscriptionExtensionTableName))
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from subscription extension table", err)
+	}
+
+	extensions, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.SubscriptionExtension, error) {
+		var ext notificationsModels.SubscriptionExtension
+		scanErr := row.Scan(&ext)
+		return ext, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to SubscriptionExtension model", err)
+	}
+	return extensions, nil
+}
+
+// DeleteSubscriptionExtensionBySubscriptionName deletes the extension settings of a subscription, nothing is deleted
+// if the extension doesn't exist
+func (c *Client) DeleteSubscriptionExtensionBySubscriptionName(name string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(subscriptionExtensionTableName, subscriptionNameCol), name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete row with subscription name '%s' from subscription extension table", name), err)
+	}
+	return nil
+}
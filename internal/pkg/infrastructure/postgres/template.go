@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// AddTemplate adds a new notification template to the database
+func (c *Client) AddTemplate(t notificationsModels.Template) (notificationsModels.Template, errors.EdgeX) {
+	timestamp := time.Now().UTC().UnixMilli()
+	t.Created = timestamp
+	t.Modified = timestamp
+	dataBytes, err := json.Marshal(t)
+	if err != nil {
+		return t, errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal Template model", err)
+	}
+
+	_, err = c.ConnPool.Exec(context.Background(), sqlInsert(templateTableName, nameCol, contentCol), t.Name, dataBytes)
+	if err != nil {
+		return t, pgClient.WrapDBError(fmt.Sprintf("failed to insert row with name '%s' to template table", t.Name), err)
+	}
+	return t, nil
+}
+
+// UpdateTemplate updates the notification template
+func (c *Client) UpdateTemplate(t notificationsModels.Template) errors.EdgeX {
+	t.Modified = time.Now().UTC().UnixMilli()
+	dataBytes, err := json.Marshal(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal Template model", err)
+	}
+
+	commandTag, err := c.ConnPool.Exec(context.Background(), sqlUpdateColsByCondCol(templateTableName, nameCol, contentCol), dataBytes, t.Name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to update row with name '%s' from template table", t.Name), err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("template %s does not exist", t.Name), nil)
+	}
+	return nil
+}
+
+// TemplateByName queries the notification template by name
+func (c *Client) TemplateByName(name string) (notificationsModels.Template, errors.EdgeX) {
+	var t notificationsModels.Template
+	err := c.ConnPool.QueryRow(context.Background(), sqlQueryFieldsByCol(templateTableName, []string{contentCol}, nameCol), name).Scan(&t)
+	if err != nil {
+		return t, pgClient.WrapDBError(fmt.Sprintf("failed to query row with name '%s' from template table", name), err)
+	}
+	return t, nil
+}
+
+// AllTemplates queries the notification templates with offset and limit, all the templates are returned if limit is
+// negative
+func (c *Client) AllTemplates(offset, limit int) ([]notificationsModels.Template, errors.EdgeX) {
+	offset, validLimit := getValidOffsetAndLimit(offset, limit)
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryContentWithPagination(templateTableName), offset, validLimit)
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from template table", err)
+	}
+
+	templates, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.Template, error) {
+		var t notificationsModels.Template
+		scanErr := row.Scan(&t)
+		return t, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to Template model", err)
+	}
+	return templates, nil
+}
+
+// TemplatesByCategory queries the notification templates of the category and the generic templates without category
+func (c *Client) TemplatesByCategory(category string) ([]notificationsModels.Template, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(context.Background(), sqlQueryTemplatesByCategory(), category)
+	if err != nil {
+		return nil, pgClient.WrapDBError(fmt.Sprintf("failed to query rows by category '%s' from template table", category), err)
+	}
+
+	templates, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (notificationsModels.Template, error) {
+		var t notificationsModels.Template
+		scanErr := row.Scan(&t)
+		return t, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to Template model", err)
+	}
+	return templates, nil
+}
+
+// TemplateTotalCount returns the total count of the notification templates
+func (c *Client) TemplateTotalCount() (int64, errors.EdgeX) {
+	return getTotalRowsCount(context.Background(), c.ConnPool, sqlQueryCount(templateTableName))
+}
+
+// DeleteTemplateByName deletes the notification template by name
+func (c *Client) DeleteTemplateByName(name string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(context.Background(), sqlDeleteByColumns(templateTableName, nameCol), name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete row with name '%s' from template table", name), err)
+	}
+	return nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -7,12 +7,14 @@ package postgres
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
 	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
 )
 
@@ -98,3 +100,12 @@ func getUTCStartAndEndTime(start, end int64) (time.Time, time.Time) {
 func getUTCTime(timestamp int64) time.Time {
 	return time.UnixMilli(timestamp).UTC()
 }
+
+// likePatternReplacer escapes the LIKE wildcards and the default escape character
+var likePatternReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// keyPrefixLikePattern returns the LIKE pattern matching the child keys of the keeper key, the wildcards % and _ allowed
+// in the key names are escaped so that they only match themselves
+func keyPrefixLikePattern(key string) string {
+	return likePatternReplacer.Replace(key) + constants.KeyDelimiter + "%"
+}
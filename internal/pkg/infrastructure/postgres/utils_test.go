@@ -0,0 +1,17 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyPrefixLikePattern(t *testing.T) {
+	assert.Equal(t, "edgex/core-data/%", keyPrefixLikePattern("edgex/core-data"))
+	assert.Equal(t, `edgex/my\_service/50\%/%`, keyPrefixLikePattern("edgex/my_service/50%"))
+}
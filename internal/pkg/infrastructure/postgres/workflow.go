@@ -0,0 +1,96 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	pgClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/postgres"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// UpsertWorkflow adds the workflow setting of a schedule job, or replaces the existing one
+func (c *Client) UpsertWorkflow(ctx context.Context, workflow schedulerModels.Workflow) errors.EdgeX {
+	dataBytes, err := json.Marshal(workflow)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed to marshal Workflow model", err)
+	}
+
+	_, err = c.ConnPool.Exec(ctx, sqlUpsertContentByCol(workflowTableName, jobNameCol), workflow.JobName, dataBytes)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to upsert row with job name '%s' to workflow table", workflow.JobName), err)
+	}
+	return nil
+}
+
+// WorkflowByJobName queries the workflow setting of a schedule job
+func (c *Client) WorkflowByJobName(ctx context.Context, name string) (schedulerModels.Workflow, errors.EdgeX) {
+	var workflow schedulerModels.Workflow
+	err := c.ConnPool.QueryRow(ctx, sqlQueryFieldsByCol(workflowTableName, []string{contentCol}, jobNameCol), name).Scan(&workflow)
+	if err != nil {
+		return workflow, pgClient.WrapDBError(fmt.Sprintf("failed to query row with job name '%s' from workflow table", name), err)
+	}
+	return workflow, nil
+}
+
+// AllWorkflows queries the workflow settings of all schedule jobs
+func (c *Client) AllWorkflows(ctx context.Context) ([]schedulerModels.Workflow, errors.EdgeX) {
+	rows, err := c.ConnPool.Query(ctx, sqlQueryContent(workflowTableName))
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to query rows from workflow table", err)
+	}
+
+	workflows, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (schedulerModels.Workflow, error) {
+		var workflow schedulerModels.Workflow
+		scanErr := row.Scan(&workflow)
+		return workflow, scanErr
+	})
+	if err != nil {
+		return nil, pgClient.WrapDBError("failed to collect rows to Workflow model", err)
+	}
+	return workflows, nil
+}
+
+// DeleteWorkflowByJobName deletes the workflow setting of a schedule job, nothing is deleted if the workflow doesn't
+// exist
+func (c *Client) DeleteWorkflowByJobName(ctx context.Context, name string) errors.EdgeX {
+	_, err := c.ConnPool.Exec(ctx, sqlDeleteByColumns(workflowTableName, jobNameCol), name)
+	if err != nil {
+		return pgClient.WrapDBError(fmt.Sprintf("failed to delete row with job name '%s' from workflow table", name), err)
+	}
+	return nil
+}
+
+// WorkflowRunRecordsByJobName queries the schedule action records of the workflow runs of a schedule job with the given
+// time range, offset and limit. A run is identified by the scheduledAt of the records of the step actions, the time
+// range, offset and limit apply to the runs, the newest run first.
+func (c *Client) WorkflowRunRecordsByJobName(ctx context.Context, jobName string, actionIds []string, start, end int64, offset, limit int) ([]model.ScheduleActionRecord, errors.EdgeX) {
+	startTime, endTime, offset, validLimit, err := getValidTimeRangeParameters(start, end, offset, limit)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	records, err := queryScheduleActionRecords(ctx, c.ConnPool, sqlQueryWorkflowRunRecords(),
+		pgx.NamedArgs{jobNameCol: jobName, actionIdCol: actionIds, startTimeCondition: startTime, endTimeCondition: endTime, offsetCondition: offset, limitCondition: validLimit})
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("failed to query workflow run records by job name %s", jobName), err)
+	}
+	return records, nil
+}
+
+// WorkflowRunCountByJobName returns the total count of the workflow runs of a schedule job with the given time range
+func (c *Client) WorkflowRunCountByJobName(ctx context.Context, jobName string, actionIds []string, start, end int64) (int64, errors.EdgeX) {
+	startTime, endTime := getUTCStartAndEndTime(start, end)
+	return getTotalRowsCount(ctx, c.ConnPool, sqlQueryWorkflowRunCount(),
+		pgx.NamedArgs{jobNameCol: jobName, actionIdCol: actionIds, startTimeCondition: startTime, endTimeCondition: endTime})
+}
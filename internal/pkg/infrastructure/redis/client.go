@@ -1,11 +1,12 @@
 //
-// Copyright (C) 2020-2023 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package redis
 
 import (
+	"context"
 	"fmt"
 	"github.com/gomodule/redigo/redis"
 
@@ -14,8 +15,11 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/db"
 	redisClient "github.com/edgexfoundry/edgex-go/internal/pkg/db/redis"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/google/uuid"
 )
@@ -1593,6 +1597,484 @@ func (c *Client) TransmissionCountByNotificationId(id string) (int64, errors.Edg
 	return count, nil
 }
 
+// UpsertResendTask adds the resend task of a transmission, or updates the next attempt time of the existing task
+func (c *Client) UpsertResendTask(task notificationsModels.ResendTask) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := upsertResendTask(conn, task)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to upsert the resend task of transmission %s", task.TransmissionId), edgeXerr)
+	}
+	return nil
+}
+
+// ResendTasksByNextAttempt queries the resend tasks due before the given time in milliseconds, the earliest task comes
+// first and all the due tasks are returned if limit is negative
+func (c *Client) ResendTasksByNextAttempt(before int64, limit int) ([]notificationsModels.ResendTask, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	tasks, edgeXerr := resendTasksByNextAttempt(conn, before, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return tasks, nil
+}
+
+// ResendTaskByTransmissionId queries the resend task of a transmission
+func (c *Client) ResendTaskByTransmissionId(id string) (notificationsModels.ResendTask, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	task, edgeXerr := resendTaskByTransmissionId(conn, id)
+	if edgeXerr != nil {
+		return task, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the resend task of transmission %s", id), edgeXerr)
+	}
+	return task, nil
+}
+
+// DeleteResendTaskByTransmissionId deletes the resend task of a transmission, nothing is deleted if the task doesn't exist
+func (c *Client) DeleteResendTaskByTransmissionId(id string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteResendTaskByTransmissionId(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the resend task of transmission %s", id), edgeXerr)
+	}
+	return nil
+}
+
+// UpsertSubscriptionExtension adds the extension settings of a subscription, or replaces the existing ones
+func (c *Client) UpsertSubscriptionExtension(ext notificationsModels.SubscriptionExtension) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := upsertSubscriptionExtension(conn, ext)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to upsert the extension of subscription %s", ext.SubscriptionName), edgeXerr)
+	}
+	return nil
+}
+
+// SubscriptionExtensionBySubscriptionName queries the extension settings of a subscription
+func (c *Client) SubscriptionExtensionBySubscriptionName(name string) (notificationsModels.SubscriptionExtension, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	ext, edgeXerr := subscriptionExtensionBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return ext, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return ext, nil
+}
+
+// AllSubscriptionExtensions queries the extension settings of all subscriptions
+func (c *Client) AllSubscriptionExtensions() ([]notificationsModels.SubscriptionExtension, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	extensions, edgeXerr := allSubscriptionExtensions(conn)
+	if edgeXerr != nil {
+		return extensions, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query all subscription extensions", edgeXerr)
+	}
+	return extensions, nil
+}
+
+// DeleteSubscriptionExtensionBySubscriptionName deletes the extension settings of a subscription, nothing is deleted
+// if the extension doesn't exist
+func (c *Client) DeleteSubscriptionExtensionBySubscriptionName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteSubscriptionExtensionBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the extension of subscription %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// AddTemplate adds a new notification template
+func (c *Client) AddTemplate(t notificationsModels.Template) (notificationsModels.Template, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	t, edgeXerr := addTemplate(conn, t)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to add template %s", t.Name), edgeXerr)
+	}
+	return t, nil
+}
+
+// UpdateTemplate updates the notification template
+func (c *Client) UpdateTemplate(t notificationsModels.Template) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := updateTemplate(conn, t)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update template %s", t.Name), edgeXerr)
+	}
+	return nil
+}
+
+// TemplateByName queries the notification template by name
+func (c *Client) TemplateByName(name string) (notificationsModels.Template, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	t, edgeXerr := templateByName(conn, name)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return t, nil
+}
+
+// AllTemplates queries the notification templates with offset and limit, all the templates are returned if limit is
+// negative
+func (c *Client) AllTemplates(offset, limit int) ([]notificationsModels.Template, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	templates, edgeXerr := allTemplates(conn, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return templates, nil
+}
+
+// TemplatesByCategory queries the notification templates of the category and the generic templates without category
+func (c *Client) TemplatesByCategory(category string) ([]notificationsModels.Template, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	templates, edgeXerr := templatesByCategory(conn, category)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the templates of category %s", category), edgeXerr)
+	}
+	return templates, nil
+}
+
+// TemplateTotalCount returns the total count of the notification templates
+func (c *Client) TemplateTotalCount() (int64, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, TemplateCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteTemplateByName deletes the notification template by name
+func (c *Client) DeleteTemplateByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteTemplateByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete template %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// AddDigestItem adds the notification accumulated in the pending digest of a subscription
+func (c *Client) AddDigestItem(item notificationsModels.DigestItem) (notificationsModels.DigestItem, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	addedItem, edgeXerr := addDigestItem(conn, item)
+	if edgeXerr != nil {
+		return addedItem, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to add the digest item of subscription %s", item.SubscriptionName), edgeXerr)
+	}
+	return addedItem, nil
+}
+
+// DigestItemsBySubscriptionName queries the notifications accumulated in the pending digest of a subscription, the
+// earliest item comes first
+func (c *Client) DigestItemsBySubscriptionName(name string) ([]notificationsModels.DigestItem, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	items, edgeXerr := digestItemsBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return items, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the digest items of subscription %s", name), edgeXerr)
+	}
+	return items, nil
+}
+
+// DeleteDigestItemsByIds deletes the digest items by ids
+func (c *Client) DeleteDigestItemsByIds(ids []string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteDigestItemsByIds(conn, ids)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to delete the digest items", edgeXerr)
+	}
+	return nil
+}
+
+// DeleteDigestItemsBySubscriptionName deletes the pending digest items of a subscription
+func (c *Client) DeleteDigestItemsBySubscriptionName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteDigestItemsBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the digest items of subscription %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// UpsertNotificationOccurrence adds the occurrence of a fingerprint, or replaces the existing one
+func (c *Client) UpsertNotificationOccurrence(o notificationsModels.NotificationOccurrence) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := upsertNotificationOccurrence(conn, o)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to upsert the occurrence of notification %s", o.NotificationId), edgeXerr)
+	}
+	return nil
+}
+
+// NotificationOccurrenceByFingerprint queries the occurrence of a fingerprint
+func (c *Client) NotificationOccurrenceByFingerprint(fingerprint string) (notificationsModels.NotificationOccurrence, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	o, edgeXerr := notificationOccurrenceByFingerprint(conn, fingerprint)
+	if edgeXerr != nil {
+		return o, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return o, nil
+}
+
+// NotificationOccurrenceByNotificationId queries the occurrence of a notification
+func (c *Client) NotificationOccurrenceByNotificationId(id string) (notificationsModels.NotificationOccurrence, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	o, edgeXerr := notificationOccurrenceByNotificationId(conn, id)
+	if edgeXerr != nil {
+		return o, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return o, nil
+}
+
+// AddHeldNotification adds the notification held in the quiet hours of a subscription
+func (c *Client) AddHeldNotification(held notificationsModels.HeldNotification) (notificationsModels.HeldNotification, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	addedHeld, edgeXerr := addHeldNotification(conn, held)
+	if edgeXerr != nil {
+		return addedHeld, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to add the held notification of subscription %s", held.SubscriptionName), edgeXerr)
+	}
+	return addedHeld, nil
+}
+
+// HeldNotificationsBySubscriptionName queries the notifications held in the quiet hours of a subscription, the earliest
+// held notification comes first
+func (c *Client) HeldNotificationsBySubscriptionName(name string) ([]notificationsModels.HeldNotification, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	heldNotifications, edgeXerr := heldNotificationsBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return heldNotifications, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the held notifications of subscription %s", name), edgeXerr)
+	}
+	return heldNotifications, nil
+}
+
+// DeleteHeldNotificationsByIds deletes the held notifications by ids
+func (c *Client) DeleteHeldNotificationsByIds(ids []string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteHeldNotificationsByIds(conn, ids)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to delete the held notifications", edgeXerr)
+	}
+	return nil
+}
+
+// DeleteHeldNotificationsBySubscriptionName deletes the held notifications of a subscription
+func (c *Client) DeleteHeldNotificationsBySubscriptionName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteHeldNotificationsBySubscriptionName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the held notifications of subscription %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// AddEscalationPolicy adds a new escalation policy
+func (c *Client) AddEscalationPolicy(p notificationsModels.EscalationPolicy) (notificationsModels.EscalationPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	p, edgeXerr := addEscalationPolicy(conn, p)
+	if edgeXerr != nil {
+		return p, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to add escalation policy %s", p.Name), edgeXerr)
+	}
+	return p, nil
+}
+
+// UpdateEscalationPolicy updates the escalation policy
+func (c *Client) UpdateEscalationPolicy(p notificationsModels.EscalationPolicy) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := updateEscalationPolicy(conn, p)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update escalation policy %s", p.Name), edgeXerr)
+	}
+	return nil
+}
+
+// EscalationPolicyByName queries the escalation policy by name
+func (c *Client) EscalationPolicyByName(name string) (notificationsModels.EscalationPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	p, edgeXerr := escalationPolicyByName(conn, name)
+	if edgeXerr != nil {
+		return p, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return p, nil
+}
+
+// AllEscalationPolicies queries the escalation policies with offset and limit, all the policies are returned if limit
+// is negative
+func (c *Client) AllEscalationPolicies(offset, limit int) ([]notificationsModels.EscalationPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	policies, edgeXerr := allEscalationPolicies(conn, offset, limit)
+	if edgeXerr != nil {
+		return policies, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query all escalation policies", edgeXerr)
+	}
+	return policies, nil
+}
+
+// EscalationPoliciesByCategories queries the escalation policies escalating any of the categories
+func (c *Client) EscalationPoliciesByCategories(categories []string) ([]notificationsModels.EscalationPolicy, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	policies, edgeXerr := escalationPoliciesByCategories(conn, categories)
+	if edgeXerr != nil {
+		return policies, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query escalation policies by categories %v", categories), edgeXerr)
+	}
+	return policies, nil
+}
+
+// EscalationPolicyTotalCount returns the total count of the escalation policies
+func (c *Client) EscalationPolicyTotalCount() (int64, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	count, edgeXerr := getMemberNumber(conn, ZCARD, EscalationPolicyCollection)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteEscalationPolicyByName deletes the escalation policy by name
+func (c *Client) DeleteEscalationPolicyByName(name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteEscalationPolicyByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete escalation policy %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// UpsertEscalationTask adds the escalation task of a notification, or replaces the existing task
+func (c *Client) UpsertEscalationTask(task notificationsModels.EscalationTask) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := upsertEscalationTask(conn, task)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to upsert the escalation task of notification %s", task.NotificationId), edgeXerr)
+	}
+	return nil
+}
+
+// EscalationTaskByNotificationId queries the escalation task of a notification
+func (c *Client) EscalationTaskByNotificationId(id string) (notificationsModels.EscalationTask, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	task, edgeXerr := escalationTaskByNotificationId(conn, id)
+	if edgeXerr != nil {
+		return task, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return task, nil
+}
+
+// EscalationTasksByNextAttempt queries the escalation tasks due before the given time in milliseconds, the earliest
+// task comes first and all the due tasks are returned if limit is negative
+func (c *Client) EscalationTasksByNextAttempt(before int64, limit int) ([]notificationsModels.EscalationTask, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	tasks, edgeXerr := escalationTasksByNextAttempt(conn, before, limit)
+	if edgeXerr != nil {
+		return tasks, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query the due escalation tasks", edgeXerr)
+	}
+	return tasks, nil
+}
+
+// DeleteEscalationTaskByNotificationId deletes the escalation task of a notification, nothing is deleted if the task
+// doesn't exist
+func (c *Client) DeleteEscalationTaskByNotificationId(id string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteEscalationTaskByNotificationId(conn, id)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the escalation task of notification %s", id), edgeXerr)
+	}
+	return nil
+}
+
+// NotificationStatistics counts the notifications created within the time range by the group and the time bucket of
+// the interval
+func (c *Client) NotificationStatistics(groupBy string, start, end, interval int64, category, severity string) ([]notificationsModels.NotificationStatistic, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	statistics, edgeXerr := notificationStatistics(conn, groupBy, start, end, interval, category, severity)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the notification statistics grouped by %s", groupBy), edgeXerr)
+	}
+	return statistics, nil
+}
+
+// SubscriptionDeliveryStatistics calculates the delivery statistics of the transmissions created within the time range
+// by the subscription
+func (c *Client) SubscriptionDeliveryStatistics(start, end int64) ([]notificationsModels.SubscriptionDeliveryStatistic, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	statistics, edgeXerr := subscriptionDeliveryStatistics(conn, start, end)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), "fail to query the subscription delivery statistics", edgeXerr)
+	}
+	return statistics, nil
+}
+
 // LatestReadingByOffset returns a latest reading by offset
 func (c *Client) LatestReadingByOffset(offset uint32) (model.Reading, errors.EdgeX) {
 	conn := c.Pool.Get()
@@ -1677,6 +2159,84 @@ func (c *Client) DeleteKeeperKeys(key string, prefixMatch bool) (kvs []model.Key
 	return kvs, nil
 }
 
+// KeeperKeysIndex returns the modify index of the specified key or the keys with the same key prefix
+func (c *Client) KeeperKeysIndex(key string) (uint64, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	index, edgeXerr := keeperKeysIndex(conn, replaceKeyDelimiterForDB(key))
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to get the modify index of key %s", key), edgeXerr)
+	}
+	return index, nil
+}
+
+// KeeperTxn applies the set, delete and check-index operations atomically
+func (c *Client) KeeperTxn(ops []keeperModels.KVTxnOp) ([]model.KeyOnly, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	dbOps := make([]keeperModels.KVTxnOp, len(ops))
+	for i, op := range ops {
+		op.Key = replaceKeyDelimiterForDB(op.Key)
+		dbOps[i] = op
+	}
+
+	keys, edgeXerr := keeperTxn(conn, dbOps)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	// replace the key delimiter in the response for Keeper
+	for i, key := range keys {
+		keys[i].SetKey(replaceKeyDelimiterForKeeper(string(key)))
+	}
+	return keys, nil
+}
+
+// KeeperKeysHistory returns the revisions of the specified key or the keys with the same key prefix within the time range
+func (c *Client) KeeperKeysHistory(key string, start int64, end int64, offset int, limit int) ([]keeperModels.KVRevision, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	revisions, edgeXerr := keeperKeysHistory(conn, replaceKeyDelimiterForDB(key), start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the history of key %s", key), edgeXerr)
+	}
+
+	// replace the key delimiter in the response for Keeper
+	for i := range revisions {
+		revisions[i].Key = replaceKeyDelimiterForKeeper(revisions[i].Key)
+	}
+	return revisions, nil
+}
+
+// KeeperKeysHistoryCount returns the count of the revisions of the specified key or the keys with the same key prefix
+// within the time range
+func (c *Client) KeeperKeysHistoryCount(key string, start int64, end int64) (int64, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	count, edgeXerr := keeperKeysHistoryCount(conn, replaceKeyDelimiterForDB(key), start, end)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to count the history of key %s", key), edgeXerr)
+	}
+	return count, nil
+}
+
+// DeleteKeeperKeysHistoryByAge deletes the revisions of the keys older than the age, the latest revision of each
+// existing key is kept
+func (c *Client) DeleteKeeperKeysHistoryByAge(age int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteKeeperKeysHistoryByAge(conn, age)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete the history of keys older than %d milliseconds", age), edgeXerr)
+	}
+	return nil
+}
+
 func (c *Client) AddRegistration(r model.Registration) (model.Registration, errors.EdgeX) {
 	conn := c.Pool.Get()
 	defer closeRedisConnection(conn, c.loggingClient)
@@ -1725,6 +2285,63 @@ func (c *Client) RegistrationByServiceId(id string) (model.Registration, errors.
 	return r, nil
 }
 
+// AddStatusTransition appends the health status transition of a registered service to the registry history
+func (c *Client) AddStatusTransition(t keeperModels.StatusTransition) (keeperModels.StatusTransition, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	if len(t.Id) == 0 {
+		t.Id = uuid.New().String()
+	}
+
+	t, edgeXerr := addStatusTransition(conn, t)
+	if edgeXerr != nil {
+		return keeperModels.StatusTransition{}, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return t, nil
+}
+
+// StatusTransitionsByServiceId queries the health status transitions of a registered service within the time range,
+// the newest transition comes first
+func (c *Client) StatusTransitionsByServiceId(serviceId string, start int64, end int64, offset int, limit int) ([]keeperModels.StatusTransition, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	transitions, edgeXerr := statusTransitionsByServiceId(conn, serviceId, start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query the status transitions by serviceId %s", serviceId), edgeXerr)
+	}
+
+	return transitions, nil
+}
+
+// StatusTransitionCountByServiceId returns the count of the health status transitions of a registered service within
+// the time range
+func (c *Client) StatusTransitionCountByServiceId(serviceId string, start int64, end int64) (int64, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	count, edgeXerr := getMemberCountByScoreRange(conn, CreateKey(RegistrationHistoryCollectionServiceId, serviceId), start, end)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	return count, nil
+}
+
+// DeleteStatusTransitionsByAge deletes the health status transitions older than the age in milliseconds
+func (c *Client) DeleteStatusTransitionsByAge(age int64) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteStatusTransitionsByAge(conn, age)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return nil
+}
+
 func (c *Client) UpdateRegistration(r model.Registration) errors.EdgeX {
 	conn := c.Pool.Get()
 	defer closeRedisConnection(conn, c.loggingClient)
@@ -1736,3 +2353,132 @@ func (c *Client) UpdateRegistration(r model.Registration) errors.EdgeX {
 
 	return nil
 }
+
+// UpsertServiceInstance adds the discovery information of a registered service instance, or replaces the existing one
+func (c *Client) UpsertServiceInstance(i keeperModels.ServiceInstance) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	err := upsertServiceInstance(conn, i)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return nil
+}
+
+// ServiceInstances retrieves the discovery information of all the registered service instances
+func (c *Client) ServiceInstances() ([]keeperModels.ServiceInstance, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	instances, err := serviceInstances(conn)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return instances, nil
+}
+
+// DeleteServiceInstanceByServiceId deletes the discovery information of a registered service instance, nothing is
+// deleted if the service instance doesn't exist
+func (c *Client) DeleteServiceInstanceByServiceId(id string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	err := deleteServiceInstanceByServiceId(conn, id)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return nil
+}
+
+// AddCommandSequence adds a new command sequence
+func (c *Client) AddCommandSequence(_ context.Context, s commandModels.CommandSequence) (commandModels.CommandSequence, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	if len(s.Id) == 0 {
+		s.Id = uuid.New().String()
+	}
+
+	s, edgeXerr := addCommandSequence(conn, s)
+	if edgeXerr != nil {
+		return s, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to add command sequence %s", s.Name), edgeXerr)
+	}
+	return s, nil
+}
+
+// AllCommandSequences queries the command sequences having all the labels with offset and limit
+func (c *Client) AllCommandSequences(_ context.Context, labels []string, offset, limit int) ([]commandModels.CommandSequence, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	sequences, edgeXerr := commandSequencesByLabels(conn, offset, limit, labels)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr),
+			fmt.Sprintf("fail to query command sequences by offset %d, limit %d and labels %v", offset, limit, labels), edgeXerr)
+	}
+	return sequences, nil
+}
+
+// UpdateCommandSequence updates the command sequence
+func (c *Client) UpdateCommandSequence(_ context.Context, s commandModels.CommandSequence) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := updateCommandSequence(conn, s)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to update command sequence %s", s.Name), edgeXerr)
+	}
+	return nil
+}
+
+// DeleteCommandSequenceByName deletes the command sequence by name
+func (c *Client) DeleteCommandSequenceByName(_ context.Context, name string) errors.EdgeX {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	edgeXerr := deleteCommandSequenceByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to delete command sequence %s", name), edgeXerr)
+	}
+	return nil
+}
+
+// CommandSequenceById queries the command sequence by id
+func (c *Client) CommandSequenceById(_ context.Context, id string) (commandModels.CommandSequence, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	s, edgeXerr := commandSequenceById(conn, id)
+	if edgeXerr != nil {
+		return s, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query command sequence by id %s", id), edgeXerr)
+	}
+	return s, nil
+}
+
+// CommandSequenceByName queries the command sequence by name
+func (c *Client) CommandSequenceByName(_ context.Context, name string) (commandModels.CommandSequence, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	s, edgeXerr := commandSequenceByName(conn, name)
+	if edgeXerr != nil {
+		return s, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query command sequence by name %s", name), edgeXerr)
+	}
+	return s, nil
+}
+
+// CommandSequenceTotalCount returns the total count of the command sequences having all the labels
+func (c *Client) CommandSequenceTotalCount(_ context.Context, labels []string) (int64, errors.EdgeX) {
+	conn := c.Pool.Get()
+	defer closeRedisConnection(conn, c.loggingClient)
+
+	count, edgeXerr := getMemberCountByLabels(conn, ZRANGE, CommandSequenceCollection, labels)
+	if edgeXerr != nil {
+		return 0, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return count, nil
+}
@@ -0,0 +1,161 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+
+	commandModels "github.com/edgexfoundry/edgex-go/internal/core/command/models"
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+)
+
+const (
+	CommandSequenceCollection      = "cc|seq"
+	CommandSequenceCollectionName  = CommandSequenceCollection + DBKeySeparator + common.Name
+	CommandSequenceCollectionLabel = CommandSequenceCollection + DBKeySeparator + common.Label
+)
+
+// commandSequenceStoredKey return the command sequence's stored key which combines the collection name and object id
+func commandSequenceStoredKey(id string) string {
+	return CreateKey(CommandSequenceCollection, id)
+}
+
+// sendAddCommandSequenceCmd sends redis command for adding command sequence
+func sendAddCommandSequenceCmd(conn redis.Conn, storedKey string, s commandModels.CommandSequence) errors.EdgeX {
+	m, err := json.Marshal(s)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal command sequence for Redis persistence", err)
+	}
+	_ = conn.Send(SET, storedKey, m)
+	_ = conn.Send(ZADD, CommandSequenceCollection, s.Modified, storedKey)
+	_ = conn.Send(HSET, CommandSequenceCollectionName, s.Name, storedKey)
+	for _, label := range s.Labels {
+		_ = conn.Send(ZADD, CreateKey(CommandSequenceCollectionLabel, label), s.Modified, storedKey)
+	}
+	return nil
+}
+
+// sendDeleteCommandSequenceCmd sends redis command for deleting command sequence
+func sendDeleteCommandSequenceCmd(conn redis.Conn, storedKey string, s commandModels.CommandSequence) {
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, CommandSequenceCollection, storedKey)
+	_ = conn.Send(HDEL, CommandSequenceCollectionName, s.Name)
+	for _, label := range s.Labels {
+		_ = conn.Send(ZREM, CreateKey(CommandSequenceCollectionLabel, label), storedKey)
+	}
+}
+
+// addCommandSequence adds a new command sequence into DB
+func addCommandSequence(conn redis.Conn, s commandModels.CommandSequence) (commandModels.CommandSequence, errors.EdgeX) {
+	exists, edgeXerr := objectIdExists(conn, commandSequenceStoredKey(s.Id))
+	if edgeXerr != nil {
+		return s, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return s, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command sequence id %s already exists", s.Id), edgeXerr)
+	}
+
+	exists, edgeXerr = objectNameExists(conn, CommandSequenceCollectionName, s.Name)
+	if edgeXerr != nil {
+		return s, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return s, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("command sequence name '%s' already exists", s.Name), edgeXerr)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	s.Created = ts
+	s.Modified = ts
+
+	storedKey := commandSequenceStoredKey(s.Id)
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddCommandSequenceCmd(conn, storedKey, s)
+	if edgeXerr != nil {
+		return s, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return s, errors.NewCommonEdgeX(errors.KindDatabaseError, "command sequence creation failed", err)
+	}
+	return s, nil
+}
+
+// updateCommandSequence updates the command sequence with the same name
+func updateCommandSequence(conn redis.Conn, s commandModels.CommandSequence) errors.EdgeX {
+	old, edgeXerr := commandSequenceByName(conn, s.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	s.Modified = pkgCommon.MakeTimestamp()
+	storedKey := commandSequenceStoredKey(old.Id)
+
+	_ = conn.Send(MULTI)
+	sendDeleteCommandSequenceCmd(conn, storedKey, old)
+	edgeXerr = sendAddCommandSequenceCmd(conn, storedKey, s)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "command sequence update failed", err)
+	}
+	return nil
+}
+
+// deleteCommandSequenceByName deletes the command sequence by name
+func deleteCommandSequenceByName(conn redis.Conn, name string) errors.EdgeX {
+	s, edgeXerr := commandSequenceByName(conn, name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	sendDeleteCommandSequenceCmd(conn, commandSequenceStoredKey(s.Id), s)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "command sequence deletion failed", err)
+	}
+	return nil
+}
+
+// commandSequenceById queries the command sequence by id
+func commandSequenceById(conn redis.Conn, id string) (s commandModels.CommandSequence, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, commandSequenceStoredKey(id), &s)
+	if edgeXerr != nil {
+		return s, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return s, nil
+}
+
+// commandSequenceByName queries the command sequence by name
+func commandSequenceByName(conn redis.Conn, name string) (s commandModels.CommandSequence, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, CommandSequenceCollectionName, name, &s)
+	if edgeXerr != nil {
+		return s, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return s, nil
+}
+
+// commandSequencesByLabels queries the command sequences having all the labels by offset and limit, the latest modified
+// command sequence comes first
+func commandSequencesByLabels(conn redis.Conn, offset int, limit int, labels []string) ([]commandModels.CommandSequence, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByLabelsAndSomeRange(conn, ZREVRANGE, CommandSequenceCollection, labels, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	sequences := make([]commandModels.CommandSequence, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &sequences[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "command sequence format parsing failed from the database", err)
+		}
+	}
+	return sequences, nil
+}
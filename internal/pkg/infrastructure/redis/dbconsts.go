@@ -18,11 +18,15 @@ const (
 	HSET             = "HSET"
 	HSETNX           = "HSETNX"
 	HGET             = "HGET"
+	HMGET            = "HMGET"
 	HEXISTS          = "HEXISTS"
 	HDEL             = "HDEL"
+	INCR             = "INCR"
+	WATCH            = "WATCH"
 	SADD             = "SADD"
 	SREM             = "SREM"
 	ZADD             = "ZADD"
+	ZSCORE           = "ZSCORE"
 	ZREM             = "ZREM"
 	EXEC             = "EXEC"
 	ZRANGE           = "ZRANGE"
@@ -40,6 +44,7 @@ const (
 	INFO             = "INFO"
 	MEMORY           = "MEMORY"
 	WEIGHTS          = "WEIGHTS"
+	WITHSCORES       = "WITHSCORES"
 )
 
 const (
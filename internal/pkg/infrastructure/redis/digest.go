@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	// DigestItemCollection is the key prefix of the digest items stored by id
+	DigestItemCollection = "sn|digest|item"
+	// DigestItemCollectionSubscription is the key prefix of the sorted sets of the digest item keys of the subscriptions
+	// scored by the created time
+	DigestItemCollectionSubscription = DigestItemCollection + DBKeySeparator + "sub"
+)
+
+func digestItemsKey(subscriptionName string) string {
+	return CreateKey(DigestItemCollectionSubscription, subscriptionName)
+}
+
+// addDigestItem adds the notification accumulated in the pending digest of the subscription
+func addDigestItem(conn redis.Conn, item notificationsModels.DigestItem) (notificationsModels.DigestItem, errors.EdgeX) {
+	if len(item.Id) == 0 {
+		item.Id = uuid.New().String()
+	}
+	if item.Created == 0 {
+		item.Created = pkgCommon.MakeTimestamp()
+	}
+	jsonBytes, err := json.Marshal(item)
+	if err != nil {
+		return item, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal digest item for Redis persistence", err)
+	}
+
+	storedKey := CreateKey(DigestItemCollection, item.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	_ = conn.Send(ZADD, digestItemsKey(item.SubscriptionName), item.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return item, errors.NewCommonEdgeX(errors.KindDatabaseError, "digest item creation failed", err)
+	}
+	return item, nil
+}
+
+// digestItemsBySubscriptionName queries the pending digest items of the subscription, the earliest item comes first
+func digestItemsBySubscriptionName(conn redis.Conn, name string) ([]notificationsModels.DigestItem, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRange(conn, digestItemsKey(name), 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	items := make([]notificationsModels.DigestItem, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &items[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "digest item format parsing failed from the database", err)
+		}
+	}
+	return items, nil
+}
+
+// deleteDigestItemsByIds deletes the digest items by ids
+func deleteDigestItemsByIds(conn redis.Conn, ids []string) errors.EdgeX {
+	if len(ids) == 0 {
+		return nil
+	}
+	storedKeys := make([]interface{}, len(ids))
+	for i, id := range ids {
+		storedKeys[i] = CreateKey(DigestItemCollection, id)
+	}
+	objects, edgeXerr := getObjectsByIds(conn, storedKeys)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, o := range objects {
+		var item notificationsModels.DigestItem
+		if err := json.Unmarshal(o, &item); err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "digest item format parsing failed from the database", err)
+		}
+		storedKey := CreateKey(DigestItemCollection, item.Id)
+		_ = conn.Send(DEL, storedKey)
+		_ = conn.Send(ZREM, digestItemsKey(item.SubscriptionName), storedKey)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "digest items deletion failed", err)
+	}
+	return nil
+}
+
+// deleteDigestItemsBySubscriptionName deletes the pending digest items of the subscription
+func deleteDigestItemsBySubscriptionName(conn redis.Conn, name string) errors.EdgeX {
+	itemsKey := digestItemsKey(name)
+	storedKeys, err := redis.Values(conn.Do(ZRANGE, itemsKey, 0, -1))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query digest item keys from database failed", err)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, storedKey := range storedKeys {
+		_ = conn.Send(DEL, storedKey)
+	}
+	_ = conn.Send(DEL, itemsKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "digest items deletion failed", err)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	// EscalationPolicyCollection is the sorted set of the escalation policy keys scored by the created time
+	EscalationPolicyCollection = "sn|escalation"
+	// EscalationTaskCollection is the sorted set of the escalation task keys scored by the next attempt time, the tasks
+	// are stored by the notification id
+	EscalationTaskCollection = EscalationPolicyCollection + DBKeySeparator + "task"
+	// EscalationPolicyCollectionCategory is the key prefix of the sorted sets of the escalation policy keys of the
+	// categories scored by the created time
+	EscalationPolicyCollectionCategory = EscalationPolicyCollection + DBKeySeparator + "category"
+)
+
+func escalationPolicyStoredKey(name string) string {
+	return CreateKey(EscalationPolicyCollection, name)
+}
+
+func escalationPolicyCategoryKey(category string) string {
+	return CreateKey(EscalationPolicyCollectionCategory, category)
+}
+
+func escalationTaskStoredKey(notificationId string) string {
+	return CreateKey(EscalationTaskCollection, notificationId)
+}
+
+// addEscalationPolicy adds a new escalation policy
+func addEscalationPolicy(conn redis.Conn, p notificationsModels.EscalationPolicy) (notificationsModels.EscalationPolicy, errors.EdgeX) {
+	storedKey := escalationPolicyStoredKey(p.Name)
+	exists, edgeXerr := objectIdExists(conn, storedKey)
+	if edgeXerr != nil {
+		return p, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return p, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("escalation policy name %s already exists", p.Name), nil)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	p.Created = ts
+	p.Modified = ts
+	jsonBytes, err := json.Marshal(p)
+	if err != nil {
+		return p, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal escalation policy for Redis persistence", err)
+	}
+
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	_ = conn.Send(ZADD, EscalationPolicyCollection, p.Created, storedKey)
+	for _, category := range p.Categories {
+		_ = conn.Send(ZADD, escalationPolicyCategoryKey(category), p.Created, storedKey)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return p, errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation policy creation failed", err)
+	}
+	return p, nil
+}
+
+// updateEscalationPolicy replaces the existing escalation policy
+func updateEscalationPolicy(conn redis.Conn, p notificationsModels.EscalationPolicy) errors.EdgeX {
+	old, edgeXerr := escalationPolicyByName(conn, p.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	p.Created = old.Created
+	p.Modified = pkgCommon.MakeTimestamp()
+	jsonBytes, err := json.Marshal(p)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal escalation policy for Redis persistence", err)
+	}
+	storedKey := escalationPolicyStoredKey(p.Name)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	for _, category := range old.Categories {
+		if !slices.Contains(p.Categories, category) {
+			_ = conn.Send(ZREM, escalationPolicyCategoryKey(category), storedKey)
+		}
+	}
+	for _, category := range p.Categories {
+		_ = conn.Send(ZADD, escalationPolicyCategoryKey(category), p.Created, storedKey)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation policy update failed", err)
+	}
+	return nil
+}
+
+// escalationPolicyByName queries the escalation policy by name
+func escalationPolicyByName(conn redis.Conn, name string) (p notificationsModels.EscalationPolicy, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, escalationPolicyStoredKey(name), &p)
+	if edgeXerr != nil {
+		return p, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query escalation policy %s", name), edgeXerr)
+	}
+	return p, nil
+}
+
+// allEscalationPolicies queries the escalation policies by offset and limit, the earliest created policy comes first
+func allEscalationPolicies(conn redis.Conn, offset, limit int) ([]notificationsModels.EscalationPolicy, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRange(conn, EscalationPolicyCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	policies := make([]notificationsModels.EscalationPolicy, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &policies[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation policy format parsing failed from the database", err)
+		}
+	}
+	return policies, nil
+}
+
+// escalationPoliciesByCategories queries the escalation policies escalating any of the categories
+func escalationPoliciesByCategories(conn redis.Conn, categories []string) ([]notificationsModels.EscalationPolicy, errors.EdgeX) {
+	var storedKeys []string
+	for _, category := range categories {
+		keys, err := redis.Strings(conn.Do(ZRANGE, escalationPolicyCategoryKey(category), 0, -1))
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("fail to retrieve escalation policy storeKeys of category %s", category), err)
+		}
+		for _, key := range keys {
+			if !slices.Contains(storedKeys, key) {
+				storedKeys = append(storedKeys, key)
+			}
+		}
+	}
+	objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(storedKeys))
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	policies := make([]notificationsModels.EscalationPolicy, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &policies[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation policy format parsing failed from the database", err)
+		}
+	}
+	return policies, nil
+}
+
+// deleteEscalationPolicyByName deletes the escalation policy by name
+func deleteEscalationPolicyByName(conn redis.Conn, name string) errors.EdgeX {
+	p, edgeXerr := escalationPolicyByName(conn, name)
+	if edgeXerr != nil {
+		if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+			return nil
+		}
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	storedKey := escalationPolicyStoredKey(name)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, EscalationPolicyCollection, storedKey)
+	for _, category := range p.Categories {
+		_ = conn.Send(ZREM, escalationPolicyCategoryKey(category), storedKey)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation policy deletion failed", err)
+	}
+	return nil
+}
+
+// upsertEscalationTask adds the escalation task of the notification or replaces the existing one
+func upsertEscalationTask(conn redis.Conn, task notificationsModels.EscalationTask) errors.EdgeX {
+	jsonBytes, err := json.Marshal(task)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal escalation task for Redis persistence", err)
+	}
+
+	storedKey := escalationTaskStoredKey(task.NotificationId)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	_ = conn.Send(ZADD, EscalationTaskCollection, task.NextAttempt, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation task upsert failed", err)
+	}
+	return nil
+}
+
+// escalationTaskByNotificationId queries the escalation task of the notification
+func escalationTaskByNotificationId(conn redis.Conn, id string) (task notificationsModels.EscalationTask, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, escalationTaskStoredKey(id), &task)
+	if edgeXerr != nil {
+		return task, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query escalation task of notification %s", id), edgeXerr)
+	}
+	return task, nil
+}
+
+// escalationTasksByNextAttempt queries the escalation tasks due before the given time, the earliest task comes first
+func escalationTasksByNextAttempt(conn redis.Conn, before int64, limit int) ([]notificationsModels.EscalationTask, errors.EdgeX) {
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, EscalationTaskCollection, 0, before, LIMIT, 0, limit))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query escalation tasks from database failed", err)
+	}
+	if len(storedKeys) == 0 {
+		return []notificationsModels.EscalationTask{}, nil
+	}
+	objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(storedKeys))
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	tasks := make([]notificationsModels.EscalationTask, 0, len(objects))
+	for _, o := range objects {
+		var task notificationsModels.EscalationTask
+		err = json.Unmarshal(o, &task)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation task format parsing failed from the database", err)
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// deleteEscalationTaskByNotificationId deletes the escalation task of the notification
+func deleteEscalationTaskByNotificationId(conn redis.Conn, id string) errors.EdgeX {
+	storedKey := escalationTaskStoredKey(id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, EscalationTaskCollection, storedKey)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "escalation task deletion failed", err)
+	}
+	return nil
+}
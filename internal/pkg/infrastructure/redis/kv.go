@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,10 +8,12 @@ package redis
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
@@ -20,7 +22,21 @@ import (
 	"github.com/spf13/cast"
 )
 
-const KVCollection = "kp|kv"
+const (
+	KVCollection = "kp|kv"
+	// KVModifyIndexCounter is the global counter used to stamp every change of the keeper keys
+	KVModifyIndexCounter = "kp|kv|index"
+	// KVModifyIndexCollection is the Hash storing the modify index of each existing keeper key
+	KVModifyIndexCollection = "kp|kv|modifyindex"
+	// KVPrefixIndexCollection is the Hash storing the modify index of the latest change of each key prefix, including
+	// the deletions, so that the watchers of the key prefix can be notified without scanning the keys
+	KVPrefixIndexCollection = "kp|kv|prefixindex"
+	// KVHistoryCollection is the Sorted Set storing the history of the changes of all the keeper keys, the members are
+	// the JSON encoded revisions scored by the timestamp of the change. Each revision is also added to the Sorted Set
+	// kp|kv|history:<key prefix> of the key and all its upper level keys, so that the history of a key prefix can be
+	// queried by range.
+	KVHistoryCollection = "kp|kv|history"
+)
 
 // replaceKeyDelimiterForDB replace the key delimiter from slash(for EdgeX Keeper) to colon(for Redis)
 func replaceKeyDelimiterForDB(wholeKey string) string {
@@ -45,24 +61,72 @@ func keeperKeys(conn redis.Conn, key string, keyOnly bool, isRaw bool) (configs
 // addKeeperKeys stores the value in the specified key
 func addKeeperKeys(conn redis.Conn, kv models.KVS, isFlatten bool) (keysResp []models.KeyOnly, edgeXerr errors.EdgeX) {
 	key := kv.Key
-	storedKey := CreateKey(KVCollection, key)
+
+	edgeXerr = validateKeeperKeyUpdate(conn, kv)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+	edgeXerr = seedKeeperKeysHistory(conn)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+
+	modifyIndex, err := redis.Uint64(conn.Do(INCR, KVModifyIndexCounter))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "increase the modify index failed", err)
+	}
+
+	_ = conn.Send(MULTI)
+
+	values := make(map[string]string)
+	keysResp, edgeXerr = sendUpdateKeeperKeyCmds(conn, kv, isFlatten, values)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+
+	if len(keysResp) > 0 {
+		_ = conn.Send(HSET, modifyIndexArgs(keysResp, modifyIndex)...)
+		_ = conn.Send(HSET, prefixIndexArgs(keysResp, modifyIndex)...)
+		edgeXerr = sendAddHistoryCmds(conn, newRevisions(keysResp, values, constants.TxnVerbSet, modifyIndex))
+		if edgeXerr != nil {
+			return nil, edgeXerr
+		}
+	}
+
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("create/update key %s failed", key), err)
+	}
+
+	return keysResp, nil
+}
+
+// validateKeeperKeyUpdate checks if the key is allowed to be updated with the value
+func validateKeeperKeyUpdate(conn redis.Conn, kv models.KVS) errors.EdgeX {
+	storedKey := CreateKey(KVCollection, kv.Key)
 
 	// if the key (ex. core-data/Writable) already exists and is a hash type with child key(s) exist (ex. core-data/Writable/LogLevel)
 	// the updated value is ony allowed to be a map to update the child keys
 	if exists, _ := objectIdExists(conn, storedKey); exists {
 		if keyType, _ := getKeyType(conn, storedKey); keyType == Hash {
 			if _, ok := kv.Value.(map[string]any); !ok {
-				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "update key failed since child key(s) already exist", nil)
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, "update key failed since child key(s) already exist", nil)
 			}
 		}
 	}
+	return nil
+}
 
-	_ = conn.Send(MULTI)
+// sendUpdateKeeperKeyCmds send redis commands to store the value in the specified key, the commands are expected to be
+// sent after MULTI, the raw values of the stored keys are collected into values
+func sendUpdateKeeperKeyCmds(conn redis.Conn, kv models.KVS, isFlatten bool, values map[string]string) (keysResp []models.KeyOnly, edgeXerr errors.EdgeX) {
+	key := kv.Key
+	storedKey := CreateKey(KVCollection, key)
 
 	sendAddUpperLevelKeyCmds(conn, key)
 
 	if isFlatten {
-		keysResp, edgeXerr = sendCreateKeysByDataTypeCmds(conn, storedKey, kv.Value)
+		keysResp, edgeXerr = sendCreateKeysByDataTypeCmds(conn, storedKey, kv.Value, values)
 		if edgeXerr != nil {
 			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("send create/update key %s command failed", key), edgeXerr)
 		}
@@ -76,18 +140,103 @@ func addKeeperKeys(conn redis.Conn, kv models.KVS, isFlatten bool) (keysResp []m
 			}
 			storedValue = string(vJSONBytes)
 		}
-		keysResp, edgeXerr = sendCreateKeysByDataTypeCmds(conn, storedKey, storedValue)
+		keysResp, edgeXerr = sendCreateKeysByDataTypeCmds(conn, storedKey, storedValue, values)
 		if edgeXerr != nil {
 			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("send create/update key %s command failed", key), edgeXerr)
 		}
 	}
+	return keysResp, nil
+}
 
-	_, err := conn.Do(EXEC)
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("create/update key %s failed", key), err)
+// newRevisions returns the revisions of the keys changed by the action
+func newRevisions(keys []models.KeyOnly, values map[string]string, action string, modifyIndex uint64) []keeperModels.KVRevision {
+	timestamp := time.Now().UnixMilli()
+	revisions := make([]keeperModels.KVRevision, len(keys))
+	for i, k := range keys {
+		revisions[i] = keeperModels.KVRevision{
+			Key:         string(k),
+			Value:       values[string(k)],
+			Action:      action,
+			ModifyIndex: modifyIndex,
+			Timestamp:   timestamp,
+		}
 	}
+	return revisions
+}
 
-	return keysResp, nil
+// historyStoredKey returns the Sorted Set storing the revisions of the key and the keys with the same key prefix
+func historyStoredKey(key string) string {
+	return CreateKey(KVHistoryCollection, key)
+}
+
+// sendAddHistoryCmds send redis commands to append the revisions to the history of all the keys and the history of
+// each key prefix of the revision
+func sendAddHistoryCmds(conn redis.Conn, revisions []keeperModels.KVRevision) errors.EdgeX {
+	for _, revision := range revisions {
+		member, err := json.Marshal(revision)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal KV revision for Redis persistence", err)
+		}
+		_ = conn.Send(ZADD, KVHistoryCollection, revision.Timestamp, member)
+		for _, prefix := range keyPrefixes(revision.Key) {
+			_ = conn.Send(ZADD, historyStoredKey(prefix), revision.Timestamp, member)
+		}
+	}
+	return nil
+}
+
+// sendDeleteHistoryCmds send redis commands to remove the revision member of the key from the history of all the keys
+// and the history of each key prefix of the revision
+func sendDeleteHistoryCmds(conn redis.Conn, key string, member []byte) {
+	_ = conn.Send(ZREM, KVHistoryCollection, member)
+	for _, prefix := range keyPrefixes(key) {
+		_ = conn.Send(ZREM, historyStoredKey(prefix), member)
+	}
+}
+
+// keyPrefixes returns the key and all its upper level keys, e.g. a:b:c, a:b and a of the key a:b:c
+func keyPrefixes(key string) []string {
+	prefixes := []string{key}
+	for idx := strings.LastIndex(key, DBKeySeparator); idx != -1; idx = strings.LastIndex(key, DBKeySeparator) {
+		key = key[:idx]
+		prefixes = append(prefixes, key)
+	}
+	return prefixes
+}
+
+// modifyIndexArgs returns the HSET arguments to stamp the keys with the modify index
+func modifyIndexArgs(keys []models.KeyOnly, modifyIndex uint64) redis.Args {
+	args := redis.Args{}.Add(KVModifyIndexCollection)
+	for _, k := range keys {
+		args = args.Add(string(k), modifyIndex)
+	}
+	return args
+}
+
+// deletedKeyFieldsArgs returns the HDEL arguments to remove the modify index of the deleted keys
+func deletedKeyFieldsArgs(keys []models.KeyOnly) redis.Args {
+	args := redis.Args{}.Add(KVModifyIndexCollection)
+	for _, k := range keys {
+		args = args.Add(string(k))
+	}
+	return args
+}
+
+// prefixIndexArgs returns the HSET arguments to stamp the keys and all their upper level keys with the modify index,
+// e.g. the change of key a:b:c stamps a, a:b and a:b:c
+func prefixIndexArgs(keys []models.KeyOnly, modifyIndex uint64) redis.Args {
+	args := redis.Args{}.Add(KVPrefixIndexCollection)
+	stamped := make(map[string]struct{})
+	for _, k := range keys {
+		for _, prefix := range keyPrefixes(string(k)) {
+			if _, ok := stamped[prefix]; ok {
+				break
+			}
+			stamped[prefix] = struct{}{}
+			args = args.Add(prefix, modifyIndex)
+		}
+	}
+	return args
 }
 
 // sendAddUpperLevelKeyCmds send redis commands to add the fields in each upper level Hashes
@@ -115,7 +264,8 @@ func sendAddUpperLevelKeyCmds(conn redis.Conn, key string) {
 // sendCreateKeysByDataTypeCmds send redis commands to add the key in redis based on the value type
 // if the value type is string, a key with string type will be created
 // otherwise, if the value type is an object, a key with Hash type will be created along with fields corresponding to the object properties
-func sendCreateKeysByDataTypeCmds(conn redis.Conn, key string, value interface{}) (keysResp []models.KeyOnly, edgeXerr errors.EdgeX) {
+// the raw values of the created string keys are collected into values
+func sendCreateKeysByDataTypeCmds(conn redis.Conn, key string, value interface{}, values map[string]string) (keysResp []models.KeyOnly, edgeXerr errors.EdgeX) {
 	switch v := value.(type) {
 	case map[string]interface{}:
 		for innerKey, element := range v {
@@ -128,7 +278,7 @@ func sendCreateKeysByDataTypeCmds(conn redis.Conn, key string, value interface{}
 			_ = conn.Send(HSET, key, innerKey, innerHashValue)
 
 			// create the innerHashValue key at next level
-			resp, sendErr := sendCreateKeysByDataTypeCmds(conn, innerHashValue, element)
+			resp, sendErr := sendCreateKeysByDataTypeCmds(conn, innerHashValue, element, values)
 			if sendErr != nil {
 				return nil, sendErr
 			}
@@ -169,6 +319,7 @@ func sendCreateKeysByDataTypeCmds(conn redis.Conn, key string, value interface{}
 			return keysResp, errors.NewCommonEdgeX(errors.KindDatabaseError, "retrieve updated key failed", nil)
 		}
 		queryKey := key[idx+1:]
+		values[queryKey] = string(storedValueBytes)
 		keysResp = []models.KeyOnly{models.KeyOnly(queryKey)}
 	default:
 		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("unknown data type of key %s", key), nil)
@@ -178,6 +329,11 @@ func sendCreateKeysByDataTypeCmds(conn redis.Conn, key string, value interface{}
 
 // deleteKeeperKeys delete the value in the specified key or keys with the same prefix
 func deleteKeeperKeys(conn redis.Conn, key string, prefixMatch bool) (keys []models.KeyOnly, edgeXerr errors.EdgeX) {
+	edgeXerr = seedKeeperKeysHistory(conn)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+
 	keys, edgeXerr = deleteByKeyPrefix(conn, CreateKey(KVCollection, key), prefixMatch)
 	if edgeXerr != nil {
 		return keys, edgeXerr
@@ -187,9 +343,40 @@ func deleteKeeperKeys(conn redis.Conn, key string, prefixMatch bool) (keys []mod
 	if edgeXerr != nil {
 		return keys, edgeXerr
 	}
+
+	// stamp the key prefixes of the deleted keys so that the modify index of the key prefix moves forward
+	modifyIndex, err := redis.Uint64(conn.Do(INCR, KVModifyIndexCounter))
+	if err != nil {
+		return keys, errors.NewCommonEdgeX(errors.KindDatabaseError, "increase the modify index failed", err)
+	}
+	if len(keys) > 0 {
+		_ = conn.Send(MULTI)
+		_ = conn.Send(HDEL, deletedKeyFieldsArgs(keys)...)
+		_ = conn.Send(HSET, prefixIndexArgs(keys, modifyIndex)...)
+		edgeXerr = sendAddHistoryCmds(conn, newRevisions(keys, nil, constants.TxnVerbDelete, modifyIndex))
+		if edgeXerr != nil {
+			return keys, edgeXerr
+		}
+		_, err = conn.Do(EXEC)
+		if err != nil {
+			return keys, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("update the modify index and history of deleted key %s failed", key), err)
+		}
+	}
 	return keys, edgeXerr
 }
 
+// keeperKeysIndex returns the modify index of the latest change of the specified key and the keys with the same prefix,
+// including the deleted keys
+func keeperKeysIndex(conn redis.Conn, key string) (uint64, errors.EdgeX) {
+	index, err := redis.Uint64(conn.Do(HGET, KVPrefixIndexCollection, key))
+	if err == redis.ErrNil {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query the modify index of key %s failed", key), err)
+	}
+	return index, nil
+}
+
 // deleteByKeyPrefix delete the specified key or keys with the same prefix
 func deleteByKeyPrefix(conn redis.Conn, key string, prefixMatch bool) ([]models.KeyOnly, errors.EdgeX) {
 	var keyResp []models.KeyOnly
@@ -300,3 +487,344 @@ func deleteUpperLevelKeyFields(conn redis.Conn, key string) errors.EdgeX {
 	}
 	return nil
 }
+
+// keyDeletion holds the redis commands arguments to delete a key or the keys with the same prefix within a transaction
+type keyDeletion struct {
+	storedKeys  redis.Args
+	upperFields []redis.Args
+	keys        []models.KeyOnly
+}
+
+// planKeyDeletion collects the stored keys and the upper level Hash fields to be deleted for the specified key or the
+// keys with the same prefix, removedFields counts the Hash fields planned to be deleted by the previous operations of
+// the same transaction
+func planKeyDeletion(conn redis.Conn, key string, prefixMatch bool, removedFields map[string]int) (keyDeletion, errors.EdgeX) {
+	var plan keyDeletion
+	storedKey := CreateKey(KVCollection, key)
+
+	exists, edgeXerr := objectIdExists(conn, storedKey)
+	if edgeXerr != nil {
+		return plan, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if !exists {
+		return plan, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("query key %s not exists", replaceKeyDelimiterForKeeper(key)), nil)
+	}
+
+	edgeXerr = collectStoredKeys(conn, storedKey, prefixMatch, &plan)
+	if edgeXerr != nil {
+		return plan, edgeXerr
+	}
+
+	// the same as deleteUpperLevelKeyFields, the upper level Hash is deleted once all of its fields are deleted
+	prevKey := key
+	for prevKey != "" {
+		if idx := strings.LastIndex(prevKey, DBKeySeparator); idx != -1 {
+			upperKey := prevKey[:idx]
+			upperKeyPath := CreateKey(KVCollection, upperKey)
+			plan.upperFields = append(plan.upperFields, redis.Args{}.Add(upperKeyPath, prevKey[idx+1:]))
+			removedFields[upperKeyPath]++
+
+			length, err := redis.Int(conn.Do(HLEN, upperKeyPath))
+			if err != nil {
+				return plan, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("check the length of hash key %s failed", upperKey), err)
+			}
+			if length > removedFields[upperKeyPath] {
+				break
+			}
+			prevKey = upperKey
+		} else {
+			plan.upperFields = append(plan.upperFields, redis.Args{}.Add(KVCollection, prevKey))
+			prevKey = ""
+		}
+	}
+	return plan, nil
+}
+
+// collectStoredKeys is a recursive function that collects the specified stored key and the stored keys in the Hash fields
+func collectStoredKeys(conn redis.Conn, storedKey string, prefixMatch bool, plan *keyDeletion) errors.EdgeX {
+	keyType, edgeXerr := getKeyType(conn, storedKey)
+	if edgeXerr != nil {
+		return edgeXerr
+	}
+
+	plan.storedKeys = plan.storedKeys.Add(storedKey)
+	switch keyType {
+	case String:
+		// get the query key after KVCollection prefix (kp:)
+		idx := strings.Index(storedKey, DBKeySeparator)
+		if idx == -1 {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "retrieve query key failed", nil)
+		}
+		plan.keys = append(plan.keys, models.KeyOnly(storedKey[idx+1:]))
+	case Hash:
+		if !prefixMatch {
+			return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("keys having the same prefix %s exist and cannot be deleted", storedKey), nil)
+		}
+		keyMap, edgeXerr := getMapByKey(conn, storedKey)
+		if edgeXerr != nil {
+			return edgeXerr
+		}
+		for _, v := range keyMap {
+			edgeXerr = collectStoredKeys(conn, v, prefixMatch, plan)
+			if edgeXerr != nil {
+				return edgeXerr
+			}
+		}
+	}
+	return nil
+}
+
+// keyModifyIndex returns the modify index of the specified key, the index of a key not exists is 0
+func keyModifyIndex(conn redis.Conn, key string) (uint64, errors.EdgeX) {
+	keyType, err := redis.String(conn.Do(TYPE, CreateKey(KVCollection, key)))
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, "key type check failed", err)
+	}
+	if keyType != String {
+		return 0, nil
+	}
+
+	index, err := redis.Uint64(conn.Do(HGET, KVModifyIndexCollection, key))
+	if err == redis.ErrNil {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindDatabaseError, fmt.Sprintf("query the modify index of key %s failed", key), err)
+	}
+	return index, nil
+}
+
+// keeperTxn applies the set, delete and check-index operations atomically. The check-index operations are evaluated
+// against the keys before the transaction regardless of their positions, the other operations are validated against
+// the keys before the transaction with the modify index counter watched, and the transaction is aborted with a status
+// conflict error if any key is changed in between.
+func keeperTxn(conn redis.Conn, ops []keeperModels.KVTxnOp) ([]models.KeyOnly, errors.EdgeX) {
+	edgeXerr := seedKeeperKeysHistory(conn)
+	if edgeXerr != nil {
+		return nil, edgeXerr
+	}
+
+	_, err := conn.Do(WATCH, KVModifyIndexCounter)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "watch the modify index failed", err)
+	}
+
+	modifyIndex, err := redis.Uint64(conn.Do(GET, KVModifyIndexCounter))
+	if err != nil && err != redis.ErrNil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query the modify index failed", err)
+	}
+	modifyIndex++
+
+	failed, edgeXerr := keeperModels.CheckTxnIndexes(ops, func(key string) (uint64, errors.EdgeX) {
+		return keyModifyIndex(conn, key)
+	})
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("operation %d of key %s failed", failed, replaceKeyDelimiterForKeeper(ops[failed].Key)), edgeXerr)
+	}
+
+	// validate the operations and plan the deletions before the transaction
+	deletions := make(map[int]keyDeletion)
+	removedFields := make(map[string]int)
+	for i, op := range ops {
+		var edgeXerr errors.EdgeX
+		switch op.Verb {
+		case constants.TxnVerbCheckIndex:
+			// already evaluated against the keys before the transaction
+		case constants.TxnVerbSet:
+			edgeXerr = validateKeeperKeyUpdate(conn, models.KVS{Key: op.Key, StoredData: models.StoredData{Value: op.Value}})
+		case constants.TxnVerbDelete:
+			deletions[i], edgeXerr = planKeyDeletion(conn, op.Key, op.PrefixMatch, removedFields)
+		default:
+			edgeXerr = errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown verb %s", op.Verb), nil)
+		}
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("operation %d of key %s failed", i, replaceKeyDelimiterForKeeper(op.Key)), edgeXerr)
+		}
+	}
+
+	_ = conn.Send(MULTI)
+
+	var keysResp []models.KeyOnly
+	var revisions []keeperModels.KVRevision
+	for i, op := range ops {
+		var keys []models.KeyOnly
+		var edgeXerr errors.EdgeX
+		switch op.Verb {
+		case constants.TxnVerbSet:
+			values := make(map[string]string)
+			keys, edgeXerr = sendUpdateKeeperKeyCmds(conn, models.KVS{Key: op.Key, StoredData: models.StoredData{Value: op.Value}}, op.Flatten, values)
+			revisions = append(revisions, newRevisions(keys, values, op.Verb, modifyIndex)...)
+		case constants.TxnVerbDelete:
+			plan := deletions[i]
+			_ = conn.Send(DEL, plan.storedKeys...)
+			for _, field := range plan.upperFields {
+				_ = conn.Send(HDEL, field...)
+			}
+			keys = plan.keys
+			revisions = append(revisions, newRevisions(keys, nil, op.Verb, modifyIndex)...)
+		}
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("operation %d of key %s failed", i, replaceKeyDelimiterForKeeper(op.Key)), edgeXerr)
+		}
+		if len(keys) > 0 {
+			if op.Verb == constants.TxnVerbDelete {
+				_ = conn.Send(HDEL, deletedKeyFieldsArgs(keys)...)
+			} else {
+				_ = conn.Send(HSET, modifyIndexArgs(keys, modifyIndex)...)
+			}
+		}
+		keysResp = append(keysResp, keys...)
+	}
+
+	_ = conn.Send(SET, KVModifyIndexCounter, modifyIndex)
+	if len(keysResp) > 0 {
+		_ = conn.Send(HSET, prefixIndexArgs(keysResp, modifyIndex)...)
+		edgeXerr = sendAddHistoryCmds(conn, revisions)
+		if edgeXerr != nil {
+			return nil, edgeXerr
+		}
+	}
+
+	reply, err := conn.Do(EXEC)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "execute the transaction failed", err)
+	}
+	// the reply of EXEC is nil if the watched modify index counter is changed by another request
+	if reply == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindStatusConflict, "the keys are changed by another request during the transaction", nil)
+	}
+	return keysResp, nil
+}
+
+// keeperKeysHistory returns the revisions of the specified key and the keys with the same prefix within the time range,
+// the newest revision comes first
+func keeperKeysHistory(conn redis.Conn, key string, start int64, end int64, offset int, limit int) ([]keeperModels.KVRevision, errors.EdgeX) {
+	if end < start {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "end must be greater than start", nil)
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	members, err := redis.ByteSlices(conn.Do(ZREVRANGEBYSCORE, historyStoredKey(key), end, start, LIMIT, offset, limit))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query the KV history failed", err)
+	}
+
+	revisions := make([]keeperModels.KVRevision, len(members))
+	for i, member := range members {
+		err = json.Unmarshal(member, &revisions[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "unable to JSON unmarshal KV revision", err)
+		}
+	}
+	// the revisions with the same timestamp are sorted by the JSON members, sort them by the modify index instead
+	sort.SliceStable(revisions, func(i, j int) bool {
+		if revisions[i].Timestamp != revisions[j].Timestamp {
+			return revisions[i].Timestamp > revisions[j].Timestamp
+		}
+		return revisions[i].ModifyIndex > revisions[j].ModifyIndex
+	})
+	return revisions, nil
+}
+
+// keeperKeysHistoryCount returns the count of the revisions of the specified key and the keys with the same prefix
+// within the time range
+func keeperKeysHistoryCount(conn redis.Conn, key string, start int64, end int64) (int64, errors.EdgeX) {
+	if end < start {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, "end must be greater than start", nil)
+	}
+	return getMemberCountByScoreRange(conn, historyStoredKey(key), start, end)
+}
+
+// seedKeeperKeysHistory records the current value of all the existing keys if the history is empty, so that the keys
+// stored before the history is introduced can be restored. The seeding runs once before the first change of any key
+// after the upgrade, and the seeded revisions are stamped with the modified time of the keys.
+func seedKeeperKeysHistory(conn redis.Conn) errors.EdgeX {
+	exists, edgeXerr := objectIdExists(conn, KVHistoryCollection)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if exists {
+		return nil
+	}
+	exists, edgeXerr = objectIdExists(conn, KVCollection)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if !exists {
+		return nil
+	}
+
+	kvs, edgeXerr := getObjectsByKey(conn, KVCollection, false, true)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	revisions := make([]keeperModels.KVRevision, 0, len(kvs))
+	for _, resp := range kvs {
+		kv, ok := resp.(*models.KVS)
+		if !ok {
+			continue
+		}
+		index, edgeXerr := keyModifyIndex(conn, kv.Key)
+		if edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		revisions = append(revisions, keeperModels.KVRevision{
+			Key:         kv.Key,
+			Value:       cast.ToString(kv.Value),
+			Action:      constants.TxnVerbSet,
+			ModifyIndex: index,
+			Timestamp:   kv.Modified,
+		})
+	}
+	if len(revisions) == 0 {
+		return nil
+	}
+
+	_ = conn.Send(MULTI)
+	edgeXerr = sendAddHistoryCmds(conn, revisions)
+	if edgeXerr != nil {
+		return edgeXerr
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "seed the KV history failed", err)
+	}
+	return nil
+}
+
+// deleteKeeperKeysHistoryByAge deletes the revisions older than the age, the latest revision of each key before the
+// age is kept unless the key is deleted, so that the state of the keys at any time within the age is not changed
+func deleteKeeperKeysHistoryByAge(conn redis.Conn, age int64) errors.EdgeX {
+	expireTimestamp := time.Now().UnixMilli() - age
+	members, err := redis.ByteSlices(conn.Do(ZRANGEBYSCORE, KVHistoryCollection, InfiniteMin, fmt.Sprintf("(%d", expireTimestamp)))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query the expired KV history failed", err)
+	}
+
+	revisions := make([]keeperModels.KVRevision, len(members))
+	for i, member := range members {
+		err = json.Unmarshal(member, &revisions[i])
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "unable to JSON unmarshal KV revision", err)
+		}
+	}
+	latest := make(map[string]int)
+	for i, revision := range revisions {
+		if j, ok := latest[revision.Key]; !ok || revision.ModifyIndex >= revisions[j].ModifyIndex {
+			latest[revision.Key] = i
+		}
+	}
+
+	_ = conn.Send(MULTI)
+	for i, revision := range revisions {
+		if latest[revision.Key] == i && revision.Action == constants.TxnVerbSet {
+			continue
+		}
+		sendDeleteHistoryCmds(conn, revision.Key, members[i])
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "delete the expired KV history failed", err)
+	}
+	return nil
+}
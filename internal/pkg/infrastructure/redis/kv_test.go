@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixIndexArgs(t *testing.T) {
+	keys := []models.KeyOnly{"a:b:c", "a:b:d", "e"}
+	expected := redis.Args{}.Add(KVPrefixIndexCollection, "a:b:c", 7, "a:b", 7, "a", 7, "a:b:d", 7, "e", 7)
+	assert.Equal(t, expected, prefixIndexArgs(keys, 7))
+}
+
+func TestKeyPrefixes(t *testing.T) {
+	assert.Equal(t, []string{"a:b:c", "a:b", "a"}, keyPrefixes("a:b:c"))
+	assert.Equal(t, []string{"a"}, keyPrefixes("a"))
+}
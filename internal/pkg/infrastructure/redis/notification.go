@@ -243,9 +243,14 @@ func deleteNotificationById(conn redis.Conn, id string) errors.EdgeX {
 	if edgexErr != nil {
 		return errors.NewCommonEdgeXWrapper(edgexErr)
 	}
+	occurrenceKeys, edgexErr := notificationOccurrenceStoredKeys(conn, []string{notification.Id})
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
+	}
 	storedKey := notificationStoredKey(notification.Id)
 	_ = conn.Send(MULTI)
 	sendDeleteNotificationCmd(conn, storedKey, notification)
+	sendDeleteNotificationOccurrenceCmd(conn, notification.Id, occurrenceKeys)
 	_, err := conn.Do(EXEC)
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "notification deletion failed", err)
@@ -272,16 +277,23 @@ func deleteNotificationByIds(conn redis.Conn, ids []string) errors.EdgeX {
 		return errors.NewCommonEdgeXWrapper(edgexErr)
 	}
 	var transmissions []models.Transmission
-	for _, notification := range notifications {
+	notificationIds := make([]string, len(notifications))
+	for i, notification := range notifications {
 		trans, edgexErr := transmissionsByNotificationId(conn, 0, -1, notification.Id)
 		if edgexErr != nil {
 			return errors.NewCommonEdgeXWrapper(edgexErr)
 		}
 		transmissions = append(transmissions, trans...)
+		notificationIds[i] = notification.Id
+	}
+	occurrenceKeys, edgexErr := notificationOccurrenceStoredKeys(conn, notificationIds)
+	if edgexErr != nil {
+		return errors.NewCommonEdgeXWrapper(edgexErr)
 	}
 	_ = conn.Send(MULTI)
 	for _, notification := range notifications {
 		sendDeleteNotificationCmd(conn, notificationStoredKey(notification.Id), notification)
+		sendDeleteNotificationOccurrenceCmd(conn, notification.Id, occurrenceKeys)
 	}
 	for _, transmission := range transmissions {
 		sendDeleteTransmissionCmd(conn, transmissionStoredKey(transmission.Id), transmission)
@@ -413,6 +425,15 @@ func (c *Client) asyncDeleteNotificationByStoreKeys(storeKeys []string) {
 		c.loggingClient.Errorf("Deleted notifications failed while retrieving objects by storeKeys, %v", edgeXerr)
 		return
 	}
+	ids := make([]string, len(storeKeys))
+	for i, storeKey := range storeKeys {
+		ids[i] = idFromStoredKey(storeKey)
+	}
+	occurrenceKeys, edgeXerr := notificationOccurrenceStoredKeys(conn, ids)
+	if edgeXerr != nil {
+		c.loggingClient.Errorf("Deleted notifications failed while retrieving the occurrence storeKeys, %v", edgeXerr)
+		return
+	}
 
 	// cmdSize is used to count the notification deletion command
 	cmdSize := 0
@@ -427,6 +448,7 @@ func (c *Client) asyncDeleteNotificationByStoreKeys(storeKeys []string) {
 			continue
 		}
 		sendDeleteNotificationCmd(conn, notificationStoredKey(nc.Id), nc)
+		sendDeleteNotificationOccurrenceCmd(conn, nc.Id, occurrenceKeys)
 		cmdSize++
 
 		if cmdSize >= c.BatchSize {
@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	// NotificationOccurrenceCollection is the key prefix of the notification occurrences stored by fingerprint
+	NotificationOccurrenceCollection = "sn|occurrence"
+	// NotificationOccurrenceCollectionNotificationId is the hash mapping the notification id to the stored key of the
+	// occurrence
+	NotificationOccurrenceCollectionNotificationId = NotificationOccurrenceCollection + DBKeySeparator + "notification"
+)
+
+// upsertNotificationOccurrence adds the occurrence of the fingerprint or replaces the existing one, the notification id
+// of the replaced occurrence no longer refers to the occurrence
+func upsertNotificationOccurrence(conn redis.Conn, o notificationsModels.NotificationOccurrence) errors.EdgeX {
+	jsonBytes, err := json.Marshal(o)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal notification occurrence for Redis persistence", err)
+	}
+
+	storedKey := CreateKey(NotificationOccurrenceCollection, o.Fingerprint)
+	var existing notificationsModels.NotificationOccurrence
+	edgeXerr := getObjectById(conn, storedKey, &existing)
+	if edgeXerr != nil && errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	if edgeXerr == nil && existing.NotificationId != o.NotificationId {
+		_ = conn.Send(HDEL, NotificationOccurrenceCollectionNotificationId, existing.NotificationId)
+	}
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	_ = conn.Send(HSET, NotificationOccurrenceCollectionNotificationId, o.NotificationId, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "notification occurrence upsert failed", err)
+	}
+	return nil
+}
+
+// notificationOccurrenceByFingerprint queries the occurrence of the fingerprint
+func notificationOccurrenceByFingerprint(conn redis.Conn, fingerprint string) (o notificationsModels.NotificationOccurrence, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, CreateKey(NotificationOccurrenceCollection, fingerprint), &o)
+	if edgeXerr != nil {
+		return o, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query notification occurrence by fingerprint %s", fingerprint), edgeXerr)
+	}
+	return o, nil
+}
+
+// notificationOccurrenceByNotificationId queries the occurrence of the notification
+func notificationOccurrenceByNotificationId(conn redis.Conn, id string) (o notificationsModels.NotificationOccurrence, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectByHash(conn, NotificationOccurrenceCollectionNotificationId, id, &o)
+	if edgeXerr != nil {
+		return o, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query notification occurrence by notification id %s", id), edgeXerr)
+	}
+	return o, nil
+}
+
+// notificationOccurrenceStoredKeys returns the stored keys of the occurrences of the notifications by the notification
+// id, the notifications without occurrence are skipped
+func notificationOccurrenceStoredKeys(conn redis.Conn, ids []string) (map[string]string, errors.EdgeX) {
+	storedKeys := make(map[string]string)
+	if len(ids) == 0 {
+		return storedKeys, nil
+	}
+	values, err := redis.Strings(conn.Do(HMGET, redis.Args{}.Add(NotificationOccurrenceCollectionNotificationId).AddFlat(ids)...))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "fail to retrieve notification occurrence storeKeys", err)
+	}
+	for i, v := range values {
+		if v != "" {
+			storedKeys[ids[i]] = v
+		}
+	}
+	return storedKeys, nil
+}
+
+// sendDeleteNotificationOccurrenceCmd sends redis command to delete the occurrence of the notification if any
+func sendDeleteNotificationOccurrenceCmd(conn redis.Conn, id string, storedKeys map[string]string) {
+	storedKey, ok := storedKeys[id]
+	if !ok {
+		return
+	}
+	_ = conn.Send(HDEL, NotificationOccurrenceCollectionNotificationId, id)
+	_ = conn.Send(DEL, storedKey)
+}
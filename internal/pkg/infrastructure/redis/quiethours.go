@@ -0,0 +1,122 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+	"github.com/google/uuid"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	// HeldNotificationCollection is the key prefix of the held notifications stored by id
+	HeldNotificationCollection = "sn|held"
+	// HeldNotificationCollectionSubscription is the key prefix of the sorted sets of the held notification keys of the
+	// subscriptions scored by the created time
+	HeldNotificationCollectionSubscription = HeldNotificationCollection + DBKeySeparator + "sub"
+)
+
+func heldNotificationsKey(subscriptionName string) string {
+	return CreateKey(HeldNotificationCollectionSubscription, subscriptionName)
+}
+
+// addHeldNotification adds the notification held in the quiet hours of the subscription
+func addHeldNotification(conn redis.Conn, held notificationsModels.HeldNotification) (notificationsModels.HeldNotification, errors.EdgeX) {
+	if len(held.Id) == 0 {
+		held.Id = uuid.New().String()
+	}
+	if held.Created == 0 {
+		held.Created = pkgCommon.MakeTimestamp()
+	}
+	jsonBytes, err := json.Marshal(held)
+	if err != nil {
+		return held, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal held notification for Redis persistence", err)
+	}
+
+	storedKey := CreateKey(HeldNotificationCollection, held.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	_ = conn.Send(ZADD, heldNotificationsKey(held.SubscriptionName), held.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return held, errors.NewCommonEdgeX(errors.KindDatabaseError, "held notification creation failed", err)
+	}
+	return held, nil
+}
+
+// heldNotificationsBySubscriptionName queries the held notifications of the subscription, the earliest held notification
+// comes first
+func heldNotificationsBySubscriptionName(conn redis.Conn, name string) ([]notificationsModels.HeldNotification, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRange(conn, heldNotificationsKey(name), 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	heldNotifications := make([]notificationsModels.HeldNotification, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &heldNotifications[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "held notification format parsing failed from the database", err)
+		}
+	}
+	return heldNotifications, nil
+}
+
+// deleteHeldNotificationsByIds deletes the held notifications by ids
+func deleteHeldNotificationsByIds(conn redis.Conn, ids []string) errors.EdgeX {
+	if len(ids) == 0 {
+		return nil
+	}
+	storedKeys := make([]interface{}, len(ids))
+	for i, id := range ids {
+		storedKeys[i] = CreateKey(HeldNotificationCollection, id)
+	}
+	objects, edgeXerr := getObjectsByIds(conn, storedKeys)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, o := range objects {
+		var held notificationsModels.HeldNotification
+		if err := json.Unmarshal(o, &held); err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "held notification format parsing failed from the database", err)
+		}
+		storedKey := CreateKey(HeldNotificationCollection, held.Id)
+		_ = conn.Send(DEL, storedKey)
+		_ = conn.Send(ZREM, heldNotificationsKey(held.SubscriptionName), storedKey)
+	}
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "held notifications deletion failed", err)
+	}
+	return nil
+}
+
+// deleteHeldNotificationsBySubscriptionName deletes the held notifications of the subscription
+func deleteHeldNotificationsBySubscriptionName(conn redis.Conn, name string) errors.EdgeX {
+	heldKey := heldNotificationsKey(name)
+	storedKeys, err := redis.Values(conn.Do(ZRANGE, heldKey, 0, -1))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query held notification keys from database failed", err)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, storedKey := range storedKeys {
+		_ = conn.Send(DEL, storedKey)
+	}
+	_ = conn.Send(DEL, heldKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "held notifications deletion failed", err)
+	}
+	return nil
+}
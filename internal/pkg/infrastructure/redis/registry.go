@@ -14,11 +14,15 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 	"github.com/gomodule/redigo/redis"
 
+	keeperModels "github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 )
 
 const RegistrationCollection = "kp|r"
 const RegistrationCollectionName = RegistrationCollection + DBKeySeparator + common.Name
+const RegistrationHistoryCollection = "kp|r|history"
+const RegistrationHistoryCollectionServiceId = RegistrationHistoryCollection + DBKeySeparator + common.ServiceId
+const RegistrationInstanceCollection = "kp|r|instance"
 
 func registrationStoredKey(id string) string {
 	return CreateKey(RegistrationCollection, id)
@@ -155,3 +159,122 @@ func sendDeleteRegistrationCmd(conn redis.Conn, storedKey string, r models.Regis
 	_ = conn.Send(ZREM, RegistrationCollection, storedKey)
 	_ = conn.Send(HDEL, RegistrationCollectionName, r.ServiceId)
 }
+
+func addStatusTransition(conn redis.Conn, t keeperModels.StatusTransition) (keeperModels.StatusTransition, errors.EdgeX) {
+	if t.Timestamp == 0 {
+		t.Timestamp = pkgCommon.MakeTimestamp()
+	}
+
+	jsonBytes, err := json.Marshal(t)
+	if err != nil {
+		return keeperModels.StatusTransition{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal status transition for Redis persistence", err)
+	}
+
+	storedKey := CreateKey(RegistrationHistoryCollection, t.Id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	_ = conn.Send(ZADD, RegistrationHistoryCollection, t.Timestamp, storedKey)
+	_ = conn.Send(ZADD, CreateKey(RegistrationHistoryCollectionServiceId, t.ServiceId), t.Timestamp, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return keeperModels.StatusTransition{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "status transition creation failed", err)
+	}
+
+	return t, nil
+}
+
+func statusTransitionsByServiceId(conn redis.Conn, serviceId string, start int64, end int64, offset int, limit int) ([]keeperModels.StatusTransition, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByScoreRange(conn, CreateKey(RegistrationHistoryCollectionServiceId, serviceId), start, end, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	transitions := make([]keeperModels.StatusTransition, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &transitions[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "status transition format parsing failed from the database", err)
+		}
+	}
+	return transitions, nil
+}
+
+// deleteStatusTransitionsByAge deletes the status transitions older than the age in milliseconds
+func deleteStatusTransitionsByAge(conn redis.Conn, age int64) errors.EdgeX {
+	expireTimestamp := pkgCommon.MakeTimestamp() - age
+	storedKeys, err := redis.Strings(conn.Do(ZRANGEBYSCORE, RegistrationHistoryCollection, 0, expireTimestamp))
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "query the expired status transitions failed", err)
+	}
+	if len(storedKeys) == 0 {
+		return nil
+	}
+	objects, edgeXerr := getObjectsByIds(conn, pkgCommon.ConvertStringsToInterfaces(storedKeys))
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	_ = conn.Send(MULTI)
+	for _, in := range objects {
+		var t keeperModels.StatusTransition
+		err = json.Unmarshal(in, &t)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindDatabaseError, "status transition format parsing failed from the database", err)
+		}
+		storedKey := CreateKey(RegistrationHistoryCollection, t.Id)
+		_ = conn.Send(DEL, storedKey)
+		_ = conn.Send(ZREM, RegistrationHistoryCollection, storedKey)
+		_ = conn.Send(ZREM, CreateKey(RegistrationHistoryCollectionServiceId, t.ServiceId), storedKey)
+	}
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "status transitions deletion failed", err)
+	}
+	return nil
+}
+
+func upsertServiceInstance(conn redis.Conn, i keeperModels.ServiceInstance) errors.EdgeX {
+	jsonBytes, err := json.Marshal(i)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal service instance for Redis persistence", err)
+	}
+
+	storedKey := CreateKey(RegistrationInstanceCollection, i.ServiceId)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	_ = conn.Send(ZADD, RegistrationInstanceCollection, 0, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "service instance upsert failed", err)
+	}
+
+	return nil
+}
+
+func serviceInstances(conn redis.Conn) ([]keeperModels.ServiceInstance, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRange(conn, RegistrationInstanceCollection, 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	instances := make([]keeperModels.ServiceInstance, len(objects))
+	for i, in := range objects {
+		err := json.Unmarshal(in, &instances[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "service instance format parsing failed from the database", err)
+		}
+	}
+	return instances, nil
+}
+
+func deleteServiceInstanceByServiceId(conn redis.Conn, id string) errors.EdgeX {
+	storedKey := CreateKey(RegistrationInstanceCollection, id)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, RegistrationInstanceCollection, storedKey)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "service instance deletion failed", err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// ResendTaskCollection is the sorted set of the transmission ids to resend scored by the next attempt time
+const ResendTaskCollection = "sn|resend"
+
+// upsertResendTask adds the resend task or updates the next attempt time of the existing task
+func upsertResendTask(conn redis.Conn, task notificationsModels.ResendTask) errors.EdgeX {
+	_, err := conn.Do(ZADD, ResendTaskCollection, task.NextAttempt, task.TransmissionId)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "resend task upsert failed", err)
+	}
+	return nil
+}
+
+// resendTasksByNextAttempt queries the resend tasks due before the given time, the earliest task comes first
+func resendTasksByNextAttempt(conn redis.Conn, before int64, limit int) ([]notificationsModels.ResendTask, errors.EdgeX) {
+	values, err := redis.Strings(conn.Do(ZRANGEBYSCORE, ResendTaskCollection, 0, before, WITHSCORES, LIMIT, 0, limit))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "query resend tasks from database failed", err)
+	}
+
+	// the reply alternates between the member and its score
+	tasks := make([]notificationsModels.ResendTask, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		nextAttempt, err := strconv.ParseInt(values[i+1], 10, 64)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "resend task format parsing failed from the database", err)
+		}
+		tasks = append(tasks, notificationsModels.ResendTask{TransmissionId: values[i], NextAttempt: nextAttempt})
+	}
+	return tasks, nil
+}
+
+// resendTaskByTransmissionId queries the resend task of the transmission
+func resendTaskByTransmissionId(conn redis.Conn, id string) (notificationsModels.ResendTask, errors.EdgeX) {
+	nextAttempt, err := redis.Int64(conn.Do(ZSCORE, ResendTaskCollection, id))
+	if err == redis.ErrNil {
+		return notificationsModels.ResendTask{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("resend task of transmission %s doesn't exist in the database", id), err)
+	} else if err != nil {
+		return notificationsModels.ResendTask{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "query resend task from database failed", err)
+	}
+	return notificationsModels.ResendTask{TransmissionId: id, NextAttempt: nextAttempt}, nil
+}
+
+// deleteResendTaskByTransmissionId deletes the resend task of the transmission
+func deleteResendTaskByTransmissionId(conn redis.Conn, id string) errors.EdgeX {
+	_, err := conn.Do(ZREM, ResendTaskCollection, id)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "resend task deletion failed", err)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/gomodule/redigo/redis"
+
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// notificationStatistics counts the notifications created within the time range by the group and the time bucket of
+// the interval, Redis has no aggregation over the JSON objects so the notifications are counted in memory
+func notificationStatistics(conn redis.Conn, groupBy string, start, end, interval int64, category, severity string) ([]notificationsModels.NotificationStatistic, errors.EdgeX) {
+	if interval <= 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the interval of the time bucket must be positive", nil)
+	}
+	objects, edgeXerr := getObjectsByScoreRange(conn, NotificationCollectionCreated, start, end, 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	notifications, edgeXerr := convertObjectsToNotifications(objects)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return countNotifications(notifications, groupBy, start, interval, category, severity)
+}
+
+// countNotifications counts the notifications of the category and the severity by the group and the time bucket, the
+// result is ordered by the bucket and the group the same as the Postgres aggregation
+func countNotifications(notifications []models.Notification, groupBy string, start, interval int64, category, severity string) ([]notificationsModels.NotificationStatistic, errors.EdgeX) {
+	type bucketGroup struct {
+		bucket int64
+		group  string
+	}
+	counts := make(map[bucketGroup]int64)
+	for _, n := range notifications {
+		if (len(category) != 0 && n.Category != category) || (len(severity) != 0 && string(n.Severity) != severity) {
+			continue
+		}
+		var groups []string
+		switch groupBy {
+		case notificationsModels.GroupByCategory:
+			groups = []string{n.Category}
+		case notificationsModels.GroupBySeverity:
+			groups = []string{string(n.Severity)}
+		case notificationsModels.GroupByStatus:
+			groups = []string{string(n.Status)}
+		case notificationsModels.GroupByLabel:
+			// a notification with multiple labels is counted in the group of each label
+			groups = n.Labels
+		default:
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported group by %s", groupBy), nil)
+		}
+		bucket := (n.Created-start)/interval*interval + start
+		for _, group := range groups {
+			counts[bucketGroup{bucket: bucket, group: group}]++
+		}
+	}
+
+	statistics := make([]notificationsModels.NotificationStatistic, 0, len(counts))
+	for key, count := range counts {
+		statistics = append(statistics, notificationsModels.NotificationStatistic{Bucket: key.bucket, Group: key.group, Count: count})
+	}
+	sort.Slice(statistics, func(i, j int) bool {
+		if statistics[i].Bucket != statistics[j].Bucket {
+			return statistics[i].Bucket < statistics[j].Bucket
+		}
+		return statistics[i].Group < statistics[j].Group
+	})
+	return statistics, nil
+}
+
+// subscriptionDeliveryStatistics calculates the delivery statistics of the transmissions created within the time range
+// by the subscription
+func subscriptionDeliveryStatistics(conn redis.Conn, start, end int64) ([]notificationsModels.SubscriptionDeliveryStatistic, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByScoreRange(conn, TransmissionCollectionCreated, start, end, 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	transmissions, edgeXerr := objectsToTransmissions(objects)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	var ids []string
+	seen := make(map[string]bool)
+	for _, trans := range transmissions {
+		if !seen[trans.NotificationId] {
+			seen[trans.NotificationId] = true
+			ids = append(ids, trans.NotificationId)
+		}
+	}
+	notifications, edgeXerr := notificationByIds(conn, ids)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	notificationCreated := make(map[string]int64, len(notifications))
+	for _, n := range notifications {
+		notificationCreated[n.Id] = n.Created
+	}
+	return calculateDeliveryStatistics(transmissions, notificationCreated), nil
+}
+
+// calculateDeliveryStatistics calculates the delivery statistics of the transmissions by the subscription, the
+// transmissions of the notifications which no longer exist are skipped the same as the Postgres aggregation
+func calculateDeliveryStatistics(transmissions []models.Transmission, notificationCreated map[string]int64) []notificationsModels.SubscriptionDeliveryStatistic {
+	type accumulator struct {
+		statistic   notificationsModels.SubscriptionDeliveryStatistic
+		resendCount int64
+		latencies   []float64
+	}
+	accumulators := make(map[string]*accumulator)
+	for _, trans := range transmissions {
+		created, ok := notificationCreated[trans.NotificationId]
+		if !ok {
+			continue
+		}
+		acc, ok := accumulators[trans.SubscriptionName]
+		if !ok {
+			acc = &accumulator{statistic: notificationsModels.SubscriptionDeliveryStatistic{SubscriptionName: trans.SubscriptionName}}
+			accumulators[trans.SubscriptionName] = acc
+		}
+		acc.statistic.Total++
+		switch trans.Status {
+		case models.Sent, models.Acknowledged:
+			acc.statistic.Sent++
+		case models.Failed, notificationsModels.RateLimited, notificationsModels.CircuitOpen:
+			acc.statistic.Failed++
+		case models.Resending:
+			acc.statistic.Resending++
+		case models.Escalated:
+			acc.statistic.Escalated++
+		}
+		acc.resendCount += int64(trans.ResendCount)
+
+		var firstSent int64
+		for _, record := range trans.Records {
+			if record.Status == models.Sent && (firstSent == 0 || record.Sent < firstSent) {
+				firstSent = record.Sent
+			}
+		}
+		if firstSent != 0 {
+			acc.latencies = append(acc.latencies, float64(firstSent-created))
+		}
+	}
+
+	statistics := make([]notificationsModels.SubscriptionDeliveryStatistic, 0, len(accumulators))
+	for _, acc := range accumulators {
+		acc.statistic.MeanResendCount = float64(acc.resendCount) / float64(acc.statistic.Total)
+		acc.statistic.P95Latency = percentile(acc.latencies, 0.95)
+		statistics = append(statistics, acc.statistic)
+	}
+	sort.Slice(statistics, func(i, j int) bool {
+		return statistics[i].SubscriptionName < statistics[j].SubscriptionName
+	})
+	return statistics
+}
+
+// percentile returns the percentile of the values with the linear interpolation between the adjacent values, which is
+// the same as the percentile_cont of Postgres, 0 is returned if there is no value
+func percentile(values []float64, fraction float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	position := fraction * float64(len(sorted)-1)
+	lower := math.Floor(position)
+	upper := math.Ceil(position)
+	return sorted[int(lower)] + (sorted[int(upper)]-sorted[int(lower)])*(position-lower)
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestCountNotifications(t *testing.T) {
+	notifications := []models.Notification{
+		{Category: "health", Severity: models.Critical, Status: models.New, Labels: []string{"a", "b"}, Created: 1000},
+		{Category: "health", Severity: models.Normal, Status: models.Processed, Labels: []string{"a"}, Created: 1500},
+		{Category: "security", Severity: models.Critical, Status: models.Processed, Created: 2100},
+	}
+
+	tests := []struct {
+		name     string
+		groupBy  string
+		category string
+		severity string
+		expected []notificationsModels.NotificationStatistic
+	}{
+		{"group by category", notificationsModels.GroupByCategory, "", "", []notificationsModels.NotificationStatistic{
+			{Bucket: 1000, Group: "health", Count: 2},
+			{Bucket: 2000, Group: "security", Count: 1},
+		}},
+		{"group by category of critical", notificationsModels.GroupByCategory, "", string(models.Critical), []notificationsModels.NotificationStatistic{
+			{Bucket: 1000, Group: "health", Count: 1},
+			{Bucket: 2000, Group: "security", Count: 1},
+		}},
+		{"group by label", notificationsModels.GroupByLabel, "", "", []notificationsModels.NotificationStatistic{
+			{Bucket: 1000, Group: "a", Count: 2},
+			{Bucket: 1000, Group: "b", Count: 1},
+		}},
+		{"group by status of category", notificationsModels.GroupByStatus, "health", "", []notificationsModels.NotificationStatistic{
+			{Bucket: 1000, Group: string(models.New), Count: 1},
+			{Bucket: 1000, Group: string(models.Processed), Count: 1},
+		}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			statistics, err := countNotifications(notifications, testCase.groupBy, 1000, 1000, testCase.category, testCase.severity)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, statistics)
+		})
+	}
+
+	_, err := countNotifications(notifications, "sender", 1000, 1000, "", "")
+	assert.Error(t, err)
+}
+
+func TestCalculateDeliveryStatistics(t *testing.T) {
+	transmissions := []models.Transmission{
+		{SubscriptionName: "sub1", NotificationId: "n1", Status: models.Sent, Records: []models.TransmissionRecord{{Status: models.Sent, Sent: 1100}}},
+		{SubscriptionName: "sub1", NotificationId: "n2", Status: models.Acknowledged, ResendCount: 2, Records: []models.TransmissionRecord{
+			{Status: models.Failed, Sent: 2100}, {Status: models.Sent, Sent: 2500}, {Status: models.Sent, Sent: 2900},
+		}},
+		{SubscriptionName: "sub1", NotificationId: "n1", Status: models.Escalated, ResendCount: 3, Records: []models.TransmissionRecord{{Status: models.Failed, Sent: 1100}}},
+		{SubscriptionName: "sub2", NotificationId: "n2", Status: notificationsModels.RateLimited},
+		{SubscriptionName: "sub2", NotificationId: "n2", Status: models.Resending, ResendCount: 1},
+		{SubscriptionName: "sub2", NotificationId: "deleted", Status: models.Sent},
+	}
+	notificationCreated := map[string]int64{"n1": 1000, "n2": 2000}
+
+	statistics := calculateDeliveryStatistics(transmissions, notificationCreated)
+	require.Len(t, statistics, 2)
+	// the latencies of sub1 are 100 and 500, the first SENT record counts
+	assert.InDelta(t, 480, statistics[0].P95Latency, 0.0001)
+	statistics[0].P95Latency = 0
+	assert.Equal(t, notificationsModels.SubscriptionDeliveryStatistic{
+		SubscriptionName: "sub1", Total: 3, Sent: 2, Escalated: 1, MeanResendCount: float64(5) / 3,
+	}, statistics[0])
+	assert.Equal(t, notificationsModels.SubscriptionDeliveryStatistic{
+		SubscriptionName: "sub2", Total: 2, Failed: 1, Resending: 1, MeanResendCount: 0.5,
+	}, statistics[1])
+}
+
+func TestPercentile(t *testing.T) {
+	assert.Equal(t, float64(0), percentile(nil, 0.95))
+	assert.Equal(t, float64(7), percentile([]float64{7}, 0.95))
+	assert.InDelta(t, 95, percentile([]float64{100, 1, 50, 25, 75}, 0.95), 0.0001)
+	assert.InDelta(t, 50, percentile([]float64{100, 1, 50, 25, 75}, 0.5), 0.0001)
+}
@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// SubscriptionExtensionCollection is the sorted set of the subscription extension keys, the extensions are stored by
+// the subscription name
+const SubscriptionExtensionCollection = "sn|sub|ext"
+
+func subscriptionExtensionStoredKey(subscriptionName string) string {
+	return CreateKey(SubscriptionExtensionCollection, subscriptionName)
+}
+
+// upsertSubscriptionExtension adds the extension settings of the subscription or replaces the existing ones
+func upsertSubscriptionExtension(conn redis.Conn, ext notificationsModels.SubscriptionExtension) errors.EdgeX {
+	jsonBytes, err := json.Marshal(ext)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal subscription extension for Redis persistence", err)
+	}
+
+	storedKey := subscriptionExtensionStoredKey(ext.SubscriptionName)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	_ = conn.Send(ZADD, SubscriptionExtensionCollection, 0, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription extension upsert failed", err)
+	}
+	return nil
+}
+
+// subscriptionExtensionBySubscriptionName queries the extension settings of the subscription
+func subscriptionExtensionBySubscriptionName(conn redis.Conn, name string) (ext notificationsModels.SubscriptionExtension, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, subscriptionExtensionStoredKey(name), &ext)
+	if edgeXerr != nil {
+		return ext, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query extension of subscription %s", name), edgeXerr)
+	}
+	return ext, nil
+}
+
+// allSubscriptionExtensions queries the extension settings of all subscriptions
+func allSubscriptionExtensions(conn redis.Conn) ([]notificationsModels.SubscriptionExtension, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRange(conn, SubscriptionExtensionCollection, 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	extensions := make([]notificationsModels.SubscriptionExtension, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &extensions[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription extension format parsing failed from the database", err)
+		}
+	}
+	return extensions, nil
+}
+
+// deleteSubscriptionExtensionBySubscriptionName deletes the extension settings of the subscription
+func deleteSubscriptionExtensionBySubscriptionName(conn redis.Conn, name string) errors.EdgeX {
+	storedKey := subscriptionExtensionStoredKey(name)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, SubscriptionExtensionCollection, storedKey)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "subscription extension deletion failed", err)
+	}
+	return nil
+}
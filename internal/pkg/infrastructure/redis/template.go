@@ -0,0 +1,161 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/gomodule/redigo/redis"
+
+	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	// TemplateCollection is the sorted set of the notification template keys scored by the created time
+	TemplateCollection = "sn|tmpl"
+	// TemplateCollectionCategory is the key prefix of the sorted sets of the template keys of the categories scored by
+	// the created time, the generic templates without category are indexed by the empty category
+	TemplateCollectionCategory = TemplateCollection + DBKeySeparator + "category"
+)
+
+func templateStoredKey(name string) string {
+	return CreateKey(TemplateCollection, name)
+}
+
+func templateCategoryKey(category string) string {
+	return CreateKey(TemplateCollectionCategory, category)
+}
+
+// addTemplate adds a new notification template
+func addTemplate(conn redis.Conn, t notificationsModels.Template) (notificationsModels.Template, errors.EdgeX) {
+	storedKey := templateStoredKey(t.Name)
+	exists, edgeXerr := objectIdExists(conn, storedKey)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeXWrapper(edgeXerr)
+	} else if exists {
+		return t, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("template name %s already exists", t.Name), nil)
+	}
+
+	ts := pkgCommon.MakeTimestamp()
+	t.Created = ts
+	t.Modified = ts
+	jsonBytes, err := json.Marshal(t)
+	if err != nil {
+		return t, errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal template for Redis persistence", err)
+	}
+
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	_ = conn.Send(ZADD, TemplateCollection, t.Created, storedKey)
+	_ = conn.Send(ZADD, templateCategoryKey(t.Category), t.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return t, errors.NewCommonEdgeX(errors.KindDatabaseError, "template creation failed", err)
+	}
+	return t, nil
+}
+
+// updateTemplate replaces the existing notification template
+func updateTemplate(conn redis.Conn, t notificationsModels.Template) errors.EdgeX {
+	old, edgeXerr := templateByName(conn, t.Name)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	t.Created = old.Created
+	t.Modified = pkgCommon.MakeTimestamp()
+	jsonBytes, err := json.Marshal(t)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "unable to JSON marshal template for Redis persistence", err)
+	}
+	storedKey := templateStoredKey(t.Name)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(SET, storedKey, jsonBytes)
+	if old.Category != t.Category {
+		_ = conn.Send(ZREM, templateCategoryKey(old.Category), storedKey)
+	}
+	_ = conn.Send(ZADD, templateCategoryKey(t.Category), t.Created, storedKey)
+	_, err = conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "template update failed", err)
+	}
+	return nil
+}
+
+// templateByName queries the notification template by name
+func templateByName(conn redis.Conn, name string) (t notificationsModels.Template, edgeXerr errors.EdgeX) {
+	edgeXerr = getObjectById(conn, templateStoredKey(name), &t)
+	if edgeXerr != nil {
+		return t, errors.NewCommonEdgeX(errors.Kind(edgeXerr), fmt.Sprintf("fail to query template %s", name), edgeXerr)
+	}
+	return t, nil
+}
+
+// allTemplates queries the notification templates by offset and limit, the earliest created template comes first
+func allTemplates(conn redis.Conn, offset, limit int) ([]notificationsModels.Template, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRange(conn, TemplateCollection, offset, limit)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	templates := make([]notificationsModels.Template, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &templates[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "template format parsing failed from the database", err)
+		}
+	}
+	return templates, nil
+}
+
+// templatesByCategory queries the notification templates of the category and the generic templates without category
+func templatesByCategory(conn redis.Conn, category string) ([]notificationsModels.Template, errors.EdgeX) {
+	objects, edgeXerr := getObjectsByRange(conn, templateCategoryKey(""), 0, -1)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if category != "" {
+		categoryObjects, edgeXerr := getObjectsByRange(conn, templateCategoryKey(category), 0, -1)
+		if edgeXerr != nil {
+			return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		objects = append(objects, categoryObjects...)
+	}
+
+	templates := make([]notificationsModels.Template, len(objects))
+	for i, o := range objects {
+		err := json.Unmarshal(o, &templates[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindDatabaseError, "template format parsing failed from the database", err)
+		}
+	}
+	return templates, nil
+}
+
+// deleteTemplateByName deletes the notification template by name
+func deleteTemplateByName(conn redis.Conn, name string) errors.EdgeX {
+	t, edgeXerr := templateByName(conn, name)
+	if edgeXerr != nil {
+		if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+			return nil
+		}
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	storedKey := templateStoredKey(name)
+	_ = conn.Send(MULTI)
+	_ = conn.Send(DEL, storedKey)
+	_ = conn.Send(ZREM, TemplateCollection, storedKey)
+	_ = conn.Send(ZREM, templateCategoryKey(t.Category), storedKey)
+	_, err := conn.Do(EXEC)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "template deletion failed", err)
+	}
+	return nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2025 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -12,6 +12,9 @@ import (
 // RESTSenderName contains the name of the channel.RESTSender implementation in the DIC.
 var RESTSenderName = di.TypeInstanceToName(RESTSender{})
 
+// WebhookSenderName contains the name of the channel.WebhookSender implementation in the DIC.
+var WebhookSenderName = di.TypeInstanceToName(WebhookSender{})
+
 // EmailSenderName contains the name of the channel.EmailSender implementation in the DIC.
 var EmailSenderName = di.TypeInstanceToName(EmailSender{})
 
@@ -26,6 +29,11 @@ func RESTSenderFrom(get di.Get) Sender {
 	return get(RESTSenderName).(Sender)
 }
 
+// WebhookSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
+func WebhookSenderFrom(get di.Get) Sender {
+	return get(WebhookSenderName).(Sender)
+}
+
 // EmailSenderFrom helper function queries the DIC and returns the channel.Sender implementation.
 func EmailSenderFrom(get di.Get) Sender {
 	return get(EmailSenderName).(Sender)
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -13,6 +13,7 @@ import (
 	mail "net/smtp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 
@@ -26,6 +27,13 @@ const (
 	secretKeyUsername = "username"
 	// secretKeyPassword is the key to read the password from the secret data
 	secretKeyPassword = "password"
+
+	authModeUsernamePassword = "usernamepassword"
+	authModeCramMD5          = "cram-md5"
+	authModeXOAuth2          = "xoauth2"
+
+	// defaultSmtpIdleTimeout is the duration to keep the idle SMTP session open if the IdleTimeout is not specified
+	defaultSmtpIdleTimeout = 30 * time.Second
 )
 
 func buildSmtpMessage(sender string, subject string, toAddresses []string, contentType string, message string) []byte {
@@ -44,6 +52,14 @@ func buildSmtpMessage(sender string, subject string, toAddresses []string, conte
 	}
 
 	buf.WriteString(smtpNewline)
+	writeSmtpBody(buf, message)
+
+	return buf.Bytes()
+}
+
+// writeSmtpBody writes the message as the email body, the lines are broken to meet the maximum line size
+func writeSmtpBody(buf *bytes.Buffer, message string) {
+	smtpNewline := "\r\n"
 
 	//maximum line size is 1000
 	//split on newline first then break further as needed
@@ -56,16 +72,70 @@ func buildSmtpMessage(sender string, subject string, toAddresses []string, conte
 		}
 		buf.WriteString(line[idx:] + smtpNewline)
 	}
+}
+
+// buildSmtpAlternativeMessage builds the multipart/alternative email with both the plain text and the HTML body, the
+// mail client displays the HTML part if it's supported
+func buildSmtpAlternativeMessage(sender string, subject string, toAddresses []string, textBody string, htmlBody string) []byte {
+	smtpNewline := "\r\n"
+	boundary := "edgex-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+
+	buf := bytes.NewBufferString("Subject: " + subject + smtpNewline)
+	buf.WriteString("From: " + sender + smtpNewline)
+	buf.WriteString("To: " + strings.Join(toAddresses, ",") + smtpNewline)
+	_, _ = fmt.Fprintf(buf, "MIME-version: 1.0;\r\nContent-Type: multipart/alternative; boundary=\"%s\"\r\n", boundary)
+	buf.WriteString(smtpNewline)
+
+	for _, part := range []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain", textBody},
+		{"text/html", htmlBody},
+	} {
+		buf.WriteString("--" + boundary + smtpNewline)
+		_, _ = fmt.Fprintf(buf, "Content-Type: %s; charset=\"UTF-8\"\r\n", part.contentType)
+		buf.WriteString(smtpNewline)
+		writeSmtpBody(buf, part.body)
+	}
+	buf.WriteString("--" + boundary + "--" + smtpNewline)
 
 	return buf.Bytes()
 }
 
-func deduceAuth(dic *di.Container, s config.SmtpInfo) (mail.Auth, errors.EdgeX) {
+// deduceAuth creates the SMTP auth according to the AuthMode with the credentials from the secret store, the
+// 'usernamepassword' AuthMode is used if it's not specified
+func deduceAuth(dic *di.Container, s config.SmtpInfo, tokenSource *oauth2TokenSource) (mail.Auth, errors.EdgeX) {
 	lc := container.LoggingClientFrom(dic.Get)
 	secretProvider := container.SecretProviderFrom(dic.Get)
 	if secretProvider == nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "secret provider is missing. Make sure it is specified to be used in bootstrap.Run()", nil)
 	}
+
+	authMode := strings.ToLower(s.AuthMode)
+	if authMode == authModeXOAuth2 {
+		// the client secret and refresh token are optional, so all the secrets are retrieved instead of the specified keys
+		secrets, err := secretProvider.GetSecret(s.SecretName)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(err), "fail to retrieve the secrets from the secret store", err)
+		}
+		username := secrets[secretKeyUsername]
+		if username == "" {
+			return nil, errors.NewCommonEdgeX(errors.KindServerError, "username doesn't exist for SMTP auth", nil)
+		}
+		accessToken, rotatedRefreshToken, edgexErr := tokenSource.token(s.OAuth2, secrets, time.Now())
+		if edgexErr != nil {
+			return nil, errors.NewCommonEdgeX(errors.Kind(edgexErr), "fail to fetch the access token for SMTP XOAUTH2 auth", edgexErr)
+		}
+		if rotatedRefreshToken != "" {
+			secrets[secretKeyRefreshToken] = rotatedRefreshToken
+			if err = secretProvider.StoreSecret(s.SecretName, secrets); err != nil {
+				lc.Warnf("fail to store the rotated refresh token to the secret store, the next token refresh may fail: %v", err)
+			}
+		}
+		return newXOAuth2Auth(username, accessToken, s.Host), nil
+	}
+
 	secrets, err := secretProvider.GetSecret(s.SecretName, secretKeyUsername, secretKeyPassword)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.Kind(err), "fail to retrieve the secrets from the secret store", err)
@@ -79,7 +149,14 @@ func deduceAuth(dic *di.Container, s config.SmtpInfo) (mail.Auth, errors.EdgeX)
 		lc.Debugf("user didn't provide the password, send the email without auth")
 		return nil, nil
 	}
-	return mail.PlainAuth("", username, password, s.Host), nil
+	switch authMode {
+	case "", authModeUsernamePassword:
+		return mail.PlainAuth("", username, password, s.Host), nil
+	case authModeCramMD5:
+		return mail.CRAMMD5Auth(username, password), nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported SMTP AuthMode %s", s.AuthMode), nil)
+	}
 }
 
 // sendEmail replicates the functionality provided by the SendMail function from smtp package.
@@ -97,14 +174,39 @@ func deduceAuth(dic *di.Container, s config.SmtpInfo) (mail.Auth, errors.EdgeX)
 // this function is to use it as a support function for handling the low level SMTP
 // protocol mechanism, it is not exported.
 func sendEmail(s config.SmtpInfo, auth mail.Auth, to []string, msg []byte) errors.EdgeX {
-	addr := s.Host + ":" + strconv.Itoa(s.Port)
-	c, err := mail.Dial(addr)
+	c, err := openSmtpSession(s, auth)
 	if err != nil {
-		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("fail to connected the SMTP server with address %s", addr), err)
+		return errors.NewCommonEdgeXWrapper(err)
 	}
 	defer func(c *mail.Client) {
 		_ = c.Close()
 	}(c)
+	if err = sendSmtpMessage(c, s.Sender, to, msg); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := c.Quit(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// openSmtpSession connects the SMTP server, upgrades the connection to TLS if the server supports STARTTLS and
+// authenticates with the auth if it's not nil
+func openSmtpSession(s config.SmtpInfo, auth mail.Auth) (*mail.Client, errors.EdgeX) {
+	addr := s.Host + ":" + strconv.Itoa(s.Port)
+	c, err := mail.Dial(addr)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("fail to connected the SMTP server with address %s", addr), err)
+	}
+	edgexErr := startSmtpSession(c, s, addr, auth)
+	if edgexErr != nil {
+		_ = c.Close()
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return c, nil
+}
+
+func startSmtpSession(c *mail.Client, s config.SmtpInfo, addr string, auth mail.Auth) errors.EdgeX {
 	serverName, _, err := net.SplitHostPort(addr)
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
@@ -125,14 +227,19 @@ func sendEmail(s config.SmtpInfo, auth mail.Auth, to []string, msg []byte) error
 		}
 		err = c.Auth(auth)
 		if err != nil {
-			return errors.NewCommonEdgeXWrapper(err)
+			return errors.NewCommonEdgeX(errors.KindServerError, "fail to authenticate with the SMTP server", err)
 		}
 	}
-	if err = c.Mail(s.Sender); err != nil {
+	return nil
+}
+
+// sendSmtpMessage sends the message in a mail transaction of the established SMTP session
+func sendSmtpMessage(c *mail.Client, sender string, to []string, msg []byte) errors.EdgeX {
+	if err := c.Mail(sender); err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 	for _, addr := range to {
-		if err = c.Rcpt(addr); err != nil {
+		if err := c.Rcpt(addr); err != nil {
 			return errors.NewCommonEdgeXWrapper(err)
 		}
 	}
@@ -148,9 +255,5 @@ func sendEmail(s config.SmtpInfo, auth mail.Auth, to []string, msg []byte) error
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
-	err = c.Quit()
-	if err != nil {
-		return errors.NewCommonEdgeXWrapper(err)
-	}
 	return nil
 }
@@ -0,0 +1,175 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	mail "net/smtp"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+)
+
+const (
+	// secretKeyClientId is the key to read the OAuth2 client id from the secret data
+	secretKeyClientId = "clientId"
+	// secretKeyClientSecret is the key to read the OAuth2 client secret from the secret data
+	secretKeyClientSecret = "clientSecret"
+	// secretKeyRefreshToken is the key to read the OAuth2 refresh token from the secret data
+	secretKeyRefreshToken = "refreshToken"
+
+	// tokenExpiryDelta refreshes the access token a little before it expires to tolerate the clock skew and the
+	// latency of the SMTP session
+	tokenExpiryDelta = 30 * time.Second
+	// defaultTokenLifetime is used if the token endpoint doesn't tell the lifetime of the access token
+	defaultTokenLifetime = 5 * time.Minute
+)
+
+// xoauth2Auth implements the XOAUTH2 SASL mechanism used by the mail providers for the OAuth2 bearer tokens
+type xoauth2Auth struct {
+	username    string
+	accessToken string
+	host        string
+}
+
+func newXOAuth2Auth(username string, accessToken string, host string) mail.Auth {
+	return &xoauth2Auth{username: username, accessToken: accessToken, host: host}
+}
+
+func (a *xoauth2Auth) Start(server *mail.ServerInfo) (string, []byte, error) {
+	// the bearer token must not be sent in clear text, same as the PLAIN auth of the smtp package
+	if !server.TLS && !isLocalhost(server.Name) {
+		return "", nil, fmt.Errorf("unencrypted connection")
+	}
+	if server.Name != a.host {
+		return "", nil, fmt.Errorf("wrong host name")
+	}
+	resp := "user=" + a.username + "\x01auth=Bearer " + a.accessToken + "\x01\x01"
+	return "XOAUTH2", []byte(resp), nil
+}
+
+func (a *xoauth2Auth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if more {
+		// the server challenges with the error detail if the token is rejected, and the client must reply with an
+		// empty response to receive the final error reply
+		return []byte{}, nil
+	}
+	return nil, nil
+}
+
+func isLocalhost(name string) bool {
+	return name == "localhost" || name == "127.0.0.1" || name == "::1"
+}
+
+// oauth2TokenResponse is the successful response of the token endpoint defined by RFC 6749
+type oauth2TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// oauth2TokenSource fetches the access token from the token endpoint and caches it until it's about to expire
+type oauth2TokenSource struct {
+	mutex      sync.Mutex
+	httpClient *http.Client
+	// credentialKey identifies the client credentials which the cached token is issued to, so that the token is
+	// fetched again once the credentials in the secret store are changed
+	credentialKey string
+	accessToken   string
+	expiry        time.Time
+}
+
+func newOAuth2TokenSource() *oauth2TokenSource {
+	return &oauth2TokenSource{httpClient: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// token returns the cached access token, or fetches a new one from the token endpoint with the refresh token grant
+// if the refresh token exists in the secrets, otherwise with the client credentials grant. The refresh token rotated
+// by the token endpoint is returned so that the caller can store it back to the secret store.
+func (ts *oauth2TokenSource) token(info config.SmtpOAuth2Info, secrets map[string]string, now time.Time) (accessToken string, rotatedRefreshToken string, edgexErr errors.EdgeX) {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+
+	if info.TokenURL == "" {
+		return "", "", errors.NewCommonEdgeX(errors.KindContractInvalid, "the OAuth2 TokenURL is required for the XOAUTH2 auth mode", nil)
+	}
+	clientId := secrets[secretKeyClientId]
+	if clientId == "" {
+		return "", "", errors.NewCommonEdgeX(errors.KindServerError, "clientId doesn't exist for SMTP XOAUTH2 auth", nil)
+	}
+	refreshToken := secrets[secretKeyRefreshToken]
+	credentialKey := clientId + "|" + secrets[secretKeyClientSecret] + "|" + refreshToken
+	if ts.accessToken != "" && ts.credentialKey == credentialKey && now.Before(ts.expiry) {
+		return ts.accessToken, "", nil
+	}
+
+	form := url.Values{}
+	form.Set("client_id", clientId)
+	if clientSecret := secrets[secretKeyClientSecret]; clientSecret != "" {
+		form.Set("client_secret", clientSecret)
+	}
+	if refreshToken != "" {
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", refreshToken)
+	} else {
+		form.Set("grant_type", "client_credentials")
+	}
+	if len(info.Scopes) > 0 {
+		form.Set("scope", strings.Join(info.Scopes, " "))
+	}
+
+	resp, err := ts.httpClient.PostForm(info.TokenURL, form)
+	if err != nil {
+		return "", "", errors.NewCommonEdgeX(errors.KindServiceUnavailable, fmt.Sprintf("fail to request the access token from %s", info.TokenURL), err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", errors.NewCommonEdgeX(errors.KindIOError, "fail to read the token endpoint response", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", "", errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("the token endpoint responds with status code %d: %s", resp.StatusCode, string(body)), nil)
+	}
+	var tokenResp oauth2TokenResponse
+	if err = json.Unmarshal(body, &tokenResp); err != nil {
+		return "", "", errors.NewCommonEdgeX(errors.KindServerError, "fail to decode the token endpoint response", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", "", errors.NewCommonEdgeX(errors.KindServerError, "the token endpoint responds without the access token", nil)
+	}
+
+	lifetime := defaultTokenLifetime
+	if tokenResp.ExpiresIn > 0 {
+		lifetime = time.Duration(tokenResp.ExpiresIn) * time.Second
+	}
+	ts.accessToken = tokenResp.AccessToken
+	ts.expiry = now.Add(lifetime - tokenExpiryDelta)
+	ts.credentialKey = credentialKey
+	if refreshToken != "" && tokenResp.RefreshToken != "" && tokenResp.RefreshToken != refreshToken {
+		rotatedRefreshToken = tokenResp.RefreshToken
+		// the rotated refresh token will be stored to the secret store, so the cached token is still valid with it
+		ts.credentialKey = clientId + "|" + secrets[secretKeyClientSecret] + "|" + rotatedRefreshToken
+	}
+	return ts.accessToken, rotatedRefreshToken, nil
+}
+
+// invalidate drops the cached access token, e.g. the SMTP server rejects it before it's expired
+func (ts *oauth2TokenSource) invalidate() {
+	ts.mutex.Lock()
+	defer ts.mutex.Unlock()
+	ts.accessToken = ""
+}
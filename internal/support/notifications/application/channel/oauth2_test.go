@@ -0,0 +1,172 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	mail "net/smtp"
+	"net/url"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+)
+
+const testSmtpSecretName = "smtp"
+
+func TestXOAuth2Auth(t *testing.T) {
+	auth := newXOAuth2Auth("user@example.com", "token", "smtp.example.com")
+
+	proto, resp, err := auth.Start(&mail.ServerInfo{Name: "smtp.example.com", TLS: true})
+	require.NoError(t, err)
+	assert.Equal(t, "XOAUTH2", proto)
+	assert.Equal(t, "user=user@example.com\x01auth=Bearer token\x01\x01", string(resp))
+
+	resp, err = auth.Next([]byte(`{"status":"401"}`), true)
+	require.NoError(t, err)
+	assert.Empty(t, resp)
+
+	_, _, err = auth.Start(&mail.ServerInfo{Name: "smtp.example.com", TLS: false})
+	assert.Error(t, err, "the token should not be sent over the unencrypted connection")
+	_, _, err = auth.Start(&mail.ServerInfo{Name: "other.example.com", TLS: true})
+	assert.Error(t, err, "the token should not be sent to the other host")
+}
+
+func TestOAuth2TokenSource(t *testing.T) {
+	var requests []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		requests = append(requests, r.PostForm)
+		resp := oauth2TokenResponse{AccessToken: "access-" + r.PostForm.Get("grant_type"), TokenType: "Bearer", ExpiresIn: 3600}
+		if r.PostForm.Get("grant_type") == "refresh_token" {
+			resp.RefreshToken = "rotated"
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+	info := config.SmtpOAuth2Info{TokenURL: server.URL, Scopes: []string{"https://outlook.office.com/SMTP.Send", "offline_access"}}
+	now := time.Now()
+
+	t.Run("refresh token grant", func(t *testing.T) {
+		requests = nil
+		ts := newOAuth2TokenSource()
+		secrets := map[string]string{secretKeyClientId: "client", secretKeyClientSecret: "secret", secretKeyRefreshToken: "refresh"}
+
+		token, rotated, err := ts.token(info, secrets, now)
+		require.NoError(t, err)
+		assert.Equal(t, "access-refresh_token", token)
+		assert.Equal(t, "rotated", rotated)
+		require.Len(t, requests, 1)
+		assert.Equal(t, "refresh", requests[0].Get("refresh_token"))
+		assert.Equal(t, "client", requests[0].Get("client_id"))
+		assert.Equal(t, "secret", requests[0].Get("client_secret"))
+		assert.Equal(t, "https://outlook.office.com/SMTP.Send offline_access", requests[0].Get("scope"))
+
+		// the cached token is used with the rotated refresh token until it's about to expire
+		secrets[secretKeyRefreshToken] = rotated
+		token, rotated, err = ts.token(info, secrets, now.Add(time.Hour-tokenExpiryDelta-time.Second))
+		require.NoError(t, err)
+		assert.Equal(t, "access-refresh_token", token)
+		assert.Empty(t, rotated)
+		assert.Len(t, requests, 1)
+
+		_, _, err = ts.token(info, secrets, now.Add(time.Hour-tokenExpiryDelta))
+		require.NoError(t, err)
+		require.Len(t, requests, 2)
+		assert.Equal(t, "rotated", requests[1].Get("refresh_token"))
+
+		ts.invalidate()
+		_, _, err = ts.token(info, secrets, now)
+		require.NoError(t, err)
+		assert.Len(t, requests, 3)
+	})
+
+	t.Run("client credentials grant", func(t *testing.T) {
+		requests = nil
+		ts := newOAuth2TokenSource()
+		secrets := map[string]string{secretKeyClientId: "client", secretKeyClientSecret: "secret"}
+
+		token, rotated, err := ts.token(info, secrets, now)
+		require.NoError(t, err)
+		assert.Equal(t, "access-client_credentials", token)
+		assert.Empty(t, rotated)
+		require.Len(t, requests, 1)
+
+		// the token is fetched again once the credentials are changed
+		secrets[secretKeyClientSecret] = "new-secret"
+		_, _, err = ts.token(info, secrets, now)
+		require.NoError(t, err)
+		require.Len(t, requests, 2)
+		assert.Equal(t, "new-secret", requests[1].Get("client_secret"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		ts := newOAuth2TokenSource()
+		_, _, err := ts.token(config.SmtpOAuth2Info{}, map[string]string{secretKeyClientId: "client"}, now)
+		assert.Error(t, err, "TokenURL is required")
+		_, _, err = ts.token(info, map[string]string{}, now)
+		assert.Error(t, err, "clientId is required")
+	})
+}
+
+func TestDeduceAuth(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(oauth2TokenResponse{AccessToken: "token", ExpiresIn: 3600, RefreshToken: "rotated"})
+	}))
+	defer tokenServer.Close()
+
+	credentials := map[string]string{secretKeyUsername: "user", secretKeyPassword: "password"}
+	oauth2Secrets := map[string]string{secretKeyUsername: "user", secretKeyClientId: "client", secretKeyRefreshToken: "refresh"}
+	secretProvider := &mocks.SecretProvider{}
+	secretProvider.On("GetSecret", testSmtpSecretName, secretKeyUsername, secretKeyPassword).Return(credentials, nil)
+	secretProvider.On("GetSecret", testSmtpSecretName).Return(oauth2Secrets, nil)
+	secretProvider.On("StoreSecret", testSmtpSecretName, map[string]string{secretKeyUsername: "user", secretKeyClientId: "client", secretKeyRefreshToken: "rotated"}).Return(nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		bootstrapContainer.SecretProviderName: func(get di.Get) interface{} {
+			return secretProvider
+		},
+	})
+
+	tests := []struct {
+		name          string
+		authMode      string
+		expectedProto string
+		errorExpected bool
+	}{
+		{"default", "", "PLAIN", false},
+		{"username and password", "usernamepassword", "PLAIN", false},
+		{"CRAM-MD5", "CRAM-MD5", "CRAM-MD5", false},
+		{"XOAUTH2", "xoauth2", "XOAUTH2", false},
+		{"unsupported", "ntlm", "", true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := config.SmtpInfo{Host: "localhost", SecretName: testSmtpSecretName, AuthMode: testCase.authMode, OAuth2: config.SmtpOAuth2Info{TokenURL: tokenServer.URL}}
+			auth, err := deduceAuth(dic, s, newOAuth2TokenSource())
+			if testCase.errorExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			proto, _, startErr := auth.Start(&mail.ServerInfo{Name: "localhost", TLS: true, Auth: []string{testCase.expectedProto}})
+			require.NoError(t, startErr)
+			assert.Equal(t, testCase.expectedProto, proto)
+		})
+	}
+	secretProvider.AssertCalled(t, "StoreSecret", testSmtpSecretName, map[string]string{secretKeyUsername: "user", secretKeyClientId: "client", secretKeyRefreshToken: "rotated"})
+}
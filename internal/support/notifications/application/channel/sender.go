@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,7 +8,10 @@ package channel
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	mail "net/smtp"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -16,7 +19,9 @@ import (
 	zmq "github.com/pebbe/zmq4"
 
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	bootstrapInterfaces "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces"
@@ -62,37 +67,210 @@ func (sender *RESTSender) Send(notification models.Notification, address models.
 	return utils.SendRequestWithRESTAddress(lc, notification.Content, notification.ContentType, restAddress, injector)
 }
 
+// WebhookSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications to the
+// WEBHOOK channels of the subscriptions with the webhook setting
+type WebhookSender struct {
+	dic            *di.Container
+	secretProvider bootstrapInterfaces.SecretProviderExt
+	mutex          sync.Mutex
+	// clientCache stores the HTTP client of each TLS setting for reusing the connections
+	clientCache map[notificationsModels.WebhookTLS]*http.Client
+}
+
+// NewWebhookSender creates the WebhookSender instance
+func NewWebhookSender(dic *di.Container, secretProvider bootstrapInterfaces.SecretProviderExt) Sender {
+	return &WebhookSender{dic: dic, secretProvider: secretProvider, clientCache: make(map[notificationsModels.WebhookTLS]*http.Client)}
+}
+
+// Send sends the templated webhook request signed by HMAC-SHA256 to the specified address
+func (sender *WebhookSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
+	lc := container.LoggingClientFrom(sender.dic.Get)
+
+	webhookAddress, ok := address.(notificationsModels.WebhookAddress)
+	if !ok {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to WebhookAddress", nil)
+	}
+
+	req, body, err := newWebhookRequest(notification, webhookAddress)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if webhookAddress.Webhook.SecretName != "" {
+		secrets, secretErr := sender.secretProvider.GetSecret(webhookAddress.Webhook.SecretName, secretKeyWebhookHmac)
+		if secretErr != nil {
+			return "", errors.NewCommonEdgeX(errors.Kind(secretErr), "fail to retrieve the webhook signing key from the secret store", secretErr)
+		}
+		req.Header.Set(signatureHeader(webhookAddress.Webhook), signWebhookBody(secrets[secretKeyWebhookHmac], body))
+	}
+	if webhookAddress.InjectEdgeXAuth {
+		if authErr := secret.NewJWTSecretProvider(sender.secretProvider).AddAuthenticationData(req); authErr != nil {
+			return "", errors.NewCommonEdgeXWrapper(authErr)
+		}
+	}
+
+	client, err := sender.prepareHttpClient(webhookAddress.Webhook.TLS)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	res, err = utils.SendRequestAndGetResponse(client, req)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("success to send webhook request with address %v", webhookAddress.BaseAddress)
+	return res, nil
+}
+
 // EmailSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications via email
 type EmailSender struct {
-	dic *di.Container
+	dic   *di.Container
+	mutex sync.Mutex
+	// session is the SMTP session kept open for sending the subsequent emails if the connection reuse is enabled
+	session *smtpSession
+	// tokenSource fetches and caches the access token for the XOAUTH2 auth mode
+	tokenSource *oauth2TokenSource
+}
+
+// smtpSession is the established SMTP session for reusing
+type smtpSession struct {
+	client *mail.Client
+	// addr is the SMTP server address which the session connects to
+	addr string
+	// sent is the number of emails sent over the session
+	sent      int
+	idleTimer *time.Timer
 }
 
 // NewEmailSender creates the EmailSender instance
-func NewEmailSender(dic *di.Container) Sender {
-	return &EmailSender{dic: dic}
+func NewEmailSender(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) Sender {
+	sender := &EmailSender{dic: dic, tokenSource: newOAuth2TokenSource()}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-ctx.Done()
+		sender.mutex.Lock()
+		defer sender.mutex.Unlock()
+		sender.closeSession()
+	}()
+	return sender
 }
 
 // Send sends the email to the specified address
 func (sender *EmailSender) Send(notification models.Notification, address models.Address) (res string, err errors.EdgeX) {
 	smtpInfo := notificationContainer.ConfigurationFrom(sender.dic.Get).Smtp
 
-	emailAddress, ok := address.(models.EmailAddress)
-	if !ok {
+	var msg []byte
+	var emailAddress models.EmailAddress
+	switch a := address.(type) {
+	case models.EmailAddress:
+		emailAddress = a
+		msg = buildSmtpMessage(notification.Sender, smtpInfo.Subject, emailAddress.Recipients, notification.ContentType, notification.Content)
+	case notificationsModels.TemplatedEmailAddress:
+		emailAddress = a.EmailAddress
+		subject := a.Subject
+		if subject == "" {
+			subject = smtpInfo.Subject
+		}
+		if a.HTMLBody == "" {
+			msg = buildSmtpMessage(notification.Sender, subject, emailAddress.Recipients, notification.ContentType, notification.Content)
+		} else {
+			msg = buildSmtpAlternativeMessage(notification.Sender, subject, emailAddress.Recipients, notification.Content, a.HTMLBody)
+		}
+	default:
 		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to EmailAddress", nil)
 	}
 
-	msg := buildSmtpMessage(notification.Sender, smtpInfo.Subject, emailAddress.Recipients, notification.ContentType, notification.Content)
-	auth, err := deduceAuth(sender.dic, smtpInfo)
-	if err != nil {
-		return "", errors.NewCommonEdgeXWrapper(err)
+	if smtpInfo.ConnectionReuse.Enabled {
+		err = sender.sendWithSession(smtpInfo, emailAddress.Recipients, msg)
+	} else {
+		var auth mail.Auth
+		auth, err = deduceAuth(sender.dic, smtpInfo, sender.tokenSource)
+		if err != nil {
+			return "", errors.NewCommonEdgeXWrapper(err)
+		}
+		err = sendEmail(smtpInfo, auth, emailAddress.Recipients, msg)
 	}
-	err = sendEmail(smtpInfo, auth, emailAddress.Recipients, msg)
 	if err != nil {
+		if strings.EqualFold(smtpInfo.AuthMode, authModeXOAuth2) {
+			// the access token may be revoked before it expires, fetch a new one for the next attempt
+			sender.tokenSource.invalidate()
+		}
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
 	return "", nil
 }
 
+// sendWithSession sends the email over the reused SMTP session, the session is opened again if it's closed by the
+// server, exceeds the MaxMessages, or the SMTP server address is changed
+func (sender *EmailSender) sendWithSession(s config.SmtpInfo, to []string, msg []byte) errors.EdgeX {
+	lc := container.LoggingClientFrom(sender.dic.Get)
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+
+	addr := s.Host + ":" + strconv.Itoa(s.Port)
+	if sender.session != nil {
+		maxMessages := s.ConnectionReuse.MaxMessages
+		if sender.session.addr != addr || (maxMessages > 0 && sender.session.sent >= maxMessages) || sender.session.client.Noop() != nil {
+			sender.closeSession()
+		}
+	}
+	if sender.session == nil {
+		auth, err := deduceAuth(sender.dic, s, sender.tokenSource)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		client, err := openSmtpSession(s, auth)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		sender.session = &smtpSession{client: client, addr: addr}
+		lc.Debugf("opened the SMTP session with %s for reusing", addr)
+	}
+
+	err := sendSmtpMessage(sender.session.client, s.Sender, to, msg)
+	if err != nil {
+		sender.closeSession()
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	sender.session.sent++
+
+	idleTimeout := defaultSmtpIdleTimeout
+	if s.ConnectionReuse.IdleTimeout != "" {
+		d, parseErr := time.ParseDuration(s.ConnectionReuse.IdleTimeout)
+		if parseErr != nil || d <= 0 {
+			lc.Warnf("invalid SMTP ConnectionReuse IdleTimeout '%s', use the default value %v", s.ConnectionReuse.IdleTimeout, defaultSmtpIdleTimeout)
+		} else {
+			idleTimeout = d
+		}
+	}
+	session := sender.session
+	if session.idleTimer != nil {
+		session.idleTimer.Stop()
+	}
+	session.idleTimer = time.AfterFunc(idleTimeout, func() {
+		sender.mutex.Lock()
+		defer sender.mutex.Unlock()
+		// the session may be replaced while the timer is firing
+		if sender.session == session {
+			sender.closeSession()
+		}
+	})
+	return nil
+}
+
+// closeSession quits the reused SMTP session, the caller must hold the mutex
+func (sender *EmailSender) closeSession() {
+	if sender.session == nil {
+		return
+	}
+	if sender.session.idleTimer != nil {
+		sender.session.idleTimer.Stop()
+	}
+	if err := sender.session.client.Quit(); err != nil {
+		_ = sender.session.client.Close()
+	}
+	sender.session = nil
+}
+
 // MQTTSender is the implementation of the interfaces.ChannelSender, which is used to send the notifications via MQTT broker
 type MQTTSender struct {
 	dic   *di.Container
@@ -0,0 +1,219 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	// DefaultWebhookSignatureHeader is the header carrying the HMAC signature if the webhook doesn't specify one
+	DefaultWebhookSignatureHeader = "X-EdgeX-Signature-256"
+	// webhookSignaturePrefix prefixes the hex encoded HMAC-SHA256 signature of the request body
+	webhookSignaturePrefix = "sha256="
+	// secretKeyWebhookHmac is the key to read the HMAC signing key from the secret data
+	secretKeyWebhookHmac = "hmacKey"
+	// secretKeyCACert is the key to read the PEM encoded CA certificate from the secret data
+	secretKeyCACert = "caCert"
+	// secretKeyClientCert is the key to read the PEM encoded client certificate from the secret data
+	secretKeyClientCert = "clientCert"
+	// secretKeyClientKey is the key to read the PEM encoded client private key from the secret data
+	secretKeyClientKey = "clientKey"
+	// defaultWebhookTimeout is the timeout of the webhook requests if the Service.RequestTimeout is invalid
+	defaultWebhookTimeout = 5 * time.Second
+)
+
+var webhookTemplateFuncs = template.FuncMap{
+	// json encodes the value as JSON, e.g. {{json .Content}} renders the content as a quoted and escaped JSON string
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// ValidateWebhook checks the body template and the TLS setting of the webhook
+func ValidateWebhook(w notificationsModels.Webhook) errors.EdgeX {
+	if _, err := parseWebhookTemplate(w.BodyTemplate); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if _, err := tlsVersion(w.TLS.MinVersion); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for name := range w.Headers {
+		if http.CanonicalHeaderKey(name) == http.CanonicalHeaderKey(signatureHeader(w)) {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("header %s is reserved for the signature", name), nil)
+		}
+	}
+	return nil
+}
+
+func parseWebhookTemplate(text string) (*template.Template, errors.EdgeX) {
+	tmpl, err := template.New("webhook").Funcs(webhookTemplateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the webhook body template", err)
+	}
+	return tmpl, nil
+}
+
+// renderWebhookBody executes the body template with the notification, the notification content is returned as is if the
+// template is empty
+func renderWebhookBody(text string, n models.Notification) ([]byte, errors.EdgeX) {
+	if text == "" {
+		return []byte(n.Content), nil
+	}
+	tmpl, err := parseWebhookTemplate(text)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	var buf bytes.Buffer
+	if execErr := tmpl.Execute(&buf, dtos.FromNotificationModelToDTO(n)); execErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to execute the webhook body template", execErr)
+	}
+	return buf.Bytes(), nil
+}
+
+// signWebhookBody returns the signature header value of the body signed by HMAC-SHA256 with the key
+func signWebhookBody(key string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(body)
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+func signatureHeader(w notificationsModels.Webhook) string {
+	if w.SignatureHeader == "" {
+		return DefaultWebhookSignatureHeader
+	}
+	return w.SignatureHeader
+}
+
+func tlsVersion(version string) (uint16, errors.EdgeX) {
+	switch version {
+	case "", "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	default:
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported TLS version %s, must be 1.2 or 1.3", version), nil)
+	}
+}
+
+// newWebhookRequest creates the webhook request of the notification with the rendered body and the custom headers
+func newWebhookRequest(n models.Notification, address notificationsModels.WebhookAddress) (*http.Request, []byte, errors.EdgeX) {
+	body, err := renderWebhookBody(address.Webhook.BodyTemplate, n)
+	if err != nil {
+		return nil, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	scheme := address.Scheme
+	if scheme == "" {
+		scheme = common.HTTP
+	}
+	method := address.HTTPMethod
+	if method == "" {
+		method = http.MethodPost
+	}
+	url := fmt.Sprintf("%s://%s:%d%s", scheme, address.Host, address.Port, address.Path)
+	req, reqErr := http.NewRequest(method, url, bytes.NewReader(body))
+	if reqErr != nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindServerError, "fail to create the webhook request", reqErr)
+	}
+
+	contentType := address.Webhook.ContentType
+	if contentType == "" {
+		contentType = n.ContentType
+	}
+	if contentType == "" {
+		contentType = common.ContentTypeJSON
+	}
+	req.Header.Set(common.ContentType, contentType)
+	for name, value := range address.Webhook.Headers {
+		req.Header.Set(name, value)
+	}
+	return req, body, nil
+}
+
+// webhookTLSConfig creates the TLS client config of the webhook, the certificates are read from the secret store
+func (sender *WebhookSender) webhookTLSConfig(setting notificationsModels.WebhookTLS) (*tls.Config, errors.EdgeX) {
+	minVersion, err := tlsVersion(setting.MinVersion)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: setting.InsecureSkipVerify, // nolint:gosec
+		ServerName:         setting.ServerName,
+		MinVersion:         minVersion,
+	}
+	if setting.SecretName == "" {
+		return tlsConfig, nil
+	}
+
+	secrets, secretErr := sender.secretProvider.GetSecret(setting.SecretName)
+	if secretErr != nil {
+		return nil, errors.NewCommonEdgeX(errors.Kind(secretErr), "fail to retrieve the webhook TLS secrets from the secret store", secretErr)
+	}
+	if caCert, ok := secrets[secretKeyCACert]; ok && caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCert)) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the webhook CA certificate", nil)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	clientCert, certOk := secrets[secretKeyClientCert]
+	clientKey, keyOk := secrets[secretKeyClientKey]
+	if certOk && keyOk {
+		cert, certErr := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if certErr != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the webhook client certificate and key", certErr)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// prepareHttpClient returns the cached HTTP client of the TLS setting, the client is created with the TLS config and the
+// Service.RequestTimeout if it's not cached
+func (sender *WebhookSender) prepareHttpClient(setting notificationsModels.WebhookTLS) (*http.Client, errors.EdgeX) {
+	sender.mutex.Lock()
+	defer sender.mutex.Unlock()
+
+	if client, ok := sender.clientCache[setting]; ok {
+		return client, nil
+	}
+	tlsConfig, err := sender.webhookTLSConfig(setting)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	timeout := defaultWebhookTimeout
+	requestTimeout := notificationContainer.ConfigurationFrom(sender.dic.Get).Service.RequestTimeout
+	if d, parseErr := time.ParseDuration(requestTimeout); parseErr != nil || d <= 0 {
+		container.LoggingClientFrom(sender.dic.Get).Warnf("invalid Service RequestTimeout '%s', use the default value %v for the webhook requests", requestTimeout, defaultWebhookTimeout)
+	} else {
+		timeout = d
+	}
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}, Timeout: timeout}
+	sender.clientCache[setting] = client
+	return client, nil
+}
@@ -0,0 +1,124 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package channel
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/interfaces/mocks"
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const testWebhookSecretName = "webhook"
+
+func TestWebhookSender_Send(t *testing.T) {
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	secretProvider := &mocks.SecretProviderExt{}
+	secretProvider.On("GetSecret", testWebhookSecretName, secretKeyWebhookHmac).Return(map[string]string{secretKeyWebhookHmac: "key"}, nil)
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		notificationContainer.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Service: bootstrapConfig.ServiceInfo{RequestTimeout: "5s"}}
+		},
+	})
+	sender := NewWebhookSender(dic, secretProvider)
+
+	notification := models.Notification{Id: "id", Category: "health-check", Severity: models.Critical, Content: `disk "sda" is full`}
+	address := notificationsModels.WebhookAddress{
+		RESTAddress: models.RESTAddress{
+			BaseAddress: models.BaseAddress{Type: common.REST, Host: host, Port: portNum},
+			Path:        "/hook",
+			HTTPMethod:  http.MethodPost,
+		},
+		Webhook: notificationsModels.Webhook{
+			BodyTemplate: `{"text":{{json .Content}},"severity":"{{.Severity}}"}`,
+			Headers:      map[string]string{"X-Source": "edgex"},
+			SecretName:   testWebhookSecretName,
+		},
+	}
+	expectedBody := `{"text":"disk \"sda\" is full","severity":"CRITICAL"}`
+
+	res, err := sender.Send(notification, address)
+	require.NoError(t, err)
+
+	assert.Equal(t, "ok", res)
+	require.NotNil(t, received)
+	assert.Equal(t, "/hook", received.URL.Path)
+	assert.Equal(t, expectedBody, string(receivedBody))
+	assert.Equal(t, "edgex", received.Header.Get("X-Source"))
+	assert.Equal(t, common.ContentTypeJSON, received.Header.Get(common.ContentType))
+	assert.Equal(t, signWebhookBody("key", []byte(expectedBody)), received.Header.Get(DefaultWebhookSignatureHeader))
+
+	// the HTTP client is reused by the subsequent requests with the same TLS setting
+	webhookSender := sender.(*WebhookSender)
+	require.Len(t, webhookSender.clientCache, 1)
+	client := webhookSender.clientCache[address.Webhook.TLS]
+	assert.Equal(t, 5*time.Second, client.Timeout)
+	_, err = sender.Send(notification, address)
+	require.NoError(t, err)
+	require.Len(t, webhookSender.clientCache, 1)
+	assert.Same(t, client, webhookSender.clientCache[address.Webhook.TLS])
+}
+
+func TestSignWebhookBody(t *testing.T) {
+	// the expected signature is computed by: echo -n 'body' | openssl dgst -sha256 -hmac 'key'
+	assert.Equal(t, "sha256=515aae133b435d4000956731f68ae5cf5eb85d4f0dc6a546d2bfcd3595ec1ae1", signWebhookBody("key", []byte("body")))
+}
+
+func TestValidateWebhook(t *testing.T) {
+	tests := []struct {
+		name          string
+		webhook       notificationsModels.Webhook
+		expectedError bool
+	}{
+		{"valid", notificationsModels.Webhook{BodyTemplate: `{"text":{{json .Content}}}`, TLS: notificationsModels.WebhookTLS{MinVersion: "1.3"}}, false},
+		{"valid - empty template", notificationsModels.Webhook{}, false},
+		{"invalid - template syntax", notificationsModels.Webhook{BodyTemplate: `{{.Content`}, true},
+		{"invalid - TLS version", notificationsModels.Webhook{TLS: notificationsModels.WebhookTLS{MinVersion: "1.0"}}, true},
+		{"invalid - signature header overridden", notificationsModels.Webhook{Headers: map[string]string{"x-edgex-signature-256": "fake"}}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := ValidateWebhook(testCase.webhook)
+			if testCase.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
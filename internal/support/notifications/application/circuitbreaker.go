@@ -0,0 +1,121 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+)
+
+const defaultCircuitBreakerCoolDown = time.Minute
+
+// destinationCircuits keeps the circuit breakers of the destination addresses
+var destinationCircuits = newCircuitBreakers()
+
+type circuitState int
+
+const (
+	// circuitClosed sends the transmissions and counts the consecutive failures
+	circuitClosed circuitState = iota
+	// circuitOpen fails the transmissions fast until the cool-down passes
+	circuitOpen
+	// circuitHalfOpen lets a single probe transmission through and fails the others fast
+	circuitHalfOpen
+)
+
+type circuit struct {
+	state    circuitState
+	failures int
+	openedAt time.Time
+}
+
+// circuitBreakers keeps the circuits in memory by the destination, the destination is known down once the consecutive
+// failures reach the threshold, and it is probed again after the cool-down
+type circuitBreakers struct {
+	mutex    sync.Mutex
+	circuits map[string]*circuit
+}
+
+func newCircuitBreakers() *circuitBreakers {
+	return &circuitBreakers{circuits: make(map[string]*circuit)}
+}
+
+// allow tells whether the transmission to the destination should be sent, the open circuit becomes half-open after the
+// cool-down and lets the transmission through as the probe
+func (cb *circuitBreakers) allow(destination string, coolDown time.Duration, now time.Time) bool {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	c, ok := cb.circuits[destination]
+	if !ok {
+		return true
+	}
+	switch c.state {
+	case circuitOpen:
+		if now.Sub(c.openedAt) < coolDown {
+			return false
+		}
+		c.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		// the probe is in flight
+		return false
+	default:
+		return true
+	}
+}
+
+// record records the result of the transmission to the destination, true is returned if the circuit opens
+func (cb *circuitBreakers) record(destination string, success bool, threshold int, now time.Time) bool {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	if success {
+		delete(cb.circuits, destination)
+		return false
+	}
+	c, ok := cb.circuits[destination]
+	if !ok {
+		c = &circuit{}
+		cb.circuits[destination] = c
+	}
+	c.failures++
+	if c.state == circuitHalfOpen || (c.state == circuitClosed && c.failures >= threshold) {
+		c.state = circuitOpen
+		c.openedAt = now
+		return true
+	}
+	return false
+}
+
+// destinationOf returns the destination of the address guarded by a circuit breaker, the email addresses share the
+// destination of the SMTP server
+func destinationOf(config *config.ConfigurationStruct, address models.Address) string {
+	base := address.GetBaseAddress()
+	if base.Type == common.EMAIL {
+		return fmt.Sprintf("%s://%s:%d", base.Type, config.Smtp.Host, config.Smtp.Port)
+	}
+	return fmt.Sprintf("%s://%s:%d", base.Type, base.Host, base.Port)
+}
+
+// circuitBreakerSetting returns the failure threshold and the cool-down of the circuit breakers, the circuit breakers
+// are disabled if the threshold is not positive
+func circuitBreakerSetting(config *config.ConfigurationStruct) (int, time.Duration, errors.EdgeX) {
+	coolDown := defaultCircuitBreakerCoolDown
+	if config.Transmission.CircuitBreaker.CoolDown != "" {
+		var err error
+		coolDown, err = time.ParseDuration(config.Transmission.CircuitBreaker.CoolDown)
+		if err != nil {
+			return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse Transmission.CircuitBreaker.CoolDown %s", config.Transmission.CircuitBreaker.CoolDown), err)
+		}
+	}
+	return config.Transmission.CircuitBreaker.FailureThreshold, coolDown, nil
+}
@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreakers(t *testing.T) {
+	destination := "REST://localhost:8080"
+	threshold := 3
+	coolDown := time.Minute
+	now := time.Now()
+	cb := newCircuitBreakers()
+
+	// the consecutive failures below the threshold keep the circuit closed, and a success resets the count
+	assert.False(t, cb.record(destination, false, threshold, now))
+	assert.False(t, cb.record(destination, false, threshold, now))
+	assert.False(t, cb.record(destination, true, threshold, now))
+	assert.False(t, cb.record(destination, false, threshold, now))
+	assert.False(t, cb.record(destination, false, threshold, now))
+	assert.True(t, cb.allow(destination, coolDown, now))
+
+	// the circuit opens at the threshold and fails fast until the cool-down passes
+	assert.True(t, cb.record(destination, false, threshold, now))
+	assert.False(t, cb.allow(destination, coolDown, now.Add(coolDown-time.Second)))
+	assert.True(t, cb.allow("REST://other:8080", coolDown, now), "the other destinations are not affected")
+
+	// a single probe is let through after the cool-down, and the failed probe opens the circuit again
+	probeAt := now.Add(coolDown)
+	assert.True(t, cb.allow(destination, coolDown, probeAt))
+	assert.False(t, cb.allow(destination, coolDown, probeAt), "only one probe is in flight")
+	assert.True(t, cb.record(destination, false, threshold, probeAt))
+	assert.False(t, cb.allow(destination, coolDown, probeAt.Add(time.Second)))
+
+	// the successful probe closes the circuit
+	probeAt = probeAt.Add(coolDown)
+	assert.True(t, cb.allow(destination, coolDown, probeAt))
+	assert.False(t, cb.record(destination, true, threshold, probeAt))
+	assert.True(t, cb.allow(destination, coolDown, probeAt))
+	assert.True(t, cb.allow(destination, coolDown, probeAt))
+}
@@ -0,0 +1,197 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// the notification fields which may compose the fingerprint
+const (
+	fingerprintCategory = "category"
+	fingerprintLabels   = "labels"
+	fingerprintSender   = "sender"
+	fingerprintSeverity = "severity"
+	fingerprintContent  = "content"
+)
+
+// dedupLocks serializes the deduplication per fingerprint so that the concurrent repeats are counted on the same
+// notification
+var dedupLocks = newKeyedMutex()
+
+// ValidateDedupConfig checks the suppression window and the fingerprint fields of the deduplication
+func ValidateDedupConfig(dedup config.DedupInfo) errors.EdgeX {
+	if !dedup.Enabled {
+		return nil
+	}
+	window, err := time.ParseDuration(dedup.Window)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse Dedup.Window %s", dedup.Window), err)
+	}
+	if window <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Dedup.Window must be positive", nil)
+	}
+	if len(dedup.Fingerprint) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Dedup.Fingerprint must not be empty", nil)
+	}
+	for _, field := range dedup.Fingerprint {
+		switch strings.ToLower(field) {
+		case fingerprintCategory, fingerprintLabels, fingerprintSender, fingerprintSeverity, fingerprintContent:
+		default:
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported Dedup.Fingerprint field %s", field), nil)
+		}
+	}
+	return nil
+}
+
+// fingerprint hashes the specified fields of the notification, the labels are compared regardless of the order
+func fingerprint(fields []string, n models.Notification) string {
+	hash := sha256.New()
+	for _, field := range fields {
+		field = strings.ToLower(field)
+		var value string
+		switch field {
+		case fingerprintCategory:
+			value = n.Category
+		case fingerprintLabels:
+			labels := slices.Clone(n.Labels)
+			slices.Sort(labels)
+			value = strings.Join(labels, ",")
+		case fingerprintSender:
+			value = n.Sender
+		case fingerprintSeverity:
+			value = string(n.Severity)
+		case fingerprintContent:
+			contentHash := sha256.Sum256([]byte(n.Content))
+			value = hex.EncodeToString(contentHash[:])
+		}
+		// the length prefix keeps the fields apart, e.g. category "ab" with sender "c" and category "a" with sender "bc"
+		_, _ = fmt.Fprintf(hash, "%s:%d:%s;", field, len(value), value)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// countRepeat increments the occurrence count of the existing notification if the notification repeats in the
+// suppression window, the id of the existing notification is returned, or an empty id if the notification should be
+// added as a new one
+func countRepeat(dic *di.Container, fp string, now time.Time) (string, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	window, err := time.ParseDuration(container.ConfigurationFrom(dic.Get).Dedup.Window)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse Dedup.Window", err)
+	}
+
+	o, edgeXerr := dbClient.NotificationOccurrenceByFingerprint(fp)
+	if edgeXerr != nil {
+		if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+			return "", nil
+		}
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	if !now.Before(time.UnixMilli(o.FirstSeen).Add(window)) {
+		return "", nil
+	}
+	// the notification may have been deleted in the window
+	_, edgeXerr = dbClient.NotificationById(o.NotificationId)
+	if edgeXerr != nil {
+		if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+			return "", nil
+		}
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	o.Count++
+	o.LastSeen = now.UnixMilli()
+	edgeXerr = dbClient.UpsertNotificationOccurrence(o)
+	if edgeXerr != nil {
+		return "", errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	return o.NotificationId, nil
+}
+
+// addDeduplicatedNotification adds the notification unless it repeats in the suppression window, in which case only the
+// occurrence count of the existing notification is incremented and the notification isn't distributed again
+func addDeduplicatedNotification(n models.Notification, ctx context.Context, dic *di.Container) (string, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	fp := fingerprint(container.ConfigurationFrom(dic.Get).Dedup.Fingerprint, n)
+	now := time.Now()
+
+	unlock := dedupLocks.lock(fp)
+	defer unlock()
+
+	id, err := countRepeat(dic, fp, now)
+	if err != nil {
+		// the notification is never lost because of the deduplication failure
+		lc.Errorf("fail to deduplicate the notification, add it as a new notification: %v", err)
+	} else if id != "" {
+		lc.Debugf("Notification repeats in the suppression window, counted on the notification %s. Correlation-ID: %s", id, correlation.FromContext(ctx))
+		return id, nil
+	}
+
+	addedNotification, err := dbClient.AddNotification(n)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.UpsertNotificationOccurrence(notificationsModels.NotificationOccurrence{
+		Fingerprint:    fp,
+		NotificationId: addedNotification.Id,
+		Count:          1,
+		FirstSeen:      now.UnixMilli(),
+		LastSeen:       now.UnixMilli(),
+	})
+	if err != nil {
+		lc.Errorf("fail to record the occurrence of notification %s, its repeats won't be deduplicated: %v", addedNotification.Id, err)
+	}
+
+	lc.Debugf("Notification created on DB successfully. Notification ID: %s, Correlation-ID: %s ",
+		addedNotification.Id,
+		correlation.FromContext(ctx))
+
+	go distribute(dic, addedNotification) // nolint:errcheck
+
+	return addedNotification.Id, nil
+}
+
+// NotificationOccurrenceById queries the occurrence of the notification, the notification which has never repeated
+// has one occurrence
+func NotificationOccurrenceById(id string, dic *di.Container) (dtos.NotificationOccurrence, errors.EdgeX) {
+	if id == "" {
+		return dtos.NotificationOccurrence{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "id is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	o, err := dbClient.NotificationOccurrenceByNotificationId(id)
+	if err == nil {
+		return dtos.FromNotificationOccurrenceModelToDTO(o), nil
+	}
+	if errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return dtos.NotificationOccurrence{}, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// the notification added without deduplication
+	n, err := dbClient.NotificationById(id)
+	if err != nil {
+		return dtos.NotificationOccurrence{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.NotificationOccurrence{NotificationId: n.Id, Count: 1, FirstSeen: n.Created, LastSeen: n.Created}, nil
+}
@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestFingerprint(t *testing.T) {
+	fields := []string{"category", "labels", "sender", "content"}
+	n := notification
+	n.Labels = []string{"disk", "critical"}
+
+	reorderedLabels := n
+	reorderedLabels.Labels = []string{"critical", "disk"}
+	otherSeverity := n
+	otherSeverity.Severity = models.Minor
+	otherContent := n
+	otherContent.Content = "other"
+	otherSender := n
+	otherSender.Sender = "senderB"
+	shiftedFields := n
+	shiftedFields.Category = n.Category + n.Sender[:1]
+	shiftedFields.Sender = n.Sender[1:]
+
+	tests := []struct {
+		name         string
+		notification models.Notification
+		expectedSame bool
+	}{
+		{"labels in different order", reorderedLabels, true},
+		{"different severity not in the fingerprint", otherSeverity, true},
+		{"different content", otherContent, false},
+		{"different sender", otherSender, false},
+		{"characters shifted between fields", shiftedFields, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expectedSame, fingerprint(fields, n) == fingerprint(fields, testCase.notification))
+		})
+	}
+}
+
+func TestAddDeduplicatedNotification(t *testing.T) {
+	existingId := "e0ab4f2a-e1c8-4a2b-8b0e-54b8e6c1c3d1"
+	addedId := "9f3a8b7e-1d2c-4e5f-a6b7-c8d9e0f1a2b3"
+	fields := []string{"category", "labels", "sender", "content"}
+	fp := fingerprint(fields, notification)
+	now := time.Now()
+	added := notification
+	added.Id = addedId
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+	tests := []struct {
+		name              string
+		occurrence        notificationsModels.NotificationOccurrence
+		occurrenceErr     errors.EdgeX
+		existingErr       errors.EdgeX
+		expectedId        string
+		expectedCount     int64
+		expectedFirstSeen bool
+	}{
+		{"first occurrence", notificationsModels.NotificationOccurrence{}, notFound, nil, addedId, 1, true},
+		{"repeat in the window", notificationsModels.NotificationOccurrence{Fingerprint: fp, NotificationId: existingId, Count: 3, FirstSeen: now.Add(-time.Minute).UnixMilli()}, nil, nil, existingId, 4, false},
+		{"repeat after the window", notificationsModels.NotificationOccurrence{Fingerprint: fp, NotificationId: existingId, Count: 3, FirstSeen: now.Add(-10 * time.Minute).UnixMilli()}, nil, nil, addedId, 1, true},
+		{"existing notification deleted", notificationsModels.NotificationOccurrence{Fingerprint: fp, NotificationId: existingId, Count: 3, FirstSeen: now.Add(-time.Minute).UnixMilli()}, nil, notFound, addedId, 1, true},
+		{"deduplication failure", notificationsModels.NotificationOccurrence{}, errors.NewCommonEdgeX(errors.KindDatabaseError, "db error", nil), nil, addedId, 1, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic()
+			container.ConfigurationFrom(dic.Get).Dedup = config.DedupInfo{Enabled: true, Window: "5m", Fingerprint: fields}
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("NotificationOccurrenceByFingerprint", fp).Return(testCase.occurrence, testCase.occurrenceErr)
+			dbClientMock.On("NotificationById", existingId).Return(models.Notification{Id: existingId}, testCase.existingErr)
+			dbClientMock.On("AddNotification", notification).Return(added, nil)
+			dbClientMock.On("UpsertNotificationOccurrence", mock.Anything).Return(nil)
+			dbClientMock.On("SubscriptionsByCategoriesAndLabels", 0, -1, mock.Anything, mock.Anything).Return([]models.Subscription{}, nil)
+			dbClientMock.On("UpdateNotification", mock.Anything).Return(nil)
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+			})
+
+			id, err := AddNotification(notification, context.Background(), dic)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedId, id)
+
+			if testCase.expectedId == existingId {
+				dbClientMock.AssertNotCalled(t, "AddNotification", mock.Anything)
+			}
+			dbClientMock.AssertCalled(t, "UpsertNotificationOccurrence", mock.MatchedBy(func(o notificationsModels.NotificationOccurrence) bool {
+				firstSeenUpdated := o.FirstSeen >= now.UnixMilli()
+				return o.Fingerprint == fp && o.NotificationId == testCase.expectedId && o.Count == testCase.expectedCount &&
+					firstSeenUpdated == testCase.expectedFirstSeen && o.LastSeen >= now.UnixMilli()
+			}))
+		})
+	}
+}
@@ -0,0 +1,255 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	// DigestCategory is the category of the digest combining the notifications of different categories
+	DigestCategory = "digest"
+
+	defaultDigestPollInterval = 5 * time.Second
+)
+
+// digestLocks serializes the digest claiming per subscription so that the accumulated notifications are sent only once
+var digestLocks = newKeyedMutex()
+
+// validateDigest checks the window and the max items of the digest
+func validateDigest(d notificationsModels.Digest) errors.EdgeX {
+	window, err := time.ParseDuration(d.Window)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the digest window %s", d.Window), err)
+	}
+	if window <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the digest window must be positive", nil)
+	}
+	if d.MaxItems < 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the digest max items must not be negative", nil)
+	}
+	return nil
+}
+
+// accumulateDigest adds the non-critical notification to the pending digest of the subscription, false is returned if
+// the subscription has no digest setting and the notification should be sent immediately
+func accumulateDigest(dic *di.Container, n models.Notification, sub models.Subscription, ext notificationsModels.SubscriptionExtension) (bool, errors.EdgeX) {
+	if n.Severity == models.Critical || ext.Digest == nil {
+		return false, nil
+	}
+	_, err := container.DBClientFrom(dic.Get).AddDigestItem(notificationsModels.DigestItem{SubscriptionName: sub.Name, Notification: n})
+	if err != nil {
+		return false, errors.NewCommonEdgeXWrapper(err)
+	}
+	return true, nil
+}
+
+// processDigest sends the pending digest of the subscription if the window is closed, the accumulated notifications
+// are sent in batches of the max items once the max items is reached
+func processDigest(dic *di.Container, subscriptionName string, d notificationsModels.Digest, now time.Time) errors.EdgeX {
+	window, err := time.ParseDuration(d.Window)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the digest window %s", d.Window), err)
+	}
+
+	sub, digests, edgeXerr := claimDigests(dic, subscriptionName, func(items []notificationsModels.DigestItem) [][]notificationsModels.DigestItem {
+		var batches [][]notificationsModels.DigestItem
+		for len(items) > 0 {
+			batch := items
+			if d.MaxItems > 0 && len(items) >= d.MaxItems {
+				batch = items[:d.MaxItems]
+			} else if now.Before(time.UnixMilli(items[0].Created).Add(window)) {
+				// the window of the earliest item is still open
+				break
+			}
+			batches = append(batches, batch)
+			items = items[len(batch):]
+		}
+		return batches
+	})
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+	transmitDigests(dic, sub, digests)
+	return nil
+}
+
+// claimDigests combines the batches of the pending digest items of the subscription into the digest notifications and
+// removes the combined items under the lock of the subscription, so that the accumulated notifications are claimed only
+// once and the digests can be transmitted outside the lock
+func claimDigests(dic *di.Container, subscriptionName string, batchesOf func([]notificationsModels.DigestItem) [][]notificationsModels.DigestItem) (models.Subscription, []models.Notification, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	unlock := digestLocks.lock(subscriptionName)
+	defer unlock()
+
+	items, err := dbClient.DigestItemsBySubscriptionName(subscriptionName)
+	if err != nil {
+		return models.Subscription{}, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	batches := batchesOf(items)
+	if len(batches) == 0 {
+		return models.Subscription{}, nil, nil
+	}
+
+	sub, err := dbClient.SubscriptionByName(subscriptionName)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return models.Subscription{}, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	deliverable := err == nil && sub.AdminState != models.Locked
+
+	var digests []models.Notification
+	for _, batch := range batches {
+		if deliverable {
+			n, err := dbClient.AddNotification(digestNotification(batch))
+			if err != nil {
+				return sub, digests, errors.NewCommonEdgeXWrapper(err)
+			}
+			digests = append(digests, n)
+		} else {
+			lc.Debugf("subscription %s is not found or locked, drop the %d notifications of the digest", subscriptionName, len(batch))
+		}
+
+		ids := make([]string, len(batch))
+		for i, item := range batch {
+			ids[i] = item.Id
+		}
+		err = dbClient.DeleteDigestItemsByIds(ids)
+		if err != nil {
+			return sub, digests, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return sub, digests, nil
+}
+
+// transmitDigests sends the digest notifications to the channels of the subscription
+func transmitDigests(dic *di.Container, sub models.Subscription, digests []models.Notification) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	for _, n := range digests {
+		for _, address := range sub.Channels {
+			_, err := transmit(dic, n, sub, address)
+			if err != nil {
+				lc.Errorf("fail to transmit the digest %s to the subscription %s with address %v, err: %v", n.Id, sub.Name, address.GetBaseAddress(), err)
+			}
+		}
+		lc.Debugf("sent the digest %s to the subscription %s", n.Id, sub.Name)
+	}
+}
+
+// digestNotification combines the accumulated notifications into one notification, which has the common category of
+// the notifications, the labels of all notifications and the highest severity
+func digestNotification(items []notificationsModels.DigestItem) models.Notification {
+	digest := models.Notification{
+		Sender:      common.SupportNotificationsServiceKey,
+		Category:    items[0].Notification.Category,
+		Severity:    models.Normal,
+		ContentType: common.ContentTypeText,
+		Description: fmt.Sprintf("Digest of %d notifications", len(items)),
+		Status:      models.Processed,
+	}
+
+	var content strings.Builder
+	content.WriteString(digest.Description)
+	for _, item := range items {
+		n := item.Notification
+		if n.Category != digest.Category {
+			digest.Category = DigestCategory
+		}
+		if n.Severity == models.Minor {
+			digest.Severity = models.Minor
+		}
+		for _, label := range n.Labels {
+			if !slices.Contains(digest.Labels, label) {
+				digest.Labels = append(digest.Labels, label)
+			}
+		}
+		fmt.Fprintf(&content, "\n[%s] %s %s: %s", n.Severity, time.UnixMilli(n.Created).UTC().Format(time.RFC3339), n.Sender, n.Content)
+	}
+	digest.Content = content.String()
+	return digest
+}
+
+// StartDigestScheduler starts the scheduler sending the pending digests of the subscriptions when the windows close
+func StartDigestScheduler(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	pollInterval := defaultDigestPollInterval
+	if config.Digest.PollInterval != "" {
+		var err error
+		pollInterval, err = time.ParseDuration(config.Digest.PollInterval)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse Digest.PollInterval %s", config.Digest.PollInterval), err)
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Info("Exiting digest scheduler")
+				return
+			case <-ticker.C:
+				processDigests(dic)
+			}
+		}
+	}()
+	lc.Infof("digest scheduler started with poll interval %s", pollInterval)
+	return nil
+}
+
+func processDigests(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	extensions, err := container.DBClientFrom(dic.Get).AllSubscriptionExtensions()
+	if err != nil {
+		lc.Errorf("fail to query the subscription extensions: %v", err)
+		return
+	}
+
+	now := time.Now()
+	for _, ext := range extensions {
+		if ext.Digest == nil {
+			continue
+		}
+		if err = processDigest(dic, ext.SubscriptionName, *ext.Digest, now); err != nil {
+			lc.Errorf("fail to process the digest of subscription %s: %v", ext.SubscriptionName, err)
+		}
+	}
+}
+
+// sendPendingDigest sends the pending digest of the subscription immediately regardless of the window
+func sendPendingDigest(dic *di.Container, subscriptionName string) errors.EdgeX {
+	sub, digests, err := claimDigests(dic, subscriptionName, func(items []notificationsModels.DigestItem) [][]notificationsModels.DigestItem {
+		if len(items) == 0 {
+			return nil
+		}
+		return [][]notificationsModels.DigestItem{items}
+	})
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	transmitDigests(dic, sub, digests)
+	return nil
+}
@@ -0,0 +1,182 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func digestItem(id string, category string, severity models.NotificationSeverity, labels []string, created time.Time) notificationsModels.DigestItem {
+	n := notification
+	n.Id = id
+	n.Category = category
+	n.Severity = severity
+	n.Labels = labels
+	n.Content = "content of " + id
+	n.Created = created.UnixMilli()
+	return notificationsModels.DigestItem{Id: id, SubscriptionName: sub.Name, Notification: n, Created: created.UnixMilli()}
+}
+
+func TestDigestNotification(t *testing.T) {
+	created := time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name             string
+		items            []notificationsModels.DigestItem
+		expectedCategory string
+		expectedSeverity models.NotificationSeverity
+		expectedLabels   []string
+	}{
+		{"same category",
+			[]notificationsModels.DigestItem{
+				digestItem("1", "health-check", models.Normal, []string{"a"}, created),
+				digestItem("2", "health-check", models.Normal, []string{"a", "b"}, created),
+			},
+			"health-check", models.Normal, []string{"a", "b"}},
+		{"mixed categories and severities",
+			[]notificationsModels.DigestItem{
+				digestItem("1", "health-check", models.Normal, nil, created),
+				digestItem("2", "disk", models.Minor, []string{"c"}, created),
+			},
+			DigestCategory, models.Minor, []string{"c"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			n := digestNotification(testCase.items)
+
+			assert.Equal(t, testCase.expectedCategory, n.Category)
+			assert.Equal(t, testCase.expectedSeverity, n.Severity)
+			assert.Equal(t, testCase.expectedLabels, n.Labels)
+			assert.Equal(t, models.Processed, n.Status)
+			assert.Equal(t, common.ContentTypeText, n.ContentType)
+			assert.Contains(t, n.Content, "[NORMAL] 2026-10-18T08:00:00Z senderA: content of 1")
+		})
+	}
+}
+
+func TestProcessDigest(t *testing.T) {
+	now := time.Now()
+	digestSub := sub
+	digestSub.Name = "digest-subscription"
+	digestSub.Channels = []models.Address{testRestAddress}
+	window := notificationsModels.Digest{Window: "10m"}
+	maxItems := notificationsModels.Digest{Window: "10m", MaxItems: 2}
+
+	openItems := []notificationsModels.DigestItem{
+		digestItem("open-1", "health-check", models.Normal, nil, now.Add(-time.Minute)),
+	}
+	closedItems := []notificationsModels.DigestItem{
+		digestItem("closed-1", "health-check", models.Normal, nil, now.Add(-11*time.Minute)),
+		digestItem("closed-2", "health-check", models.Normal, nil, now.Add(-time.Minute)),
+	}
+	fullItems := []notificationsModels.DigestItem{
+		digestItem("full-1", "health-check", models.Normal, nil, now.Add(-3*time.Minute)),
+		digestItem("full-2", "health-check", models.Normal, nil, now.Add(-2*time.Minute)),
+		digestItem("full-3", "health-check", models.Normal, nil, now.Add(-time.Minute)),
+	}
+
+	tests := []struct {
+		name                string
+		digest              notificationsModels.Digest
+		items               []notificationsModels.DigestItem
+		expectedDeletedIds  [][]string
+		expectedDigestCount int
+	}{
+		{"window open", window, openItems, nil, 0},
+		{"window closed", window, closedItems, [][]string{{"closed-1", "closed-2"}}, 1},
+		{"max items reached", maxItems, fullItems, [][]string{{"full-1", "full-2"}}, 1},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic := mockDic()
+			dbClientMock := &dbMock.DBClient{}
+			dbClientMock.On("DigestItemsBySubscriptionName", digestSub.Name).Return(testCase.items, nil)
+			dbClientMock.On("SubscriptionByName", digestSub.Name).Return(digestSub, nil)
+			dbClientMock.On("AddNotification", mock.Anything).Return(func(n models.Notification) models.Notification {
+				n.Id = "digest-notification"
+				return n
+			}, nil)
+			dbClientMock.On("AddTransmission", mock.Anything).Return(func(trans models.Transmission) models.Transmission {
+				return trans
+			}, nil)
+			dbClientMock.On("DeleteDigestItemsByIds", mock.Anything).Return(nil)
+			dbClientMock.On("SubscriptionExtensionBySubscriptionName", digestSub.Name).Return(notificationsModels.SubscriptionExtension{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription extension not found", nil))
+			dbClientMock.On("TemplatesByCategory", mock.Anything).Return([]notificationsModels.Template{}, nil)
+			restSender := &senderMock.Sender{}
+			restSender.On("Send", mock.Anything, testRestAddress).Return("", nil)
+			dic.Update(di.ServiceConstructorMap{
+				container.DBClientInterfaceName: func(get di.Get) interface{} {
+					return dbClientMock
+				},
+				channel.RESTSenderName: func(get di.Get) interface{} {
+					return restSender
+				},
+			})
+
+			err := processDigest(dic, digestSub.Name, testCase.digest, now)
+			require.NoError(t, err)
+
+			dbClientMock.AssertNumberOfCalls(t, "AddNotification", testCase.expectedDigestCount)
+			restSender.AssertNumberOfCalls(t, "Send", testCase.expectedDigestCount)
+			for _, ids := range testCase.expectedDeletedIds {
+				dbClientMock.AssertCalled(t, "DeleteDigestItemsByIds", ids)
+			}
+			dbClientMock.AssertNumberOfCalls(t, "DeleteDigestItemsByIds", len(testCase.expectedDeletedIds))
+		})
+	}
+}
+
+func TestAccumulateDigest(t *testing.T) {
+	digestSub := sub
+	digestSub.Name = "digest-subscription"
+	criticalNotification := notification
+	criticalNotification.Severity = models.Critical
+	digestExt := notificationsModels.SubscriptionExtension{SubscriptionName: digestSub.Name, Digest: &notificationsModels.Digest{Window: "10m"}}
+	noDigestExt := notificationsModels.SubscriptionExtension{SubscriptionName: sub.Name}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddDigestItem", mock.Anything).Return(notificationsModels.DigestItem{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name                string
+		notification        models.Notification
+		subscription        models.Subscription
+		extension           notificationsModels.SubscriptionExtension
+		expectedAccumulated bool
+	}{
+		{"accumulated", notification, digestSub, digestExt, true},
+		{"critical bypasses the digest", criticalNotification, digestSub, digestExt, false},
+		{"no digest setting", notification, sub, noDigestExt, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			accumulated, err := accumulateDigest(dic, testCase.notification, testCase.subscription, testCase.extension)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedAccumulated, accumulated)
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddDigestItem", 1)
+}
@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"sync"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+)
+
+const (
+	defaultTransmissionWorkers   = 4
+	defaultTransmissionQueueSize = 100
+)
+
+// transmissionDispatcherName contains the name of the transmissionDispatcher instance in the DIC
+var transmissionDispatcherName = di.TypeInstanceToName(transmissionDispatcher{})
+
+// transmissionJob is a transmission of the notification to an address of the subscription waiting for a worker
+type transmissionJob struct {
+	n       models.Notification
+	sub     models.Subscription
+	address models.Address
+}
+
+// transmissionDispatcher transmits the notifications by a bounded pool of workers per channel type, so that a slow or
+// unreachable channel type doesn't hold up the others and the number of the concurrent transmissions is limited
+type transmissionDispatcher struct {
+	ctx    context.Context
+	queues map[string]chan transmissionJob
+}
+
+// StartTransmissionWorkers starts the workers of each channel type transmitting the notifications
+func StartTransmissionWorkers(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	if _, _, err := circuitBreakerSetting(config); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	queueSize := config.Transmission.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultTransmissionQueueSize
+	}
+	defaultWorkers := config.Transmission.DefaultWorkers
+	if defaultWorkers <= 0 {
+		defaultWorkers = defaultTransmissionWorkers
+	}
+
+	d := &transmissionDispatcher{ctx: ctx, queues: make(map[string]chan transmissionJob)}
+	for _, channelType := range []string{common.REST, common.EMAIL, common.MQTT, common.ZeroMQ} {
+		workers := config.Transmission.Workers[channelType]
+		if workers <= 0 {
+			workers = defaultWorkers
+		}
+		queue := make(chan transmissionJob, queueSize)
+		d.queues[channelType] = queue
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				d.work(ctx, dic, queue)
+			}()
+		}
+		lc.Infof("started %d transmission workers for the %s channels", workers, channelType)
+	}
+
+	dic.Update(di.ServiceConstructorMap{
+		transmissionDispatcherName: func(get di.Get) interface{} {
+			return d
+		},
+	})
+	return nil
+}
+
+func (d *transmissionDispatcher) work(ctx context.Context, dic *di.Container, queue chan transmissionJob) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-queue:
+			transmit(dic, job.n, job.sub, job.address) // nolint:errcheck
+		}
+	}
+}
+
+// dispatch queues the transmission for the workers of the channel type, it waits for a free slot if the queue is full
+// so that the callers are slowed down instead of spawning the unbounded transmissions
+func (d *transmissionDispatcher) dispatch(job transmissionJob) bool {
+	queue, ok := d.queues[job.address.GetBaseAddress().Type]
+	if !ok {
+		return false
+	}
+	select {
+	case <-d.ctx.Done():
+	case queue <- job:
+	}
+	return true
+}
+
+// dispatchTransmission transmits the notification to the address of the subscription by the workers of the channel
+// type, or in a new goroutine if the workers are not started or the channel type is unknown
+func dispatchTransmission(dic *di.Container, n models.Notification, sub models.Subscription, address models.Address) {
+	d, ok := dic.Get(transmissionDispatcherName).(*transmissionDispatcher)
+	if ok && d.dispatch(transmissionJob{n: n, sub: sub, address: address}) {
+		return
+	}
+	go transmit(dic, n, sub, address) // nolint:errcheck
+}
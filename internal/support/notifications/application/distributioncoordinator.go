@@ -1,12 +1,15 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package application
 
 import (
+	"time"
+
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
@@ -29,15 +32,24 @@ func distribute(dic *di.Container, n models.Notification) errors.EdgeX {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	now := time.Now()
 	for _, sub := range subs {
 		if sub.AdminState == models.Locked {
 			lc.Debugf("subscription %s is locked, skip the notification transmission", sub.Name)
 			continue
 		}
-		for _, address := range sub.Channels {
-			// Async transmit the notification to improve the performance
-			go transmit(dic, n, sub, address) // nolint:errcheck
+		ext, err := subscriptionExtensionOf(dbClient, sub.Name)
+		if err != nil {
+			lc.Errorf("fail to query the extension of subscription %s, send the notification immediately: %v", sub.Name, err)
+		}
+		// the non-critical notification is held or dropped if the subscription is in the quiet hours
+		held, err := holdInQuietHours(dic, n, sub, ext, now)
+		if err != nil {
+			lc.Errorf("fail to check the quiet hours of subscription %s, send the notification immediately: %v", sub.Name, err)
+		} else if held {
+			continue
 		}
+		deliver(dic, n, sub, ext)
 	}
 
 	n.Status = models.Processed
@@ -49,6 +61,24 @@ func distribute(dic *di.Container, n models.Notification) errors.EdgeX {
 	return nil
 }
 
+// deliver transmits the notification to the channels of the subscription, or accumulates it to the digest of the
+// subscription
+func deliver(dic *di.Container, n models.Notification, sub models.Subscription, ext notificationsModels.SubscriptionExtension) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	// the non-critical notification is sent later in the digest if the subscription has the digest setting
+	accumulated, err := accumulateDigest(dic, n, sub, ext)
+	if err != nil {
+		lc.Errorf("fail to accumulate the notification to the digest of subscription %s, send it immediately: %v", sub.Name, err)
+	} else if accumulated {
+		return
+	}
+	for _, address := range sub.Channels {
+		// Async transmit the notification by the workers of the channel type to improve the performance
+		dispatchTransmission(dic, n, sub, address)
+	}
+}
+
 // transmit transmits the notification with specified subscription and address
 func transmit(dic *di.Container, n models.Notification, sub models.Subscription, address models.Address) (models.Transmission, errors.EdgeX) {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
@@ -67,8 +97,9 @@ func transmit(dic *di.Container, n models.Notification, sub models.Subscription,
 		return trans, nil
 	}
 
-	// Resend the critical notification if the transmission is failed.
-	if n.Severity == models.Critical && trans.Status == models.Failed {
+	// Resend the critical notification if the transmission is failed, the fast failures of the rate limit and the circuit
+	// breaker are resent after the cool-down.
+	if n.Severity == models.Critical && (sendingFailed(trans.Status) || fastFailed(trans.Status)) {
 		// Change the transmission status to RESENDING which means this transmission process is resending the notification and should not be removed.
 		trans.Status = models.RESENDING
 		err = dbClient.UpdateTransmission(trans)
@@ -76,17 +107,10 @@ func transmit(dic *di.Container, n models.Notification, sub models.Subscription,
 			lc.Error(err.Message())
 			return trans, errors.NewCommonEdgeXWrapper(err)
 		}
-		trans, err = reSend(dic, n, sub, trans)
-		if err != nil {
-			lc.Errorf("fail to handle the critical notification sending for the subscription %s with address %v, err: %v", sub.Name, address.GetBaseAddress(), err)
-			return trans, errors.NewCommonEdgeXWrapper(err)
-		}
-	}
-	// Trigger a escalated notification if the transmission is Escalated
-	if trans.Status == models.Escalated {
-		err = escalatedSend(dic, n, trans)
+		// Persist the resend task so that the resend scheduler picks it up, even after the service restarts
+		err = scheduleResend(dic, sub, trans)
 		if err != nil {
-			lc.Errorf("fail to handle the escalated notification sending, err: %v", err)
+			lc.Errorf("fail to schedule the critical notification resending for the subscription %s with address %v, err: %v", sub.Name, address.GetBaseAddress(), err)
 			return trans, errors.NewCommonEdgeXWrapper(err)
 		}
 	}
@@ -0,0 +1,409 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const defaultEscalationPollInterval = 10 * time.Second
+
+// escalationMutex serializes the escalation so that each level of a notification is escalated only once
+var escalationMutex sync.Mutex
+
+// levelDelay parses the delay of the escalation level, the level without delay is escalated immediately
+func levelDelay(level notificationsModels.EscalationLevel) (time.Duration, errors.EdgeX) {
+	if level.Delay == "" {
+		return 0, nil
+	}
+	delay, err := time.ParseDuration(level.Delay)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the escalation level delay %s", level.Delay), err)
+	}
+	if delay < 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("escalation level delay %s must not be negative", level.Delay), nil)
+	}
+	return delay, nil
+}
+
+// validateEscalationPolicy checks the level delays of the policy and that its categories are not escalated with other
+// policies
+func validateEscalationPolicy(dic *di.Container, p notificationsModels.EscalationPolicy) errors.EdgeX {
+	for _, level := range p.Levels {
+		if _, err := levelDelay(level); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if len(p.Categories) == 0 {
+		return nil
+	}
+
+	policies, err := container.DBClientFrom(dic.Get).EscalationPoliciesByCategories(p.Categories)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, other := range policies {
+		if other.Name == p.Name {
+			continue
+		}
+		for _, category := range p.Categories {
+			if slices.Contains(other.Categories, category) {
+				return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("category %s is already escalated with policy %s", category, other.Name), nil)
+			}
+		}
+	}
+	return nil
+}
+
+// escalationPolicyOf returns the escalation policy of the escalated transmission, the policy referenced by the
+// subscription of the transmission takes precedence over the policy of the notification category
+func escalationPolicyOf(dic *di.Container, n models.Notification, trans models.Transmission) (notificationsModels.EscalationPolicy, bool, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	ext, err := subscriptionExtensionOf(dbClient, trans.SubscriptionName)
+	if err != nil {
+		return notificationsModels.EscalationPolicy{}, false, errors.NewCommonEdgeXWrapper(err)
+	}
+	if ext.Escalation != nil {
+		p, err := dbClient.EscalationPolicyByName(ext.Escalation.PolicyName)
+		if err == nil {
+			return p, true, nil
+		}
+		if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			return notificationsModels.EscalationPolicy{}, false, errors.NewCommonEdgeXWrapper(err)
+		}
+		lc.Warnf("escalation policy %s referenced by subscription %s does not exist, escalate by the notification category", ext.Escalation.PolicyName, trans.SubscriptionName)
+	}
+
+	if n.Category == "" {
+		return notificationsModels.EscalationPolicy{}, false, nil
+	}
+	policies, err := dbClient.EscalationPoliciesByCategories([]string{n.Category})
+	if err != nil {
+		return notificationsModels.EscalationPolicy{}, false, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(policies) == 0 {
+		return notificationsModels.EscalationPolicy{}, false, nil
+	}
+	// a category is escalated with one policy at most
+	return policies[0], true, nil
+}
+
+// startEscalation persists the escalation task of the notification and escalates the first level immediately if it
+// has no delay, the notification which is already escalating isn't escalated again by another transmission
+func startEscalation(dic *di.Container, n models.Notification, trans models.Transmission, p notificationsModels.EscalationPolicy) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	escalationMutex.Lock()
+	defer escalationMutex.Unlock()
+
+	_, err := dbClient.EscalationTaskByNotificationId(n.Id)
+	if err == nil {
+		lc.Debugf("notification %s is already escalating, skip the escalation of transmission %s", n.Id, trans.Id)
+		return nil
+	} else if errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	delay, err := levelDelay(p.Levels[0])
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	now := time.Now()
+	task := notificationsModels.EscalationTask{
+		NotificationId: n.Id,
+		TransmissionId: trans.Id,
+		PolicyName:     p.Name,
+		NextAttempt:    now.Add(delay).UnixMilli(),
+	}
+	err = dbClient.UpsertEscalationTask(task)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("escalate notification %s with policy %s", n.Id, p.Name)
+
+	if delay > 0 {
+		return nil
+	}
+	return processEscalationTask(dic, task, now)
+}
+
+// escalationAcknowledged checks whether the notification or any of the notifications sent by the escalated levels is
+// acknowledged
+func escalationAcknowledged(dic *di.Container, n models.Notification, task notificationsModels.EscalationTask) (bool, errors.EdgeX) {
+	if n.Acknowledged {
+		return true, nil
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	for _, id := range task.EscalatedNotificationIds {
+		escalated, err := dbClient.NotificationById(id)
+		if err != nil {
+			if errors.Kind(err) == errors.KindEntityDoesNotExist {
+				continue
+			}
+			return false, errors.NewCommonEdgeXWrapper(err)
+		}
+		if escalated.Acknowledged {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// processEscalationTask sends the escalated notification to the subscriptions of the next level and schedules the
+// level after it, the escalation stops once the notification is acknowledged or the last level is escalated
+func processEscalationTask(dic *di.Container, task notificationsModels.EscalationTask, now time.Time) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	n, err := dbClient.NotificationById(task.NotificationId)
+	if err != nil {
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			lc.Debugf("notification %s of the escalation task no longer exists", task.NotificationId)
+			return deleteEscalationTask(dic, task.NotificationId)
+		}
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	acknowledged, err := escalationAcknowledged(dic, n, task)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if acknowledged {
+		lc.Debugf("notification %s is acknowledged, stop the escalation", task.NotificationId)
+		return deleteEscalationTask(dic, task.NotificationId)
+	}
+	p, err := dbClient.EscalationPolicyByName(task.PolicyName)
+	if err != nil {
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			lc.Warnf("escalation policy %s no longer exists, stop the escalation of notification %s", task.PolicyName, task.NotificationId)
+			return deleteEscalationTask(dic, task.NotificationId)
+		}
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	// the policy may have been updated with fewer levels
+	if task.NextLevel >= len(p.Levels) {
+		return deleteEscalationTask(dic, task.NotificationId)
+	}
+
+	escalated := escalatedNotification(n, models.Transmission{Id: task.TransmissionId})
+	escalated, err = dbClient.AddNotification(escalated)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "fail to create the escalated notification", err)
+	}
+	for _, name := range p.Levels[task.NextLevel].Subscriptions {
+		sub, err := dbClient.SubscriptionByName(name)
+		if err != nil {
+			lc.Warnf("subscription %s of escalation policy %s level %d does not exist, skip the escalated notification sending", name, p.Name, task.NextLevel)
+			continue
+		}
+		if sub.AdminState == models.Locked {
+			lc.Debugf("subscription %s is locked, skip the escalated notification sending", sub.Name)
+			continue
+		}
+		for _, address := range sub.Channels {
+			dispatchTransmission(dic, escalated, sub, address)
+		}
+	}
+	lc.Debugf("escalated notification %s to level %d of policy %s", task.NotificationId, task.NextLevel, p.Name)
+
+	task.EscalatedNotificationIds = append(task.EscalatedNotificationIds, escalated.Id)
+	task.NextLevel++
+	if task.NextLevel >= len(p.Levels) {
+		return deleteEscalationTask(dic, task.NotificationId)
+	}
+	delay, err := levelDelay(p.Levels[task.NextLevel])
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	task.NextAttempt = now.Add(delay).UnixMilli()
+	err = dbClient.UpsertEscalationTask(task)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+func deleteEscalationTask(dic *di.Container, notificationId string) errors.EdgeX {
+	err := container.DBClientFrom(dic.Get).DeleteEscalationTaskByNotificationId(notificationId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// stopEscalation deletes the escalation tasks of the acknowledged notifications
+func stopEscalation(dic *di.Container, ids []string) errors.EdgeX {
+	escalationMutex.Lock()
+	defer escalationMutex.Unlock()
+	for _, id := range ids {
+		if err := deleteEscalationTask(dic, id); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// StartEscalationScheduler starts the scheduler escalating the due levels of the escalating notifications
+func StartEscalationScheduler(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	pollInterval := defaultEscalationPollInterval
+	if config.Escalation.PollInterval != "" {
+		var err error
+		pollInterval, err = time.ParseDuration(config.Escalation.PollInterval)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse Escalation.PollInterval %s", config.Escalation.PollInterval), err)
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Info("Exiting escalation scheduler")
+				return
+			case <-ticker.C:
+				processDueEscalationTasks(dic)
+			}
+		}
+	}()
+	lc.Infof("escalation scheduler started with poll interval %s", pollInterval)
+	return nil
+}
+
+func processDueEscalationTasks(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	escalationMutex.Lock()
+	defer escalationMutex.Unlock()
+	now := time.Now()
+	tasks, err := container.DBClientFrom(dic.Get).EscalationTasksByNextAttempt(now.UnixMilli(), -1)
+	if err != nil {
+		lc.Errorf("fail to query the due escalation tasks: %v", err)
+		return
+	}
+	for _, task := range tasks {
+		if err = processEscalationTask(dic, task, now); err != nil {
+			lc.Errorf("fail to escalate notification %s: %v", task.NotificationId, err)
+		}
+	}
+}
+
+// AddEscalationPolicy validates and adds the new escalation policy
+func AddEscalationPolicy(dto dtos.EscalationPolicy, ctx context.Context, dic *di.Container) (name string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	p := dtos.ToEscalationPolicyModel(dto)
+	err := validateEscalationPolicy(dic, p)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	addedPolicy, err := dbClient.AddEscalationPolicy(p)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Escalation policy created on DB successfully. Escalation policy name: %s, Correlation-ID: %s ", addedPolicy.Name, correlation.FromContext(ctx))
+	return addedPolicy.Name, nil
+}
+
+// UpdateEscalationPolicy validates and replaces the existing escalation policy specified by name, the escalating
+// notifications continue with the updated levels
+func UpdateEscalationPolicy(dto dtos.EscalationPolicy, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	p := dtos.ToEscalationPolicyModel(dto)
+	err := validateEscalationPolicy(dic, p)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.UpdateEscalationPolicy(p)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Escalation policy updated on DB successfully. Escalation policy name: %s, Correlation-ID: %s ", p.Name, correlation.FromContext(ctx))
+	return nil
+}
+
+// AllEscalationPolicies queries escalation policies by offset and limit
+func AllEscalationPolicies(offset, limit int, dic *di.Container) (policies []dtos.EscalationPolicy, totalCount int64, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	totalCount, err = dbClient.EscalationPolicyTotalCount()
+	if err != nil {
+		return policies, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dtos.EscalationPolicy{}, totalCount, err
+	}
+
+	policyModels, err := dbClient.AllEscalationPolicies(offset, limit)
+	if err != nil {
+		return policies, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	policies = make([]dtos.EscalationPolicy, len(policyModels))
+	for i, p := range policyModels {
+		policies[i] = dtos.FromEscalationPolicyModelToDTO(p)
+	}
+	return policies, totalCount, nil
+}
+
+// EscalationPolicyByName queries escalation policy by name
+func EscalationPolicyByName(name string, dic *di.Container) (policy dtos.EscalationPolicy, err errors.EdgeX) {
+	if name == "" {
+		return policy, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	p, err := dbClient.EscalationPolicyByName(name)
+	if err != nil {
+		return policy, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromEscalationPolicyModelToDTO(p), nil
+}
+
+// DeleteEscalationPolicyByName deletes the escalation policy by name, the notifications escalating with the policy stop
+// escalating
+func DeleteEscalationPolicyByName(name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	_, err := dbClient.EscalationPolicyByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteEscalationPolicyByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestEscalationPolicyOf(t *testing.T) {
+	subscriptionPolicy := notificationsModels.EscalationPolicy{Name: "subscription-policy", Levels: []notificationsModels.EscalationLevel{{Subscriptions: []string{"operator"}}}}
+	categoryPolicy := notificationsModels.EscalationPolicy{Name: "category-policy", Levels: subscriptionPolicy.Levels, Categories: []string{notification.Category}}
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+	otherCategory := notification
+	otherCategory.Category = "other"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", "referencing").Return(notificationsModels.SubscriptionExtension{SubscriptionName: "referencing", Escalation: &notificationsModels.SubscriptionEscalation{PolicyName: subscriptionPolicy.Name}}, nil)
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", "dangling").Return(notificationsModels.SubscriptionExtension{SubscriptionName: "dangling", Escalation: &notificationsModels.SubscriptionEscalation{PolicyName: "deleted"}}, nil)
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", sub.Name).Return(notificationsModels.SubscriptionExtension{}, notFound)
+	dbClientMock.On("EscalationPolicyByName", subscriptionPolicy.Name).Return(subscriptionPolicy, nil)
+	dbClientMock.On("EscalationPolicyByName", "deleted").Return(notificationsModels.EscalationPolicy{}, notFound)
+	dbClientMock.On("EscalationPoliciesByCategories", []string{notification.Category}).Return([]notificationsModels.EscalationPolicy{categoryPolicy}, nil)
+	dbClientMock.On("EscalationPoliciesByCategories", []string{otherCategory.Category}).Return([]notificationsModels.EscalationPolicy{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name             string
+		notification     models.Notification
+		subscriptionName string
+		expectedFound    bool
+		expectedPolicy   string
+	}{
+		{"referenced by the subscription", notification, "referencing", true, subscriptionPolicy.Name},
+		{"referenced policy deleted, by the category", notification, "dangling", true, categoryPolicy.Name},
+		{"by the category", notification, sub.Name, true, categoryPolicy.Name},
+		{"no policy", otherCategory, sub.Name, false, ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			p, found, err := escalationPolicyOf(dic, testCase.notification, models.Transmission{SubscriptionName: testCase.subscriptionName})
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedFound, found)
+			assert.Equal(t, testCase.expectedPolicy, p.Name)
+		})
+	}
+}
+
+func TestProcessEscalationTask(t *testing.T) {
+	criticalNotification := notification
+	criticalNotification.Id = "critical-notification"
+	criticalNotification.Severity = models.Critical
+	acknowledgedNotification := criticalNotification
+	acknowledgedNotification.Id = "acknowledged-notification"
+	acknowledgedNotification.Acknowledged = true
+	acknowledgedEscalated := models.Notification{Id: "acknowledged-escalated", Acknowledged: true}
+	escalatedId := "escalated-notification"
+	policy := notificationsModels.EscalationPolicy{
+		Name: "on-call",
+		Levels: []notificationsModels.EscalationLevel{
+			{Subscriptions: []string{"operator"}},
+			{Delay: "10m", Subscriptions: []string{"supervisor"}},
+		},
+	}
+	now := time.Now()
+	notFound := errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil)
+
+	mockDBClient := func() (*di.Container, *dbMock.DBClient) {
+		dic := mockDic()
+		dbClientMock := &dbMock.DBClient{}
+		dbClientMock.On("NotificationById", criticalNotification.Id).Return(criticalNotification, nil)
+		dbClientMock.On("NotificationById", acknowledgedNotification.Id).Return(acknowledgedNotification, nil)
+		dbClientMock.On("NotificationById", acknowledgedEscalated.Id).Return(acknowledgedEscalated, nil)
+		dbClientMock.On("EscalationPolicyByName", policy.Name).Return(policy, nil)
+		dbClientMock.On("EscalationPolicyByName", "deleted").Return(notificationsModels.EscalationPolicy{}, notFound)
+		dbClientMock.On("AddNotification", mock.Anything).Return(models.Notification{Id: escalatedId}, nil)
+		dbClientMock.On("SubscriptionByName", "operator").Return(models.Subscription{Name: "operator"}, nil)
+		dbClientMock.On("SubscriptionByName", "supervisor").Return(models.Subscription{Name: "supervisor"}, nil)
+		dbClientMock.On("UpsertEscalationTask", mock.Anything).Return(nil)
+		dbClientMock.On("DeleteEscalationTaskByNotificationId", mock.Anything).Return(nil)
+		dic.Update(di.ServiceConstructorMap{
+			container.DBClientInterfaceName: func(get di.Get) interface{} {
+				return dbClientMock
+			},
+		})
+		return dic, dbClientMock
+	}
+
+	newTask := func(notificationId string, policyName string, nextLevel int, escalatedIds ...string) notificationsModels.EscalationTask {
+		return notificationsModels.EscalationTask{
+			NotificationId:           notificationId,
+			TransmissionId:           "escalated-transmission",
+			PolicyName:               policyName,
+			NextLevel:                nextLevel,
+			EscalatedNotificationIds: escalatedIds,
+		}
+	}
+
+	tests := []struct {
+		name              string
+		task              notificationsModels.EscalationTask
+		expectedEscalated bool
+		expectedNextLevel int
+	}{
+		{"first level", newTask(criticalNotification.Id, policy.Name, 0), true, 1},
+		{"last level", newTask(criticalNotification.Id, policy.Name, 1), true, -1},
+		{"notification acknowledged", newTask(acknowledgedNotification.Id, policy.Name, 0), false, -1},
+		{"escalated notification acknowledged", newTask(criticalNotification.Id, policy.Name, 1, acknowledgedEscalated.Id), false, -1},
+		{"policy deleted", newTask(criticalNotification.Id, "deleted", 0), false, -1},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dic, dbClientMock := mockDBClient()
+
+			err := processEscalationTask(dic, testCase.task, now)
+			require.NoError(t, err)
+
+			if testCase.expectedEscalated {
+				dbClientMock.AssertCalled(t, "AddNotification", mock.MatchedBy(func(n models.Notification) bool {
+					return n.Status == models.Escalated
+				}))
+				dbClientMock.AssertCalled(t, "SubscriptionByName", policy.Levels[testCase.task.NextLevel].Subscriptions[0])
+			} else {
+				dbClientMock.AssertNotCalled(t, "AddNotification", mock.Anything)
+			}
+			if testCase.expectedNextLevel < 0 {
+				dbClientMock.AssertCalled(t, "DeleteEscalationTaskByNotificationId", testCase.task.NotificationId)
+				dbClientMock.AssertNotCalled(t, "UpsertEscalationTask", mock.Anything)
+				return
+			}
+			dbClientMock.AssertNotCalled(t, "DeleteEscalationTaskByNotificationId", mock.Anything)
+			dbClientMock.AssertCalled(t, "UpsertEscalationTask", mock.MatchedBy(func(task notificationsModels.EscalationTask) bool {
+				return task.NextLevel == testCase.expectedNextLevel &&
+					task.NextAttempt == now.Add(10*time.Minute).UnixMilli() &&
+					len(task.EscalatedNotificationIds) == 1 && task.EscalatedNotificationIds[0] == escalatedId
+			}))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import "sync"
+
+// keyedMutex provides a mutex per key so that the work on different keys is not serialized, the mutex of a key is
+// removed once no one holds or waits for it
+type keyedMutex struct {
+	mutex sync.Mutex
+	locks map[string]*keyedLock
+}
+
+type keyedLock struct {
+	sync.Mutex
+	refs int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: make(map[string]*keyedLock)}
+}
+
+// lock locks the mutex of the key and returns the function unlocking it
+func (k *keyedMutex) lock(key string) func() {
+	k.mutex.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		k.locks[key] = l
+	}
+	l.refs++
+	k.mutex.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		k.mutex.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(k.locks, key)
+		}
+		k.mutex.Unlock()
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2024 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -30,6 +30,10 @@ var asyncPurgeNotificationOnce sync.Once
 // The AddNotification function accepts the new Notification model from the controller function
 // and then invokes AddNotification function of infrastructure layer to add new Notification
 func AddNotification(n models.Notification, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+	if container.ConfigurationFrom(dic.Get).Dedup.Enabled {
+		return addDeduplicatedNotification(n, ctx, dic)
+	}
+
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
@@ -259,6 +263,13 @@ func UpdateNotificationAckStatus(ack bool, ids []string, dic *di.Container) erro
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	// the acknowledged notifications are no longer escalated
+	if ack {
+		err = stopEscalation(dic, ids)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,263 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	quietWindowTimeLayout         = "15:04"
+	defaultQuietHoursPollInterval = 30 * time.Second
+)
+
+// quietHoursMutex serializes the sending of the held notifications so that they are sent only once
+var quietHoursMutex sync.Mutex
+
+// parseWeekday parses the full or the three-letter name of the weekday case-insensitively
+func parseWeekday(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(name, d.String()) || strings.EqualFold(name, d.String()[:3]) {
+			return d, true
+		}
+	}
+	return time.Sunday, false
+}
+
+// quietWindowMinutes parses the start and the end of the window as the minutes of the day
+func quietWindowMinutes(w notificationsModels.QuietWindow) (int, int, errors.EdgeX) {
+	start, err := time.Parse(quietWindowTimeLayout, w.Start)
+	if err != nil {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the quiet window start %s, the format should be HH:MM", w.Start), err)
+	}
+	end, err := time.Parse(quietWindowTimeLayout, w.End)
+	if err != nil {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the quiet window end %s, the format should be HH:MM", w.End), err)
+	}
+	return start.Hour()*60 + start.Minute(), end.Hour()*60 + end.Minute(), nil
+}
+
+// validateQuietHours checks the time zone, the windows and the action of the quiet hours
+func validateQuietHours(qh notificationsModels.QuietHours) errors.EdgeX {
+	if _, err := time.LoadLocation(qh.Timezone); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to load the time zone %s", qh.Timezone), err)
+	}
+	if len(qh.Windows) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the quiet hours must have at least one window", nil)
+	}
+	for _, w := range qh.Windows {
+		start, end, err := quietWindowMinutes(w)
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		if start == end {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the quiet window start and end must differ, but both are %s", w.Start), nil)
+		}
+		for _, day := range w.Weekdays {
+			if _, ok := parseWeekday(day); !ok {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown weekday %s of the quiet window", day), nil)
+			}
+		}
+	}
+	if qh.Action != notificationsModels.QuietHoursHold && qh.Action != notificationsModels.QuietHoursDrop {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported quiet hours action %s", qh.Action), nil)
+	}
+	return nil
+}
+
+// appliesOn checks whether the window applies to the window starting on the weekday
+func appliesOn(w notificationsModels.QuietWindow, day time.Weekday) bool {
+	if len(w.Weekdays) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(w.Weekdays, func(name string) bool {
+		d, ok := parseWeekday(name)
+		return ok && d == day
+	})
+}
+
+// inQuietHours checks whether the time is in any window of the quiet hours, the window crossing midnight applies to the
+// weekday it starts on
+func inQuietHours(qh notificationsModels.QuietHours, now time.Time) (bool, errors.EdgeX) {
+	location, err := time.LoadLocation(qh.Timezone)
+	if err != nil {
+		return false, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to load the time zone %s", qh.Timezone), err)
+	}
+	local := now.In(location)
+	minutes := local.Hour()*60 + local.Minute()
+	today := local.Weekday()
+	yesterday := (today + 6) % 7
+
+	for _, w := range qh.Windows {
+		start, end, edgeXerr := quietWindowMinutes(w)
+		if edgeXerr != nil {
+			return false, errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+		if start < end {
+			if minutes >= start && minutes < end && appliesOn(w, today) {
+				return true, nil
+			}
+			continue
+		}
+		// the window crosses midnight
+		if minutes >= start && appliesOn(w, today) {
+			return true, nil
+		}
+		if minutes < end && appliesOn(w, yesterday) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// holdInQuietHours holds or drops the non-critical notification if the subscription is in the quiet hours, false is
+// returned if the notification should be sent now
+func holdInQuietHours(dic *di.Container, n models.Notification, sub models.Subscription, ext notificationsModels.SubscriptionExtension, now time.Time) (bool, errors.EdgeX) {
+	if n.Severity == models.Critical || ext.QuietHours == nil {
+		return false, nil
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	qh := *ext.QuietHours
+	quiet, err := inQuietHours(qh, now)
+	if err != nil {
+		return false, errors.NewCommonEdgeXWrapper(err)
+	}
+	if !quiet {
+		return false, nil
+	}
+
+	if qh.Action == notificationsModels.QuietHoursDrop {
+		lc.Debugf("subscription %s is in the quiet hours, drop the notification %s", sub.Name, n.Id)
+		return true, nil
+	}
+	_, err = dbClient.AddHeldNotification(notificationsModels.HeldNotification{SubscriptionName: sub.Name, Notification: n})
+	if err != nil {
+		return false, errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("subscription %s is in the quiet hours, hold the notification %s", sub.Name, n.Id)
+	return true, nil
+}
+
+// processQuietHours sends the held notifications of the subscription if the quiet hours have ended
+func processQuietHours(dic *di.Container, ext notificationsModels.SubscriptionExtension, now time.Time) errors.EdgeX {
+	quiet, err := inQuietHours(*ext.QuietHours, now)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if quiet {
+		return nil
+	}
+	return releaseHeldNotifications(dic, ext)
+}
+
+// releaseHeldNotifications sends the held notifications to the subscription with the extension and removes them, the
+// notifications are dropped if the subscription is not found or locked
+func releaseHeldNotifications(dic *di.Container, ext notificationsModels.SubscriptionExtension) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	subscriptionName := ext.SubscriptionName
+
+	heldNotifications, err := dbClient.HeldNotificationsBySubscriptionName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(heldNotifications) == 0 {
+		return nil
+	}
+
+	sub, err := dbClient.SubscriptionByName(subscriptionName)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err == nil && sub.AdminState != models.Locked {
+		for _, held := range heldNotifications {
+			deliver(dic, held.Notification, sub, ext)
+		}
+		lc.Debugf("sent the %d notifications held in the quiet hours of subscription %s", len(heldNotifications), sub.Name)
+	} else {
+		lc.Debugf("subscription %s is not found or locked, drop the %d notifications held in the quiet hours", subscriptionName, len(heldNotifications))
+	}
+
+	ids := make([]string, len(heldNotifications))
+	for i, held := range heldNotifications {
+		ids[i] = held.Id
+	}
+	err = dbClient.DeleteHeldNotificationsByIds(ids)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// StartQuietHoursScheduler starts the scheduler sending the held notifications of the subscriptions when the quiet
+// hours end
+func StartQuietHoursScheduler(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	pollInterval := defaultQuietHoursPollInterval
+	if config.QuietHours.PollInterval != "" {
+		var err error
+		pollInterval, err = time.ParseDuration(config.QuietHours.PollInterval)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse QuietHours.PollInterval %s", config.QuietHours.PollInterval), err)
+		}
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Info("Exiting quiet hours scheduler")
+				return
+			case <-ticker.C:
+				processAllQuietHours(dic)
+			}
+		}
+	}()
+	lc.Infof("quiet hours scheduler started with poll interval %s", pollInterval)
+	return nil
+}
+
+func processAllQuietHours(dic *di.Container) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	extensions, err := container.DBClientFrom(dic.Get).AllSubscriptionExtensions()
+	if err != nil {
+		lc.Errorf("fail to query the subscription extensions: %v", err)
+		return
+	}
+
+	quietHoursMutex.Lock()
+	defer quietHoursMutex.Unlock()
+	now := time.Now()
+	for _, ext := range extensions {
+		if ext.QuietHours == nil {
+			continue
+		}
+		if err = processQuietHours(dic, ext, now); err != nil {
+			lc.Errorf("fail to process the quiet hours of subscription %s: %v", ext.SubscriptionName, err)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestInQuietHours(t *testing.T) {
+	nightly := notificationsModels.QuietHours{
+		Timezone: "Asia/Taipei",
+		Windows:  []notificationsModels.QuietWindow{{Start: "22:00", End: "06:00"}},
+		Action:   notificationsModels.QuietHoursHold,
+	}
+	fridayNight := notificationsModels.QuietHours{
+		Windows: []notificationsModels.QuietWindow{{Start: "20:00", End: "08:00", Weekdays: []string{"Fri"}}},
+		Action:  notificationsModels.QuietHoursHold,
+	}
+	weekend := notificationsModels.QuietHours{
+		Windows: []notificationsModels.QuietWindow{{Start: "09:00", End: "17:00", Weekdays: []string{"saturday", "Sunday"}}},
+		Action:  notificationsModels.QuietHoursDrop,
+	}
+	// 2026-10-16 is Friday
+	utc := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name          string
+		quietHours    notificationsModels.QuietHours
+		now           time.Time
+		expectedQuiet bool
+	}{
+		{"before midnight in the time zone", nightly, utc(16, 15, 0), true},
+		{"after midnight in the time zone", nightly, utc(16, 21, 59), true},
+		{"window end is exclusive", nightly, utc(16, 22, 0), false},
+		{"daytime in the time zone", nightly, utc(16, 4, 0), false},
+		{"on the start weekday", fridayNight, utc(16, 21, 0), true},
+		{"after midnight of the start weekday", fridayNight, utc(17, 7, 59), true},
+		{"not on the start weekday", fridayNight, utc(15, 21, 0), false},
+		{"after midnight of another weekday", fridayNight, utc(16, 7, 0), false},
+		{"weekend", weekend, utc(18, 12, 0), true},
+		{"weekday", weekend, utc(16, 12, 0), false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			quiet, err := inQuietHours(testCase.quietHours, testCase.now)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedQuiet, quiet)
+		})
+	}
+}
+
+func TestHoldInQuietHours(t *testing.T) {
+	holdSub := sub
+	holdSub.Name = "hold-subscription"
+	dropSub := sub
+	dropSub.Name = "drop-subscription"
+	criticalNotification := notification
+	criticalNotification.Severity = models.Critical
+	allDay := []notificationsModels.QuietWindow{{Start: "00:00", End: "23:59"}}
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	holdExt := notificationsModels.SubscriptionExtension{
+		SubscriptionName: holdSub.Name,
+		QuietHours:       &notificationsModels.QuietHours{Windows: allDay, Action: notificationsModels.QuietHoursHold},
+	}
+	dropExt := notificationsModels.SubscriptionExtension{
+		SubscriptionName: dropSub.Name,
+		QuietHours:       &notificationsModels.QuietHours{Windows: allDay, Action: notificationsModels.QuietHoursDrop},
+	}
+	noQuietHoursExt := notificationsModels.SubscriptionExtension{SubscriptionName: sub.Name}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddHeldNotification", mock.Anything).Return(notificationsModels.HeldNotification{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name         string
+		notification models.Notification
+		subscription models.Subscription
+		extension    notificationsModels.SubscriptionExtension
+		expectedHeld bool
+	}{
+		{"held", notification, holdSub, holdExt, true},
+		{"dropped", notification, dropSub, dropExt, true},
+		{"critical bypasses the quiet hours", criticalNotification, holdSub, holdExt, false},
+		{"no quiet hours setting", notification, sub, noQuietHoursExt, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			held, err := holdInQuietHours(dic, testCase.notification, testCase.subscription, testCase.extension, now)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedHeld, held)
+		})
+	}
+	dbClientMock.AssertNumberOfCalls(t, "AddHeldNotification", 1)
+}
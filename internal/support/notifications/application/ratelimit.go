@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// subscriptionRateLimiters keeps the token buckets of the subscriptions with the rate limit setting
+var subscriptionRateLimiters = newRateLimiters()
+
+// tokenBucket refills the tokens continuously at the rate of the rate limit setting up to the burst
+type tokenBucket struct {
+	// setting is the rate limit setting which the bucket is built with, the bucket is rebuilt once the setting changes
+	setting notificationsModels.RateLimit
+	// rate is the number of the tokens refilled per second
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+func newTokenBucket(rl notificationsModels.RateLimit, now time.Time) (*tokenBucket, errors.EdgeX) {
+	interval, err := rateLimitInterval(rl)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	capacity := rl.Burst
+	if capacity <= 0 {
+		capacity = rl.Limit
+	}
+	return &tokenBucket{
+		setting:  rl,
+		rate:     float64(rl.Limit) / interval.Seconds(),
+		capacity: float64(capacity),
+		tokens:   float64(capacity),
+		last:     now,
+	}, nil
+}
+
+// take takes a token from the bucket, false is returned if the bucket is empty
+func (b *tokenBucket) take(now time.Time) bool {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = min(b.capacity, b.tokens+elapsed*b.rate)
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// rateLimiters keeps the token buckets in memory by the subscription name
+type rateLimiters struct {
+	mutex   sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+func newRateLimiters() *rateLimiters {
+	return &rateLimiters{buckets: make(map[string]*tokenBucket)}
+}
+
+// take takes a token from the bucket of the subscription, the bucket is rebuilt if the rate limit setting changes
+func (r *rateLimiters) take(subscriptionName string, rl notificationsModels.RateLimit, now time.Time) (bool, errors.EdgeX) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	bucket, ok := r.buckets[subscriptionName]
+	if !ok || bucket.setting != rl {
+		var err errors.EdgeX
+		bucket, err = newTokenBucket(rl, now)
+		if err != nil {
+			return false, errors.NewCommonEdgeXWrapper(err)
+		}
+		r.buckets[subscriptionName] = bucket
+	}
+	return bucket.take(now), nil
+}
+
+func (r *rateLimiters) remove(subscriptionName string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.buckets, subscriptionName)
+}
+
+func rateLimitInterval(rl notificationsModels.RateLimit) (time.Duration, errors.EdgeX) {
+	interval, err := time.ParseDuration(rl.Interval)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the rate limit interval %s", rl.Interval), err)
+	}
+	if interval <= 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the rate limit interval %s must be positive", rl.Interval), nil)
+	}
+	return interval, nil
+}
+
+// validateRateLimit checks the limit, the interval and the burst of the rate limit
+func validateRateLimit(rl notificationsModels.RateLimit) errors.EdgeX {
+	if rl.Limit <= 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the rate limit must be positive", nil)
+	}
+	if rl.Burst < 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "the rate limit burst must not be negative", nil)
+	}
+	if _, err := rateLimitInterval(rl); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// takeRateLimitToken takes a token of the subscription rate limit for a transmission, true is returned if the
+// subscription has no rate limit setting
+func takeRateLimitToken(ext notificationsModels.SubscriptionExtension, now time.Time) (bool, errors.EdgeX) {
+	if ext.RateLimit == nil {
+		subscriptionRateLimiters.remove(ext.SubscriptionName)
+		return true, nil
+	}
+	return subscriptionRateLimiters.take(ext.SubscriptionName, *ext.RateLimit, now)
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestRateLimiters(t *testing.T) {
+	now := time.Now()
+	limiters := newRateLimiters()
+	rl := notificationsModels.RateLimit{Limit: 2, Interval: "1m", Burst: 3}
+
+	take := func(at time.Time) bool {
+		allowed, err := limiters.take("limited", rl, at)
+		require.NoError(t, err)
+		return allowed
+	}
+	// the bucket starts full with the burst
+	assert.True(t, take(now))
+	assert.True(t, take(now))
+	assert.True(t, take(now))
+	assert.False(t, take(now))
+	// a token is refilled every 30 seconds
+	assert.False(t, take(now.Add(29*time.Second)))
+	assert.True(t, take(now.Add(30*time.Second)))
+	assert.False(t, take(now.Add(30*time.Second)))
+	// the tokens never exceed the burst
+	later := now.Add(time.Hour)
+	assert.True(t, take(later))
+	assert.True(t, take(later))
+	assert.True(t, take(later))
+	assert.False(t, take(later))
+
+	// the bucket is rebuilt once the setting changes, and the burst defaults to the limit
+	rl.Burst = 0
+	assert.True(t, take(later))
+	assert.True(t, take(later))
+	assert.False(t, take(later))
+
+	limiters.remove("limited")
+	assert.True(t, take(later))
+
+	_, err := limiters.take("invalid", notificationsModels.RateLimit{Limit: 1, Interval: "1 minute"}, now)
+	assert.Error(t, err)
+}
+
+func TestSendNotificationViaChannel_RateLimited(t *testing.T) {
+	limitedSubscriptionName := "rate-limited-subscription"
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	ext := notificationsModels.SubscriptionExtension{
+		SubscriptionName: limitedSubscriptionName,
+		RateLimit:        &notificationsModels.RateLimit{Limit: 1, Interval: "1h"},
+	}
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", limitedSubscriptionName).Return(ext, nil)
+	dbClientMock.On("TemplatesByCategory", notification.Category).Return([]notificationsModels.Template{}, nil)
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", notification, testRestAddress).Return("", nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+	defer subscriptionRateLimiters.remove(limitedSubscriptionName)
+
+	record := sendNotificationViaChannel(dic, notification, limitedSubscriptionName, testRestAddress)
+	assert.Equal(t, models.Sent, record.Status)
+	record = sendNotificationViaChannel(dic, notification, limitedSubscriptionName, testRestAddress)
+	assert.Equal(t, notificationsModels.RateLimited, record.Status)
+	assert.True(t, fastFailed(record.Status))
+	assert.False(t, sendingFailed(record.Status))
+	restSender.AssertNumberOfCalls(t, "Send", 1)
+
+	// the rate limited resend doesn't consume the resend count, so the transmission isn't escalated
+	resendLimit := container.ConfigurationFrom(dic.Get).Writable.ResendLimit
+	trans := models.NewTransmission(limitedSubscriptionName, testRestAddress, notification.Id)
+	trans.Status = models.RESENDING
+	trans.ResendCount = resendLimit - 1
+	trans, err := reSend(dic, notification, models.Subscription{}, trans)
+	require.NoError(t, err)
+	assert.Equal(t, models.RESENDING, trans.Status)
+	assert.Equal(t, resendLimit-1, trans.ResendCount)
+	assert.Equal(t, notificationsModels.RateLimited, trans.Records[0].Status)
+	restSender.AssertNumberOfCalls(t, "Send", 1)
+
+	// the transmission keeps failing fast is escalated after the max fast failures
+	trans.Records = make([]models.TransmissionRecord, maxFastFailures(container.ConfigurationFrom(dic.Get))-1)
+	for i := range trans.Records {
+		trans.Records[i].Status = notificationsModels.CircuitOpen
+	}
+	trans, err = reSend(dic, notification, models.Subscription{}, trans)
+	require.NoError(t, err)
+	assert.Equal(t, models.Escalated, trans.Status)
+	restSender.AssertNumberOfCalls(t, "Send", 1)
+}
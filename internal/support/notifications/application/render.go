@@ -0,0 +1,216 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	htmlTemplate "html/template"
+	"slices"
+	"strings"
+	"text/template"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// defaultTemplateContentType is the content type of the notification rendered from the template without the content type
+const defaultTemplateContentType = "text/plain"
+
+// templateData is the data executing the notification templates, e.g. {{.Severity}}, {{.Content}} or
+// {{if hasLabel .Labels "disk"}}
+type templateData struct {
+	dtos.Notification
+	SubscriptionName string
+	Locale           string
+}
+
+var templateFuncs = map[string]any{
+	"hasLabel": func(labels []string, label string) bool { return slices.Contains(labels, label) },
+	"join":     strings.Join,
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// validateTemplate checks the channel type of the template and parses the subject and bodies
+func validateTemplate(t notificationsModels.Template) errors.EdgeX {
+	if t.ChannelType != "" && !slices.ContainsFunc([]string{common.REST, common.EMAIL, common.MQTT, common.ZeroMQ}, func(channelType string) bool {
+		return strings.EqualFold(channelType, t.ChannelType)
+	}) {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported channel type %s", t.ChannelType), nil)
+	}
+	if _, err := template.New("subject").Funcs(templateFuncs).Parse(t.Subject); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the subject template", err)
+	}
+	if _, err := template.New("body").Funcs(templateFuncs).Parse(t.Body); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the body template", err)
+	}
+	if _, err := htmlTemplate.New("htmlBody").Funcs(templateFuncs).Parse(t.HTMLBody); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the HTML body template", err)
+	}
+	return nil
+}
+
+// baseLanguage returns the language of the locale, e.g. zh of zh-TW
+func baseLanguage(locale string) string {
+	language, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	return strings.ToLower(language)
+}
+
+// selectTemplate returns the template matching the notification category, the channel type and the locale. The
+// template of the exact locale is preferred to the one of the same language, which is preferred to the one without
+// locale, then the template of the specific category and channel type is preferred to the generic one.
+func selectTemplate(templates []notificationsModels.Template, category, channelType, locale string) (notificationsModels.Template, bool) {
+	var selected notificationsModels.Template
+	bestScore := 0
+	for _, t := range templates {
+		if t.Category != "" && t.Category != category {
+			continue
+		}
+		if t.ChannelType != "" && !strings.EqualFold(t.ChannelType, channelType) {
+			continue
+		}
+
+		var score int
+		switch {
+		case t.Locale == "":
+			score = 1
+		case strings.EqualFold(strings.ReplaceAll(t.Locale, "_", "-"), strings.ReplaceAll(locale, "_", "-")):
+			score = 3
+		case baseLanguage(t.Locale) == baseLanguage(locale) && !strings.ContainsAny(t.Locale, "-_"):
+			score = 2
+		default:
+			continue
+		}
+		score *= 4
+		if t.Category != "" {
+			score += 2
+		}
+		if t.ChannelType != "" {
+			score++
+		}
+
+		if score > bestScore {
+			selected = t
+			bestScore = score
+		}
+	}
+	return selected, bestScore > 0
+}
+
+// subscriptionTemplate returns the template used to send the notification to the subscription via the channel type
+func subscriptionTemplate(dic *di.Container, n models.Notification, ext notificationsModels.SubscriptionExtension, channelType string) (notificationsModels.Template, string, bool, errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	var selection notificationsModels.SubscriptionTemplate
+	if ext.Template != nil {
+		selection = *ext.Template
+	}
+	if selection.TemplateName != "" {
+		t, err := dbClient.TemplateByName(selection.TemplateName)
+		if err != nil {
+			return notificationsModels.Template{}, "", false, errors.NewCommonEdgeXWrapper(err)
+		}
+		return t, selection.Locale, true, nil
+	}
+
+	// only the templates of the notification category and the generic ones are the candidates
+	templates, err := dbClient.TemplatesByCategory(n.Category)
+	if err != nil {
+		return notificationsModels.Template{}, "", false, errors.NewCommonEdgeXWrapper(err)
+	}
+	t, ok := selectTemplate(templates, n.Category, channelType, selection.Locale)
+	return t, selection.Locale, ok, nil
+}
+
+// renderNotification renders the notification with the template of the subscription, the rendered body replaces the
+// notification content and the email address carries the rendered subject and HTML body. The notification is sent as
+// is if no template is found or the rendering fails.
+func renderNotification(dic *di.Container, n models.Notification, ext notificationsModels.SubscriptionExtension, address models.Address) (models.Notification, models.Address) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	subscriptionName := ext.SubscriptionName
+	channelType := address.GetBaseAddress().Type
+	t, locale, ok, err := subscriptionTemplate(dic, n, ext, channelType)
+	if err != nil {
+		lc.Errorf("fail to find the template of subscription %s, send the notification as is: %v", subscriptionName, err)
+		return n, address
+	}
+	if !ok {
+		return n, address
+	}
+
+	data := templateData{Notification: dtos.FromNotificationModelToDTO(n), SubscriptionName: subscriptionName, Locale: locale}
+	rendered, subject, htmlBody, err := executeTemplate(t, data, channelType == common.EMAIL)
+	if err != nil {
+		lc.Errorf("fail to render the notification %s with template %s, send the notification as is: %v", n.Id, t.Name, err)
+		return n, address
+	}
+
+	if t.Body != "" {
+		n.Content = rendered
+		n.ContentType = t.ContentType
+		if n.ContentType == "" {
+			n.ContentType = defaultTemplateContentType
+		}
+	}
+	if emailAddress, isEmail := address.(models.EmailAddress); isEmail {
+		return n, notificationsModels.TemplatedEmailAddress{EmailAddress: emailAddress, Subject: subject, HTMLBody: htmlBody}
+	}
+	return n, address
+}
+
+// executeTemplate renders the body, the subject and the HTML body of the template, the subject and HTML body are only
+// rendered for the email
+func executeTemplate(t notificationsModels.Template, data templateData, email bool) (body string, subject string, htmlBody string, err errors.EdgeX) {
+	execute := func(name, text string) (string, errors.EdgeX) {
+		tmpl, parseErr := template.New(name).Funcs(templateFuncs).Option("missingkey=zero").Parse(text)
+		if parseErr != nil {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the %s template", name), parseErr)
+		}
+		var buf bytes.Buffer
+		if execErr := tmpl.Execute(&buf, data); execErr != nil {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to execute the %s template", name), execErr)
+		}
+		return buf.String(), nil
+	}
+
+	if body, err = execute("body", t.Body); err != nil {
+		return "", "", "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if !email {
+		return body, "", "", nil
+	}
+	if subject, err = execute("subject", t.Subject); err != nil {
+		return "", "", "", errors.NewCommonEdgeXWrapper(err)
+	}
+	if t.HTMLBody != "" {
+		tmpl, parseErr := htmlTemplate.New("htmlBody").Funcs(templateFuncs).Parse(t.HTMLBody)
+		if parseErr != nil {
+			return "", "", "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse the HTML body template", parseErr)
+		}
+		var buf bytes.Buffer
+		if execErr := tmpl.Execute(&buf, data); execErr != nil {
+			return "", "", "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to execute the HTML body template", execErr)
+		}
+		htmlBody = buf.String()
+	}
+	// the subject is a single header line
+	subject = strings.Join(strings.Fields(subject), " ")
+	return body, subject, htmlBody, nil
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestSelectTemplate(t *testing.T) {
+	generic := notificationsModels.Template{Name: "generic"}
+	english := notificationsModels.Template{Name: "english", Locale: "en"}
+	chinese := notificationsModels.Template{Name: "chinese", Locale: "zh"}
+	taiwan := notificationsModels.Template{Name: "taiwan", Locale: "zh-TW"}
+	healthEmail := notificationsModels.Template{Name: "health-email", Category: notification.Category, ChannelType: common.EMAIL}
+	otherCategory := notificationsModels.Template{Name: "other-category", Category: "other", Locale: "zh-TW"}
+	templates := []notificationsModels.Template{generic, english, chinese, taiwan, healthEmail, otherCategory}
+
+	tests := []struct {
+		name         string
+		templates    []notificationsModels.Template
+		channelType  string
+		locale       string
+		expectedName string
+	}{
+		{"exact locale", templates, common.REST, "zh-TW", taiwan.Name},
+		{"exact locale with underscore", templates, common.REST, "zh_tw", taiwan.Name},
+		{"base language", templates, common.REST, "zh-CN", chinese.Name},
+		{"specific category and channel type without locale", templates, common.EMAIL, "fr", healthEmail.Name},
+		{"generic without locale", templates, common.REST, "fr", generic.Name},
+		{"no locale", templates, common.REST, "", generic.Name},
+		{"not found", []notificationsModels.Template{english, otherCategory}, common.REST, "fr", ""},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			selected, ok := selectTemplate(testCase.templates, notification.Category, testCase.channelType, testCase.locale)
+			assert.Equal(t, testCase.expectedName != "", ok)
+			assert.Equal(t, testCase.expectedName, selected.Name)
+		})
+	}
+}
+
+func TestRenderNotification(t *testing.T) {
+	n := notification
+	n.Id = "notification-id"
+	n.Content = "disk <usage> 95%"
+	n.Labels = []string{"disk"}
+
+	emailTemplate := notificationsModels.Template{
+		Name:        "email",
+		ChannelType: common.EMAIL,
+		Subject:     "[{{upper (printf \"%s\" .Severity)}}]\n{{.Category}}",
+		Body:        `{{if hasLabel .Labels "disk"}}Disk: {{end}}{{.Content}}`,
+		HTMLBody:    "<p>{{.Content}}</p>",
+	}
+	restTemplate := notificationsModels.Template{
+		Name:        "rest",
+		ChannelType: common.REST,
+		Locale:      "zh-TW",
+		Body:        `{"locale":{{json .Locale}},"text":{{json .Content}}}`,
+		ContentType: common.ContentTypeJSON,
+	}
+	brokenTemplate := notificationsModels.Template{Name: "broken", Body: "{{.Unknown.Field}}"}
+	emailExt := notificationsModels.SubscriptionExtension{SubscriptionName: sub.Name}
+	restExt := notificationsModels.SubscriptionExtension{
+		SubscriptionName: "rest-subscription",
+		Template:         &notificationsModels.SubscriptionTemplate{Locale: "zh-TW"},
+	}
+	brokenExt := notificationsModels.SubscriptionExtension{
+		SubscriptionName: "broken-subscription",
+		Template:         &notificationsModels.SubscriptionTemplate{TemplateName: brokenTemplate.Name},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("TemplatesByCategory", n.Category).Return([]notificationsModels.Template{emailTemplate, restTemplate}, nil)
+	dbClientMock.On("TemplateByName", brokenTemplate.Name).Return(brokenTemplate, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	t.Run("email", func(t *testing.T) {
+		rendered, address := renderNotification(dic, n, emailExt, testEmailAddress)
+		require.IsType(t, notificationsModels.TemplatedEmailAddress{}, address)
+		emailAddress := address.(notificationsModels.TemplatedEmailAddress)
+		assert.Equal(t, testEmailAddress, emailAddress.EmailAddress)
+		assert.Equal(t, "[NORMAL] health-check", emailAddress.Subject)
+		assert.Equal(t, "<p>disk &lt;usage&gt; 95%</p>", emailAddress.HTMLBody)
+		assert.Equal(t, "Disk: disk <usage> 95%", rendered.Content)
+		assert.Equal(t, defaultTemplateContentType, rendered.ContentType)
+	})
+	t.Run("rest with locale", func(t *testing.T) {
+		rendered, address := renderNotification(dic, n, restExt, testRestAddress)
+		assert.Equal(t, testRestAddress, address)
+		assert.JSONEq(t, `{"locale":"zh-TW","text":"disk <usage> 95%"}`, rendered.Content)
+		assert.Equal(t, common.ContentTypeJSON, rendered.ContentType)
+	})
+	t.Run("render failed", func(t *testing.T) {
+		rendered, address := renderNotification(dic, n, brokenExt, testRestAddress)
+		assert.Equal(t, testRestAddress, address)
+		assert.Equal(t, n, rendered)
+	})
+}
@@ -0,0 +1,360 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	defaultResendWorkers         = 1
+	defaultResendPollInterval    = time.Second
+	defaultResendMaxTaskErrors   = 10
+	defaultResendMaxFastFailures = 20
+)
+
+// scheduleResend persists the resend task of the RESENDING transmission with the next attempt time computed from the
+// resend count of the transmission, or after the cool-down if the last attempt failed fast
+func scheduleResend(dic *di.Container, sub models.Subscription, trans models.Transmission) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	if len(trans.Records) > 0 && fastFailed(trans.Records[len(trans.Records)-1].Status) {
+		coolDown := fastFailureCoolDown(dic, trans.SubscriptionName, trans.Records[len(trans.Records)-1].Status)
+		err := dbClient.UpsertResendTask(notificationsModels.ResendTask{TransmissionId: trans.Id, NextAttempt: time.Now().Add(coolDown).UnixMilli()})
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		return nil
+	}
+
+	_, resendInterval, err := resendLimitAndInterval(config, sub)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	var maxInterval time.Duration
+	if config.Resend.MaxInterval != "" {
+		var parseErr error
+		maxInterval, parseErr = time.ParseDuration(config.Resend.MaxInterval)
+		if parseErr != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to parse Resend.MaxInterval", parseErr)
+		}
+	}
+
+	nextAttempt := time.Now().Add(resendBackoff(resendInterval, maxInterval, trans.ResendCount))
+	err = dbClient.UpsertResendTask(notificationsModels.ResendTask{TransmissionId: trans.Id, NextAttempt: nextAttempt.UnixMilli()})
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// fastFailureCoolDown returns the delay before retrying the fast-failed transmission, which is the circuit breaker
+// cool-down for the open circuit, or the time of refilling one token for the rate limit of the subscription
+func fastFailureCoolDown(dic *di.Container, subscriptionName string, status models.TransmissionStatus) time.Duration {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	if status == notificationsModels.RateLimited {
+		ext, err := subscriptionExtensionOf(container.DBClientFrom(dic.Get), subscriptionName)
+		if err == nil && ext.RateLimit != nil && ext.RateLimit.Limit > 0 {
+			if interval, err := rateLimitInterval(*ext.RateLimit); err == nil {
+				return interval / time.Duration(ext.RateLimit.Limit)
+			}
+		}
+		lc.Debugf("fail to find the rate limit of subscription %s, retry after the circuit breaker cool-down", subscriptionName)
+	}
+	_, coolDown, err := circuitBreakerSetting(container.ConfigurationFrom(dic.Get))
+	if err != nil {
+		return defaultCircuitBreakerCoolDown
+	}
+	return coolDown
+}
+
+// processResendTask resends the notification of the transmission in the resend task, the task is rescheduled if the
+// transmission keeps RESENDING, otherwise the task is removed. The transmission state is checked before resending so
+// that the task left behind by a crash is handled correctly.
+func processResendTask(dic *di.Container, task notificationsModels.ResendTask) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	trans, err := dbClient.TransmissionById(task.TransmissionId)
+	if err != nil {
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			lc.Debugf("transmission %s of the resend task no longer exists", task.TransmissionId)
+			return deleteResendTask(dic, task.TransmissionId)
+		}
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	n, err := dbClient.NotificationById(trans.NotificationId)
+	if err != nil {
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			lc.Debugf("notification %s of the resend task no longer exists", trans.NotificationId)
+			return deleteResendTask(dic, task.TransmissionId)
+		}
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	switch trans.Status {
+	case models.RESENDING:
+	case models.Escalated:
+		// the service stopped after escalating the transmission but before sending the escalated notification
+		return escalateAndDeleteResendTask(dic, n, trans)
+	default:
+		return deleteResendTask(dic, task.TransmissionId)
+	}
+
+	// the subscription may have been deleted, then the default resend limit and interval are used
+	sub, err := dbClient.SubscriptionByName(trans.SubscriptionName)
+	if err != nil {
+		lc.Debugf("subscription %s of transmission %s not found, use the default resend limit and interval", trans.SubscriptionName, trans.Id)
+		sub = models.Subscription{}
+	}
+
+	trans, err = reSend(dic, n, sub, trans)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	switch trans.Status {
+	case models.RESENDING:
+		return scheduleResend(dic, sub, trans)
+	case models.Escalated:
+		return escalateAndDeleteResendTask(dic, n, trans)
+	default:
+		return deleteResendTask(dic, task.TransmissionId)
+	}
+}
+
+func escalateAndDeleteResendTask(dic *di.Container, n models.Notification, trans models.Transmission) errors.EdgeX {
+	err := escalatedSend(dic, n, trans)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "fail to handle the escalated notification sending", err)
+	}
+	return deleteResendTask(dic, trans.Id)
+}
+
+func deleteResendTask(dic *di.Container, transmissionId string) errors.EdgeX {
+	err := container.DBClientFrom(dic.Get).DeleteResendTaskByTransmissionId(transmissionId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// recoverResendTasks schedules the RESENDING transmissions which have no resend task, e.g. the service stopped after
+// changing the transmission status but before persisting the task, or the transmission was resending by the previous
+// in-memory implementation
+func recoverResendTasks(dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	resending, err := dbClient.TransmissionsByStatus(0, -1, string(models.RESENDING))
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	now := time.Now().UnixMilli()
+	for _, trans := range resending {
+		_, err = dbClient.ResendTaskByTransmissionId(trans.Id)
+		if err == nil {
+			continue
+		} else if errors.Kind(err) != errors.KindEntityDoesNotExist {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		lc.Infof("recover the resend task of transmission %s", trans.Id)
+		err = dbClient.UpsertResendTask(notificationsModels.ResendTask{TransmissionId: trans.Id, NextAttempt: now})
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// resendScheduler polls the due resend tasks from the database and dispatches them to a pool of workers, a task is
+// never dispatched again while it is being processed
+type resendScheduler struct {
+	dic           *di.Container
+	lc            logger.LoggingClient
+	workers       int
+	pollInterval  time.Duration
+	maxInterval   time.Duration
+	maxTaskErrors int
+	tasks         chan notificationsModels.ResendTask
+	mutex         sync.Mutex
+	inflight      map[string]struct{}
+	// taskErrors counts the consecutive errors of processing the resend task of each transmission
+	taskErrors map[string]int
+}
+
+// StartResendScheduler recovers the pending resends and starts the workers resending the failed critical notifications
+func StartResendScheduler(ctx context.Context, wg *sync.WaitGroup, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	pollInterval := defaultResendPollInterval
+	if config.Resend.PollInterval != "" {
+		var err error
+		pollInterval, err = time.ParseDuration(config.Resend.PollInterval)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse Resend.PollInterval %s", config.Resend.PollInterval), err)
+		}
+	}
+	var maxInterval time.Duration
+	if config.Resend.MaxInterval != "" {
+		var err error
+		maxInterval, err = time.ParseDuration(config.Resend.MaxInterval)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse Resend.MaxInterval %s", config.Resend.MaxInterval), err)
+		}
+	}
+
+	if err := recoverResendTasks(dic); err != nil {
+		// the scheduler still runs for the tasks already persisted
+		lc.Errorf("fail to recover the resend tasks: %v", err)
+	}
+
+	s := &resendScheduler{
+		dic:           dic,
+		lc:            lc,
+		workers:       max(config.Resend.Workers, defaultResendWorkers),
+		pollInterval:  pollInterval,
+		maxInterval:   maxInterval,
+		maxTaskErrors: defaultResendMaxTaskErrors,
+		inflight:      make(map[string]struct{}),
+		taskErrors:    make(map[string]int),
+	}
+	if config.Resend.MaxTaskErrors > 0 {
+		s.maxTaskErrors = config.Resend.MaxTaskErrors
+	}
+	s.tasks = make(chan notificationsModels.ResendTask, s.workers)
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.work(ctx)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.run(ctx)
+	}()
+	lc.Infof("resend scheduler started with %d workers", s.workers)
+	return nil
+}
+
+func (s *resendScheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			s.lc.Info("Exiting resend scheduler")
+			return
+		case <-ticker.C:
+			s.poll(ctx)
+		}
+	}
+}
+
+// poll dispatches the due resend tasks which are not being processed to the workers
+func (s *resendScheduler) poll(ctx context.Context) {
+	dbClient := container.DBClientFrom(s.dic.Get)
+	tasks, err := dbClient.ResendTasksByNextAttempt(time.Now().UnixMilli(), s.workers*2)
+	if err != nil {
+		s.lc.Errorf("fail to query the due resend tasks: %v", err)
+		return
+	}
+
+	for _, task := range tasks {
+		if !s.acquire(task.TransmissionId) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case s.tasks <- task:
+		}
+	}
+}
+
+func (s *resendScheduler) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task := <-s.tasks:
+			if err := processResendTask(s.dic, task); err != nil {
+				s.handleTaskError(task, err)
+			} else {
+				s.resetTaskErrors(task.TransmissionId)
+			}
+			s.release(task.TransmissionId)
+		}
+	}
+}
+
+// handleTaskError postpones the resend task failed to process with the backoff of its consecutive errors, so that the
+// task doesn't keep the workers busy while the database or the transmission is broken. The task is dropped after
+// maxTaskErrors consecutive errors, and the RESENDING transmission is recovered after the service restarts.
+func (s *resendScheduler) handleTaskError(task notificationsModels.ResendTask, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(s.dic.Get)
+
+	s.mutex.Lock()
+	s.taskErrors[task.TransmissionId]++
+	count := s.taskErrors[task.TransmissionId]
+	s.mutex.Unlock()
+
+	if count >= s.maxTaskErrors {
+		s.lc.Errorf("give up resending the notification of transmission %s after %d consecutive errors: %v", task.TransmissionId, count, err)
+		if deleteErr := dbClient.DeleteResendTaskByTransmissionId(task.TransmissionId); deleteErr != nil {
+			s.lc.Errorf("fail to delete the resend task of transmission %s: %v", task.TransmissionId, deleteErr)
+			return
+		}
+		s.resetTaskErrors(task.TransmissionId)
+		return
+	}
+
+	delay := resendBackoff(s.pollInterval, s.maxInterval, count)
+	s.lc.Errorf("fail to resend the notification of transmission %s, retry in %s: %v", task.TransmissionId, delay, err)
+	task.NextAttempt = time.Now().Add(delay).UnixMilli()
+	if upsertErr := dbClient.UpsertResendTask(task); upsertErr != nil {
+		s.lc.Errorf("fail to postpone the resend task of transmission %s: %v", task.TransmissionId, upsertErr)
+	}
+}
+
+func (s *resendScheduler) resetTaskErrors(transmissionId string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.taskErrors, transmissionId)
+}
+
+func (s *resendScheduler) acquire(transmissionId string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.inflight[transmissionId]; ok {
+		return false
+	}
+	s.inflight[transmissionId] = struct{}{}
+	return true
+}
+
+func (s *resendScheduler) release(transmissionId string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.inflight, transmissionId)
+}
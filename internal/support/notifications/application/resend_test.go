@@ -0,0 +1,170 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestProcessResendTask(t *testing.T) {
+	criticalNotification := notification
+	criticalNotification.Id = "critical-notification"
+	criticalNotification.Severity = models.Critical
+
+	newTransmission := func(id string, address models.Address, status models.TransmissionStatus, resendCount int) models.Transmission {
+		trans := models.NewTransmission(sub.Name, address, criticalNotification.Id)
+		trans.Id = id
+		trans.Status = status
+		trans.ResendCount = resendCount
+		return trans
+	}
+	sentTrans := newTransmission("sent", testRestAddress, models.RESENDING, 0)
+	retryTrans := newTransmission("retry", testRestAddress2, models.RESENDING, 0)
+	escalateTrans := newTransmission("escalate", testRestAddress2, models.RESENDING, 1)
+	escalatedTrans := newTransmission("escalated", testRestAddress2, models.Escalated, 2)
+	acknowledgedTrans := newTransmission("acknowledged", testRestAddress2, models.Acknowledged, 1)
+	notFoundId := "not-found"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	for _, trans := range []models.Transmission{sentTrans, retryTrans, escalateTrans, escalatedTrans, acknowledgedTrans} {
+		dbClientMock.On("TransmissionById", trans.Id).Return(trans, nil)
+	}
+	dbClientMock.On("TransmissionById", notFoundId).Return(models.Transmission{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("NotificationById", criticalNotification.Id).Return(criticalNotification, nil)
+	dbClientMock.On("SubscriptionByName", sub.Name).Return(sub, nil)
+	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	dbClientMock.On("UpsertResendTask", mock.Anything).Return(nil)
+	dbClientMock.On("DeleteResendTaskByTransmissionId", mock.Anything).Return(nil)
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", sub.Name).Return(notificationsModels.SubscriptionExtension{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription extension not found", nil))
+	dbClientMock.On("TemplatesByCategory", mock.Anything).Return([]notificationsModels.Template{}, nil)
+	// the escalated notification is skipped without the escalation policy and the escalation subscription
+	dbClientMock.On("EscalationPoliciesByCategories", mock.Anything).Return([]notificationsModels.EscalationPolicy{}, nil)
+	dbClientMock.On("SubscriptionByName", models.EscalationSubscriptionName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", criticalNotification, testRestAddress).Return("", nil)
+	restSender.On("Send", criticalNotification, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+	})
+
+	tests := []struct {
+		name                string
+		transmissionId      string
+		expectedRescheduled bool
+		expectedDeleted     bool
+	}{
+		{"resend successfully", sentTrans.Id, false, true},
+		{"resend failed, reschedule", retryTrans.Id, true, false},
+		{"resend failed, escalate", escalateTrans.Id, false, true},
+		{"escalated before crash", escalatedTrans.Id, false, true},
+		{"already acknowledged", acknowledgedTrans.Id, false, true},
+		{"transmission not found", notFoundId, false, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := processResendTask(dic, notificationsModels.ResendTask{TransmissionId: testCase.transmissionId})
+			require.NoError(t, err)
+
+			isTask := mock.MatchedBy(func(task notificationsModels.ResendTask) bool {
+				return task.TransmissionId == testCase.transmissionId
+			})
+			if testCase.expectedRescheduled {
+				dbClientMock.AssertCalled(t, "UpsertResendTask", isTask)
+			} else {
+				dbClientMock.AssertNotCalled(t, "UpsertResendTask", isTask)
+			}
+			if testCase.expectedDeleted {
+				dbClientMock.AssertCalled(t, "DeleteResendTaskByTransmissionId", testCase.transmissionId)
+			} else {
+				dbClientMock.AssertNotCalled(t, "DeleteResendTaskByTransmissionId", testCase.transmissionId)
+			}
+		})
+	}
+}
+
+func TestResendSchedulerHandleTaskError(t *testing.T) {
+	task := notificationsModels.ResendTask{TransmissionId: "failing"}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("UpsertResendTask", mock.Anything).Return(nil)
+	dbClientMock.On("DeleteResendTaskByTransmissionId", task.TransmissionId).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	s := &resendScheduler{
+		dic:           dic,
+		lc:            logger.NewMockClient(),
+		pollInterval:  time.Minute,
+		maxTaskErrors: 2,
+		taskErrors:    make(map[string]int),
+	}
+	taskErr := errors.NewCommonEdgeX(errors.KindDatabaseError, "database error", nil)
+
+	// the failed task is postponed instead of being picked up again on the next poll
+	s.handleTaskError(task, taskErr)
+	dbClientMock.AssertCalled(t, "UpsertResendTask", mock.MatchedBy(func(postponed notificationsModels.ResendTask) bool {
+		return postponed.TransmissionId == task.TransmissionId && postponed.NextAttempt > time.Now().UnixMilli()
+	}))
+	dbClientMock.AssertNotCalled(t, "DeleteResendTaskByTransmissionId", task.TransmissionId)
+
+	// the task is dropped after the max consecutive errors
+	s.handleTaskError(task, taskErr)
+	dbClientMock.AssertNumberOfCalls(t, "UpsertResendTask", 1)
+	dbClientMock.AssertCalled(t, "DeleteResendTaskByTransmissionId", task.TransmissionId)
+	assert.Empty(t, s.taskErrors)
+}
+
+func TestRecoverResendTasks(t *testing.T) {
+	scheduled := models.NewTransmission(sub.Name, testRestAddress, notification.Id)
+	scheduled.Id = "scheduled"
+	unscheduled := models.NewTransmission(sub.Name, testRestAddress, notification.Id)
+	unscheduled.Id = "unscheduled"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("TransmissionsByStatus", 0, -1, string(models.RESENDING)).Return([]models.Transmission{scheduled, unscheduled}, nil)
+	// the task scheduled far in the future is still found
+	dbClientMock.On("ResendTaskByTransmissionId", scheduled.Id).Return(notificationsModels.ResendTask{TransmissionId: scheduled.Id, NextAttempt: time.Now().Add(24 * time.Hour).UnixMilli()}, nil)
+	dbClientMock.On("ResendTaskByTransmissionId", unscheduled.Id).Return(notificationsModels.ResendTask{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("UpsertResendTask", mock.Anything).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	err := recoverResendTasks(dic)
+	require.NoError(t, err)
+	dbClientMock.AssertNumberOfCalls(t, "UpsertResendTask", 1)
+	dbClientMock.AssertCalled(t, "UpsertResendTask", mock.MatchedBy(func(task notificationsModels.ResendTask) bool {
+		return task.TransmissionId == unscheduled.Id
+	}))
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -7,12 +7,15 @@ package application
 
 import (
 	"fmt"
+	"math"
+	"math/rand/v2"
 	"time"
 
 	pkgCommon "github.com/edgexfoundry/edgex-go/internal/pkg/common"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -25,52 +28,61 @@ import (
 func firstSend(dic *di.Container, n models.Notification, trans models.Transmission) models.Transmission {
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
-	record := sendNotificationViaChannel(dic, n, trans.Channel)
+	record := sendNotificationViaChannel(dic, n, trans.SubscriptionName, trans.Channel)
 	trans.Records = append(trans.Records, record)
 	trans.Status = record.Status
 	lc.Debugf("sent the notification to %s with address %v, transmission status %s", trans.SubscriptionName, trans.Channel.GetBaseAddress(), trans.Status)
 	return trans
 }
 
-// reSend sends the Critical notification and return the transmission
+// reSend makes one attempt to resend the Critical notification and returns the transmission, which keeps RESENDING if
+// the attempt fails and becomes Escalated once the resend count reaches the resend limit. The fast failure of the rate
+// limit or the circuit breaker isn't a send attempt and doesn't consume the resend count.
 func reSend(dic *di.Container, n models.Notification, sub models.Subscription, trans models.Transmission) (models.Transmission, errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 	config := container.ConfigurationFrom(dic.Get)
 
-	resendLimit, resendInterval, err := resendLimitAndInterval(config, sub)
+	resendLimit, _, err := resendLimitAndInterval(config, sub)
 	if err != nil {
 		return trans, errors.NewCommonEdgeXWrapper(err)
 	}
-	for i := 1; i <= resendLimit; i++ {
-		// Since this sending process is triggered for the critical notification which is failed to send to the subscription at the first time,
-		// so we wait seconds and retry to send the notification again.
-		time.Sleep(resendInterval)
-		lc.Warn("fail to send the critical notification. Retry to send again...")
 
-		record := sendNotificationViaChannel(dic, n, trans.Channel)
-		if record.Status == models.Failed {
-			// fail to transmit the notification, keep resending
-			trans.Status = models.RESENDING
+	record := sendNotificationViaChannel(dic, n, trans.SubscriptionName, trans.Channel)
+	trans.Records = append(trans.Records, record)
+	if fastFailed(record.Status) {
+		// the notification isn't sent, keep resending after the cool-down without consuming the resend count until the
+		// destination keeps failing fast for too long
+		if fastFailures := trailingFastFailures(trans.Records); fastFailures >= maxFastFailures(config) {
+			lc.Warnf("the critical notification to %s fails fast %d times in a row, escalate the transmission %s", trans.SubscriptionName, fastFailures, trans.Id)
+			trans.Status = models.Escalated
 		} else {
-			trans.Status = record.Status
+			lc.Debugf("the critical notification to %s with address %v fails fast with %s, transmission Id: %s", trans.SubscriptionName, trans.Channel.GetBaseAddress(), record.Status, trans.Id)
 		}
-		trans.ResendCount = trans.ResendCount + 1
-		trans.Records = append(trans.Records, record)
 		err = dbClient.UpdateTransmission(trans)
 		if err != nil {
 			return trans, errors.NewCommonEdgeXWrapper(err)
 		}
+		return trans, nil
+	}
+	if sendingFailed(record.Status) {
+		// fail to transmit the notification, keep resending
+		trans.Status = models.RESENDING
+	} else {
+		trans.Status = record.Status
+	}
+	trans.ResendCount = trans.ResendCount + 1
 
-		if trans.Status == models.RESENDING {
-			continue
-		}
+	switch {
+	case trans.Status != models.RESENDING:
 		lc.Debugf("success to send the critical notification to %s with address %v, transmission Id: %s", trans.SubscriptionName, trans.Channel.GetBaseAddress(), trans.Id)
-		return trans, nil
+	case trans.ResendCount >= resendLimit:
+		lc.Warn("Resend count exceeds the configurable limit, escalate the transmission.")
+		trans.Status = models.Escalated
+	default:
+		lc.Warnf("fail to resend the critical notification to %s with address %v, transmission Id: %s, resend count: %d", trans.SubscriptionName, trans.Channel.GetBaseAddress(), trans.Id, trans.ResendCount)
 	}
 
-	lc.Warn("Resend count exceeds the configurable limit, escalate the transmission.")
-	trans.Status = models.Escalated
 	err = dbClient.UpdateTransmission(trans)
 	if err != nil {
 		return trans, errors.NewCommonEdgeXWrapper(err)
@@ -78,6 +90,44 @@ func reSend(dic *di.Container, n models.Notification, sub models.Subscription, t
 	return trans, nil
 }
 
+// trailingFastFailures returns the number of the fast-failed records at the end of the transmission records, i.e. the
+// fast failures since the last real send attempt
+func trailingFastFailures(records []models.TransmissionRecord) int {
+	count := 0
+	for i := len(records) - 1; i >= 0 && fastFailed(records[i].Status); i-- {
+		count++
+	}
+	return count
+}
+
+func maxFastFailures(config *config.ConfigurationStruct) int {
+	if config.Resend.MaxFastFailures > 0 {
+		return config.Resend.MaxFastFailures
+	}
+	return defaultResendMaxFastFailures
+}
+
+// resendBackoff returns the delay before the next resend attempt, which starts from the resend interval and doubles on
+// each attempt up to the max interval, a random jitter of up to half of the delay is subtracted so that the failed
+// transmissions don't retry at the same time
+func resendBackoff(interval time.Duration, maxInterval time.Duration, resendCount int) time.Duration {
+	delay := interval
+	for i := 0; i < resendCount && (maxInterval <= 0 || delay < maxInterval); i++ {
+		if delay > math.MaxInt64/2 {
+			break
+		}
+		delay *= 2
+	}
+	if maxInterval > 0 && delay > maxInterval {
+		delay = maxInterval
+	}
+	if delay <= 1 {
+		return delay
+	}
+	half := delay / 2
+	return delay - half + rand.N(half+1)
+}
+
 func resendLimitAndInterval(config *config.ConfigurationStruct, sub models.Subscription) (int, time.Duration, errors.EdgeX) {
 	resendLimit := config.Writable.ResendLimit
 	if sub.ResendLimit > 0 {
@@ -94,11 +144,20 @@ func resendLimitAndInterval(config *config.ConfigurationStruct, sub models.Subsc
 	return resendLimit, resendIntervalDuration, nil
 }
 
-// escalatedSend handle the escalated notification for the ESCALATION subscription
+// escalatedSend escalates the notification with the escalation policy of the transmission, or handles the escalated
+// notification for the ESCALATION subscription if there is no policy
 func escalatedSend(dic *di.Container, n models.Notification, trans models.Transmission) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
+	p, found, err := escalationPolicyOf(dic, n, trans)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), "fail to query the escalation policy", err)
+	}
+	if found {
+		return startEscalation(dic, n, trans, p)
+	}
+
 	sub, err := dbClient.SubscriptionByName(models.EscalationSubscriptionName)
 	if err != nil {
 		lc.Warnf(fmt.Sprintf("subscription %s does not exists, skip the escalated notification sending", models.EscalationSubscriptionName))
@@ -112,7 +171,7 @@ func escalatedSend(dic *di.Container, n models.Notification, trans models.Transm
 	}
 
 	for _, address := range sub.Channels {
-		go transmit(dic, escalated, sub, address) // nolint:errcheck
+		dispatchTransmission(dic, escalated, sub, address)
 	}
 	return nil
 }
@@ -126,14 +185,70 @@ func escalatedNotification(n models.Notification, trans models.Transmission) mod
 	return n
 }
 
-// sendNotificationViaChannel sends notification via address and return the transmission record. The record status should be SENT or FAILED.
-func sendNotificationViaChannel(dic *di.Container, n models.Notification, address models.Address) (transRecord models.TransmissionRecord) {
+// sendingFailed tells whether the transmission record status means the notification is sent but not delivered
+func sendingFailed(status models.TransmissionStatus) bool {
+	return status == models.Failed
+}
+
+// fastFailed tells whether the transmission record status means the notification is not sent because of the rate
+// limit or the circuit breaker
+func fastFailed(status models.TransmissionStatus) bool {
+	return status == notificationsModels.RateLimited || status == notificationsModels.CircuitOpen
+}
+
+// sendNotificationViaChannel renders the notification with the subscription template, sends it via address and return
+// the transmission record. The record status should be SENT or FAILED, or RATE_LIMITED and CIRCUIT_OPEN if the
+// notification is not sent because of the subscription rate limit or the circuit breaker of the destination.
+func sendNotificationViaChannel(dic *di.Container, n models.Notification, subscriptionName string, address models.Address) (transRecord models.TransmissionRecord) {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	config := container.ConfigurationFrom(dic.Get)
+
+	ext, extErr := subscriptionExtensionOf(container.DBClientFrom(dic.Get), subscriptionName)
+	if extErr != nil {
+		transRecord.Status = models.Failed
+		transRecord.Response = extErr.Error()
+		transRecord.Sent = pkgCommon.MakeTimestamp()
+		return transRecord
+	}
+
+	now := time.Now()
+	allowed, limitErr := takeRateLimitToken(ext, now)
+	if limitErr != nil {
+		lc.Warnf("fail to check the rate limit of subscription %s, send the notification anyway: %v", subscriptionName, limitErr)
+	} else if !allowed {
+		transRecord.Status = notificationsModels.RateLimited
+		transRecord.Response = fmt.Sprintf("the rate limit of subscription %s is exceeded", subscriptionName)
+		transRecord.Sent = pkgCommon.MakeTimestamp()
+		return transRecord
+	}
+
+	threshold, coolDown, settingErr := circuitBreakerSetting(config)
+	if settingErr != nil {
+		lc.Warnf("invalid circuit breaker setting, use the default cool-down: %v", settingErr)
+		coolDown = defaultCircuitBreakerCoolDown
+	}
+	destination := destinationOf(config, address)
+	if threshold > 0 {
+		if !destinationCircuits.allow(destination, coolDown, now) {
+			transRecord.Status = notificationsModels.CircuitOpen
+			transRecord.Response = fmt.Sprintf("the circuit of destination %s is open, the destination is known down", destination)
+			transRecord.Sent = pkgCommon.MakeTimestamp()
+			return transRecord
+		}
+		defer func() {
+			if destinationCircuits.record(destination, transRecord.Status != models.Failed, threshold, time.Now()) {
+				lc.Warnf("the circuit of destination %s opens, the transmissions fail fast in the next %v", destination, coolDown)
+			}
+		}()
+	}
+
 	var err errors.EdgeX
 	transRecord.Status = models.Sent
+	webhook := isWebhookChannel(ext, address)
+	n, address = renderNotification(dic, n, ext, address)
 	switch address.GetBaseAddress().Type {
 	case common.REST:
-		restSender := channel.RESTSenderFrom(dic.Get)
-		transRecord.Response, err = restSender.Send(n, address)
+		transRecord.Response, err = sendViaRESTChannel(dic, n, ext, address, webhook)
 	case common.EMAIL:
 		emailSender := channel.EmailSenderFrom(dic.Get)
 		transRecord.Response, err = emailSender.Send(n, address)
@@ -155,3 +270,21 @@ func sendNotificationViaChannel(dic *di.Container, n models.Notification, addres
 	transRecord.Sent = pkgCommon.MakeTimestamp()
 	return transRecord
 }
+
+// sendViaRESTChannel sends the notification via the REST address, the WEBHOOK channel is sent with the webhook setting
+// of the subscription, the notification content is sent as is if the subscription has no webhook setting
+func sendViaRESTChannel(dic *di.Container, n models.Notification, ext notificationsModels.SubscriptionExtension, address models.Address, webhook bool) (string, errors.EdgeX) {
+	if !webhook {
+		return channel.RESTSenderFrom(dic.Get).Send(n, address)
+	}
+
+	restAddress, ok := address.(models.RESTAddress)
+	if !ok {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast Address to RESTAddress", nil)
+	}
+	webhookAddress := notificationsModels.WebhookAddress{RESTAddress: restAddress}
+	if ext.Webhook != nil {
+		webhookAddress.Webhook = *ext.Webhook
+	}
+	return channel.WebhookSenderFrom(dic.Get).Send(n, webhookAddress)
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,11 +8,13 @@ package application
 import (
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	senderMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel/mocks"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
@@ -68,6 +70,9 @@ var testEmailAddress2 = models.EmailAddress{
 
 func TestFirstSend(t *testing.T) {
 	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", sub.Name).Return(notificationsModels.SubscriptionExtension{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription extension not found", nil))
+	dbClientMock.On("TemplatesByCategory", mock.Anything).Return([]notificationsModels.Template{}, nil)
 	restSender := &senderMock.Sender{}
 	restSender.On("Send", notification, testRestAddress).Return("", nil)
 	restSender.On("Send", notification, testRestAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the request", nil))
@@ -75,6 +80,9 @@ func TestFirstSend(t *testing.T) {
 	emailSender.On("Send", notification, testEmailAddress).Return("", nil)
 	emailSender.On("Send", notification, testEmailAddress2).Return("", errors.NewCommonEdgeX(errors.KindServerError, "fail to send the email", nil))
 	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
 		},
@@ -115,6 +123,8 @@ func TestReSend(t *testing.T) {
 	config := container.ConfigurationFrom(dic.Get)
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("UpdateTransmission", mock.Anything).Return(nil)
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", sub.Name).Return(notificationsModels.SubscriptionExtension{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription extension not found", nil))
+	dbClientMock.On("TemplatesByCategory", mock.Anything).Return([]notificationsModels.Template{}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -137,32 +147,101 @@ func TestReSend(t *testing.T) {
 	})
 
 	tests := []struct {
-		name          string
-		address       models.Address
-		expectedError bool
+		name                string
+		address             models.Address
+		resendCount         int
+		expectedStatus      models.TransmissionStatus
+		expectedResendCount int
 	}{
-		{"sent rest address successful", testRestAddress, false},
-		{"sent email address successful", testEmailAddress, false},
-		{"sent rest failed", testRestAddress2, true},
-		{"sent email failed", testEmailAddress2, true},
+		{"sent rest address successful", testRestAddress, 0, models.Sent, 1},
+		{"sent email address successful", testEmailAddress, 0, models.Sent, 1},
+		{"sent rest failed, keep resending", testRestAddress2, 0, models.RESENDING, 1},
+		{"sent email failed, keep resending", testEmailAddress2, 0, models.RESENDING, 1},
+		{"sent rest failed, escalated", testRestAddress2, config.Writable.ResendLimit - 1, models.Escalated, config.Writable.ResendLimit},
+		{"sent email failed, escalated", testEmailAddress2, config.Writable.ResendLimit - 1, models.Escalated, config.Writable.ResendLimit},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
 			sub.Channels = []models.Address{testCase.address}
 			trans := models.NewTransmission(sub.Name, testCase.address, notification.Id)
+			trans.Status = models.RESENDING
+			trans.ResendCount = testCase.resendCount
 
 			trans, err := reSend(dic, notification, sub, trans)
 			require.NoError(t, err)
 
-			if testCase.expectedError {
-				assert.EqualValues(t, models.Escalated, trans.Status)
-				assert.Equal(t, config.Writable.ResendLimit, trans.ResendCount)
-				assert.Equal(t, config.Writable.ResendLimit, len(trans.Records))
-			} else {
-				assert.EqualValues(t, models.Sent, trans.Status)
-				assert.Equal(t, 1, trans.ResendCount)
-				assert.Equal(t, 1, len(trans.Records))
+			assert.EqualValues(t, testCase.expectedStatus, trans.Status)
+			assert.Equal(t, testCase.expectedResendCount, trans.ResendCount)
+			assert.Equal(t, 1, len(trans.Records))
+		})
+	}
+}
+
+func TestResendBackoff(t *testing.T) {
+	tests := []struct {
+		name        string
+		interval    time.Duration
+		maxInterval time.Duration
+		resendCount int
+		expectedMax time.Duration
+	}{
+		{"first resend", time.Second, time.Minute, 0, time.Second},
+		{"exponential backoff", time.Second, time.Minute, 3, 8 * time.Second},
+		{"capped by max interval", time.Second, time.Minute, 10, time.Minute},
+		{"no max interval", time.Second, 0, 10, 1024 * time.Second},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				delay := resendBackoff(testCase.interval, testCase.maxInterval, testCase.resendCount)
+				assert.LessOrEqual(t, delay, testCase.expectedMax)
+				assert.GreaterOrEqual(t, delay, testCase.expectedMax/2)
 			}
 		})
 	}
 }
+
+func TestSendNotificationViaChannel_WebhookChannel(t *testing.T) {
+	dic := mockDic()
+	webhook := notificationsModels.Webhook{BodyTemplate: `{"text":"{{.Content}}"}`, SecretName: "webhook"}
+	ext := notificationsModels.SubscriptionExtension{
+		SubscriptionName: "webhookSubscription",
+		Webhook:          &webhook,
+		WebhookChannels:  []models.RESTAddress{testRestAddress},
+	}
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", ext.SubscriptionName).Return(ext, nil)
+	dbClientMock.On("TemplatesByCategory", mock.Anything).Return([]notificationsModels.Template{}, nil)
+	restSender := &senderMock.Sender{}
+	restSender.On("Send", notification, testRestAddress2).Return("", nil)
+	webhookSender := &senderMock.Sender{}
+	webhookSender.On("Send", notification, notificationsModels.WebhookAddress{RESTAddress: testRestAddress, Webhook: webhook}).Return("", nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		channel.RESTSenderName: func(get di.Get) interface{} {
+			return restSender
+		},
+		channel.WebhookSenderName: func(get di.Get) interface{} {
+			return webhookSender
+		},
+	})
+
+	tests := []struct {
+		name    string
+		address models.Address
+		sender  *senderMock.Sender
+	}{
+		{"WEBHOOK channel sent with the webhook setting", testRestAddress, webhookSender},
+		{"REST channel sent as is", testRestAddress2, restSender},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			record := sendNotificationViaChannel(dic, notification, ext.SubscriptionName, testCase.address)
+
+			assert.EqualValues(t, models.Sent, record.Status)
+			testCase.sender.AssertNumberOfCalls(t, "Send", 1)
+		})
+	}
+}
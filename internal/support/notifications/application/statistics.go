@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// DefaultStatisticsRange is the time range of the statistics before the end time if the start time is not specified
+const DefaultStatisticsRange = 7 * 24 * time.Hour
+
+// statisticsInterval returns the interval of the time bucket in milliseconds, the whole time range is a single bucket
+// if the interval is not specified
+func statisticsInterval(start, end int64, interval string) (int64, errors.EdgeX) {
+	if interval == "" {
+		return end - start + 1, nil
+	}
+	d, err := time.ParseDuration(interval)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse the interval %s", interval), err)
+	}
+	if d.Milliseconds() <= 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the interval %s must be at least 1ms", interval), nil)
+	}
+	return d.Milliseconds(), nil
+}
+
+// NotificationStatistics counts the notifications of the category and the severity created within the time range by
+// the group and the time bucket of the interval
+func NotificationStatistics(groupBy string, start, end int64, interval string, category, severity string, dic *di.Container) ([]dtos.NotificationStatistic, errors.EdgeX) {
+	switch groupBy {
+	case notificationsModels.GroupByCategory, notificationsModels.GroupBySeverity, notificationsModels.GroupByLabel, notificationsModels.GroupByStatus:
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported group by %s, the notifications can be grouped by %s, %s, %s or %s", groupBy,
+			notificationsModels.GroupByCategory, notificationsModels.GroupBySeverity, notificationsModels.GroupByLabel, notificationsModels.GroupByStatus), nil)
+	}
+	if end < start {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be less than start's value %v", end, start), nil)
+	}
+	intervalMillis, err := statisticsInterval(start, end, interval)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	statistics, err := dbClient.NotificationStatistics(groupBy, start, end, intervalMillis, category, severity)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	dtoList := make([]dtos.NotificationStatistic, len(statistics))
+	for i, s := range statistics {
+		dtoList[i] = dtos.FromNotificationStatisticModelToDTO(s)
+	}
+	return dtoList, nil
+}
+
+// SubscriptionDeliveryStatistics returns the delivery statistics of the transmissions created within the time range by
+// the subscription, the subscription with the worst delivery rate comes first
+func SubscriptionDeliveryStatistics(start, end int64, dic *di.Container) ([]dtos.SubscriptionDeliveryStatistic, errors.EdgeX) {
+	if end < start {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end's value %v is not allowed to be less than start's value %v", end, start), nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	statistics, err := dbClient.SubscriptionDeliveryStatistics(start, end)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	dtoList := make([]dtos.SubscriptionDeliveryStatistic, len(statistics))
+	for i, s := range statistics {
+		dtoList[i] = dtos.FromSubscriptionDeliveryStatisticModelToDTO(s)
+	}
+	sort.SliceStable(dtoList, func(i, j int) bool {
+		return dtoList[i].DeliveryRate < dtoList[j].DeliveryRate
+	})
+	return dtoList, nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2020-2024 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -12,8 +12,10 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -25,8 +27,9 @@ import (
 )
 
 // The AddSubscription function accepts the new Subscription model from the controller function
-// and then invokes AddSubscription function of infrastructure layer to add new Subscription
-func AddSubscription(d models.Subscription, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
+// and then invokes AddSubscription function of infrastructure layer to add new Subscription.
+// The webhookChannels are the REST channels of the subscription which are added as WEBHOOK channels.
+func AddSubscription(d models.Subscription, webhookChannels []models.RESTAddress, ctx context.Context, dic *di.Container) (id string, edgeXerr errors.EdgeX) {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
@@ -34,6 +37,15 @@ func AddSubscription(d models.Subscription, ctx context.Context, dic *di.Contain
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
 	}
+	if len(webhookChannels) > 0 {
+		err = setWebhookChannels(dbClient, d.Name, webhookChannels)
+	}
+	if err != nil {
+		if deleteErr := dbClient.DeleteSubscriptionByName(d.Name); deleteErr != nil {
+			lc.Errorf("fail to roll back the subscription %s: %v", d.Name, deleteErr)
+		}
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
 
 	lc.Debugf("Subscription created on DB successfully. Subscription ID: %s, Correlation-ID: %s ",
 		addedSubscription.Id,
@@ -59,9 +71,9 @@ func AllSubscriptions(offset, limit int, dic *di.Container) (subscriptions []dto
 	if err != nil {
 		return subscriptions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	subscriptions = make([]dtos.Subscription, len(subs))
-	for i, sub := range subs {
-		subscriptions[i] = dtos.FromSubscriptionModelToDTO(sub)
+	subscriptions, err = fromSubscriptionModelsToDTOs(dbClient, subs)
+	if err != nil {
+		return subscriptions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
 	return subscriptions, totalCount, nil
 }
@@ -76,7 +88,10 @@ func SubscriptionByName(name string, dic *di.Container) (subscription dtos.Subsc
 	if err != nil {
 		return subscription, errors.NewCommonEdgeXWrapper(err)
 	}
-	subscription = dtos.FromSubscriptionModelToDTO(subscriptionModel)
+	subscription, err = fromSubscriptionModelToDTO(dbClient, subscriptionModel)
+	if err != nil {
+		return subscription, errors.NewCommonEdgeXWrapper(err)
+	}
 	return subscription, nil
 }
 
@@ -100,9 +115,9 @@ func SubscriptionsByCategory(offset, limit int, category string, dic *di.Contain
 	if err != nil {
 		return subscriptions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	subscriptions = make([]dtos.Subscription, len(subscriptionModels))
-	for i, s := range subscriptionModels {
-		subscriptions[i] = dtos.FromSubscriptionModelToDTO(s)
+	subscriptions, err = fromSubscriptionModelsToDTOs(dbClient, subscriptionModels)
+	if err != nil {
+		return subscriptions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
 	return subscriptions, totalCount, nil
 }
@@ -127,9 +142,9 @@ func SubscriptionsByLabel(offset, limit int, label string, dic *di.Container) (s
 	if err != nil {
 		return subscriptions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	subscriptions = make([]dtos.Subscription, len(subscriptionModels))
-	for i, s := range subscriptionModels {
-		subscriptions[i] = dtos.FromSubscriptionModelToDTO(s)
+	subscriptions, err = fromSubscriptionModelsToDTOs(dbClient, subscriptionModels)
+	if err != nil {
+		return subscriptions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
 	return subscriptions, totalCount, nil
 }
@@ -154,9 +169,9 @@ func SubscriptionsByReceiver(offset, limit int, receiver string, dic *di.Contain
 	if err != nil {
 		return subscriptions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
-	subscriptions = make([]dtos.Subscription, len(subscriptionModels))
-	for i, s := range subscriptionModels {
-		subscriptions[i] = dtos.FromSubscriptionModelToDTO(s)
+	subscriptions, err = fromSubscriptionModelsToDTOs(dbClient, subscriptionModels)
+	if err != nil {
+		return subscriptions, totalCount, errors.NewCommonEdgeXWrapper(err)
 	}
 	return subscriptions, totalCount, nil
 }
@@ -180,11 +195,26 @@ func DeleteSubscriptionByName(name string, ctx context.Context, dic *di.Containe
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	err = dbClient.DeleteSubscriptionExtensionBySubscriptionName(name)
+	if err != nil {
+		// the subscription is already deleted, so the failure is only logged
+		bootstrapContainer.LoggingClientFrom(dic.Get).Warnf("fail to delete the extension of subscription %s: %v", name, err)
+	}
+	err = dbClient.DeleteDigestItemsBySubscriptionName(name)
+	if err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Warnf("fail to delete the pending digest of subscription %s: %v", name, err)
+	}
+	err = dbClient.DeleteHeldNotificationsBySubscriptionName(name)
+	if err != nil {
+		bootstrapContainer.LoggingClientFrom(dic.Get).Warnf("fail to delete the held notifications of subscription %s: %v", name, err)
+	}
+	subscriptionRateLimiters.remove(name)
 	return nil
 }
 
-// PatchSubscription executes the PATCH operation with the subscription DTO to replace the old data
-func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, dic *di.Container) errors.EdgeX {
+// PatchSubscription executes the PATCH operation with the subscription DTO to replace the old data, the webhookChannels
+// are the REST channels of the subscription which are patched as WEBHOOK channels
+func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, webhookChannels []models.RESTAddress, dic *di.Container) errors.EdgeX {
 	dbClient := container.DBClientFrom(dic.Get)
 	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
 
@@ -192,6 +222,7 @@ func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, dic *di
 	if err != nil {
 		return errors.NewCommonEdgeXWrapper(err)
 	}
+	old := subscription
 
 	requests.ReplaceSubscriptionModelFieldsWithDTO(&subscription, dto)
 
@@ -204,6 +235,16 @@ func PatchSubscription(ctx context.Context, dto dtos.UpdateSubscription, dic *di
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	if dto.Channels != nil {
+		err = setWebhookChannels(dbClient, subscription.Name, webhookChannels)
+		if err != nil {
+			if updateErr := dbClient.UpdateSubscription(old); updateErr != nil {
+				lc.Errorf("fail to roll back the subscription %s: %v", subscription.Name, updateErr)
+			}
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
 	lc.Debugf("Subscription patched on DB successfully. Correlation-ID: %s ", correlation.FromContext(ctx))
 
 	edgexErr := channel.RemoveClientFromCache(dic, subscription.Channels)
@@ -231,3 +272,65 @@ func subscriptionByDTO(dbClient interfaces.DBClient, dto dtos.UpdateSubscription
 	}
 	return subscription, nil
 }
+
+// setWebhookChannels keeps the WEBHOOK channels of the subscription in the subscription extension, the WEBHOOK channels
+// are replaced as a whole
+func setWebhookChannels(dbClient interfaces.DBClient, subscriptionName string, webhookChannels []models.RESTAddress) errors.EdgeX {
+	ext, err := subscriptionExtensionOf(dbClient, subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(ext.WebhookChannels) == 0 && len(webhookChannels) == 0 {
+		return nil
+	}
+	ext.WebhookChannels = webhookChannels
+	err = dbClient.UpsertSubscriptionExtension(ext)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.Kind(err), fmt.Sprintf("fail to store the WEBHOOK channels of subscription %s", subscriptionName), err)
+	}
+	return nil
+}
+
+// isWebhookChannel checks whether the address is one of the WEBHOOK channels of the subscription
+func isWebhookChannel(ext notificationsModels.SubscriptionExtension, address models.Address) bool {
+	restAddress, ok := address.(models.RESTAddress)
+	if !ok {
+		return false
+	}
+	for _, c := range ext.WebhookChannels {
+		if c.Scheme == restAddress.Scheme && c.Host == restAddress.Host && c.Port == restAddress.Port && c.Path == restAddress.Path &&
+			c.HTTPMethod == restAddress.HTTPMethod && c.InjectEdgeXAuth == restAddress.InjectEdgeXAuth {
+			return true
+		}
+	}
+	return false
+}
+
+// fromSubscriptionModelToDTO transforms the Subscription model to the Subscription DTO, the type of the WEBHOOK channels
+// stored as REST channels is restored
+func fromSubscriptionModelToDTO(dbClient interfaces.DBClient, subscription models.Subscription) (dtos.Subscription, errors.EdgeX) {
+	dto := dtos.FromSubscriptionModelToDTO(subscription)
+	ext, err := subscriptionExtensionOf(dbClient, subscription.Name)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+	for i, address := range subscription.Channels {
+		if isWebhookChannel(ext, address) {
+			dto.Channels[i].Type = constants.WEBHOOK
+		}
+	}
+	return dto, nil
+}
+
+// fromSubscriptionModelsToDTOs transforms the Subscription models to the Subscription DTOs with fromSubscriptionModelToDTO
+func fromSubscriptionModelsToDTOs(dbClient interfaces.DBClient, subscriptions []models.Subscription) ([]dtos.Subscription, errors.EdgeX) {
+	dtoList := make([]dtos.Subscription, len(subscriptions))
+	for i, s := range subscriptions {
+		dto, err := fromSubscriptionModelToDTO(dbClient, s)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		dtoList[i] = dto
+	}
+	return dtoList, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
@@ -98,6 +99,8 @@ func TestPatchSubscription(t *testing.T) {
 	valid := updateSubscriptionData()
 	dbClientMock.On("SubscriptionById", *valid.Id).Return(model, nil)
 	dbClientMock.On("UpdateSubscription", model).Return(nil)
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", model.Name).
+		Return(notificationsModels.SubscriptionExtension{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription extension doesn't exist", nil))
 
 	emptyCategoriesAndLabels := updateSubscriptionData()
 	emptyCategoriesAndLabels.Categories = []string{}
@@ -126,7 +129,7 @@ func TestPatchSubscription(t *testing.T) {
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
-			err := PatchSubscription(context.Background(), testCase.subscription, dic)
+			err := PatchSubscription(context.Background(), testCase.subscription, nil, dic)
 			if testCase.errorExpected {
 				require.Error(t, err)
 				assert.Equal(t, testCase.expectedErrorKind, errors.Kind(err))
@@ -0,0 +1,170 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// subscriptionExtensionOf returns the extension of the subscription, the empty extension is returned if the
+// subscription has no extension
+func subscriptionExtensionOf(dbClient interfaces.DBClient, subscriptionName string) (notificationsModels.SubscriptionExtension, errors.EdgeX) {
+	ext, err := dbClient.SubscriptionExtensionBySubscriptionName(subscriptionName)
+	if err != nil {
+		if errors.Kind(err) == errors.KindEntityDoesNotExist {
+			return notificationsModels.SubscriptionExtension{SubscriptionName: subscriptionName}, nil
+		}
+		return ext, errors.NewCommonEdgeXWrapper(err)
+	}
+	return ext, nil
+}
+
+// validateSubscriptionExtension checks each setting of the extension and that the referenced template and escalation
+// policy exist
+func validateSubscriptionExtension(dbClient interfaces.DBClient, ext notificationsModels.SubscriptionExtension) errors.EdgeX {
+	if ext.Webhook != nil {
+		if err := channel.ValidateWebhook(*ext.Webhook); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if ext.Template != nil && ext.Template.TemplateName != "" {
+		if _, err := dbClient.TemplateByName(ext.Template.TemplateName); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if ext.Digest != nil {
+		if err := validateDigest(*ext.Digest); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if ext.QuietHours != nil {
+		if err := validateQuietHours(*ext.QuietHours); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if ext.Escalation != nil {
+		if _, err := dbClient.EscalationPolicyByName(ext.Escalation.PolicyName); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if ext.RateLimit != nil {
+		if err := validateRateLimit(*ext.RateLimit); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	return nil
+}
+
+// settingsRemoved applies the removal of the digest, the quiet hours and the rate limit from the extension, the
+// pending digest is sent and the held notifications are released immediately
+func settingsRemoved(dic *di.Container, old, ext notificationsModels.SubscriptionExtension) errors.EdgeX {
+	if ext.RateLimit == nil {
+		subscriptionRateLimiters.remove(ext.SubscriptionName)
+	}
+	if old.Digest != nil && ext.Digest == nil {
+		if err := sendPendingDigest(dic, ext.SubscriptionName); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), "fail to send the pending digest", err)
+		}
+	}
+	if old.QuietHours != nil && ext.QuietHours == nil {
+		quietHoursMutex.Lock()
+		defer quietHoursMutex.Unlock()
+		if err := releaseHeldNotifications(dic, ext); err != nil {
+			return errors.NewCommonEdgeX(errors.Kind(err), "fail to send the held notifications", err)
+		}
+	}
+	return nil
+}
+
+// UpsertSubscriptionExtension sets the extension of the subscription, the existing extension is replaced as a whole
+func UpsertSubscriptionExtension(subscriptionName string, dto dtos.SubscriptionExtension, ctx context.Context, dic *di.Container) errors.EdgeX {
+	if subscriptionName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	// make sure the subscription exists
+	_, err := dbClient.SubscriptionByName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	ext := dtos.ToSubscriptionExtensionModel(subscriptionName, dto)
+	err = validateSubscriptionExtension(dbClient, ext)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	old, err := subscriptionExtensionOf(dbClient, subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	// the WEBHOOK channels are set by the subscription channels
+	ext.WebhookChannels = old.WebhookChannels
+	err = dbClient.UpsertSubscriptionExtension(ext)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	lc.Debugf("Extension of subscription %s upserted on DB successfully. Correlation-ID: %s ", subscriptionName, correlation.FromContext(ctx))
+
+	err = settingsRemoved(dic, old, ext)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// SubscriptionExtensionBySubscriptionName queries the extension of the subscription
+func SubscriptionExtensionBySubscriptionName(subscriptionName string, dic *di.Container) (dtos.SubscriptionExtension, errors.EdgeX) {
+	if subscriptionName == "" {
+		return dtos.SubscriptionExtension{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	ext, err := dbClient.SubscriptionExtensionBySubscriptionName(subscriptionName)
+	if err != nil {
+		return dtos.SubscriptionExtension{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromSubscriptionExtensionModelToDTO(ext), nil
+}
+
+// DeleteSubscriptionExtensionBySubscriptionName deletes the extension of the subscription, the pending digest and the
+// held notifications of the subscription are sent immediately. The WEBHOOK channels of the subscription are kept.
+func DeleteSubscriptionExtensionBySubscriptionName(subscriptionName string, dic *di.Container) errors.EdgeX {
+	if subscriptionName == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	old, err := dbClient.SubscriptionExtensionBySubscriptionName(subscriptionName)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	ext := notificationsModels.SubscriptionExtension{SubscriptionName: subscriptionName, WebhookChannels: old.WebhookChannels}
+	if len(ext.WebhookChannels) > 0 {
+		err = dbClient.UpsertSubscriptionExtension(ext)
+	} else {
+		err = dbClient.DeleteSubscriptionExtensionBySubscriptionName(subscriptionName)
+	}
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = settingsRemoved(dic, old, ext)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
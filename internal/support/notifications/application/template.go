@@ -0,0 +1,111 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// AddTemplate validates and adds the new template
+func AddTemplate(dto dtos.Template, ctx context.Context, dic *di.Container) (name string, edgeXerr errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	t := dtos.ToTemplateModel(dto)
+	err := validateTemplate(t)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	addedTemplate, err := dbClient.AddTemplate(t)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Template created on DB successfully. Template name: %s, Correlation-ID: %s ", addedTemplate.Name, correlation.FromContext(ctx))
+	return addedTemplate.Name, nil
+}
+
+// UpdateTemplate validates and replaces the existing template specified by name
+func UpdateTemplate(dto dtos.Template, ctx context.Context, dic *di.Container) errors.EdgeX {
+	dbClient := container.DBClientFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+
+	t := dtos.ToTemplateModel(dto)
+	err := validateTemplate(t)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.UpdateTemplate(t)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Template updated on DB successfully. Template name: %s, Correlation-ID: %s ", t.Name, correlation.FromContext(ctx))
+	return nil
+}
+
+// AllTemplates queries templates by offset and limit
+func AllTemplates(offset, limit int, dic *di.Container) (templates []dtos.Template, totalCount int64, err errors.EdgeX) {
+	dbClient := container.DBClientFrom(dic.Get)
+
+	totalCount, err = dbClient.TemplateTotalCount()
+	if err != nil {
+		return templates, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dtos.Template{}, totalCount, err
+	}
+
+	templateModels, err := dbClient.AllTemplates(offset, limit)
+	if err != nil {
+		return templates, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	templates = make([]dtos.Template, len(templateModels))
+	for i, t := range templateModels {
+		templates[i] = dtos.FromTemplateModelToDTO(t)
+	}
+	return templates, totalCount, nil
+}
+
+// TemplateByName queries template by name
+func TemplateByName(name string, dic *di.Container) (template dtos.Template, err errors.EdgeX) {
+	if name == "" {
+		return template, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	t, err := dbClient.TemplateByName(name)
+	if err != nil {
+		return template, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromTemplateModelToDTO(t), nil
+}
+
+// DeleteTemplateByName deletes the template by name
+func DeleteTemplateByName(name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+	dbClient := container.DBClientFrom(dic.Get)
+	_, err := dbClient.TemplateByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteTemplateByName(name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
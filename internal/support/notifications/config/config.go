@@ -1,7 +1,7 @@
 /*******************************************************************************
  * Copyright 2018 Dell Technologies Inc.
  * Copyright 2023 Intel Corporation
- * Copyright 2025 IOTech Ltd.
+ * Copyright 2025-2026 IOTech Ltd.
  *
  * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
  * in compliance with the License. You may obtain a copy of the License at
@@ -30,6 +30,15 @@ type ConfigurationStruct struct {
 	MessageBus bootstrapConfig.MessageBusInfo
 	Smtp       SmtpInfo
 	Retention  NotificationRetention
+	Resend     ResendInfo
+	Digest     DigestInfo
+	Dedup      DedupInfo
+	QuietHours QuietHoursInfo
+	Escalation EscalationInfo
+	// Transmission configures the workers transmitting the notifications and the circuit breakers of the destinations
+	Transmission TransmissionInfo
+	// NotificationSubscriber configures the subscriber accepting the notifications from message bus
+	NotificationSubscriber NotificationSubscriberInfo
 }
 
 type WritableInfo struct {
@@ -51,8 +60,30 @@ type SmtpInfo struct {
 	// SecretName is used to specify the secret path to store the credential(username and password) for connecting the SMTP server
 	// User need to store the credential via the /secret API before sending the email notification
 	SecretName string
-	// AuthMode is the SMTP authentication mechanism. Currently, 'usernamepassword' is the only AuthMode supported by this service, and the secret keys are 'username' and 'password'.
+	// AuthMode is the SMTP authentication mechanism, the supported values are 'usernamepassword', 'cram-md5' and 'xoauth2'.
+	// The secret keys are 'username' and 'password' for 'usernamepassword' and 'cram-md5'. For 'xoauth2', the secret keys
+	// are 'username', 'clientId', and the optional 'clientSecret' and 'refreshToken'; the access token is fetched with the
+	// refresh token grant if 'refreshToken' exists, otherwise with the client credentials grant.
 	AuthMode string
+	// OAuth2 configures the token endpoint for the 'xoauth2' AuthMode
+	OAuth2 SmtpOAuth2Info
+	// ConnectionReuse keeps the SMTP session open to send the subsequent emails, e.g. the digest bursts
+	ConnectionReuse SmtpConnectionReuseInfo
+}
+
+type SmtpOAuth2Info struct {
+	// TokenURL is the token endpoint of the OAuth2 authorization server
+	TokenURL string
+	// Scopes are the optional scopes requested for the access token
+	Scopes []string
+}
+
+type SmtpConnectionReuseInfo struct {
+	Enabled bool
+	// IdleTimeout is the duration to keep the idle SMTP session open, e.g. "30s"
+	IdleTimeout string
+	// MaxMessages is the maximum number of emails sent over a session before it's reopened, 0 means unlimited
+	MaxMessages int
 }
 
 type NotificationRetention struct {
@@ -62,6 +93,84 @@ type NotificationRetention struct {
 	MinCap   uint32
 }
 
+// ResendInfo configures the persistent scheduler which resends the critical notifications failed to transmit, the
+// resend interval doubles on each attempt with a random jitter
+type ResendInfo struct {
+	// Workers is the number of the workers resending the notifications concurrently
+	Workers int
+	// PollInterval is the interval of polling the due resend tasks from the database
+	PollInterval string
+	// MaxInterval caps the resend interval growing with the exponential backoff
+	MaxInterval string
+	// MaxTaskErrors is the number of the consecutive errors of processing a resend task, e.g. the database errors,
+	// after which the task is dropped until the service restarts
+	MaxTaskErrors int
+	// MaxFastFailures is the number of the consecutive attempts failing fast because of the rate limit or the circuit
+	// breaker, after which the transmission is escalated
+	MaxFastFailures int
+}
+
+// TransmissionInfo configures the bounded pool of workers per channel type which transmits the notifications to the
+// subscriptions, and the circuit breakers which fail the transmissions fast while the destinations are known down
+type TransmissionInfo struct {
+	// Workers is the number of the workers per channel type, e.g. REST, EMAIL, MQTT or ZeroMQ, the channel types not
+	// listed use DefaultWorkers
+	Workers        map[string]int
+	DefaultWorkers int
+	// QueueSize is the capacity of the transmission queue of each channel type, the distribution of the notifications
+	// waits for a free slot if the queue is full
+	QueueSize      int
+	CircuitBreaker CircuitBreakerInfo
+}
+
+// CircuitBreakerInfo configures the circuit breakers of the destination addresses
+type CircuitBreakerInfo struct {
+	// FailureThreshold is the number of the consecutive failures which opens the circuit of a destination, the circuit
+	// breakers are disabled if it's 0
+	FailureThreshold int
+	// CoolDown is the duration the circuit keeps open before a probe transmission is sent to the destination again
+	CoolDown string
+}
+
+// DigestInfo configures the scheduler which sends the pending digests of the subscriptions when the digest windows close
+type DigestInfo struct {
+	// PollInterval is the interval of checking the pending digests, which bounds the delay of closing a digest window
+	PollInterval string
+}
+
+// DedupInfo configures the deduplication of the notifications, the repeats of a notification arriving in the
+// suppression window only increment the occurrence count of the existing notification
+type DedupInfo struct {
+	Enabled bool
+	// Window is the suppression window starting from the first occurrence of the notification, e.g. 5m
+	Window string
+	// Fingerprint is the notification fields identifying the repeats, which may be category, labels, sender, severity
+	// and content. The content is compared by hash.
+	Fingerprint []string
+}
+
+// QuietHoursInfo configures the scheduler which sends the notifications held in the quiet hours of the subscriptions
+type QuietHoursInfo struct {
+	// PollInterval is the interval of checking the ended quiet hours, which bounds the delay of sending the held
+	// notifications
+	PollInterval string
+}
+
+// EscalationInfo configures the scheduler which escalates the critical notifications with the escalation policies
+type EscalationInfo struct {
+	// PollInterval is the interval of checking the due escalation levels, which bounds the delay of escalating a level
+	PollInterval string
+}
+
+// NotificationSubscriberInfo configures the message bus subscriber which accepts the AddNotificationRequest envelopes
+type NotificationSubscriberInfo struct {
+	// Enabled indicates whether to accept the notifications from message bus
+	Enabled bool
+	// SubscribeTopic is the topic to subscribe the notifications, which is prefixed with the base topic prefix of the
+	// message bus
+	SubscribeTopic string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// support-notifications API routes not yet defined in go-mod-core-contracts
+const (
+	ApiSubscriptionExtensionRoute = common.ApiSubscriptionByNameRoute + "/extension"
+
+	ApiNotificationOccurrenceRoute = common.ApiNotificationByIdRoute + "/occurrence"
+
+	ApiTemplateRoute       = common.ApiBase + "/template"
+	ApiAllTemplateRoute    = ApiTemplateRoute + "/" + common.All
+	ApiTemplateByNameRoute = ApiTemplateRoute + "/" + common.Name + "/:" + common.Name
+
+	ApiEscalationPolicyRoute       = common.ApiBase + "/escalationpolicy"
+	ApiAllEscalationPolicyRoute    = ApiEscalationPolicyRoute + "/" + common.All
+	ApiEscalationPolicyByNameRoute = ApiEscalationPolicyRoute + "/" + common.Name + "/:" + common.Name
+
+	ApiStatisticsRoute                     = common.ApiBase + "/statistics"
+	ApiNotificationStatisticsRoute         = ApiStatisticsRoute + "/" + common.Notification
+	ApiSubscriptionDeliveryStatisticsRoute = ApiStatisticsRoute + "/" + common.Subscription
+)
+
+// support-notifications address types not yet defined in go-mod-core-contracts
+const (
+	// WEBHOOK is the type of the REST address sent with the webhook setting of the subscription extension, the WEBHOOK
+	// channels are stored as REST channels of the subscription
+	WEBHOOK = "WEBHOOK"
+)
+
+// support-notifications query string keys not yet defined in go-mod-core-contracts
+const (
+	GroupBy  = "groupBy"
+	Interval = "interval"
+)
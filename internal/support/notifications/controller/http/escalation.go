@@ -0,0 +1,159 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
+)
+
+type EscalationPolicyController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewEscalationPolicyController creates and initializes an EscalationPolicyController
+func NewEscalationPolicyController(dic *di.Container) *EscalationPolicyController {
+	return &EscalationPolicyController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// AddEscalationPolicy adds the batch of escalation policies
+func (ec *EscalationPolicyController) AddEscalationPolicy(c echo.Context) error {
+	return ec.writePolicies(c, func(reqDTO requests.EscalationPolicyRequest) (interface{}, errors.EdgeX) {
+		name, err := application.AddEscalationPolicy(reqDTO.EscalationPolicy, c.Request().Context(), ec.dic)
+		if err != nil {
+			return nil, err
+		}
+		return commonDTO.NewBaseWithIdResponse(reqDTO.RequestId, "", http.StatusCreated, name), nil
+	})
+}
+
+// UpdateEscalationPolicy replaces the batch of escalation policies specified by name
+func (ec *EscalationPolicyController) UpdateEscalationPolicy(c echo.Context) error {
+	return ec.writePolicies(c, func(reqDTO requests.EscalationPolicyRequest) (interface{}, errors.EdgeX) {
+		err := application.UpdateEscalationPolicy(reqDTO.EscalationPolicy, c.Request().Context(), ec.dic)
+		if err != nil {
+			return nil, err
+		}
+		return commonDTO.NewBaseResponse(reqDTO.RequestId, "", http.StatusOK), nil
+	})
+}
+
+// writePolicies reads the batch of escalation policy requests and writes the multi-status response of the operation
+func (ec *EscalationPolicyController) writePolicies(c echo.Context, operate func(requests.EscalationPolicyRequest) (interface{}, errors.EdgeX)) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(ec.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requests.EscalationPolicyRequest
+	err := ec.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var multiResponses []interface{}
+	for _, reqDTO := range reqDTOs {
+		response, err := operate(reqDTO)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(reqDTO.RequestId, err.Message(), err.Code())
+		}
+		multiResponses = append(multiResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(multiResponses, w, lc)
+}
+
+// AllEscalationPolicies returns the escalation policies by offset and limit
+func (ec *EscalationPolicyController) AllEscalationPolicies(c echo.Context) error {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := notificationContainer.ConfigurationFrom(ec.dic.Get)
+
+	// parse URL query string for offset and limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	policies, totalCount, err := application.AllEscalationPolicies(offset, limit, ec.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiEscalationPoliciesResponse("", "", http.StatusOK, totalCount, policies)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// EscalationPolicyByName returns the escalation policy specified by name
+func (ec *EscalationPolicyController) EscalationPolicyByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	policy, err := application.EscalationPolicyByName(name, ec.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewEscalationPolicyResponse("", "", http.StatusOK, policy)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteEscalationPolicyByName deletes the escalation policy specified by name
+func (ec *EscalationPolicyController) DeleteEscalationPolicyByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(ec.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteEscalationPolicyByName(name, ec.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
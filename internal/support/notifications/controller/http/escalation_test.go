@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	testEscalationPolicyName     = "on-call"
+	notFoundEscalationPolicyName = "notFoundEscalationPolicy"
+)
+
+func escalationPolicyRequestData() requests.EscalationPolicyRequest {
+	return requests.EscalationPolicyRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		EscalationPolicy: dtos.EscalationPolicy{
+			Name: testEscalationPolicyName,
+			Levels: []dtos.EscalationLevel{
+				{Subscriptions: []string{"operator"}},
+				{Delay: "10m", Subscriptions: []string{"supervisor", "manager"}},
+			},
+			Categories: []string{"disk"},
+		},
+	}
+}
+
+func TestAddEscalationPolicy(t *testing.T) {
+	valid := escalationPolicyRequestData()
+	noName := escalationPolicyRequestData()
+	noName.EscalationPolicy.Name = ""
+	noLevels := escalationPolicyRequestData()
+	noLevels.EscalationPolicy.Levels = nil
+	noSubscriptions := escalationPolicyRequestData()
+	noSubscriptions.EscalationPolicy.Levels = []dtos.EscalationLevel{{Delay: "1m"}}
+	invalidDelay := escalationPolicyRequestData()
+	invalidDelay.EscalationPolicy.Levels[1].Delay = "10 minutes"
+	negativeDelay := escalationPolicyRequestData()
+	negativeDelay.EscalationPolicy.Levels[1].Delay = "-10m"
+	categoryClaimed := escalationPolicyRequestData()
+	categoryClaimed.EscalationPolicy.Name = "categoryClaimed"
+	categoryClaimed.EscalationPolicy.Categories = []string{"health-check"}
+	duplicatedName := escalationPolicyRequestData()
+	duplicatedName.EscalationPolicy.Name = "duplicatedName"
+	duplicatedName.EscalationPolicy.Categories = nil
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	existing := notificationsModels.EscalationPolicy{Name: "existing", Categories: []string{"health-check"}}
+	dbClientMock.On("EscalationPoliciesByCategories", existing.Categories).Return([]notificationsModels.EscalationPolicy{existing}, nil)
+	dbClientMock.On("EscalationPoliciesByCategories", mock.Anything).Return([]notificationsModels.EscalationPolicy{}, nil)
+	model := dtos.ToEscalationPolicyModel(valid.EscalationPolicy)
+	dbClientMock.On("AddEscalationPolicy", model).Return(model, nil)
+	model = dtos.ToEscalationPolicyModel(duplicatedName.EscalationPolicy)
+	dbClientMock.On("AddEscalationPolicy", model).Return(model, errors.NewCommonEdgeX(errors.KindDuplicateName, "escalation policy name duplicatedName already exists", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewEscalationPolicyController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                string
+		request             []requests.EscalationPolicyRequest
+		expectedMultiStatus bool
+		expectedStatusCode  int
+	}{
+		{"Valid", []requests.EscalationPolicyRequest{valid}, true, http.StatusCreated},
+		{"Invalid - no name", []requests.EscalationPolicyRequest{noName}, false, http.StatusBadRequest},
+		{"Invalid - no levels", []requests.EscalationPolicyRequest{noLevels}, false, http.StatusBadRequest},
+		{"Invalid - no subscriptions in the level", []requests.EscalationPolicyRequest{noSubscriptions}, false, http.StatusBadRequest},
+		{"Invalid - delay format", []requests.EscalationPolicyRequest{invalidDelay}, true, http.StatusBadRequest},
+		{"Invalid - negative delay", []requests.EscalationPolicyRequest{negativeDelay}, true, http.StatusBadRequest},
+		{"Invalid - category escalated with another policy", []requests.EscalationPolicyRequest{categoryClaimed}, true, http.StatusConflict},
+		{"Invalid - duplicated name", []requests.EscalationPolicyRequest{duplicatedName}, true, http.StatusConflict},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPost, constants.ApiEscalationPolicyRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AddEscalationPolicy(c)
+			require.NoError(t, err)
+
+			// Assert
+			if !testCase.expectedMultiStatus {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+				return
+			}
+			var res []commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, common.ApiVersion, res[0].ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.request[0].RequestId, res[0].RequestId, "RequestID not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2021-2023 IOTech Ltd
+// Copyright (C) 2021-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -19,6 +19,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
@@ -113,6 +114,27 @@ func (nc *NotificationController) NotificationById(c echo.Context) error {
 	return pkg.EncodeAndWriteResponse(response, w, lc)
 }
 
+// NotificationOccurrenceById returns the occurrence count of the notification, which is incremented by the repeats
+// deduplicated in the suppression window
+func (nc *NotificationController) NotificationOccurrenceById(c echo.Context) error {
+	lc := container.LoggingClientFrom(nc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	id := c.Param(common.Id)
+
+	occurrence, err := application.NotificationOccurrenceById(id, nc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewNotificationOccurrenceResponse("", "", http.StatusOK, occurrence)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
 func (nc *NotificationController) NotificationsByCategory(c echo.Context) error {
 	lc := container.LoggingClientFrom(nc.dic.Get)
 	r := c.Request()
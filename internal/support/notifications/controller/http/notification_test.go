@@ -1005,6 +1005,7 @@ func TestUpdateNotificationAckStatusByIds(t *testing.T) {
 	dbClientMock.On("UpdateNotificationAckStatusByIds", false, ids).Return(nil)
 	dbClientMock.On("UpdateNotificationAckStatusByIds", false, dbError).Return(errors.NewCommonEdgeX(
 		errors.KindDatabaseError, "DB error", nil))
+	dbClientMock.On("DeleteEscalationTaskByNotificationId", mock.Anything).Return(nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -1056,4 +1057,7 @@ func TestUpdateNotificationAckStatusByIds(t *testing.T) {
 			}
 		})
 	}
+	// the acknowledged notifications stop escalating
+	dbClientMock.AssertCalled(t, "DeleteEscalationTaskByNotificationId", ids[0])
+	dbClientMock.AssertCalled(t, "DeleteEscalationTaskByNotificationId", ids[1])
 }
@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+type StatisticsController struct {
+	dic *di.Container
+}
+
+// NewStatisticsController creates and initializes a StatisticsController
+func NewStatisticsController(dic *di.Container) *StatisticsController {
+	return &StatisticsController{
+		dic: dic,
+	}
+}
+
+// parseStatisticsTimeRange parses the start and end query strings, the end defaults to now and the start defaults to
+// the default statistics range before the end
+func parseStatisticsTimeRange(c echo.Context) (start int64, end int64, edgexErr errors.EdgeX) {
+	end, edgexErr = utils.ParseQueryStringToInt64(c, common.End, time.Now().UnixMilli(), 0, math.MaxInt64)
+	if edgexErr != nil {
+		return start, end, edgexErr
+	}
+	start, edgexErr = utils.ParseQueryStringToInt64(c, common.Start, max(end-application.DefaultStatisticsRange.Milliseconds(), 0), 0, math.MaxInt64)
+	if edgexErr != nil {
+		return start, end, edgexErr
+	}
+	return start, end, nil
+}
+
+// NotificationStatistics counts the notifications grouped by category, severity, label or status over the time buckets
+func (sc *StatisticsController) NotificationStatistics(c echo.Context) error {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	start, end, err := parseStatisticsTimeRange(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	groupBy := utils.ParseQueryStringToString(r, constants.GroupBy, notificationsModels.GroupByCategory)
+	interval := c.QueryParam(constants.Interval)
+	category := c.QueryParam(common.Category)
+	severity := c.QueryParam(common.Severity)
+
+	statistics, err := application.NotificationStatistics(groupBy, start, end, interval, category, severity, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewNotificationStatisticsResponse("", "", http.StatusOK, statistics)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// SubscriptionDeliveryStatistics returns the delivery statistics of the subscriptions, the worst delivery rate first
+func (sc *StatisticsController) SubscriptionDeliveryStatistics(c echo.Context) error {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	start, end, err := parseStatisticsTimeRange(c)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	statistics, err := application.SubscriptionDeliveryStatistics(start, end, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewSubscriptionDeliveryStatisticsResponse("", "", http.StatusOK, statistics)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+func TestNotificationStatistics(t *testing.T) {
+	statistics := []notificationsModels.NotificationStatistic{
+		{Bucket: 0, Group: "health", Count: 3},
+		{Bucket: 86400000, Group: "health", Count: 1},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("NotificationStatistics", notificationsModels.GroupByCategory, int64(0), int64(172799999), int64(86400000), "", "CRITICAL").Return(statistics, nil)
+	dbClientMock.On("NotificationStatistics", notificationsModels.GroupByLabel, int64(1000), int64(2000), int64(1001), "health", "").Return(nil, nil)
+	dbClientMock.On("NotificationStatistics", notificationsModels.GroupByCategory, mock.Anything, mock.Anything, mock.Anything, "", "").Return(nil, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewStatisticsController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		query              string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - daily buckets of critical notifications", "?start=0&end=172799999&interval=24h&severity=CRITICAL", 2, http.StatusOK},
+		{"Valid - single bucket by label", "?groupBy=label&start=1000&end=2000&category=health", 0, http.StatusOK},
+		{"Valid - default time range", "", 0, http.StatusOK},
+		{"Invalid - unsupported group by", "?groupBy=sender", 0, http.StatusBadRequest},
+		{"Invalid - invalid interval", "?interval=1day", 0, http.StatusBadRequest},
+		{"Invalid - end before start", "?start=2000&end=1000", 0, http.StatusBadRequest},
+		{"Invalid - invalid start", "?start=yesterday", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiNotificationStatisticsRoute+testCase.query, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.NotificationStatistics(c)
+			require.NoError(t, err)
+			var res responses.NotificationStatisticsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			assert.Len(t, res.Statistics, testCase.expectedCount, "Statistic count not as expected")
+		})
+	}
+}
+
+func TestSubscriptionDeliveryStatistics(t *testing.T) {
+	statistics := []notificationsModels.SubscriptionDeliveryStatistic{
+		{SubscriptionName: "healthy", Total: 10, Sent: 10, P95Latency: 120},
+		{SubscriptionName: "unhealthy", Total: 10, Sent: 4, Failed: 5, Escalated: 1, MeanResendCount: 2.5, P95Latency: 3000},
+		{SubscriptionName: "degraded", Total: 4, Sent: 3, Resending: 1, MeanResendCount: 0.25, P95Latency: 800},
+	}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionDeliveryStatistics", int64(1000), int64(2000)).Return(statistics, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewStatisticsController(dic)
+	require.NotNil(t, controller)
+
+	e := echo.New()
+	req, err := http.NewRequest(http.MethodGet, constants.ApiSubscriptionDeliveryStatisticsRoute+"?start=1000&end=2000", http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	err = controller.SubscriptionDeliveryStatistics(e.NewContext(req, recorder))
+	require.NoError(t, err)
+	var res responses.SubscriptionDeliveryStatisticsResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	require.Len(t, res.Statistics, 3)
+	// the subscription with the worst delivery rate comes first
+	assert.Equal(t, "unhealthy", res.Statistics[0].SubscriptionName)
+	assert.InDelta(t, 0.4, res.Statistics[0].DeliveryRate, 0.0001)
+	assert.Equal(t, int64(5), res.Statistics[0].Failed)
+	assert.Equal(t, float64(3000), res.Statistics[0].P95Latency)
+	assert.Equal(t, "degraded", res.Statistics[1].SubscriptionName)
+	assert.Equal(t, "healthy", res.Statistics[2].SubscriptionName)
+	assert.Equal(t, float64(1), res.Statistics[2].DeliveryRate)
+
+	req, err = http.NewRequest(http.MethodGet, constants.ApiSubscriptionDeliveryStatisticsRoute+"?start=2000&end=1000", http.NoBody)
+	require.NoError(t, err)
+	recorder = httptest.NewRecorder()
+	err = controller.SubscriptionDeliveryStatistics(e.NewContext(req, recorder))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, recorder.Result().StatusCode, "HTTP status code not as expected")
+}
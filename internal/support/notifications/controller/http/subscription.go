@@ -15,13 +15,14 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
 	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	notificationsRequests "github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
 
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
-	requestDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	responseDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/responses"
 
 	"github.com/labstack/echo/v4"
@@ -52,18 +53,17 @@ func (sc *SubscriptionController) AddSubscription(c echo.Context) error {
 	ctx := r.Context()
 	correlationId := correlation.FromContext(ctx)
 
-	var reqDTOs []requestDTO.AddSubscriptionRequest
+	var reqDTOs []notificationsRequests.AddSubscriptionRequest
 	err := sc.reader.Read(r.Body, &reqDTOs)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
 	}
-	subscriptions := requestDTO.AddSubscriptionReqToSubscriptionModels(reqDTOs)
 
 	var addResponses []interface{}
-	for i, s := range subscriptions {
+	for _, req := range reqDTOs {
 		var response interface{}
-		reqId := reqDTOs[i].RequestId
-		newId, err := application.AddSubscription(s, ctx, sc.dic)
+		reqId := req.RequestId
+		newId, err := application.AddSubscription(dtos.ToSubscriptionModel(req.Subscription), req.WebhookChannels, ctx, sc.dic)
 		if err != nil {
 			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
@@ -222,7 +222,7 @@ func (sc *SubscriptionController) PatchSubscription(c echo.Context) error {
 	ctx := r.Context()
 	correlationId := correlation.FromContext(ctx)
 
-	var reqDTOs []requestDTO.UpdateSubscriptionRequest
+	var reqDTOs []notificationsRequests.UpdateSubscriptionRequest
 	err := sc.reader.Read(r.Body, &reqDTOs)
 	if err != nil {
 		return utils.WriteErrorResponse(w, ctx, lc, err, "")
@@ -232,7 +232,7 @@ func (sc *SubscriptionController) PatchSubscription(c echo.Context) error {
 	for _, dto := range reqDTOs {
 		var response interface{}
 		reqId := dto.RequestId
-		err := application.PatchSubscription(ctx, dto.Subscription, sc.dic)
+		err := application.PatchSubscription(ctx, dto.Subscription, dto.WebhookChannels, sc.dic)
 		if err != nil {
 			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
 			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
@@ -15,8 +15,10 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
 	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
@@ -49,8 +51,18 @@ var (
 	testSubscriptionReceiver       = "receiver"
 	testSubscriptionResendLimit    = 5
 	testSubscriptionResendInterval = "10s"
+	testWebhookSubscriptionName    = "webhookSubscription"
+	extensionNotFoundError         = errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription extension doesn't exist in the database", nil)
 )
 
+// webhookChannel returns the WEBHOOK channel of the request and the REST address it's stored as
+func webhookChannel() (dtos.Address, models.RESTAddress) {
+	address := dtos.NewRESTAddress("webhook-host", 443, http.MethodPost, "https")
+	restAddress := dtos.ToAddressModel(address).(models.RESTAddress)
+	address.Type = constants.WEBHOOK
+	return address, restAddress
+}
+
 func mockDic() *di.Container {
 	return di.NewContainer(di.ServiceConstructorMap{
 		container.ConfigurationName: func(get di.Get) interface{} {
@@ -143,6 +155,19 @@ func TestAddSubscription(t *testing.T) {
 	invalidResendInterval := addSubscriptionRequestData()
 	invalidResendInterval.Subscription.ResendInterval = "10"
 
+	webhookAddress, webhookRESTAddress := webhookChannel()
+	webhook := addSubscriptionRequestData()
+	webhook.Subscription.Name = testWebhookSubscriptionName
+	model = dtos.ToSubscriptionModel(webhook.Subscription)
+	model.Channels = []models.Address{model.Channels[0], webhookRESTAddress}
+	webhook.Subscription.Channels = []dtos.Address{testSubscriptionChannels[0], webhookAddress}
+	dbClientMock.On("AddSubscription", model).Return(model, nil)
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", testWebhookSubscriptionName).Return(notificationsModels.SubscriptionExtension{}, extensionNotFoundError)
+	dbClientMock.On("UpsertSubscriptionExtension", notificationsModels.SubscriptionExtension{
+		SubscriptionName: testWebhookSubscriptionName,
+		WebhookChannels:  []models.RESTAddress{webhookRESTAddress},
+	}).Return(nil)
+
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -157,6 +182,7 @@ func TestAddSubscription(t *testing.T) {
 	}{
 		{"Valid", []requests.AddSubscriptionRequest{valid}, http.StatusCreated},
 		{"Valid - no request Id", []requests.AddSubscriptionRequest{noRequestId}, http.StatusCreated},
+		{"Valid - WEBHOOK channel", []requests.AddSubscriptionRequest{webhook}, http.StatusCreated},
 		{"Invalid - no name", []requests.AddSubscriptionRequest{noName}, http.StatusBadRequest},
 		{"Invalid - duplicated name", []requests.AddSubscriptionRequest{duplicatedName}, http.StatusConflict},
 		{"Invalid - unsupported channel type", []requests.AddSubscriptionRequest{unsupportedChannelType}, http.StatusBadRequest},
@@ -219,6 +245,7 @@ func TestAllSubscriptions(t *testing.T) {
 	dbClientMock.On("AllSubscriptions", 0, 20).Return(subscriptions, nil)
 	dbClientMock.On("AllSubscriptions", 1, 2).Return([]models.Subscription{subscriptions[1], subscriptions[2]}, nil)
 	dbClientMock.On("AllSubscriptions", 4, 1).Return([]models.Subscription{}, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, "query objects bounds out of range.", nil))
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", subscription.Name).Return(notificationsModels.SubscriptionExtension{}, extensionNotFoundError)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -293,6 +320,18 @@ func TestSubscriptionByName(t *testing.T) {
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("SubscriptionByName", subscription.Name).Return(subscription, nil)
 	dbClientMock.On("SubscriptionByName", notFoundName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", subscription.Name).Return(notificationsModels.SubscriptionExtension{}, extensionNotFoundError)
+
+	// the WEBHOOK channel is stored as the REST channel of the subscription
+	_, webhookRESTAddress := webhookChannel()
+	webhookSubscription := subscription
+	webhookSubscription.Name = testWebhookSubscriptionName
+	webhookSubscription.Channels = []models.Address{subscription.Channels[0], webhookRESTAddress}
+	dbClientMock.On("SubscriptionByName", webhookSubscription.Name).Return(webhookSubscription, nil)
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", webhookSubscription.Name).Return(notificationsModels.SubscriptionExtension{
+		SubscriptionName: webhookSubscription.Name,
+		WebhookChannels:  []models.RESTAddress{webhookRESTAddress},
+	}, nil)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -309,6 +348,7 @@ func TestSubscriptionByName(t *testing.T) {
 		expectedStatusCode int
 	}{
 		{"Valid - find subscription by name", subscription.Name, false, http.StatusOK},
+		{"Valid - find subscription with WEBHOOK channel by name", webhookSubscription.Name, false, http.StatusOK},
 		{"Invalid - name parameter is empty", emptyName, true, http.StatusBadRequest},
 		{"Invalid - subscription not found by name", notFoundName, true, http.StatusNotFound},
 	}
@@ -345,6 +385,13 @@ func TestSubscriptionByName(t *testing.T) {
 				assert.Equal(t, testCase.expectedStatusCode, int(res.StatusCode), "Response status code not as expected")
 				assert.Equal(t, testCase.subscriptionName, res.Subscription.Name, "Name not as expected")
 				assert.Empty(t, res.Message, "Message should be empty when it is successful")
+				require.Len(t, res.Subscription.Channels, 2)
+				assert.Equal(t, common.EMAIL, res.Subscription.Channels[0].Type, "Channel type not as expected")
+				if testCase.subscriptionName == testWebhookSubscriptionName {
+					assert.Equal(t, constants.WEBHOOK, res.Subscription.Channels[1].Type, "Channel type not as expected")
+				} else {
+					assert.Equal(t, common.REST, res.Subscription.Channels[1].Type, "Channel type not as expected")
+				}
 			}
 		})
 	}
@@ -583,6 +630,9 @@ func TestDeleteSubscriptionByName(t *testing.T) {
 	dic := mockDic()
 	dbClientMock := &dbMock.DBClient{}
 	dbClientMock.On("DeleteSubscriptionByName", subscription.Name).Return(nil)
+	dbClientMock.On("DeleteSubscriptionExtensionBySubscriptionName", subscription.Name).Return(nil)
+	dbClientMock.On("DeleteDigestItemsBySubscriptionName", subscription.Name).Return(nil)
+	dbClientMock.On("DeleteHeldNotificationsBySubscriptionName", subscription.Name).Return(nil)
 	dbClientMock.On("DeleteSubscriptionByName", notFoundName).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
 	dbClientMock.On("SubscriptionByName", notFoundName).Return(subscription, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
 	dbClientMock.On("SubscriptionByName", subscription.Name).Return(subscription, nil)
@@ -662,6 +712,18 @@ func TestPatchSubscription(t *testing.T) {
 	valid := testReq
 	dbClientMock.On("SubscriptionById", *valid.Subscription.Id).Return(subscriptionModel, nil)
 	dbClientMock.On("UpdateSubscription", subscriptionModel).Return(nil)
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", subscriptionModel.Name).Return(notificationsModels.SubscriptionExtension{}, extensionNotFoundError)
+
+	webhookAddress, webhookRESTAddress := webhookChannel()
+	webhookSubscriptionModel := subscriptionModel
+	webhookSubscriptionModel.Channels = []models.Address{subscriptionModel.Channels[0], webhookRESTAddress}
+	webhook := testReq
+	webhook.Subscription.Channels = []dtos.Address{testSubscriptionChannels[0], webhookAddress}
+	dbClientMock.On("UpdateSubscription", webhookSubscriptionModel).Return(nil)
+	dbClientMock.On("UpsertSubscriptionExtension", notificationsModels.SubscriptionExtension{
+		SubscriptionName: subscriptionModel.Name,
+		WebhookChannels:  []models.RESTAddress{webhookRESTAddress},
+	}).Return(nil)
 	validWithNoReqID := testReq
 	validWithNoReqID.RequestId = ""
 	validWithNoId := testReq
@@ -725,6 +787,7 @@ func TestPatchSubscription(t *testing.T) {
 		{"Valid - no requestId", []requests.UpdateSubscriptionRequest{validWithNoReqID}, http.StatusMultiStatus, http.StatusOK},
 		{"Valid - no id", []requests.UpdateSubscriptionRequest{validWithNoId}, http.StatusMultiStatus, http.StatusOK},
 		{"Valid - no name", []requests.UpdateSubscriptionRequest{validWithNoName}, http.StatusMultiStatus, http.StatusOK},
+		{"Valid - WEBHOOK channel", []requests.UpdateSubscriptionRequest{webhook}, http.StatusMultiStatus, http.StatusOK},
 		{"Invalid - invalid id", []requests.UpdateSubscriptionRequest{invalidId}, http.StatusBadRequest, http.StatusBadRequest},
 		{"Invalid - empty id", []requests.UpdateSubscriptionRequest{emptyId}, http.StatusBadRequest, http.StatusBadRequest},
 		{"Invalid - empty name", []requests.UpdateSubscriptionRequest{emptyName}, http.StatusBadRequest, http.StatusBadRequest},
@@ -778,4 +841,5 @@ func TestPatchSubscription(t *testing.T) {
 			}
 		})
 	}
+	dbClientMock.AssertNumberOfCalls(t, "UpsertSubscriptionExtension", 1)
 }
@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
+)
+
+type SubscriptionExtensionController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewSubscriptionExtensionController creates and initializes an SubscriptionExtensionController
+func NewSubscriptionExtensionController(dic *di.Container) *SubscriptionExtensionController {
+	return &SubscriptionExtensionController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// UpsertSubscriptionExtension sets the extension of the subscription specified by name
+func (sc *SubscriptionExtensionController) UpsertSubscriptionExtension(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	var reqDTO requests.SubscriptionExtensionRequest
+	err := sc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	err = application.UpsertSubscriptionExtension(name, reqDTO.Extension, ctx, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, reqDTO.RequestId)
+	}
+
+	response := commonDTO.NewBaseResponse(reqDTO.RequestId, "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// SubscriptionExtensionBySubscriptionName returns the extension of the subscription specified by name
+func (sc *SubscriptionExtensionController) SubscriptionExtensionBySubscriptionName(c echo.Context) error {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	extension, err := application.SubscriptionExtensionBySubscriptionName(name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewSubscriptionExtensionResponse("", "", http.StatusOK, extension)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteSubscriptionExtensionBySubscriptionName deletes the extension of the subscription specified by name
+func (sc *SubscriptionExtensionController) DeleteSubscriptionExtensionBySubscriptionName(c echo.Context) error {
+	lc := container.LoggingClientFrom(sc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteSubscriptionExtensionBySubscriptionName(name, sc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
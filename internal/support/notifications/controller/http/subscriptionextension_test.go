@@ -0,0 +1,220 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const notFoundSubscriptionName = "notFoundName"
+
+func subscriptionExtensionRequestData() requests.SubscriptionExtensionRequest {
+	return requests.SubscriptionExtensionRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		Extension: dtos.SubscriptionExtension{
+			Webhook: &dtos.Webhook{
+				BodyTemplate: `{"text":{{json .Content}}}`,
+				Headers:      map[string]string{"X-Source": "edgex"},
+				SecretName:   "webhook",
+				TLS:          dtos.WebhookTLS{MinVersion: "1.3"},
+			},
+			Template:   &dtos.SubscriptionTemplate{TemplateName: testTemplateName, Locale: "zh-TW"},
+			Escalation: &dtos.SubscriptionEscalation{PolicyName: testEscalationPolicyName},
+			RateLimit:  &dtos.RateLimit{Limit: 10, Interval: "1m"},
+		},
+	}
+}
+
+func TestUpsertSubscriptionExtension(t *testing.T) {
+	valid := subscriptionExtensionRequestData()
+	empty := requests.SubscriptionExtensionRequest{BaseRequest: commonDTO.NewBaseRequest()}
+	invalidWebhookTemplate := subscriptionExtensionRequestData()
+	invalidWebhookTemplate.Extension.Webhook = &dtos.Webhook{BodyTemplate: "{{.Content"}
+	invalidTLSVersion := subscriptionExtensionRequestData()
+	invalidTLSVersion.Extension.Webhook = &dtos.Webhook{TLS: dtos.WebhookTLS{MinVersion: "1.0"}}
+	invalidDigestWindow := subscriptionExtensionRequestData()
+	invalidDigestWindow.Extension.Digest = &dtos.Digest{Window: "later"}
+	templateNotFound := subscriptionExtensionRequestData()
+	templateNotFound.Extension.Template = &dtos.SubscriptionTemplate{TemplateName: notFoundTemplateName}
+	policyNotFound := subscriptionExtensionRequestData()
+	policyNotFound.Extension.Escalation = &dtos.SubscriptionEscalation{PolicyName: notFoundEscalationPolicyName}
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionByName", testSubscriptionName).Return(models.Subscription{Name: testSubscriptionName}, nil)
+	dbClientMock.On("SubscriptionByName", notFoundSubscriptionName).Return(models.Subscription{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription doesn't exist in the database", nil))
+	dbClientMock.On("TemplateByName", testTemplateName).Return(dtos.ToTemplateModel(templateRequestData().Template), nil)
+	dbClientMock.On("TemplateByName", notFoundTemplateName).Return(notificationsModels.Template{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "template doesn't exist in the database", nil))
+	dbClientMock.On("EscalationPolicyByName", testEscalationPolicyName).Return(dtos.ToEscalationPolicyModel(escalationPolicyRequestData().EscalationPolicy), nil)
+	dbClientMock.On("EscalationPolicyByName", notFoundEscalationPolicyName).Return(notificationsModels.EscalationPolicy{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "escalation policy doesn't exist in the database", nil))
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", testSubscriptionName).Return(notificationsModels.SubscriptionExtension{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription extension doesn't exist in the database", nil))
+	dbClientMock.On("UpsertSubscriptionExtension", dtos.ToSubscriptionExtensionModel(testSubscriptionName, valid.Extension)).Return(nil)
+	dbClientMock.On("UpsertSubscriptionExtension", dtos.ToSubscriptionExtensionModel(testSubscriptionName, empty.Extension)).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewSubscriptionExtensionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		request            requests.SubscriptionExtensionRequest
+		expectedStatusCode int
+	}{
+		{"Valid", testSubscriptionName, valid, http.StatusOK},
+		{"Valid - no settings", testSubscriptionName, empty, http.StatusOK},
+		{"Invalid - subscription not found", notFoundSubscriptionName, valid, http.StatusNotFound},
+		{"Invalid - webhook template syntax", testSubscriptionName, invalidWebhookTemplate, http.StatusBadRequest},
+		{"Invalid - webhook TLS version", testSubscriptionName, invalidTLSVersion, http.StatusBadRequest},
+		{"Invalid - digest window", testSubscriptionName, invalidDigestWindow, http.StatusBadRequest},
+		{"Invalid - template not found", testSubscriptionName, templateNotFound, http.StatusNotFound},
+		{"Invalid - escalation policy not found", testSubscriptionName, policyNotFound, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPut, constants.ApiSubscriptionExtensionRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.UpsertSubscriptionExtension(c)
+			require.NoError(t, err)
+			var res commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+		})
+	}
+}
+
+func TestSubscriptionExtensionBySubscriptionName(t *testing.T) {
+	ext := dtos.ToSubscriptionExtensionModel(testSubscriptionName, subscriptionExtensionRequestData().Extension)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", testSubscriptionName).Return(ext, nil)
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", notFoundSubscriptionName).Return(notificationsModels.SubscriptionExtension{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription extension doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewSubscriptionExtensionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		expectedStatusCode int
+	}{
+		{"Valid", testSubscriptionName, http.StatusOK},
+		{"Invalid - extension not found", notFoundSubscriptionName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiSubscriptionExtensionRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.SubscriptionExtensionBySubscriptionName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res responses.SubscriptionExtensionResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, dtos.FromSubscriptionExtensionModelToDTO(ext), res.Extension)
+			}
+		})
+	}
+}
+
+func TestDeleteSubscriptionExtensionBySubscriptionName(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", testSubscriptionName).Return(notificationsModels.SubscriptionExtension{SubscriptionName: testSubscriptionName}, nil)
+	dbClientMock.On("SubscriptionExtensionBySubscriptionName", notFoundSubscriptionName).Return(notificationsModels.SubscriptionExtension{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "subscription extension doesn't exist in the database", nil))
+	dbClientMock.On("DeleteSubscriptionExtensionBySubscriptionName", testSubscriptionName).Return(nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewSubscriptionExtensionController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		subscriptionName   string
+		expectedStatusCode int
+	}{
+		{"Valid", testSubscriptionName, http.StatusOK},
+		{"Invalid - extension not found", notFoundSubscriptionName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodDelete, constants.ApiSubscriptionExtensionRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.subscriptionName)
+			err = controller.DeleteSubscriptionExtensionBySubscriptionName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
@@ -0,0 +1,159 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
+)
+
+type TemplateController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewTemplateController creates and initializes an TemplateController
+func NewTemplateController(dic *di.Container) *TemplateController {
+	return &TemplateController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// AddTemplate adds the batch of templates
+func (tc *TemplateController) AddTemplate(c echo.Context) error {
+	return tc.writeTemplates(c, func(reqDTO requests.TemplateRequest) (interface{}, errors.EdgeX) {
+		name, err := application.AddTemplate(reqDTO.Template, c.Request().Context(), tc.dic)
+		if err != nil {
+			return nil, err
+		}
+		return commonDTO.NewBaseWithIdResponse(reqDTO.RequestId, "", http.StatusCreated, name), nil
+	})
+}
+
+// UpdateTemplate replaces the batch of templates specified by name
+func (tc *TemplateController) UpdateTemplate(c echo.Context) error {
+	return tc.writeTemplates(c, func(reqDTO requests.TemplateRequest) (interface{}, errors.EdgeX) {
+		err := application.UpdateTemplate(reqDTO.Template, c.Request().Context(), tc.dic)
+		if err != nil {
+			return nil, err
+		}
+		return commonDTO.NewBaseResponse(reqDTO.RequestId, "", http.StatusOK), nil
+	})
+}
+
+// writeTemplates reads the batch of template requests and writes the multi-status response of the operation
+func (tc *TemplateController) writeTemplates(c echo.Context, operate func(requests.TemplateRequest) (interface{}, errors.EdgeX)) error {
+	r := c.Request()
+	w := c.Response()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(tc.dic.Get)
+
+	ctx := r.Context()
+	correlationId := correlation.FromContext(ctx)
+
+	var reqDTOs []requests.TemplateRequest
+	err := tc.reader.Read(r.Body, &reqDTOs)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	var multiResponses []interface{}
+	for _, reqDTO := range reqDTOs {
+		response, err := operate(reqDTO)
+		if err != nil {
+			lc.Error(err.Error(), common.CorrelationHeader, correlationId)
+			lc.Debug(err.DebugMessages(), common.CorrelationHeader, correlationId)
+			response = commonDTO.NewBaseResponse(reqDTO.RequestId, err.Message(), err.Code())
+		}
+		multiResponses = append(multiResponses, response)
+	}
+
+	utils.WriteHttpHeader(w, ctx, http.StatusMultiStatus)
+	return pkg.EncodeAndWriteResponse(multiResponses, w, lc)
+}
+
+// AllTemplates returns the templates by offset and limit
+func (tc *TemplateController) AllTemplates(c echo.Context) error {
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	config := notificationContainer.ConfigurationFrom(tc.dic.Get)
+
+	// parse URL query string for offset and limit
+	offset, limit, _, err := utils.ParseGetAllObjectsRequestQueryString(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	templates, totalCount, err := application.AllTemplates(offset, limit, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiTemplatesResponse("", "", http.StatusOK, totalCount, templates)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// TemplateByName returns the template specified by name
+func (tc *TemplateController) TemplateByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	template, err := application.TemplateByName(name, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewTemplateResponse("", "", http.StatusOK, template)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteTemplateByName deletes the template specified by name
+func (tc *TemplateController) DeleteTemplateByName(c echo.Context) error {
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteTemplateByName(name, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,173 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos/responses"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+const (
+	testTemplateName     = "disk-alert"
+	notFoundTemplateName = "notFoundTemplate"
+)
+
+func templateRequestData() requests.TemplateRequest {
+	return requests.TemplateRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		Template: dtos.Template{
+			Name:        testTemplateName,
+			Category:    "disk",
+			ChannelType: common.EMAIL,
+			Locale:      "en",
+			Subject:     "[{{.Severity}}] {{.Category}}",
+			Body:        "{{.Content}}",
+			HTMLBody:    "<p>{{.Content}}</p>",
+		},
+	}
+}
+
+func TestAddTemplate(t *testing.T) {
+	valid := templateRequestData()
+	noName := templateRequestData()
+	noName.Template.Name = ""
+	duplicatedName := templateRequestData()
+	duplicatedName.Template.Name = "duplicatedName"
+	invalidSyntax := templateRequestData()
+	invalidSyntax.Template.Body = "{{.Content"
+	unsupportedChannelType := templateRequestData()
+	unsupportedChannelType.Template.ChannelType = "unknown"
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	model := dtos.ToTemplateModel(valid.Template)
+	dbClientMock.On("AddTemplate", model).Return(model, nil)
+	model = dtos.ToTemplateModel(duplicatedName.Template)
+	dbClientMock.On("AddTemplate", model).Return(model, errors.NewCommonEdgeX(errors.KindDuplicateName, "template name duplicatedName already exists", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewTemplateController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name                string
+		request             []requests.TemplateRequest
+		expectedMultiStatus bool
+		expectedStatusCode  int
+	}{
+		{"Valid", []requests.TemplateRequest{valid}, true, http.StatusCreated},
+		{"Invalid - no name", []requests.TemplateRequest{noName}, false, http.StatusBadRequest},
+		{"Invalid - duplicated name", []requests.TemplateRequest{duplicatedName}, true, http.StatusConflict},
+		{"Invalid - template syntax", []requests.TemplateRequest{invalidSyntax}, true, http.StatusBadRequest},
+		{"Invalid - unsupported channel type", []requests.TemplateRequest{unsupportedChannelType}, true, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+			reader := strings.NewReader(string(jsonData))
+			req, err := http.NewRequest(http.MethodPost, constants.ApiTemplateRoute, reader)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			err = controller.AddTemplate(c)
+			require.NoError(t, err)
+
+			// Assert
+			if !testCase.expectedMultiStatus {
+				var res commonDTO.BaseResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+				assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "BaseResponse status code not as expected")
+				return
+			}
+			var res []commonDTO.BaseResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusMultiStatus, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, common.ApiVersion, res[0].ApiVersion, "API Version not as expected")
+			assert.Equal(t, testCase.request[0].RequestId, res[0].RequestId, "RequestID not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res[0].StatusCode, "BaseResponse status code not as expected")
+		})
+	}
+}
+
+func TestTemplateByName(t *testing.T) {
+	template := dtos.ToTemplateModel(templateRequestData().Template)
+
+	dic := mockDic()
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("TemplateByName", testTemplateName).Return(template, nil)
+	dbClientMock.On("TemplateByName", notFoundTemplateName).Return(notificationsModels.Template{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "template doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	controller := NewTemplateController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		templateName       string
+		expectedStatusCode int
+	}{
+		{"Valid", testTemplateName, http.StatusOK},
+		{"Invalid - template not found", notFoundTemplateName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiTemplateByNameRoute, http.NoBody)
+			require.NoError(t, err)
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.templateName)
+			err = controller.TemplateByName(c)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res responses.TemplateResponse
+				err = json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, dtos.FromTemplateModelToDTO(template), res.Template, "Template not as expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
+	notificationContainer "github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-messaging/v4/messaging"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+)
+
+// ResponseTopicQueryParam is the query parameter of the request envelope specifying the topic to publish the response,
+// no response is published if the query parameter is absent
+const ResponseTopicQueryParam = "responseTopic"
+
+// SubscribeNotifications subscribes to the AddNotificationRequest from message bus, the received notifications are
+// persisted and distributed in the same way as the ones added via the REST API
+func SubscribeNotifications(ctx context.Context, dic *di.Container) errors.EdgeX {
+	config := notificationContainer.ConfigurationFrom(dic.Get)
+	lc := container.LoggingClientFrom(dic.Get)
+
+	messageBus := container.MessagingClientFrom(dic.Get)
+	if messageBus == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "message bus client is not available to subscribe notifications", nil)
+	}
+
+	messages := make(chan types.MessageEnvelope)
+	messageErrors := make(chan error)
+
+	subscribeTopic := common.BuildTopic(config.MessageBus.GetBaseTopicPrefix(), config.NotificationSubscriber.SubscribeTopic)
+
+	topics := []types.TopicChannel{
+		{
+			Topic:    subscribeTopic,
+			Messages: messages,
+		},
+	}
+
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	for _, t := range topics {
+		lc.Infof("Subscribed to topics: %s", t.Topic)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				lc.Infof("Exiting waiting for MessageBus '%s' topic messages", subscribeTopic)
+				return
+			case e := <-messageErrors:
+				lc.Error(e.Error())
+			case msgEnvelope := <-messages:
+				processNotificationRequest(ctx, messageBus, msgEnvelope, lc, dic)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// processNotificationRequest adds the notification of the request envelope and publishes the ack or error response
+// to the response topic if specified
+func processNotificationRequest(
+	ctx context.Context,
+	messageBus messaging.MessageClient,
+	requestEnvelope types.MessageEnvelope,
+	lc logger.LoggingClient,
+	dic *di.Container) {
+	lc.Debugf("Notification request received from MessageBus. Topic: %s, Request-id: %s, Correlation-id: %s", requestEnvelope.ReceivedTopic, requestEnvelope.RequestID, requestEnvelope.CorrelationID)
+
+	id, err := addNotification(ctx, requestEnvelope, dic)
+	if err != nil {
+		lc.Errorf("fail to add the notification received from MessageBus, %v", err)
+		lc.Debug(err.DebugMessages(), common.CorrelationHeader, requestEnvelope.CorrelationID)
+	}
+
+	responseTopic := requestEnvelope.QueryParams[ResponseTopicQueryParam]
+	if responseTopic == "" {
+		return
+	}
+
+	var responseEnvelope types.MessageEnvelope
+	if err != nil {
+		responseEnvelope = types.NewMessageEnvelopeWithError(requestEnvelope.RequestID, err.Error())
+		responseEnvelope.CorrelationID = requestEnvelope.CorrelationID
+	} else {
+		var envelopeErr error
+		response := commonDTO.NewBaseWithIdResponse(requestEnvelope.RequestID, "", http.StatusCreated, id)
+		responseEnvelope, envelopeErr = types.NewMessageEnvelopeForResponse(response, requestEnvelope.RequestID, requestEnvelope.CorrelationID, common.ContentTypeJSON)
+		if envelopeErr != nil {
+			lc.Errorf("fail to create the response envelope of the notification %s, %v", id, envelopeErr)
+			return
+		}
+	}
+
+	if publishErr := messageBus.Publish(responseEnvelope, responseTopic); publishErr != nil {
+		lc.Errorf("Could not publish to topic '%s': %s", responseTopic, publishErr.Error())
+	}
+}
+
+func addNotification(ctx context.Context, requestEnvelope types.MessageEnvelope, dic *di.Container) (string, errors.EdgeX) {
+	addNotificationRequest, err := types.GetMsgPayload[requests.AddNotificationRequest](requestEnvelope)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to unmarshal the AddNotificationRequest", err)
+	}
+	err = addNotificationRequest.Validate()
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+
+	// nolint:staticcheck // See golangci-lint #741
+	ctx = context.WithValue(ctx, common.CorrelationHeader, requestEnvelope.CorrelationID)
+	notifications := requests.AddNotificationReqToNotificationModels([]requests.AddNotificationRequest{addNotificationRequest})
+	return application.AddNotification(notifications[0], ctx, dic)
+}
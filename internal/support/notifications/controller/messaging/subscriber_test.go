@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/edgexfoundry/go-mod-messaging/v4/messaging/mocks"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/config"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	dbMock "github.com/edgexfoundry/edgex-go/internal/support/notifications/infrastructure/interfaces/mocks"
+)
+
+const (
+	testNotificationId       = "a1b2c3d4-0000-4000-8000-000000000001"
+	testNotificationCategory = "disk"
+	testResponseTopic        = "edgex/response/notifications"
+)
+
+var testNotificationLabels = []string{"label1"}
+
+func buildTestAddNotificationRequest() requests.AddNotificationRequest {
+	notification := dtos.NewNotification(testNotificationLabels, testNotificationCategory, "disk is full", "sender1", models.Normal)
+	return requests.NewAddNotificationRequest(notification)
+}
+
+func envelopeOf(t *testing.T, request any, responseTopic string) types.MessageEnvelope {
+	payload, err := json.Marshal(request)
+	require.NoError(t, err)
+	envelope := types.NewMessageEnvelope(payload, context.Background())
+	envelope.RequestID = uuid.NewString()
+	envelope.CorrelationID = uuid.NewString()
+	envelope.ContentType = common.ContentTypeJSON
+	if responseTopic != "" {
+		envelope.QueryParams = map[string]string{ResponseTopicQueryParam: responseTopic}
+	}
+	return envelope
+}
+
+func TestProcessNotificationRequest(t *testing.T) {
+	validRequest := buildTestAddNotificationRequest()
+	added := requests.AddNotificationReqToNotificationModels([]requests.AddNotificationRequest{validRequest})[0]
+	added.Id = testNotificationId
+	processed := added
+	processed.Status = models.Processed
+
+	noContent := validRequest
+	noContent.Notification.Content = ""
+
+	dbClientMock := &dbMock.DBClient{}
+	dbClientMock.On("AddNotification", mock.Anything).Return(added, nil)
+	dbClientMock.On("SubscriptionsByCategoriesAndLabels", 0, -1, []string{testNotificationCategory}, testNotificationLabels).Return([]models.Subscription{}, nil)
+	dbClientMock.On("UpdateNotification", processed).Return(nil)
+
+	dic := di.NewContainer(di.ServiceConstructorMap{
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{}
+		},
+		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
+			return logger.NewMockClient()
+		},
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+
+	tests := []struct {
+		name               string
+		request            any
+		responseTopic      string
+		expectedPublished  bool
+		expectedErrorReply bool
+	}{
+		{"valid", validRequest, testResponseTopic, true, false},
+		{"valid - no response topic", validRequest, "", false, false},
+		{"invalid - no content", noContent, testResponseTopic, true, true},
+		{"invalid - no content and no response topic", noContent, "", false, false},
+		{"invalid - malformed payload", "not a notification", testResponseTopic, true, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			envelope := envelopeOf(t, testCase.request, testCase.responseTopic)
+
+			messageClientMock := &mocks.MessageClient{}
+			messageClientMock.On("Publish", mock.Anything, testResponseTopic).Run(func(args mock.Arguments) {
+				response := args.Get(0).(types.MessageEnvelope)
+				assert.Equal(t, envelope.RequestID, response.RequestID)
+				assert.Equal(t, envelope.CorrelationID, response.CorrelationID)
+				assert.Equal(t, testCase.expectedErrorReply, response.ErrorCode == 1)
+				if testCase.expectedErrorReply {
+					return
+				}
+				idResponse, err := types.GetMsgPayload[commonDTO.BaseWithIdResponse](response)
+				require.NoError(t, err)
+				assert.Equal(t, http.StatusCreated, idResponse.StatusCode)
+				assert.Equal(t, testNotificationId, idResponse.Id)
+			}).Return(nil)
+
+			processNotificationRequest(context.Background(), messageClientMock, envelope, logger.NewMockClient(), dic)
+
+			if testCase.expectedPublished {
+				messageClientMock.AssertNumberOfCalls(t, "Publish", 1)
+			} else {
+				messageClientMock.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
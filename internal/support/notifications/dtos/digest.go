@@ -0,0 +1,33 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// Digest and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type Digest struct {
+	Window   string `json:"window" validate:"required"`
+	MaxItems int    `json:"maxItems,omitempty" validate:"gte=0"`
+}
+
+// ToDigestModel transforms the Digest DTO to the Digest model
+func ToDigestModel(dto Digest) models.Digest {
+	return models.Digest{
+		Window:   dto.Window,
+		MaxItems: dto.MaxItems,
+	}
+}
+
+// FromDigestModelToDTO transforms the Digest model to the Digest DTO
+func FromDigestModelToDTO(d models.Digest) Digest {
+	return Digest{
+		Window:   d.Window,
+		MaxItems: d.MaxItems,
+	}
+}
@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// EscalationPolicy and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type EscalationPolicy struct {
+	Name        string            `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description string            `json:"description,omitempty"`
+	Levels      []EscalationLevel `json:"levels" validate:"required,gt=0,dive"`
+	Categories  []string          `json:"categories,omitempty" validate:"omitempty,dive,edgex-dto-none-empty-string"`
+	Created     int64             `json:"created,omitempty"`
+	Modified    int64             `json:"modified,omitempty"`
+}
+
+// EscalationLevel and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type EscalationLevel struct {
+	Delay         string   `json:"delay,omitempty"`
+	Subscriptions []string `json:"subscriptions" validate:"required,gt=0,dive,edgex-dto-none-empty-string"`
+}
+
+// SubscriptionEscalation and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type SubscriptionEscalation struct {
+	PolicyName string `json:"policyName" validate:"required,edgex-dto-none-empty-string"`
+}
+
+// ToEscalationPolicyModel transforms the EscalationPolicy DTO to the EscalationPolicy model
+func ToEscalationPolicyModel(dto EscalationPolicy) models.EscalationPolicy {
+	levels := make([]models.EscalationLevel, len(dto.Levels))
+	for i, l := range dto.Levels {
+		levels[i] = models.EscalationLevel{Delay: l.Delay, Subscriptions: l.Subscriptions}
+	}
+	return models.EscalationPolicy{
+		Name:        dto.Name,
+		Description: dto.Description,
+		Levels:      levels,
+		Categories:  dto.Categories,
+	}
+}
+
+// FromEscalationPolicyModelToDTO transforms the EscalationPolicy model to the EscalationPolicy DTO
+func FromEscalationPolicyModelToDTO(p models.EscalationPolicy) EscalationPolicy {
+	levels := make([]EscalationLevel, len(p.Levels))
+	for i, l := range p.Levels {
+		levels[i] = EscalationLevel{Delay: l.Delay, Subscriptions: l.Subscriptions}
+	}
+	return EscalationPolicy{
+		Name:        p.Name,
+		Description: p.Description,
+		Levels:      levels,
+		Categories:  p.Categories,
+		Created:     p.Created,
+		Modified:    p.Modified,
+	}
+}
+
+// ToSubscriptionEscalationModel transforms the SubscriptionEscalation DTO to the SubscriptionEscalation model
+func ToSubscriptionEscalationModel(dto SubscriptionEscalation) models.SubscriptionEscalation {
+	return models.SubscriptionEscalation{
+		PolicyName: dto.PolicyName,
+	}
+}
+
+// FromSubscriptionEscalationModelToDTO transforms the SubscriptionEscalation model to the SubscriptionEscalation DTO
+func FromSubscriptionEscalationModelToDTO(se models.SubscriptionEscalation) SubscriptionEscalation {
+	return SubscriptionEscalation{
+		PolicyName: se.PolicyName,
+	}
+}
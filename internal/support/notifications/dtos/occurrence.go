@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// NotificationOccurrence and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type NotificationOccurrence struct {
+	NotificationId string `json:"notificationId"`
+	Count          int64  `json:"count"`
+	FirstSeen      int64  `json:"firstSeen"`
+	LastSeen       int64  `json:"lastSeen"`
+}
+
+// FromNotificationOccurrenceModelToDTO transforms the NotificationOccurrence model to the NotificationOccurrence DTO
+func FromNotificationOccurrenceModelToDTO(o models.NotificationOccurrence) NotificationOccurrence {
+	return NotificationOccurrence{
+		NotificationId: o.NotificationId,
+		Count:          o.Count,
+		FirstSeen:      o.FirstSeen,
+		LastSeen:       o.LastSeen,
+	}
+}
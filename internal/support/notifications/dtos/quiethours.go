@@ -0,0 +1,52 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// QuietHours and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type QuietHours struct {
+	Timezone string        `json:"timezone,omitempty"`
+	Windows  []QuietWindow `json:"windows" validate:"required,gt=0,dive"`
+	Action   string        `json:"action" validate:"required,oneof='HOLD' 'DROP'"`
+}
+
+// QuietWindow and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type QuietWindow struct {
+	Start    string   `json:"start" validate:"required"`
+	End      string   `json:"end" validate:"required"`
+	Weekdays []string `json:"weekdays,omitempty"`
+}
+
+// ToQuietHoursModel transforms the QuietHours DTO to the QuietHours model
+func ToQuietHoursModel(dto QuietHours) models.QuietHours {
+	windows := make([]models.QuietWindow, len(dto.Windows))
+	for i, w := range dto.Windows {
+		windows[i] = models.QuietWindow{Start: w.Start, End: w.End, Weekdays: w.Weekdays}
+	}
+	return models.QuietHours{
+		Timezone: dto.Timezone,
+		Windows:  windows,
+		Action:   dto.Action,
+	}
+}
+
+// FromQuietHoursModelToDTO transforms the QuietHours model to the QuietHours DTO
+func FromQuietHoursModelToDTO(qh models.QuietHours) QuietHours {
+	windows := make([]QuietWindow, len(qh.Windows))
+	for i, w := range qh.Windows {
+		windows[i] = QuietWindow{Start: w.Start, End: w.End, Weekdays: w.Weekdays}
+	}
+	return QuietHours{
+		Timezone: qh.Timezone,
+		Windows:  windows,
+		Action:   qh.Action,
+	}
+}
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// RateLimit and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type RateLimit struct {
+	Limit    int    `json:"limit" validate:"required,gt=0"`
+	Interval string `json:"interval" validate:"required"`
+	Burst    int    `json:"burst,omitempty" validate:"gte=0"`
+}
+
+// ToRateLimitModel transforms the RateLimit DTO to the RateLimit model
+func ToRateLimitModel(dto RateLimit) models.RateLimit {
+	return models.RateLimit{
+		Limit:    dto.Limit,
+		Interval: dto.Interval,
+		Burst:    dto.Burst,
+	}
+}
+
+// FromRateLimitModelToDTO transforms the RateLimit model to the RateLimit DTO
+func FromRateLimitModelToDTO(rl models.RateLimit) RateLimit {
+	return RateLimit{
+		Limit:    rl.Limit,
+		Interval: rl.Interval,
+		Burst:    rl.Burst,
+	}
+}
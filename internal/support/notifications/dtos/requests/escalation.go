@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// EscalationPolicyRequest defines the Request Content for POST and PUT EscalationPolicy DTO.
+type EscalationPolicyRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	EscalationPolicy      dtos.EscalationPolicy `json:"escalationPolicy"`
+}
+
+// Validate satisfies the Validator interface
+func (p EscalationPolicyRequest) Validate() error {
+	err := common.Validate(p)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the EscalationPolicyRequest type
+func (p *EscalationPolicyRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		EscalationPolicy dtos.EscalationPolicy
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*p = EscalationPolicyRequest(alias)
+
+	// validate EscalationPolicyRequest DTO
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
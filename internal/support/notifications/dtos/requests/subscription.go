@@ -0,0 +1,115 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	contractsDTOs "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
+)
+
+// AddSubscriptionRequest is the AddSubscriptionRequest of go-mod-core-contracts which also accepts the WEBHOOK
+// channels, the WEBHOOK channels are read as REST channels and kept in WebhookChannels
+type AddSubscriptionRequest struct {
+	requests.AddSubscriptionRequest
+	WebhookChannels []models.RESTAddress `json:"-"`
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the AddSubscriptionRequest type
+func (a *AddSubscriptionRequest) UnmarshalJSON(b []byte) error {
+	b, webhookIndexes, err := webhookChannelsToREST(b)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := json.Unmarshal(b, &a.AddSubscriptionRequest); err != nil {
+		return err
+	}
+	a.WebhookChannels, err = webhookChannelsOf(a.Subscription.Channels, webhookIndexes)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// UpdateSubscriptionRequest is the UpdateSubscriptionRequest of go-mod-core-contracts which also accepts the WEBHOOK
+// channels, the WEBHOOK channels are read as REST channels and kept in WebhookChannels
+type UpdateSubscriptionRequest struct {
+	requests.UpdateSubscriptionRequest
+	WebhookChannels []models.RESTAddress `json:"-"`
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the UpdateSubscriptionRequest type
+func (u *UpdateSubscriptionRequest) UnmarshalJSON(b []byte) error {
+	b, webhookIndexes, err := webhookChannelsToREST(b)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := json.Unmarshal(b, &u.UpdateSubscriptionRequest); err != nil {
+		return err
+	}
+	u.WebhookChannels, err = webhookChannelsOf(u.Subscription.Channels, webhookIndexes)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// webhookChannelsToREST replaces the type of the WEBHOOK channels in the subscription request with REST, so the
+// request can be validated by go-mod-core-contracts, and returns the indexes of the WEBHOOK channels
+func webhookChannelsToREST(b []byte) ([]byte, []int, errors.EdgeX) {
+	var req map[string]json.RawMessage
+	var subscription map[string]json.RawMessage
+	var channels []map[string]json.RawMessage
+	if json.Unmarshal(b, &req) != nil || json.Unmarshal(req[common.Subscription], &subscription) != nil ||
+		json.Unmarshal(subscription["channels"], &channels) != nil {
+		// leave the malformed request to be reported by go-mod-core-contracts
+		return b, nil, nil
+	}
+
+	var webhookIndexes []int
+	for i, c := range channels {
+		var channelType string
+		if json.Unmarshal(c["type"], &channelType) != nil || channelType != constants.WEBHOOK {
+			continue
+		}
+		c["type"], _ = json.Marshal(common.REST)
+		webhookIndexes = append(webhookIndexes, i)
+	}
+	if len(webhookIndexes) == 0 {
+		return b, nil, nil
+	}
+
+	var err error
+	if subscription["channels"], err = json.Marshal(channels); err != nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to marshal the subscription channels.", err)
+	}
+	if req[common.Subscription], err = json.Marshal(subscription); err != nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to marshal the subscription.", err)
+	}
+	if b, err = json.Marshal(req); err != nil {
+		return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to marshal the subscription request.", err)
+	}
+	return b, webhookIndexes, nil
+}
+
+// webhookChannelsOf returns the REST addresses of the channels at the WEBHOOK channel indexes
+func webhookChannelsOf(channels []contractsDTOs.Address, webhookIndexes []int) ([]models.RESTAddress, errors.EdgeX) {
+	var webhookChannels []models.RESTAddress
+	for _, i := range webhookIndexes {
+		restAddress, ok := contractsDTOs.ToAddressModel(channels[i]).(models.RESTAddress)
+		if !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast the WEBHOOK channel to RESTAddress", nil)
+		}
+		webhookChannels = append(webhookChannels, restAddress)
+	}
+	return webhookChannels, nil
+}
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// SubscriptionExtensionRequest defines the Request Content for PUT SubscriptionExtension DTO.
+type SubscriptionExtensionRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Extension             dtos.SubscriptionExtension `json:"extension"`
+}
+
+// Validate satisfies the Validator interface
+func (se SubscriptionExtensionRequest) Validate() error {
+	err := common.Validate(se)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the SubscriptionExtensionRequest type
+func (se *SubscriptionExtensionRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Extension dtos.SubscriptionExtension
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*se = SubscriptionExtensionRequest(alias)
+
+	// validate SubscriptionExtensionRequest DTO
+	if err := se.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// TemplateRequest defines the Request Content for POST and PUT Template DTO.
+type TemplateRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Template              dtos.Template `json:"template"`
+}
+
+// Validate satisfies the Validator interface
+func (t TemplateRequest) Validate() error {
+	err := common.Validate(t)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the TemplateRequest type
+func (t *TemplateRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Template dtos.Template
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*t = TemplateRequest(alias)
+
+	// validate TemplateRequest DTO
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// EscalationPolicyResponse defines the Response Content for GET EscalationPolicy DTO.
+type EscalationPolicyResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	EscalationPolicy       dtos.EscalationPolicy `json:"escalationPolicy"`
+}
+
+func NewEscalationPolicyResponse(requestId string, message string, statusCode int, policy dtos.EscalationPolicy) EscalationPolicyResponse {
+	return EscalationPolicyResponse{
+		BaseResponse:     dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		EscalationPolicy: policy,
+	}
+}
+
+// MultiEscalationPoliciesResponse defines the Response Content for GET multiple EscalationPolicy DTOs.
+type MultiEscalationPoliciesResponse struct {
+	dtoCommon.BaseWithTotalCountResponse `json:",inline"`
+	EscalationPolicies                   []dtos.EscalationPolicy `json:"escalationPolicies"`
+}
+
+func NewMultiEscalationPoliciesResponse(requestId string, message string, statusCode int, totalCount int64, policies []dtos.EscalationPolicy) MultiEscalationPoliciesResponse {
+	return MultiEscalationPoliciesResponse{
+		BaseWithTotalCountResponse: dtoCommon.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		EscalationPolicies:         policies,
+	}
+}
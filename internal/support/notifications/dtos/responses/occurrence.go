@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// NotificationOccurrenceResponse defines the Response Content for GET NotificationOccurrence DTO.
+type NotificationOccurrenceResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Occurrence             dtos.NotificationOccurrence `json:"occurrence"`
+}
+
+func NewNotificationOccurrenceResponse(requestId string, message string, statusCode int, occurrence dtos.NotificationOccurrence) NotificationOccurrenceResponse {
+	return NotificationOccurrenceResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Occurrence:   occurrence,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// NotificationStatisticsResponse defines the Response Content for GET NotificationStatistic DTOs.
+type NotificationStatisticsResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Statistics             []dtos.NotificationStatistic `json:"statistics"`
+}
+
+func NewNotificationStatisticsResponse(requestId string, message string, statusCode int, statistics []dtos.NotificationStatistic) NotificationStatisticsResponse {
+	return NotificationStatisticsResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Statistics:   statistics,
+	}
+}
+
+// SubscriptionDeliveryStatisticsResponse defines the Response Content for GET SubscriptionDeliveryStatistic DTOs.
+type SubscriptionDeliveryStatisticsResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Statistics             []dtos.SubscriptionDeliveryStatistic `json:"statistics"`
+}
+
+func NewSubscriptionDeliveryStatisticsResponse(requestId string, message string, statusCode int, statistics []dtos.SubscriptionDeliveryStatistic) SubscriptionDeliveryStatisticsResponse {
+	return SubscriptionDeliveryStatisticsResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Statistics:   statistics,
+	}
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// SubscriptionExtensionResponse defines the Response Content for GET SubscriptionExtension DTO.
+type SubscriptionExtensionResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Extension              dtos.SubscriptionExtension `json:"extension"`
+}
+
+func NewSubscriptionExtensionResponse(requestId string, message string, statusCode int, extension dtos.SubscriptionExtension) SubscriptionExtensionResponse {
+	return SubscriptionExtensionResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Extension:    extension,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/dtos"
+)
+
+// TemplateResponse defines the Response Content for GET Template DTO.
+type TemplateResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Template               dtos.Template `json:"template"`
+}
+
+func NewTemplateResponse(requestId string, message string, statusCode int, template dtos.Template) TemplateResponse {
+	return TemplateResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Template:     template,
+	}
+}
+
+// MultiTemplatesResponse defines the Response Content for GET multiple Template DTOs.
+type MultiTemplatesResponse struct {
+	dtoCommon.BaseWithTotalCountResponse `json:",inline"`
+	Templates                            []dtos.Template `json:"templates"`
+}
+
+func NewMultiTemplatesResponse(requestId string, message string, statusCode int, totalCount int64, templates []dtos.Template) MultiTemplatesResponse {
+	return MultiTemplatesResponse{
+		BaseWithTotalCountResponse: dtoCommon.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		Templates:                  templates,
+	}
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// NotificationStatistic and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type NotificationStatistic struct {
+	Bucket int64  `json:"bucket"`
+	Group  string `json:"group"`
+	Count  int64  `json:"count"`
+}
+
+// SubscriptionDeliveryStatistic and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type SubscriptionDeliveryStatistic struct {
+	SubscriptionName string  `json:"subscriptionName"`
+	Total            int64   `json:"total"`
+	Sent             int64   `json:"sent"`
+	Failed           int64   `json:"failed"`
+	Resending        int64   `json:"resending"`
+	Escalated        int64   `json:"escalated"`
+	DeliveryRate     float64 `json:"deliveryRate"`
+	MeanResendCount  float64 `json:"meanResendCount"`
+	P95Latency       float64 `json:"p95Latency"`
+}
+
+// FromNotificationStatisticModelToDTO transforms the NotificationStatistic model to the NotificationStatistic DTO
+func FromNotificationStatisticModelToDTO(s models.NotificationStatistic) NotificationStatistic {
+	return NotificationStatistic{
+		Bucket: s.Bucket,
+		Group:  s.Group,
+		Count:  s.Count,
+	}
+}
+
+// FromSubscriptionDeliveryStatisticModelToDTO transforms the SubscriptionDeliveryStatistic model to the
+// SubscriptionDeliveryStatistic DTO, the delivery rate is the ratio of the sent transmissions to all the transmissions
+func FromSubscriptionDeliveryStatisticModelToDTO(s models.SubscriptionDeliveryStatistic) SubscriptionDeliveryStatistic {
+	dto := SubscriptionDeliveryStatistic{
+		SubscriptionName: s.SubscriptionName,
+		Total:            s.Total,
+		Sent:             s.Sent,
+		Failed:           s.Failed,
+		Resending:        s.Resending,
+		Escalated:        s.Escalated,
+		MeanResendCount:  s.MeanResendCount,
+		P95Latency:       s.P95Latency,
+	}
+	if s.Total > 0 {
+		dto.DeliveryRate = float64(s.Sent) / float64(s.Total)
+	}
+	return dto
+}
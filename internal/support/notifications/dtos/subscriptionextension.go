@@ -0,0 +1,83 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// SubscriptionExtension and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type SubscriptionExtension struct {
+	SubscriptionName string                  `json:"subscriptionName,omitempty"`
+	Webhook          *Webhook                `json:"webhook,omitempty"`
+	Template         *SubscriptionTemplate   `json:"template,omitempty"`
+	Digest           *Digest                 `json:"digest,omitempty"`
+	QuietHours       *QuietHours             `json:"quietHours,omitempty"`
+	Escalation       *SubscriptionEscalation `json:"escalation,omitempty"`
+	RateLimit        *RateLimit              `json:"rateLimit,omitempty"`
+}
+
+// ToSubscriptionExtensionModel transforms the SubscriptionExtension DTO of the subscription to the
+// SubscriptionExtension model
+func ToSubscriptionExtensionModel(subscriptionName string, dto SubscriptionExtension) models.SubscriptionExtension {
+	ext := models.SubscriptionExtension{SubscriptionName: subscriptionName}
+	if dto.Webhook != nil {
+		w := ToWebhookModel(*dto.Webhook)
+		ext.Webhook = &w
+	}
+	if dto.Template != nil {
+		st := ToSubscriptionTemplateModel(*dto.Template)
+		ext.Template = &st
+	}
+	if dto.Digest != nil {
+		d := ToDigestModel(*dto.Digest)
+		ext.Digest = &d
+	}
+	if dto.QuietHours != nil {
+		qh := ToQuietHoursModel(*dto.QuietHours)
+		ext.QuietHours = &qh
+	}
+	if dto.Escalation != nil {
+		se := ToSubscriptionEscalationModel(*dto.Escalation)
+		ext.Escalation = &se
+	}
+	if dto.RateLimit != nil {
+		rl := ToRateLimitModel(*dto.RateLimit)
+		ext.RateLimit = &rl
+	}
+	return ext
+}
+
+// FromSubscriptionExtensionModelToDTO transforms the SubscriptionExtension model to the SubscriptionExtension DTO
+func FromSubscriptionExtensionModelToDTO(ext models.SubscriptionExtension) SubscriptionExtension {
+	dto := SubscriptionExtension{SubscriptionName: ext.SubscriptionName}
+	if ext.Webhook != nil {
+		w := FromWebhookModelToDTO(*ext.Webhook)
+		dto.Webhook = &w
+	}
+	if ext.Template != nil {
+		st := FromSubscriptionTemplateModelToDTO(*ext.Template)
+		dto.Template = &st
+	}
+	if ext.Digest != nil {
+		d := FromDigestModelToDTO(*ext.Digest)
+		dto.Digest = &d
+	}
+	if ext.QuietHours != nil {
+		qh := FromQuietHoursModelToDTO(*ext.QuietHours)
+		dto.QuietHours = &qh
+	}
+	if ext.Escalation != nil {
+		se := FromSubscriptionEscalationModelToDTO(*ext.Escalation)
+		dto.Escalation = &se
+	}
+	if ext.RateLimit != nil {
+		rl := FromRateLimitModelToDTO(*ext.RateLimit)
+		dto.RateLimit = &rl
+	}
+	return dto
+}
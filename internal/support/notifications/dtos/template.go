@@ -0,0 +1,81 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// Template and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type Template struct {
+	Name        string `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Category    string `json:"category,omitempty"`
+	ChannelType string `json:"channelType,omitempty"`
+	Locale      string `json:"locale,omitempty"`
+	Subject     string `json:"subject,omitempty"`
+	Body        string `json:"body,omitempty"`
+	HTMLBody    string `json:"htmlBody,omitempty"`
+	ContentType string `json:"contentType,omitempty"`
+	Description string `json:"description,omitempty"`
+	Created     int64  `json:"created,omitempty"`
+	Modified    int64  `json:"modified,omitempty"`
+}
+
+// SubscriptionTemplate and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type SubscriptionTemplate struct {
+	TemplateName string `json:"templateName,omitempty"`
+	Locale       string `json:"locale,omitempty"`
+}
+
+// ToTemplateModel transforms the Template DTO to the Template model
+func ToTemplateModel(dto Template) models.Template {
+	return models.Template{
+		Name:        dto.Name,
+		Category:    dto.Category,
+		ChannelType: dto.ChannelType,
+		Locale:      dto.Locale,
+		Subject:     dto.Subject,
+		Body:        dto.Body,
+		HTMLBody:    dto.HTMLBody,
+		ContentType: dto.ContentType,
+		Description: dto.Description,
+	}
+}
+
+// FromTemplateModelToDTO transforms the Template model to the Template DTO
+func FromTemplateModelToDTO(t models.Template) Template {
+	return Template{
+		Name:        t.Name,
+		Category:    t.Category,
+		ChannelType: t.ChannelType,
+		Locale:      t.Locale,
+		Subject:     t.Subject,
+		Body:        t.Body,
+		HTMLBody:    t.HTMLBody,
+		ContentType: t.ContentType,
+		Description: t.Description,
+		Created:     t.Created,
+		Modified:    t.Modified,
+	}
+}
+
+// ToSubscriptionTemplateModel transforms the SubscriptionTemplate DTO to the SubscriptionTemplate model
+func ToSubscriptionTemplateModel(dto SubscriptionTemplate) models.SubscriptionTemplate {
+	return models.SubscriptionTemplate{
+		TemplateName: dto.TemplateName,
+		Locale:       dto.Locale,
+	}
+}
+
+// FromSubscriptionTemplateModelToDTO transforms the SubscriptionTemplate model to the SubscriptionTemplate DTO
+func FromSubscriptionTemplateModelToDTO(st models.SubscriptionTemplate) SubscriptionTemplate {
+	return SubscriptionTemplate{
+		TemplateName: st.TemplateName,
+		Locale:       st.Locale,
+	}
+}
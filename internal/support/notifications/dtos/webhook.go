@@ -0,0 +1,64 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+)
+
+// Webhook and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type Webhook struct {
+	BodyTemplate    string            `json:"bodyTemplate,omitempty"`
+	ContentType     string            `json:"contentType,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	SecretName      string            `json:"secretName,omitempty"`
+	SignatureHeader string            `json:"signatureHeader,omitempty"`
+	TLS             WebhookTLS        `json:"tls"`
+}
+
+// WebhookTLS and its properties are defined in the APIv3 specification:
+// openapi/support-notifications.yaml
+type WebhookTLS struct {
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+	ServerName         string `json:"serverName,omitempty"`
+	MinVersion         string `json:"minVersion,omitempty" validate:"omitempty,oneof='1.2' '1.3'"`
+	SecretName         string `json:"secretName,omitempty"`
+}
+
+// ToWebhookModel transforms the Webhook DTO to the Webhook model
+func ToWebhookModel(dto Webhook) models.Webhook {
+	return models.Webhook{
+		BodyTemplate:    dto.BodyTemplate,
+		ContentType:     dto.ContentType,
+		Headers:         dto.Headers,
+		SecretName:      dto.SecretName,
+		SignatureHeader: dto.SignatureHeader,
+		TLS: models.WebhookTLS{
+			InsecureSkipVerify: dto.TLS.InsecureSkipVerify,
+			ServerName:         dto.TLS.ServerName,
+			MinVersion:         dto.TLS.MinVersion,
+			SecretName:         dto.TLS.SecretName,
+		},
+	}
+}
+
+// FromWebhookModelToDTO transforms the Webhook model to the Webhook DTO
+func FromWebhookModelToDTO(w models.Webhook) Webhook {
+	return Webhook{
+		BodyTemplate:    w.BodyTemplate,
+		ContentType:     w.ContentType,
+		Headers:         w.Headers,
+		SecretName:      w.SecretName,
+		SignatureHeader: w.SignatureHeader,
+		TLS: WebhookTLS{
+			InsecureSkipVerify: w.TLS.InsecureSkipVerify,
+			ServerName:         w.TLS.ServerName,
+			MinVersion:         w.TLS.MinVersion,
+			SecretName:         w.TLS.SecretName,
+		},
+	}
+}
@@ -0,0 +1,16 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- support_notifications.resend_task is the persistent queue of the pending resends of the failed transmissions
+CREATE TABLE IF NOT EXISTS support_notifications.resend_task (
+    transmission_id UUID PRIMARY KEY,
+    next_attempt timestamp NOT NULL,
+    CONSTRAINT fk_transmission
+        FOREIGN KEY(transmission_id)
+        REFERENCES support_notifications.transmission(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_resend_task_next_attempt ON support_notifications.resend_task(next_attempt);
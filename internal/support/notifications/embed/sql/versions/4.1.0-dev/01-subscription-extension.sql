@@ -0,0 +1,11 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- support_notifications.subscription_extension is used to store the extension settings of the subscriptions, including
+-- the webhook, the template selection, the digest, the quiet hours, the escalation policy and the rate limit
+CREATE TABLE IF NOT EXISTS support_notifications.subscription_extension (
+    subscription_name TEXT PRIMARY KEY,
+    content JSONB NOT NULL
+);
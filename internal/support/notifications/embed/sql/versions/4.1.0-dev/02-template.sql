@@ -0,0 +1,10 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- support_notifications.template is used to store the notification templates
+CREATE TABLE IF NOT EXISTS support_notifications.template (
+    name TEXT PRIMARY KEY,
+    content JSONB NOT NULL
+);
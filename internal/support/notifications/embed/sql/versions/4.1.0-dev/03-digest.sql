@@ -0,0 +1,13 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- support_notifications.digest_item is used to store the notifications accumulated in the pending digests
+CREATE TABLE IF NOT EXISTS support_notifications.digest_item (
+    id UUID PRIMARY KEY,
+    subscription_name TEXT NOT NULL,
+    content JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_digest_item_subscription_name ON support_notifications.digest_item(subscription_name);
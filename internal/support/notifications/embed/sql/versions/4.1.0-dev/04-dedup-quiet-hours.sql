@@ -0,0 +1,27 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- support_notifications.occurrence is used to store the occurrences of the deduplicated notifications by fingerprint,
+-- the occurrence is deleted with its notification
+CREATE TABLE IF NOT EXISTS support_notifications.occurrence (
+    fingerprint TEXT PRIMARY KEY,
+    notification_id UUID NOT NULL,
+    content JSONB NOT NULL,
+    CONSTRAINT fk_notification
+        FOREIGN KEY(notification_id)
+        REFERENCES support_notifications.notification(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_occurrence_notification_id ON support_notifications.occurrence(notification_id);
+
+-- support_notifications.held_notification is used to store the notifications held in the quiet hours of the subscriptions
+CREATE TABLE IF NOT EXISTS support_notifications.held_notification (
+    id UUID PRIMARY KEY,
+    subscription_name TEXT NOT NULL,
+    content JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_held_notification_subscription_name ON support_notifications.held_notification(subscription_name);
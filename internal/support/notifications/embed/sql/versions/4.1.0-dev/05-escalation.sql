@@ -0,0 +1,23 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- support_notifications.escalation_policy is used to store the escalation policies
+CREATE TABLE IF NOT EXISTS support_notifications.escalation_policy (
+    name TEXT PRIMARY KEY,
+    content JSONB NOT NULL
+);
+
+-- support_notifications.escalation_task is the persistent queue of the pending escalations of the notifications
+CREATE TABLE IF NOT EXISTS support_notifications.escalation_task (
+    notification_id UUID PRIMARY KEY,
+    next_attempt timestamp NOT NULL,
+    content JSONB NOT NULL,
+    CONSTRAINT fk_notification
+        FOREIGN KEY(notification_id)
+        REFERENCES support_notifications.notification(id)
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_escalation_task_next_attempt ON support_notifications.escalation_task(next_attempt);
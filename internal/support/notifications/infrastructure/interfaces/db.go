@@ -9,6 +9,8 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	notificationsModels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
 )
 
 type DBClient interface {
@@ -66,4 +68,51 @@ type DBClient interface {
 	TransmissionCountByTimeRange(start int64, end int64) (int64, errors.EdgeX)
 	TransmissionsByNotificationId(offset, limit int, id string) ([]models.Transmission, errors.EdgeX)
 	TransmissionCountByNotificationId(id string) (int64, errors.EdgeX)
+
+	UpsertResendTask(task notificationsModels.ResendTask) errors.EdgeX
+	ResendTasksByNextAttempt(before int64, limit int) ([]notificationsModels.ResendTask, errors.EdgeX)
+	ResendTaskByTransmissionId(id string) (notificationsModels.ResendTask, errors.EdgeX)
+	DeleteResendTaskByTransmissionId(id string) errors.EdgeX
+
+	UpsertSubscriptionExtension(ext notificationsModels.SubscriptionExtension) errors.EdgeX
+	SubscriptionExtensionBySubscriptionName(name string) (notificationsModels.SubscriptionExtension, errors.EdgeX)
+	AllSubscriptionExtensions() ([]notificationsModels.SubscriptionExtension, errors.EdgeX)
+	DeleteSubscriptionExtensionBySubscriptionName(name string) errors.EdgeX
+
+	AddTemplate(t notificationsModels.Template) (notificationsModels.Template, errors.EdgeX)
+	UpdateTemplate(t notificationsModels.Template) errors.EdgeX
+	TemplateByName(name string) (notificationsModels.Template, errors.EdgeX)
+	AllTemplates(offset, limit int) ([]notificationsModels.Template, errors.EdgeX)
+	TemplatesByCategory(category string) ([]notificationsModels.Template, errors.EdgeX)
+	TemplateTotalCount() (int64, errors.EdgeX)
+	DeleteTemplateByName(name string) errors.EdgeX
+
+	AddDigestItem(item notificationsModels.DigestItem) (notificationsModels.DigestItem, errors.EdgeX)
+	DigestItemsBySubscriptionName(name string) ([]notificationsModels.DigestItem, errors.EdgeX)
+	DeleteDigestItemsByIds(ids []string) errors.EdgeX
+	DeleteDigestItemsBySubscriptionName(name string) errors.EdgeX
+
+	UpsertNotificationOccurrence(o notificationsModels.NotificationOccurrence) errors.EdgeX
+	NotificationOccurrenceByFingerprint(fingerprint string) (notificationsModels.NotificationOccurrence, errors.EdgeX)
+	NotificationOccurrenceByNotificationId(id string) (notificationsModels.NotificationOccurrence, errors.EdgeX)
+
+	AddHeldNotification(held notificationsModels.HeldNotification) (notificationsModels.HeldNotification, errors.EdgeX)
+	HeldNotificationsBySubscriptionName(name string) ([]notificationsModels.HeldNotification, errors.EdgeX)
+	DeleteHeldNotificationsByIds(ids []string) errors.EdgeX
+	DeleteHeldNotificationsBySubscriptionName(name string) errors.EdgeX
+
+	AddEscalationPolicy(p notificationsModels.EscalationPolicy) (notificationsModels.EscalationPolicy, errors.EdgeX)
+	UpdateEscalationPolicy(p notificationsModels.EscalationPolicy) errors.EdgeX
+	EscalationPolicyByName(name string) (notificationsModels.EscalationPolicy, errors.EdgeX)
+	AllEscalationPolicies(offset, limit int) ([]notificationsModels.EscalationPolicy, errors.EdgeX)
+	EscalationPoliciesByCategories(categories []string) ([]notificationsModels.EscalationPolicy, errors.EdgeX)
+	EscalationPolicyTotalCount() (int64, errors.EdgeX)
+	DeleteEscalationPolicyByName(name string) errors.EdgeX
+	UpsertEscalationTask(task notificationsModels.EscalationTask) errors.EdgeX
+	EscalationTaskByNotificationId(id string) (notificationsModels.EscalationTask, errors.EdgeX)
+	EscalationTasksByNextAttempt(before int64, limit int) ([]notificationsModels.EscalationTask, errors.EdgeX)
+	DeleteEscalationTaskByNotificationId(id string) errors.EdgeX
+
+	NotificationStatistics(groupBy string, start, end, interval int64, category, severity string) ([]notificationsModels.NotificationStatistic, errors.EdgeX)
+	SubscriptionDeliveryStatistics(start, end int64) ([]notificationsModels.SubscriptionDeliveryStatistic, errors.EdgeX)
 }
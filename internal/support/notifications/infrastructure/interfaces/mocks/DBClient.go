@@ -9,6 +9,8 @@ import (
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
+	notificationsmodels "github.com/edgexfoundry/edgex-go/internal/support/notifications/models"
+
 	requests "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 )
 
@@ -17,6 +19,96 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddDigestItem provides a mock function with given fields: item
+func (_m *DBClient) AddDigestItem(item notificationsmodels.DigestItem) (notificationsmodels.DigestItem, errors.EdgeX) {
+	ret := _m.Called(item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDigestItem")
+	}
+
+	var r0 notificationsmodels.DigestItem
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.DigestItem) (notificationsmodels.DigestItem, errors.EdgeX)); ok {
+		return rf(item)
+	}
+	if rf, ok := ret.Get(0).(func(notificationsmodels.DigestItem) notificationsmodels.DigestItem); ok {
+		r0 = rf(item)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.DigestItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationsmodels.DigestItem) errors.EdgeX); ok {
+		r1 = rf(item)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddEscalationPolicy provides a mock function with given fields: p
+func (_m *DBClient) AddEscalationPolicy(p notificationsmodels.EscalationPolicy) (notificationsmodels.EscalationPolicy, errors.EdgeX) {
+	ret := _m.Called(p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddEscalationPolicy")
+	}
+
+	var r0 notificationsmodels.EscalationPolicy
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.EscalationPolicy) (notificationsmodels.EscalationPolicy, errors.EdgeX)); ok {
+		return rf(p)
+	}
+	if rf, ok := ret.Get(0).(func(notificationsmodels.EscalationPolicy) notificationsmodels.EscalationPolicy); ok {
+		r0 = rf(p)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.EscalationPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationsmodels.EscalationPolicy) errors.EdgeX); ok {
+		r1 = rf(p)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddHeldNotification provides a mock function with given fields: held
+func (_m *DBClient) AddHeldNotification(held notificationsmodels.HeldNotification) (notificationsmodels.HeldNotification, errors.EdgeX) {
+	ret := _m.Called(held)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddHeldNotification")
+	}
+
+	var r0 notificationsmodels.HeldNotification
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.HeldNotification) (notificationsmodels.HeldNotification, errors.EdgeX)); ok {
+		return rf(held)
+	}
+	if rf, ok := ret.Get(0).(func(notificationsmodels.HeldNotification) notificationsmodels.HeldNotification); ok {
+		r0 = rf(held)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.HeldNotification)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationsmodels.HeldNotification) errors.EdgeX); ok {
+		r1 = rf(held)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddNotification provides a mock function with given fields: n
 func (_m *DBClient) AddNotification(n models.Notification) (models.Notification, errors.EdgeX) {
 	ret := _m.Called(n)
@@ -44,60 +136,587 @@ func (_m *DBClient) AddNotification(n models.Notification) (models.Notification,
 		}
 	}
 
-	return r0, r1
+	return r0, r1
+}
+
+// AddSubscription provides a mock function with given fields: e
+func (_m *DBClient) AddSubscription(e models.Subscription) (models.Subscription, errors.EdgeX) {
+	ret := _m.Called(e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSubscription")
+	}
+
+	var r0 models.Subscription
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.Subscription) (models.Subscription, errors.EdgeX)); ok {
+		return rf(e)
+	}
+	if rf, ok := ret.Get(0).(func(models.Subscription) models.Subscription); ok {
+		r0 = rf(e)
+	} else {
+		r0 = ret.Get(0).(models.Subscription)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Subscription) errors.EdgeX); ok {
+		r1 = rf(e)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddTemplate provides a mock function with given fields: t
+func (_m *DBClient) AddTemplate(t notificationsmodels.Template) (notificationsmodels.Template, errors.EdgeX) {
+	ret := _m.Called(t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTemplate")
+	}
+
+	var r0 notificationsmodels.Template
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.Template) (notificationsmodels.Template, errors.EdgeX)); ok {
+		return rf(t)
+	}
+	if rf, ok := ret.Get(0).(func(notificationsmodels.Template) notificationsmodels.Template); ok {
+		r0 = rf(t)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.Template)
+	}
+
+	if rf, ok := ret.Get(1).(func(notificationsmodels.Template) errors.EdgeX); ok {
+		r1 = rf(t)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddTransmission provides a mock function with given fields: trans
+func (_m *DBClient) AddTransmission(trans models.Transmission) (models.Transmission, errors.EdgeX) {
+	ret := _m.Called(trans)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTransmission")
+	}
+
+	var r0 models.Transmission
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(models.Transmission) (models.Transmission, errors.EdgeX)); ok {
+		return rf(trans)
+	}
+	if rf, ok := ret.Get(0).(func(models.Transmission) models.Transmission); ok {
+		r0 = rf(trans)
+	} else {
+		r0 = ret.Get(0).(models.Transmission)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Transmission) errors.EdgeX); ok {
+		r1 = rf(trans)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllEscalationPolicies provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllEscalationPolicies(offset int, limit int) ([]notificationsmodels.EscalationPolicy, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllEscalationPolicies")
+	}
+
+	var r0 []notificationsmodels.EscalationPolicy
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]notificationsmodels.EscalationPolicy, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []notificationsmodels.EscalationPolicy); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.EscalationPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllSubscriptionExtensions provides a mock function with no fields
+func (_m *DBClient) AllSubscriptionExtensions() ([]notificationsmodels.SubscriptionExtension, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllSubscriptionExtensions")
+	}
+
+	var r0 []notificationsmodels.SubscriptionExtension
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() ([]notificationsmodels.SubscriptionExtension, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []notificationsmodels.SubscriptionExtension); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.SubscriptionExtension)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllSubscriptions provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllSubscriptions(offset int, limit int) ([]models.Subscription, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllSubscriptions")
+	}
+
+	var r0 []models.Subscription
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]models.Subscription, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []models.Subscription); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllTemplates provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllTemplates(offset int, limit int) ([]notificationsmodels.Template, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllTemplates")
+	}
+
+	var r0 []notificationsmodels.Template
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]notificationsmodels.Template, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []notificationsmodels.Template); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllTransmissions provides a mock function with given fields: offset, limit
+func (_m *DBClient) AllTransmissions(offset int, limit int) ([]models.Transmission, errors.EdgeX) {
+	ret := _m.Called(offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllTransmissions")
+	}
+
+	var r0 []models.Transmission
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int, int) ([]models.Transmission, errors.EdgeX)); ok {
+		return rf(offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []models.Transmission); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Transmission)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
+		r1 = rf(offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// CleanupNotificationsByAge provides a mock function with given fields: age
+func (_m *DBClient) CleanupNotificationsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CleanupNotificationsByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// CloseSession provides a mock function with no fields
+func (_m *DBClient) CloseSession() {
+	_m.Called()
+}
+
+// DeleteDigestItemsByIds provides a mock function with given fields: ids
+func (_m *DBClient) DeleteDigestItemsByIds(ids []string) errors.EdgeX {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDigestItemsByIds")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]string) errors.EdgeX); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteDigestItemsBySubscriptionName provides a mock function with given fields: name
+func (_m *DBClient) DeleteDigestItemsBySubscriptionName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDigestItemsBySubscriptionName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteEscalationPolicyByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteEscalationPolicyByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEscalationPolicyByName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteEscalationTaskByNotificationId provides a mock function with given fields: id
+func (_m *DBClient) DeleteEscalationTaskByNotificationId(id string) errors.EdgeX {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEscalationTaskByNotificationId")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteHeldNotificationsByIds provides a mock function with given fields: ids
+func (_m *DBClient) DeleteHeldNotificationsByIds(ids []string) errors.EdgeX {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHeldNotificationsByIds")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]string) errors.EdgeX); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteHeldNotificationsBySubscriptionName provides a mock function with given fields: name
+func (_m *DBClient) DeleteHeldNotificationsBySubscriptionName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteHeldNotificationsBySubscriptionName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteNotificationById provides a mock function with given fields: id
+func (_m *DBClient) DeleteNotificationById(id string) errors.EdgeX {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNotificationById")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteNotificationByIds provides a mock function with given fields: ids
+func (_m *DBClient) DeleteNotificationByIds(ids []string) errors.EdgeX {
+	ret := _m.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNotificationByIds")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func([]string) errors.EdgeX); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteProcessedNotificationsByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteProcessedNotificationsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProcessedNotificationsByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteProcessedTransmissionsByAge provides a mock function with given fields: age
+func (_m *DBClient) DeleteProcessedTransmissionsByAge(age int64) errors.EdgeX {
+	ret := _m.Called(age)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProcessedTransmissionsByAge")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
+		r0 = rf(age)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteResendTaskByTransmissionId provides a mock function with given fields: id
+func (_m *DBClient) DeleteResendTaskByTransmissionId(id string) errors.EdgeX {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteResendTaskByTransmissionId")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteSubscriptionByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteSubscriptionByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubscriptionByName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteSubscriptionExtensionBySubscriptionName provides a mock function with given fields: name
+func (_m *DBClient) DeleteSubscriptionExtensionBySubscriptionName(name string) errors.EdgeX {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSubscriptionExtensionBySubscriptionName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
 }
 
-// AddSubscription provides a mock function with given fields: e
-func (_m *DBClient) AddSubscription(e models.Subscription) (models.Subscription, errors.EdgeX) {
-	ret := _m.Called(e)
+// DeleteTemplateByName provides a mock function with given fields: name
+func (_m *DBClient) DeleteTemplateByName(name string) errors.EdgeX {
+	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddSubscription")
-	}
-
-	var r0 models.Subscription
-	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.Subscription) (models.Subscription, errors.EdgeX)); ok {
-		return rf(e)
-	}
-	if rf, ok := ret.Get(0).(func(models.Subscription) models.Subscription); ok {
-		r0 = rf(e)
-	} else {
-		r0 = ret.Get(0).(models.Subscription)
+		panic("no return value specified for DeleteTemplateByName")
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Subscription) errors.EdgeX); ok {
-		r1 = rf(e)
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+		r0 = rf(name)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(errors.EdgeX)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
 		}
 	}
 
-	return r0, r1
+	return r0
 }
 
-// AddTransmission provides a mock function with given fields: trans
-func (_m *DBClient) AddTransmission(trans models.Transmission) (models.Transmission, errors.EdgeX) {
-	ret := _m.Called(trans)
+// DigestItemsBySubscriptionName provides a mock function with given fields: name
+func (_m *DBClient) DigestItemsBySubscriptionName(name string) ([]notificationsmodels.DigestItem, errors.EdgeX) {
+	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddTransmission")
+		panic("no return value specified for DigestItemsBySubscriptionName")
 	}
 
-	var r0 models.Transmission
+	var r0 []notificationsmodels.DigestItem
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(models.Transmission) (models.Transmission, errors.EdgeX)); ok {
-		return rf(trans)
+	if rf, ok := ret.Get(0).(func(string) ([]notificationsmodels.DigestItem, errors.EdgeX)); ok {
+		return rf(name)
 	}
-	if rf, ok := ret.Get(0).(func(models.Transmission) models.Transmission); ok {
-		r0 = rf(trans)
+	if rf, ok := ret.Get(0).(func(string) []notificationsmodels.DigestItem); ok {
+		r0 = rf(name)
 	} else {
-		r0 = ret.Get(0).(models.Transmission)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.DigestItem)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Transmission) errors.EdgeX); ok {
-		r1 = rf(trans)
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -107,29 +726,29 @@ func (_m *DBClient) AddTransmission(trans models.Transmission) (models.Transmiss
 	return r0, r1
 }
 
-// AllSubscriptions provides a mock function with given fields: offset, limit
-func (_m *DBClient) AllSubscriptions(offset int, limit int) ([]models.Subscription, errors.EdgeX) {
-	ret := _m.Called(offset, limit)
+// EscalationPoliciesByCategories provides a mock function with given fields: categories
+func (_m *DBClient) EscalationPoliciesByCategories(categories []string) ([]notificationsmodels.EscalationPolicy, errors.EdgeX) {
+	ret := _m.Called(categories)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AllSubscriptions")
+		panic("no return value specified for EscalationPoliciesByCategories")
 	}
 
-	var r0 []models.Subscription
+	var r0 []notificationsmodels.EscalationPolicy
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int) ([]models.Subscription, errors.EdgeX)); ok {
-		return rf(offset, limit)
+	if rf, ok := ret.Get(0).(func([]string) ([]notificationsmodels.EscalationPolicy, errors.EdgeX)); ok {
+		return rf(categories)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []models.Subscription); ok {
-		r0 = rf(offset, limit)
+	if rf, ok := ret.Get(0).(func([]string) []notificationsmodels.EscalationPolicy); ok {
+		r0 = rf(categories)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Subscription)
+			r0 = ret.Get(0).([]notificationsmodels.EscalationPolicy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
-		r1 = rf(offset, limit)
+	if rf, ok := ret.Get(1).(func([]string) errors.EdgeX); ok {
+		r1 = rf(categories)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -139,29 +758,27 @@ func (_m *DBClient) AllSubscriptions(offset int, limit int) ([]models.Subscripti
 	return r0, r1
 }
 
-// AllTransmissions provides a mock function with given fields: offset, limit
-func (_m *DBClient) AllTransmissions(offset int, limit int) ([]models.Transmission, errors.EdgeX) {
-	ret := _m.Called(offset, limit)
+// EscalationPolicyByName provides a mock function with given fields: name
+func (_m *DBClient) EscalationPolicyByName(name string) (notificationsmodels.EscalationPolicy, errors.EdgeX) {
+	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AllTransmissions")
+		panic("no return value specified for EscalationPolicyByName")
 	}
 
-	var r0 []models.Transmission
+	var r0 notificationsmodels.EscalationPolicy
 	var r1 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int, int) ([]models.Transmission, errors.EdgeX)); ok {
-		return rf(offset, limit)
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.EscalationPolicy, errors.EdgeX)); ok {
+		return rf(name)
 	}
-	if rf, ok := ret.Get(0).(func(int, int) []models.Transmission); ok {
-		r0 = rf(offset, limit)
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.EscalationPolicy); ok {
+		r0 = rf(name)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Transmission)
-		}
+		r0 = ret.Get(0).(notificationsmodels.EscalationPolicy)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int) errors.EdgeX); ok {
-		r1 = rf(offset, limit)
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -171,129 +788,128 @@ func (_m *DBClient) AllTransmissions(offset int, limit int) ([]models.Transmissi
 	return r0, r1
 }
 
-// CleanupNotificationsByAge provides a mock function with given fields: age
-func (_m *DBClient) CleanupNotificationsByAge(age int64) errors.EdgeX {
-	ret := _m.Called(age)
+// EscalationPolicyTotalCount provides a mock function with no fields
+func (_m *DBClient) EscalationPolicyTotalCount() (int64, errors.EdgeX) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for CleanupNotificationsByAge")
+		panic("no return value specified for EscalationPolicyTotalCount")
 	}
 
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
-		r0 = rf(age)
+	var r0 int64
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() (int64, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
-		}
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
-}
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
 
-// CloseSession provides a mock function with no fields
-func (_m *DBClient) CloseSession() {
-	_m.Called()
+	return r0, r1
 }
 
-// DeleteNotificationById provides a mock function with given fields: id
-func (_m *DBClient) DeleteNotificationById(id string) errors.EdgeX {
+// EscalationTaskByNotificationId provides a mock function with given fields: id
+func (_m *DBClient) EscalationTaskByNotificationId(id string) (notificationsmodels.EscalationTask, errors.EdgeX) {
 	ret := _m.Called(id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteNotificationById")
+		panic("no return value specified for EscalationTaskByNotificationId")
 	}
 
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+	var r0 notificationsmodels.EscalationTask
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.EscalationTask, errors.EdgeX)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.EscalationTask); ok {
 		r0 = rf(id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
-		}
-	}
-
-	return r0
-}
-
-// DeleteNotificationByIds provides a mock function with given fields: ids
-func (_m *DBClient) DeleteNotificationByIds(ids []string) errors.EdgeX {
-	ret := _m.Called(ids)
-
-	if len(ret) == 0 {
-		panic("no return value specified for DeleteNotificationByIds")
+		r0 = ret.Get(0).(notificationsmodels.EscalationTask)
 	}
 
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func([]string) errors.EdgeX); ok {
-		r0 = rf(ids)
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
 		}
 	}
 
-	return r0
+	return r0, r1
 }
 
-// DeleteProcessedNotificationsByAge provides a mock function with given fields: age
-func (_m *DBClient) DeleteProcessedNotificationsByAge(age int64) errors.EdgeX {
-	ret := _m.Called(age)
+// EscalationTasksByNextAttempt provides a mock function with given fields: before, limit
+func (_m *DBClient) EscalationTasksByNextAttempt(before int64, limit int) ([]notificationsmodels.EscalationTask, errors.EdgeX) {
+	ret := _m.Called(before, limit)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteProcessedNotificationsByAge")
+		panic("no return value specified for EscalationTasksByNextAttempt")
 	}
 
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
-		r0 = rf(age)
+	var r0 []notificationsmodels.EscalationTask
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, int) ([]notificationsmodels.EscalationTask, errors.EdgeX)); ok {
+		return rf(before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []notificationsmodels.EscalationTask); ok {
+		r0 = rf(before, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
+			r0 = ret.Get(0).([]notificationsmodels.EscalationTask)
 		}
 	}
 
-	return r0
-}
-
-// DeleteProcessedTransmissionsByAge provides a mock function with given fields: age
-func (_m *DBClient) DeleteProcessedTransmissionsByAge(age int64) errors.EdgeX {
-	ret := _m.Called(age)
-
-	if len(ret) == 0 {
-		panic("no return value specified for DeleteProcessedTransmissionsByAge")
-	}
-
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(int64) errors.EdgeX); ok {
-		r0 = rf(age)
+	if rf, ok := ret.Get(1).(func(int64, int) errors.EdgeX); ok {
+		r1 = rf(before, limit)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
 		}
 	}
 
-	return r0
+	return r0, r1
 }
 
-// DeleteSubscriptionByName provides a mock function with given fields: name
-func (_m *DBClient) DeleteSubscriptionByName(name string) errors.EdgeX {
+// HeldNotificationsBySubscriptionName provides a mock function with given fields: name
+func (_m *DBClient) HeldNotificationsBySubscriptionName(name string) ([]notificationsmodels.HeldNotification, errors.EdgeX) {
 	ret := _m.Called(name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteSubscriptionByName")
+		panic("no return value specified for HeldNotificationsBySubscriptionName")
 	}
 
-	var r0 errors.EdgeX
-	if rf, ok := ret.Get(0).(func(string) errors.EdgeX); ok {
+	var r0 []notificationsmodels.HeldNotification
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) ([]notificationsmodels.HeldNotification, errors.EdgeX)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) []notificationsmodels.HeldNotification); ok {
 		r0 = rf(name)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(errors.EdgeX)
+			r0 = ret.Get(0).([]notificationsmodels.HeldNotification)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
 }
 
 // LatestNotificationByOffset provides a mock function with given fields: offset
@@ -522,11 +1138,103 @@ func (_m *DBClient) NotificationCountByTimeRange(start int64, end int64, ack str
 	if rf, ok := ret.Get(0).(func(int64, int64, string) int64); ok {
 		r0 = rf(start, end, ack)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64, string) errors.EdgeX); ok {
+		r1 = rf(start, end, ack)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// NotificationOccurrenceByFingerprint provides a mock function with given fields: fingerprint
+func (_m *DBClient) NotificationOccurrenceByFingerprint(fingerprint string) (notificationsmodels.NotificationOccurrence, errors.EdgeX) {
+	ret := _m.Called(fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationOccurrenceByFingerprint")
+	}
+
+	var r0 notificationsmodels.NotificationOccurrence
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.NotificationOccurrence, errors.EdgeX)); ok {
+		return rf(fingerprint)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.NotificationOccurrence); ok {
+		r0 = rf(fingerprint)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.NotificationOccurrence)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(fingerprint)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// NotificationOccurrenceByNotificationId provides a mock function with given fields: id
+func (_m *DBClient) NotificationOccurrenceByNotificationId(id string) (notificationsmodels.NotificationOccurrence, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationOccurrenceByNotificationId")
+	}
+
+	var r0 notificationsmodels.NotificationOccurrence
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.NotificationOccurrence, errors.EdgeX)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.NotificationOccurrence); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.NotificationOccurrence)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// NotificationStatistics provides a mock function with given fields: groupBy, start, end, interval, category, severity
+func (_m *DBClient) NotificationStatistics(groupBy string, start int64, end int64, interval int64, category string, severity string) ([]notificationsmodels.NotificationStatistic, errors.EdgeX) {
+	ret := _m.Called(groupBy, start, end, interval, category, severity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NotificationStatistics")
+	}
+
+	var r0 []notificationsmodels.NotificationStatistic
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int64, string, string) ([]notificationsmodels.NotificationStatistic, errors.EdgeX)); ok {
+		return rf(groupBy, start, end, interval, category, severity)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64, int64, string, string) []notificationsmodels.NotificationStatistic); ok {
+		r0 = rf(groupBy, start, end, interval, category, severity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.NotificationStatistic)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int64, int64, string) errors.EdgeX); ok {
-		r1 = rf(start, end, ack)
+	if rf, ok := ret.Get(1).(func(string, int64, int64, int64, string, string) errors.EdgeX); ok {
+		r1 = rf(groupBy, start, end, interval, category, severity)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(errors.EdgeX)
@@ -758,6 +1466,68 @@ func (_m *DBClient) NotificationsByTimeRange(start int64, end int64, offset int,
 	return r0, r1
 }
 
+// ResendTaskByTransmissionId provides a mock function with given fields: id
+func (_m *DBClient) ResendTaskByTransmissionId(id string) (notificationsmodels.ResendTask, errors.EdgeX) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendTaskByTransmissionId")
+	}
+
+	var r0 notificationsmodels.ResendTask
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.ResendTask, errors.EdgeX)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.ResendTask); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.ResendTask)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResendTasksByNextAttempt provides a mock function with given fields: before, limit
+func (_m *DBClient) ResendTasksByNextAttempt(before int64, limit int) ([]notificationsmodels.ResendTask, errors.EdgeX) {
+	ret := _m.Called(before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendTasksByNextAttempt")
+	}
+
+	var r0 []notificationsmodels.ResendTask
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, int) ([]notificationsmodels.ResendTask, errors.EdgeX)); ok {
+		return rf(before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []notificationsmodels.ResendTask); ok {
+		r0 = rf(before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.ResendTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int) errors.EdgeX); ok {
+		r1 = rf(before, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // SubscriptionById provides a mock function with given fields: id
 func (_m *DBClient) SubscriptionById(id string) (models.Subscription, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -908,6 +1678,68 @@ func (_m *DBClient) SubscriptionCountByReceiver(receiver string) (int64, errors.
 	return r0, r1
 }
 
+// SubscriptionDeliveryStatistics provides a mock function with given fields: start, end
+func (_m *DBClient) SubscriptionDeliveryStatistics(start int64, end int64) ([]notificationsmodels.SubscriptionDeliveryStatistic, errors.EdgeX) {
+	ret := _m.Called(start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscriptionDeliveryStatistics")
+	}
+
+	var r0 []notificationsmodels.SubscriptionDeliveryStatistic
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(int64, int64) ([]notificationsmodels.SubscriptionDeliveryStatistic, errors.EdgeX)); ok {
+		return rf(start, end)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int64) []notificationsmodels.SubscriptionDeliveryStatistic); ok {
+		r0 = rf(start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.SubscriptionDeliveryStatistic)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int64) errors.EdgeX); ok {
+		r1 = rf(start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// SubscriptionExtensionBySubscriptionName provides a mock function with given fields: name
+func (_m *DBClient) SubscriptionExtensionBySubscriptionName(name string) (notificationsmodels.SubscriptionExtension, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscriptionExtensionBySubscriptionName")
+	}
+
+	var r0 notificationsmodels.SubscriptionExtension
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.SubscriptionExtension, errors.EdgeX)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.SubscriptionExtension); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.SubscriptionExtension)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // SubscriptionTotalCount provides a mock function with no fields
 func (_m *DBClient) SubscriptionTotalCount() (int64, errors.EdgeX) {
 	ret := _m.Called()
@@ -1066,6 +1898,98 @@ func (_m *DBClient) SubscriptionsByReceiver(offset int, limit int, receiver stri
 	return r0, r1
 }
 
+// TemplateByName provides a mock function with given fields: name
+func (_m *DBClient) TemplateByName(name string) (notificationsmodels.Template, errors.EdgeX) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TemplateByName")
+	}
+
+	var r0 notificationsmodels.Template
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) (notificationsmodels.Template, errors.EdgeX)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) notificationsmodels.Template); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(notificationsmodels.Template)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// TemplateTotalCount provides a mock function with no fields
+func (_m *DBClient) TemplateTotalCount() (int64, errors.EdgeX) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TemplateTotalCount")
+	}
+
+	var r0 int64
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func() (int64, errors.EdgeX)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() errors.EdgeX); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// TemplatesByCategory provides a mock function with given fields: category
+func (_m *DBClient) TemplatesByCategory(category string) ([]notificationsmodels.Template, errors.EdgeX) {
+	ret := _m.Called(category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TemplatesByCategory")
+	}
+
+	var r0 []notificationsmodels.Template
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string) ([]notificationsmodels.Template, errors.EdgeX)); ok {
+		return rf(category)
+	}
+	if rf, ok := ret.Get(0).(func(string) []notificationsmodels.Template); ok {
+		r0 = rf(category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]notificationsmodels.Template)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) errors.EdgeX); ok {
+		r1 = rf(category)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // TransmissionById provides a mock function with given fields: id
 func (_m *DBClient) TransmissionById(id string) (models.Transmission, errors.EdgeX) {
 	ret := _m.Called(id)
@@ -1374,6 +2298,26 @@ func (_m *DBClient) TransmissionsByTimeRange(start int64, end int64, offset int,
 	return r0, r1
 }
 
+// UpdateEscalationPolicy provides a mock function with given fields: p
+func (_m *DBClient) UpdateEscalationPolicy(p notificationsmodels.EscalationPolicy) errors.EdgeX {
+	ret := _m.Called(p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEscalationPolicy")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.EscalationPolicy) errors.EdgeX); ok {
+		r0 = rf(p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateNotification provides a mock function with given fields: s
 func (_m *DBClient) UpdateNotification(s models.Notification) errors.EdgeX {
 	ret := _m.Called(s)
@@ -1434,6 +2378,26 @@ func (_m *DBClient) UpdateSubscription(s models.Subscription) errors.EdgeX {
 	return r0
 }
 
+// UpdateTemplate provides a mock function with given fields: t
+func (_m *DBClient) UpdateTemplate(t notificationsmodels.Template) errors.EdgeX {
+	ret := _m.Called(t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTemplate")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.Template) errors.EdgeX); ok {
+		r0 = rf(t)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // UpdateTransmission provides a mock function with given fields: trans
 func (_m *DBClient) UpdateTransmission(trans models.Transmission) errors.EdgeX {
 	ret := _m.Called(trans)
@@ -1454,6 +2418,86 @@ func (_m *DBClient) UpdateTransmission(trans models.Transmission) errors.EdgeX {
 	return r0
 }
 
+// UpsertEscalationTask provides a mock function with given fields: task
+func (_m *DBClient) UpsertEscalationTask(task notificationsmodels.EscalationTask) errors.EdgeX {
+	ret := _m.Called(task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertEscalationTask")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.EscalationTask) errors.EdgeX); ok {
+		r0 = rf(task)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpsertNotificationOccurrence provides a mock function with given fields: o
+func (_m *DBClient) UpsertNotificationOccurrence(o notificationsmodels.NotificationOccurrence) errors.EdgeX {
+	ret := _m.Called(o)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertNotificationOccurrence")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.NotificationOccurrence) errors.EdgeX); ok {
+		r0 = rf(o)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpsertResendTask provides a mock function with given fields: task
+func (_m *DBClient) UpsertResendTask(task notificationsmodels.ResendTask) errors.EdgeX {
+	ret := _m.Called(task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertResendTask")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.ResendTask) errors.EdgeX); ok {
+		r0 = rf(task)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpsertSubscriptionExtension provides a mock function with given fields: ext
+func (_m *DBClient) UpsertSubscriptionExtension(ext notificationsmodels.SubscriptionExtension) errors.EdgeX {
+	ret := _m.Called(ext)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertSubscriptionExtension")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(notificationsmodels.SubscriptionExtension) errors.EdgeX); ok {
+		r0 = rf(ext)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDBClient(t interface {
@@ -1,7 +1,7 @@
 /*******************************************************************************
  * Copyright 2017 Dell Inc.
  * Copyright (c) 2019 Intel Corporation
- * Copyright (C) 2020-2025 IOTech Ltd
+ * Copyright (C) 2020-2026 IOTech Ltd
  *
  * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
  * in compliance with the License. You may obtain a copy of the License at
@@ -24,6 +24,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/application/channel"
 	"github.com/edgexfoundry/edgex-go/internal/support/notifications/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/controller/messaging"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/startup"
@@ -51,13 +52,17 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 	LoadRestRoutes(b.router, dic, b.serviceName)
 
 	restSender := channel.NewRESTSender(dic, bootstrapContainer.SecretProviderExtFrom(dic.Get))
-	emailSender := channel.NewEmailSender(dic)
+	webhookSender := channel.NewWebhookSender(dic, bootstrapContainer.SecretProviderExtFrom(dic.Get))
+	emailSender := channel.NewEmailSender(ctx, wg, dic)
 	mqttSender := channel.NewMQTTSender(ctx, wg, dic)
 	zeroMQSender := channel.NewZeroMQSender(ctx, wg, dic)
 	dic.Update(di.ServiceConstructorMap{
 		channel.RESTSenderName: func(get di.Get) interface{} {
 			return restSender
 		},
+		channel.WebhookSenderName: func(get di.Get) interface{} {
+			return webhookSender
+		},
 		channel.EmailSenderName: func(get di.Get) interface{} {
 			return emailSender
 		},
@@ -79,5 +84,37 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 		}
 		application.AsyncPurgeNotification(retentionInterval, ctx, dic)
 	}
+
+	if err := application.ValidateDedupConfig(config.Dedup); err != nil {
+		lc.Errorf("Invalid notification deduplication configuration, %v", err)
+		return false
+	}
+
+	if err := application.StartTransmissionWorkers(ctx, wg, dic); err != nil {
+		lc.Errorf("Failed to start the transmission workers, %v", err)
+		return false
+	}
+	if err := application.StartResendScheduler(ctx, wg, dic); err != nil {
+		lc.Errorf("Failed to start the resend scheduler, %v", err)
+		return false
+	}
+	if err := application.StartDigestScheduler(ctx, wg, dic); err != nil {
+		lc.Errorf("Failed to start the digest scheduler, %v", err)
+		return false
+	}
+	if err := application.StartQuietHoursScheduler(ctx, wg, dic); err != nil {
+		lc.Errorf("Failed to start the quiet hours scheduler, %v", err)
+		return false
+	}
+	if err := application.StartEscalationScheduler(ctx, wg, dic); err != nil {
+		lc.Errorf("Failed to start the escalation scheduler, %v", err)
+		return false
+	}
+	if config.NotificationSubscriber.Enabled {
+		if err := messaging.SubscribeNotifications(ctx, dic); err != nil {
+			lc.Errorf("Failed to subscribe notifications from message bus, %v", err)
+			return false
+		}
+	}
 	return true
 }
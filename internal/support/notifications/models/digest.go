@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// Digest is the digest setting of a subscription, the non-critical notifications matching the subscription are
+// accumulated and sent as one combined notification when the digest window closes
+type Digest struct {
+	// Window is the duration of the digest window starting from the first accumulated notification, e.g. 15m
+	Window string
+	// MaxItems closes the window early once the accumulated notifications reach the number, the window is only closed
+	// by time if it's zero
+	MaxItems int
+}
+
+// DigestItem is a notification accumulated in the pending digest of a subscription, which is persisted so that the
+// pending digest survives the restart of the service
+type DigestItem struct {
+	Id               string
+	SubscriptionName string
+	Notification     models.Notification
+	Created          int64
+}
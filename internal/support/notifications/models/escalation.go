@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// EscalationPolicy escalates the critical notification level by level until the notification is acknowledged, the
+// policy applies to the notifications of its categories or to the subscriptions referencing it
+type EscalationPolicy struct {
+	Name        string
+	Description string
+	// Levels are escalated in order
+	Levels []EscalationLevel
+	// Categories are the notification categories escalated with the policy when the subscription of the escalated
+	// transmission doesn't reference any policy
+	Categories []string
+	Created    int64
+	Modified   int64
+}
+
+// EscalationLevel sends the escalated notification to the subscriptions after the delay
+type EscalationLevel struct {
+	// Delay is the duration since the previous level, or since the escalation for the first level, e.g. 10m
+	Delay         string
+	Subscriptions []string
+}
+
+// SubscriptionEscalation is the escalation policy referenced by a subscription
+type SubscriptionEscalation struct {
+	PolicyName string
+}
+
+// EscalationTask is the pending escalation of a notification, which is persisted so that the escalation survives the
+// restart of the service
+type EscalationTask struct {
+	// NotificationId is the id of the escalated notification, a notification is escalated at most once at a time
+	NotificationId string
+	// TransmissionId is the id of the transmission which exhausted the resends
+	TransmissionId string
+	PolicyName     string
+	// NextLevel is the index of the next level to escalate
+	NextLevel int
+	// NextAttempt is the time of escalating the next level in milliseconds
+	NextAttempt int64
+	// EscalatedNotificationIds are the notifications sent by the escalated levels, acknowledging any of them stops the
+	// escalation as well
+	EscalatedNotificationIds []string
+}
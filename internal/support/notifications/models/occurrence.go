@@ -0,0 +1,17 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// NotificationOccurrence counts the repeats of a notification identified by the fingerprint, the repeats arriving in
+// the suppression window started by the first occurrence are counted instead of being added as new notifications
+type NotificationOccurrence struct {
+	Fingerprint    string
+	NotificationId string
+	// Count is the number of the occurrences including the first one
+	Count     int64
+	FirstSeen int64
+	LastSeen  int64
+}
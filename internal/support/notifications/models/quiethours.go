@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+const (
+	// QuietHoursHold holds the non-critical notifications and sends them when the quiet hours end
+	QuietHoursHold = "HOLD"
+	// QuietHoursDrop drops the non-critical notifications arriving in the quiet hours
+	QuietHoursDrop = "DROP"
+)
+
+// QuietHours is the quiet hours setting of a subscription, the non-critical notifications arriving in any of the quiet
+// windows are held or dropped according to the action
+type QuietHours struct {
+	// Timezone is the IANA time zone name of the windows, e.g. Europe/London, UTC is used if it's empty
+	Timezone string
+	Windows  []QuietWindow
+	Action   string
+}
+
+// QuietWindow is a daily time window in the format of HH:MM, the window crosses midnight if End is not after Start
+type QuietWindow struct {
+	Start string
+	End   string
+	// Weekdays limits the window to the days the window starts on, e.g. Saturday, the window applies to every day if
+	// it's empty
+	Weekdays []string
+}
+
+// HeldNotification is a notification held in the quiet hours of a subscription, which is persisted so that the held
+// notifications survive the restart of the service
+type HeldNotification struct {
+	Id               string
+	SubscriptionName string
+	Notification     models.Notification
+	Created          int64
+}
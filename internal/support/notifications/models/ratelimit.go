@@ -0,0 +1,29 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+const (
+	// RateLimited is the status of the transmission which is not sent because the rate limit of the subscription is
+	// exceeded
+	RateLimited models.TransmissionStatus = "RATE_LIMITED"
+	// CircuitOpen is the status of the transmission which fails fast without sending because the destination address is
+	// known to be down
+	CircuitOpen models.TransmissionStatus = "CIRCUIT_OPEN"
+)
+
+// RateLimit is the token bucket rate limit of a subscription, the bucket holds up to Burst tokens and refills Limit
+// tokens every Interval, each transmission of the subscription takes a token and is not sent if the bucket is empty
+type RateLimit struct {
+	Limit int
+	// Interval is the duration string of refilling Limit tokens, e.g. 1m
+	Interval string
+	// Burst is the capacity of the bucket, which is the same as Limit if it's not specified
+	Burst int
+}
@@ -0,0 +1,14 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ResendTask is a pending resend of a failed transmission, which is persisted so that the resend survives the restart
+// of the service
+type ResendTask struct {
+	TransmissionId string
+	// NextAttempt is the time of the next resend attempt in milliseconds
+	NextAttempt int64
+}
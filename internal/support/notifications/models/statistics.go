@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// the notification fields which the notification statistics can be grouped by
+const (
+	GroupByCategory = "category"
+	GroupBySeverity = "severity"
+	GroupByLabel    = "label"
+	GroupByStatus   = "status"
+)
+
+// NotificationStatistic is the number of the notifications of a group created in a time bucket
+type NotificationStatistic struct {
+	// Bucket is the start time of the time bucket in milliseconds
+	Bucket int64
+	// Group is the category, severity, label or status which the notifications are grouped by, a notification with
+	// multiple labels is counted in the group of each label
+	Group string
+	Count int64
+}
+
+// SubscriptionDeliveryStatistic is the delivery statistic of the transmissions of a subscription
+type SubscriptionDeliveryStatistic struct {
+	SubscriptionName string
+	Total            int64
+	// Sent is the number of the transmissions which are sent or acknowledged
+	Sent int64
+	// Failed is the number of the transmissions which are failed, rate limited or failed fast by the circuit breaker
+	Failed    int64
+	Resending int64
+	Escalated int64
+	// MeanResendCount is the average resend count of the transmissions
+	MeanResendCount float64
+	// P95Latency is the 95th percentile in milliseconds of the time from the notification being created to the
+	// first successful attempt of the transmission
+	P95Latency float64
+}
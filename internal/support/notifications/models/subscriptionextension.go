@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// SubscriptionExtension is the extended setting of a subscription stored as one record by the subscription name, each
+// setting is not applied to the subscription if it's nil
+type SubscriptionExtension struct {
+	SubscriptionName string
+	Webhook          *Webhook
+	Template         *SubscriptionTemplate
+	Digest           *Digest
+	QuietHours       *QuietHours
+	Escalation       *SubscriptionEscalation
+	RateLimit        *RateLimit
+	// WebhookChannels are the REST channels of the subscription which are added as WEBHOOK channels, they are kept
+	// with the subscription channels rather than set by the extension
+	WebhookChannels []models.RESTAddress
+}
@@ -0,0 +1,49 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// Template renders the notification fields and labels into the subject and the body sent to the subscribers, the
+// template is selected by the category of the notification, the type of the channel and the locale of the subscription
+type Template struct {
+	Name string
+	// Category matches the category of the notification, the template applies to all categories if it's empty
+	Category string
+	// ChannelType matches the address type of the channel, the template applies to all channels if it's empty
+	ChannelType string
+	// Locale matches the locale of the subscription, e.g. en or zh-TW, the template is the fallback of all locales if
+	// it's empty
+	Locale string
+	// Subject is the Go template of the email subject
+	Subject string
+	// Body is the Go template of the plain text body
+	Body string
+	// HTMLBody is the Go HTML template of the email body, the email is sent with both the plain text and HTML parts if
+	// it's not empty
+	HTMLBody    string
+	ContentType string
+	Description string
+	Created     int64
+	Modified    int64
+}
+
+// SubscriptionTemplate is the template selection of a subscription
+type SubscriptionTemplate struct {
+	// TemplateName is the template always used by the subscription, the template is selected by the notification
+	// category, the channel type and the locale if it's empty
+	TemplateName string
+	Locale       string
+}
+
+// TemplatedEmailAddress is the email address sent with the subject and the HTML body rendered from the template
+type TemplatedEmailAddress struct {
+	models.EmailAddress
+	Subject  string
+	HTMLBody string
+}
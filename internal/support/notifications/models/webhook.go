@@ -0,0 +1,44 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// Webhook is the webhook setting of a subscription, the notifications are sent to the WEBHOOK channels of the
+// subscription with the templated body, the custom headers and the HMAC signature
+type Webhook struct {
+	// BodyTemplate is the Go template of the request body executed with the notification, the notification content is
+	// sent as is if it's empty
+	BodyTemplate string
+	// ContentType overrides the content type of the notification
+	ContentType string
+	Headers     map[string]string
+	// SecretName is the name of the secret in the secret store which contains the HMAC-SHA256 signing key, the request
+	// is not signed if it's empty
+	SecretName string
+	// SignatureHeader is the header carrying the signature of the request body
+	SignatureHeader string
+	TLS             WebhookTLS
+}
+
+// WebhookTLS is the TLS client setting of the webhook requests
+type WebhookTLS struct {
+	InsecureSkipVerify bool
+	ServerName         string
+	// MinVersion is the minimum TLS version, either 1.2 or 1.3
+	MinVersion string
+	// SecretName is the name of the secret in the secret store which contains the CA certificate to verify the server,
+	// and the client certificate and key for the mutual TLS
+	SecretName string
+}
+
+// WebhookAddress is the WEBHOOK channel of a subscription, the REST address sent with the webhook setting
+type WebhookAddress struct {
+	models.RESTAddress
+	Webhook Webhook
+}
@@ -1,4 +1,4 @@
-// Copyright (C) 2020-2025 IOTech Ltd
+// Copyright (C) 2020-2026 IOTech Ltd
 // Copyright (C) 2023 Intel Corporation
 //
 // SPDX-License-Identifier: Apache-2.0
@@ -12,6 +12,7 @@ import (
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
+	"github.com/edgexfoundry/edgex-go/internal/support/notifications/constants"
 	notificationsController "github.com/edgexfoundry/edgex-go/internal/support/notifications/controller/http"
 
 	"github.com/labstack/echo/v4"
@@ -34,11 +35,39 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.DELETE(common.ApiSubscriptionByNameRoute, sc.DeleteSubscriptionByName, authenticationHook)
 	r.PATCH(common.ApiSubscriptionRoute, sc.PatchSubscription, authenticationHook)
 
+	// Subscription Extension
+	sec := notificationsController.NewSubscriptionExtensionController(dic)
+	r.PUT(constants.ApiSubscriptionExtensionRoute, sec.UpsertSubscriptionExtension, authenticationHook)
+	r.GET(constants.ApiSubscriptionExtensionRoute, sec.SubscriptionExtensionBySubscriptionName, authenticationHook)
+	r.DELETE(constants.ApiSubscriptionExtensionRoute, sec.DeleteSubscriptionExtensionBySubscriptionName, authenticationHook)
+
+	// Template
+	tc := notificationsController.NewTemplateController(dic)
+	r.POST(constants.ApiTemplateRoute, tc.AddTemplate, authenticationHook)
+	r.PUT(constants.ApiTemplateRoute, tc.UpdateTemplate, authenticationHook)
+	r.GET(constants.ApiAllTemplateRoute, tc.AllTemplates, authenticationHook)
+	r.GET(constants.ApiTemplateByNameRoute, tc.TemplateByName, authenticationHook)
+	r.DELETE(constants.ApiTemplateByNameRoute, tc.DeleteTemplateByName, authenticationHook)
+
+	// Escalation Policy
+	ec := notificationsController.NewEscalationPolicyController(dic)
+	r.POST(constants.ApiEscalationPolicyRoute, ec.AddEscalationPolicy, authenticationHook)
+	r.PUT(constants.ApiEscalationPolicyRoute, ec.UpdateEscalationPolicy, authenticationHook)
+	r.GET(constants.ApiAllEscalationPolicyRoute, ec.AllEscalationPolicies, authenticationHook)
+	r.GET(constants.ApiEscalationPolicyByNameRoute, ec.EscalationPolicyByName, authenticationHook)
+	r.DELETE(constants.ApiEscalationPolicyByNameRoute, ec.DeleteEscalationPolicyByName, authenticationHook)
+
+	// Statistics
+	stats := notificationsController.NewStatisticsController(dic)
+	r.GET(constants.ApiNotificationStatisticsRoute, stats.NotificationStatistics, authenticationHook)
+	r.GET(constants.ApiSubscriptionDeliveryStatisticsRoute, stats.SubscriptionDeliveryStatistics, authenticationHook)
+
 	// Notification
 	nc := notificationsController.NewNotificationController(dic)
 	r.POST(common.ApiNotificationRoute, nc.AddNotification, authenticationHook)
 	r.GET(common.ApiNotificationRoute, nc.NotificationsByQueryConditions, authenticationHook)
 	r.GET(common.ApiNotificationByIdRoute, nc.NotificationById, authenticationHook)
+	r.GET(constants.ApiNotificationOccurrenceRoute, nc.NotificationOccurrenceById, authenticationHook)
 	r.DELETE(common.ApiNotificationByIdRoute, nc.DeleteNotificationById, authenticationHook)
 	r.DELETE(common.ApiNotificationByIdsRoute, nc.DeleteNotificationByIds, authenticationHook)
 	r.GET(common.ApiNotificationByCategoryRoute, nc.NotificationsByCategory, authenticationHook)
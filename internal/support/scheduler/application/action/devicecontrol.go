@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024-2025 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -15,7 +15,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 )
 
-func issueSetCommand(dic *di.Container, action models.DeviceControlAction) (string, errors.EdgeX) {
+func issueSetCommand(ctx context.Context, dic *di.Container, action models.DeviceControlAction) (string, errors.EdgeX) {
 	if action.DeviceName == "" {
 		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, "device name cannot be empty", nil)
 	}
@@ -34,7 +34,7 @@ func issueSetCommand(dic *di.Container, action models.DeviceControlAction) (stri
 		return "", errors.NewCommonEdgeX(errors.KindServerError, "nil CommandClient returned", nil)
 	}
 
-	resp, err := cc.IssueSetCommandByName(context.Background(), action.DeviceName, action.SourceName, payload)
+	resp, err := cc.IssueSetCommandByName(ctx, action.DeviceName, action.SourceName, payload)
 	if err != nil {
 		return "", err
 	}
@@ -1,12 +1,14 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
 package action
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/go-co-op/gocron/v2"
@@ -51,70 +53,86 @@ func ToGocronJobDef(def models.ScheduleDef) (gocron.JobDefinition, errors.EdgeX)
 	return definition, nil
 }
 
+// ToGocronTask converts the ScheduleAction to a gocron task running the action
 func ToGocronTask(lc logger.LoggingClient, dic *di.Container, secretProvider bootstrapInterfaces.SecretProviderExt, action models.ScheduleAction) (gocron.Task, errors.EdgeX) {
 	var task gocron.Task
+	actionFunc, err := ToActionFunc(lc, dic, secretProvider, action)
+	if err != nil {
+		return task, errors.NewCommonEdgeXWrapper(err)
+	}
+	return gocron.NewTask(func() errors.EdgeX {
+		return actionFunc(context.Background())
+	}), nil
+}
+
+// ToActionFunc converts the ScheduleAction to a function running the action, which is shared by the gocron tasks of the
+// scheduled runs and the runs triggered by the events. The REST and DeviceControl actions are cancelled when the
+// context is done.
+func ToActionFunc(lc logger.LoggingClient, dic *di.Container, secretProvider bootstrapInterfaces.SecretProviderExt, action models.ScheduleAction) (func(ctx context.Context) errors.EdgeX, errors.EdgeX) {
+	var actionFunc func(ctx context.Context) errors.EdgeX
 	switch action.GetBaseScheduleAction().Type {
 	case common.ActionEdgeXMessageBus:
 		edgeXMessageBusAction, ok := action.(models.EdgeXMessageBusAction)
 		if !ok {
-			return task, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast ScheduleAction to EdgeXMessageBusAction", nil)
+			return actionFunc, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast ScheduleAction to EdgeXMessageBusAction", nil)
 		}
-		task = edgeXMessageBusActionTask(lc, dic, edgeXMessageBusAction)
+		actionFunc = edgeXMessageBusActionFunc(lc, dic, edgeXMessageBusAction)
 	case common.ActionREST:
 		restAction, ok := action.(models.RESTAction)
 		if !ok {
-			return task, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast ScheduleAction to RESTAction", nil)
+			return actionFunc, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast ScheduleAction to RESTAction", nil)
 		}
-		task = restActionTask(lc, secretProvider, restAction)
+		actionFunc = restActionFunc(lc, dic, secretProvider, restAction)
 	case common.ActionDeviceControl:
 		deviceControlAction, ok := action.(models.DeviceControlAction)
 		if !ok {
-			return task, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast ScheduleAction to DeviceControlAction", nil)
+			return actionFunc, errors.NewCommonEdgeX(errors.KindContractInvalid, "fail to cast ScheduleAction to DeviceControlAction", nil)
 		}
-		task = deviceControlActionTask(lc, dic, deviceControlAction)
+		actionFunc = deviceControlActionFunc(lc, dic, deviceControlAction)
 	default:
-		return task, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported schedule action type: %s", action.GetBaseScheduleAction().Type), nil)
+		return actionFunc, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported schedule action type: %s", action.GetBaseScheduleAction().Type), nil)
 	}
 
-	return task, nil
+	return actionFunc, nil
 }
 
-func edgeXMessageBusActionTask(lc logger.LoggingClient, dic *di.Container, action models.EdgeXMessageBusAction) gocron.Task {
-	return gocron.NewTask(func() errors.EdgeX {
+func edgeXMessageBusActionFunc(lc logger.LoggingClient, dic *di.Container, action models.EdgeXMessageBusAction) func(ctx context.Context) errors.EdgeX {
+	return func(ctx context.Context) errors.EdgeX {
 		if err := publishEdgeXMessageBus(dic, action); err != nil {
 			lc.Debugf("Failed to execute the EdgeX message bus action: %v", err)
 			return err
 		}
 		lc.Debugf("EdgeX message bus action was executed successfully")
 		return nil
-	})
+	}
 }
 
-func restActionTask(lc logger.LoggingClient, secretProvider bootstrapInterfaces.SecretProviderExt, action models.RESTAction) gocron.Task {
+func restActionFunc(lc logger.LoggingClient, dic *di.Container, secretProvider bootstrapInterfaces.SecretProviderExt, action models.RESTAction) func(ctx context.Context) errors.EdgeX {
 	var injector interfaces.AuthenticationInjector
 	if action.InjectEdgeXAuth {
 		injector = secret.NewJWTSecretProvider(secretProvider)
 	}
+	client := &http.Client{Timeout: requestTimeout(lc, dic)}
 
-	return gocron.NewTask(func() errors.EdgeX {
-		resp, err := sendRESTRequest(lc, action, injector)
+	return func(ctx context.Context) errors.EdgeX {
+		resp, err := sendRESTRequest(ctx, lc, client, action, injector)
 		if err != nil {
 			lc.Debugf("Failed to execute the rest action: %v", err)
 			return err
 		}
 		lc.Debugf("REST action was executed successfully, response: %s", resp)
 		return nil
-	})
+	}
 }
 
-func deviceControlActionTask(lc logger.LoggingClient, dic *di.Container, action models.DeviceControlAction) gocron.Task {
-	return gocron.NewTask(func() errors.EdgeX {
-		resp, err := issueSetCommand(dic, action)
+func deviceControlActionFunc(lc logger.LoggingClient, dic *di.Container, action models.DeviceControlAction) func(ctx context.Context) errors.EdgeX {
+	return func(ctx context.Context) errors.EdgeX {
+		resp, err := issueSetCommand(ctx, dic, action)
 		if err != nil {
 			lc.Debugf("Failed to execute the device control action: %v", err)
 			return err
 		}
 		lc.Debugf("DeviceControl action was executed successfully, response: %s", resp)
 		return nil
-	})
+	}
 }
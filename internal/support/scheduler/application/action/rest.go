@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -7,10 +7,13 @@ package action
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
@@ -18,10 +21,26 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
 
 	pkgUtils "github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 )
 
-func sendRESTRequest(lc logger.LoggingClient, action models.RESTAction, jwtSecretProvider interfaces.AuthenticationInjector) (res string, err errors.EdgeX) {
-	req, err := getHttpRequestFromRESTAction(action)
+// defaultRequestTimeout is the timeout of the REST actions if the Service.RequestTimeout configuration is invalid
+const defaultRequestTimeout = 5 * time.Second
+
+// requestTimeout returns the Service.RequestTimeout configuration as the timeout of the http client sending the REST
+// actions, so that the REST actions never block forever on an unresponsive target
+func requestTimeout(lc logger.LoggingClient, dic *di.Container) time.Duration {
+	configuration := container.ConfigurationFrom(dic.Get)
+	timeout, err := time.ParseDuration(configuration.Service.RequestTimeout)
+	if err != nil || timeout <= 0 {
+		lc.Warnf("Unable to parse Service.RequestTimeout value of '%s' duration, use the default timeout %v for the REST actions", configuration.Service.RequestTimeout, defaultRequestTimeout)
+		return defaultRequestTimeout
+	}
+	return timeout
+}
+
+func sendRESTRequest(ctx context.Context, lc logger.LoggingClient, client *http.Client, action models.RESTAction, jwtSecretProvider interfaces.AuthenticationInjector) (res string, err errors.EdgeX) {
+	req, err := getHttpRequestFromRESTAction(ctx, action)
 	if err != nil {
 		return "", errors.NewCommonEdgeX(errors.KindServerError, "failed to create http request", err)
 	}
@@ -32,7 +51,6 @@ func sendRESTRequest(lc logger.LoggingClient, action models.RESTAction, jwtSecre
 		}
 	}
 
-	client := &http.Client{}
 	res, err = pkgUtils.SendRequestAndGetResponse(client, req)
 	if err != nil {
 		return "", errors.NewCommonEdgeXWrapper(err)
@@ -41,7 +59,7 @@ func sendRESTRequest(lc logger.LoggingClient, action models.RESTAction, jwtSecre
 	return res, nil
 }
 
-func getHttpRequestFromRESTAction(action models.RESTAction) (*http.Request, errors.EdgeX) {
+func getHttpRequestFromRESTAction(ctx context.Context, action models.RESTAction) (*http.Request, errors.EdgeX) {
 	if !pkgUtils.ValidMethod(action.Method) {
 		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("net/http: invalid method %q", action.Method), nil)
 	}
@@ -53,7 +71,7 @@ func getHttpRequestFromRESTAction(action models.RESTAction) (*http.Request, erro
 		body = nil
 	}
 
-	req, err := http.NewRequest(action.Method, action.Address, bytes.NewBuffer(body))
+	req, err := http.NewRequestWithContext(ctx, action.Method, action.Address, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindServerError, "failed to create new request", err)
 	}
@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// UpsertConcurrencyPolicy sets the concurrency policy of the schedule job, the job is rearranged in the scheduler
+// manager so that its runs apply the concurrency policy
+func UpsertConcurrencyPolicy(ctx context.Context, name string, dto dtos.ConcurrencyPolicy, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	job, err := dbClient.ScheduleJobByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	policy := dtos.ToConcurrencyPolicyModel(name, dto)
+	old, err := dbClient.ConcurrencyPolicyByJobName(ctx, name)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	exists := err == nil
+	err = dbClient.UpsertConcurrencyPolicy(ctx, policy)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpsertConcurrencyPolicy(policy, correlationId)
+	if err != nil {
+		rollbackJobSetting(lc, name, "concurrency policy", func() errors.EdgeX {
+			if exists {
+				return dbClient.UpsertConcurrencyPolicy(ctx, old)
+			}
+			return dbClient.DeleteConcurrencyPolicyByJobName(ctx, name)
+		})
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpdateScheduleJob(job, correlationId)
+	if err != nil {
+		rollbackJobSetting(lc, name, "concurrency policy", func() errors.EdgeX {
+			if exists {
+				if err := schedulerManager.UpsertConcurrencyPolicy(old, correlationId); err != nil {
+					return err
+				}
+				return dbClient.UpsertConcurrencyPolicy(ctx, old)
+			}
+			if err := schedulerManager.DeleteConcurrencyPolicyByJobName(name, correlationId); err != nil {
+				return err
+			}
+			return dbClient.DeleteConcurrencyPolicyByJobName(ctx, name)
+		})
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully set the concurrency policy of the scheduled job: %s. Correlation-ID: %s", name, correlationId)
+	return nil
+}
+
+// ConcurrencyPolicyByJobName queries the concurrency policy of the schedule job
+func ConcurrencyPolicyByJobName(ctx context.Context, name string, dic *di.Container) (dto dtos.ConcurrencyPolicy, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	policy, err := dbClient.ConcurrencyPolicyByJobName(ctx, name)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromConcurrencyPolicyModelToDTO(policy), nil
+}
+
+// DeleteConcurrencyPolicyByJobName deletes the concurrency policy of the schedule job, the job is rearranged in the
+// scheduler manager so that its runs are allowed to overlap again
+func DeleteConcurrencyPolicyByJobName(ctx context.Context, name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	job, err := dbClient.ScheduleJobByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	old, err := dbClient.ConcurrencyPolicyByJobName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteConcurrencyPolicyByJobName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.DeleteConcurrencyPolicyByJobName(name, correlationId)
+	if err != nil {
+		rollbackJobSetting(lc, name, "concurrency policy", func() errors.EdgeX {
+			return dbClient.UpsertConcurrencyPolicy(ctx, old)
+		})
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpdateScheduleJob(job, correlationId)
+	if err != nil {
+		rollbackJobSetting(lc, name, "concurrency policy", func() errors.EdgeX {
+			if err := schedulerManager.UpsertConcurrencyPolicy(old, correlationId); err != nil {
+				return err
+			}
+			return dbClient.UpsertConcurrencyPolicy(ctx, old)
+		})
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully deleted the concurrency policy of the scheduled job: %s. Correlation-ID: %s", name, correlationId)
+	return nil
+}
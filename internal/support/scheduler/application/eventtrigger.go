@@ -0,0 +1,112 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// UpsertEventTrigger sets the event trigger of the schedule job, the job is rearranged in the scheduler manager as the
+// trigger may disable the runs scheduled by the definition of the job
+func UpsertEventTrigger(ctx context.Context, name string, dto dtos.EventTrigger, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	job, err := dbClient.ScheduleJobByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	trigger := dtos.ToEventTriggerModel(name, dto)
+	old, err := dbClient.EventTriggerByJobName(ctx, name)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	exists := err == nil
+	err = dbClient.UpsertEventTrigger(ctx, trigger)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpsertEventTrigger(trigger, correlationId)
+	if err != nil {
+		rollbackJobSetting(lc, name, "event trigger", func() errors.EdgeX {
+			if exists {
+				return dbClient.UpsertEventTrigger(ctx, old)
+			}
+			return dbClient.DeleteEventTriggerByJobName(ctx, name)
+		})
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpdateScheduleJob(job, correlationId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully set the event trigger of the scheduled job: %s. Correlation-ID: %s", name, correlationId)
+	return nil
+}
+
+// EventTriggerByJobName queries the event trigger of the schedule job
+func EventTriggerByJobName(ctx context.Context, name string, dic *di.Container) (dto dtos.EventTrigger, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	trigger, err := dbClient.EventTriggerByJobName(ctx, name)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromEventTriggerModelToDTO(trigger), nil
+}
+
+// DeleteEventTriggerByJobName deletes the event trigger of the schedule job, the job is rearranged in the scheduler
+// manager so that the runs scheduled by the definition of the job are resumed
+func DeleteEventTriggerByJobName(ctx context.Context, name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	job, err := dbClient.ScheduleJobByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = schedulerManager.DeleteEventTriggerByJobName(name, correlationId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteEventTriggerByJobName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpdateScheduleJob(job, correlationId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully deleted the event trigger of the scheduled job: %s. Correlation-ID: %s", name, correlationId)
+	return nil
+}
@@ -0,0 +1,162 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// UpsertRetryPolicy sets the retry policy of the schedule job, the job is rearranged in the scheduler manager so that
+// its actions run with the retry policy
+func UpsertRetryPolicy(ctx context.Context, name string, dto dtos.RetryPolicy, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	job, err := dbClient.ScheduleJobByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	policy := dtos.ToRetryPolicyModel(name, dto)
+	old, err := dbClient.RetryPolicyByJobName(ctx, name)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	exists := err == nil
+	err = dbClient.UpsertRetryPolicy(ctx, policy)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpsertRetryPolicy(policy, job, correlationId)
+	if err != nil {
+		rollbackJobSetting(lc, name, "retry policy", func() errors.EdgeX {
+			if exists {
+				return dbClient.UpsertRetryPolicy(ctx, old)
+			}
+			return dbClient.DeleteRetryPolicyByJobName(ctx, name)
+		})
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpdateScheduleJob(job, correlationId)
+	if err != nil {
+		rollbackJobSetting(lc, name, "retry policy", func() errors.EdgeX {
+			if exists {
+				if err := schedulerManager.UpsertRetryPolicy(old, job, correlationId); err != nil {
+					return err
+				}
+				return dbClient.UpsertRetryPolicy(ctx, old)
+			}
+			if err := schedulerManager.DeleteRetryPolicyByJobName(name, correlationId); err != nil {
+				return err
+			}
+			return dbClient.DeleteRetryPolicyByJobName(ctx, name)
+		})
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully set the retry policy of the scheduled job: %s. Correlation-ID: %s", name, correlationId)
+	return nil
+}
+
+// RetryPolicyByJobName queries the retry policy of the schedule job
+func RetryPolicyByJobName(ctx context.Context, name string, dic *di.Container) (dto dtos.RetryPolicy, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	policy, err := dbClient.RetryPolicyByJobName(ctx, name)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromRetryPolicyModelToDTO(policy), nil
+}
+
+// DeleteRetryPolicyByJobName deletes the retry policy of the schedule job, the job is rearranged in the scheduler
+// manager so that its actions run once without timeout again
+func DeleteRetryPolicyByJobName(ctx context.Context, name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	job, err := dbClient.ScheduleJobByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	old, err := dbClient.RetryPolicyByJobName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteRetryPolicyByJobName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.DeleteRetryPolicyByJobName(name, correlationId)
+	if err != nil {
+		rollbackJobSetting(lc, name, "retry policy", func() errors.EdgeX {
+			return dbClient.UpsertRetryPolicy(ctx, old)
+		})
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpdateScheduleJob(job, correlationId)
+	if err != nil {
+		rollbackJobSetting(lc, name, "retry policy", func() errors.EdgeX {
+			if err := schedulerManager.UpsertRetryPolicy(old, job, correlationId); err != nil {
+				return err
+			}
+			return dbClient.UpsertRetryPolicy(ctx, old)
+		})
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully deleted the retry policy of the scheduled job: %s. Correlation-ID: %s", name, correlationId)
+	return nil
+}
+
+// ScheduleActionAttemptsByJobName queries the schedule action records with attempt numbers of the schedule job with
+// the specified time range, offset and limit
+func ScheduleActionAttemptsByJobName(ctx context.Context, name string, start, end int64, offset, limit int, dic *di.Container) (attemptDTOs []dtos.ScheduleActionAttempt, totalCount int64, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return attemptDTOs, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	totalCount, err := dbClient.ScheduleActionAttemptCountByJobName(ctx, name, start, end)
+	if err != nil {
+		return attemptDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dtos.ScheduleActionAttempt{}, totalCount, err
+	}
+
+	attempts, err := dbClient.ScheduleActionAttemptsByJobName(ctx, name, start, end, offset, limit)
+	if err != nil {
+		return attemptDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromScheduleActionAttemptModelsToDTOs(attempts), totalCount, nil
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -13,6 +13,7 @@ import (
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/clients/logger"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
@@ -179,6 +180,33 @@ func DeleteScheduleJobByName(ctx context.Context, name string, dic *di.Container
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	// The event trigger, the workflow, the retry policy and the concurrency policy are owned by the job, failing to
+	// delete them doesn't fail the deletion of the job
+	if err = schedulerManager.DeleteEventTriggerByJobName(name, correlationId); err != nil {
+		lc.Warnf("failed to remove the event trigger of the scheduled job: %s from the scheduler manager, %v. Correlation-ID: %s", name, err, correlationId)
+	}
+	if err = dbClient.DeleteEventTriggerByJobName(ctx, name); err != nil {
+		lc.Warnf("failed to delete the event trigger of the scheduled job: %s, %v. Correlation-ID: %s", name, err, correlationId)
+	}
+	if err = schedulerManager.DeleteWorkflowByJobName(name, correlationId); err != nil {
+		lc.Warnf("failed to remove the workflow of the scheduled job: %s from the scheduler manager, %v. Correlation-ID: %s", name, err, correlationId)
+	}
+	if err = dbClient.DeleteWorkflowByJobName(ctx, name); err != nil {
+		lc.Warnf("failed to delete the workflow of the scheduled job: %s, %v. Correlation-ID: %s", name, err, correlationId)
+	}
+	if err = schedulerManager.DeleteRetryPolicyByJobName(name, correlationId); err != nil {
+		lc.Warnf("failed to remove the retry policy of the scheduled job: %s from the scheduler manager, %v. Correlation-ID: %s", name, err, correlationId)
+	}
+	if err = dbClient.DeleteRetryPolicyByJobName(ctx, name); err != nil {
+		lc.Warnf("failed to delete the retry policy of the scheduled job: %s, %v. Correlation-ID: %s", name, err, correlationId)
+	}
+	if err = schedulerManager.DeleteConcurrencyPolicyByJobName(name, correlationId); err != nil {
+		lc.Warnf("failed to remove the concurrency policy of the scheduled job: %s from the scheduler manager, %v. Correlation-ID: %s", name, err, correlationId)
+	}
+	if err = dbClient.DeleteConcurrencyPolicyByJobName(ctx, name); err != nil {
+		lc.Warnf("failed to delete the concurrency policy of the scheduled job: %s, %v. Correlation-ID: %s", name, err, correlationId)
+	}
+
 	lc.Debugf("Successfully deleted the scheduled job: %s. Correlation-ID: %s", name, correlationId)
 	return nil
 }
@@ -196,12 +224,76 @@ func LoadScheduleJobsToSchedulerManager(ctx context.Context, dic *di.Container)
 		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to load all existing scheduled jobs", err)
 	}
 
+	// The event triggers are registered before the jobs, so that the event-only jobs are added without the runs
+	// scheduled by their definitions
+	triggers, err := dbClient.AllEventTriggers(ctx)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to load all existing event triggers", err)
+	}
+	eventOnlyJobs := make(map[string]bool)
+	for _, trigger := range triggers {
+		if err := schedulerManager.UpsertEventTrigger(trigger, correlationId); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		eventOnlyJobs[trigger.JobName] = trigger.EventOnly
+	}
+
+	// The retry policies, the workflows and the concurrency policies are registered before the jobs as well, so that the
+	// jobs are added to run their actions with the retry policies, run their workflows and guard their runs
+	jobsByName := make(map[string]models.ScheduleJob, len(jobs))
+	for _, job := range jobs {
+		jobsByName[job.Name] = job
+	}
+	policies, err := dbClient.AllRetryPolicies(ctx)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to load all existing retry policies", err)
+	}
+	for _, policy := range policies {
+		job, exists := jobsByName[policy.JobName]
+		if !exists {
+			lc.Warnf("Skipping the retry policy of the scheduled job: %s which is not loaded. Correlation-ID: %s", policy.JobName, correlationId)
+			continue
+		}
+		if err := schedulerManager.UpsertRetryPolicy(policy, job, correlationId); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	concurrencyPolicies, err := dbClient.AllConcurrencyPolicies(ctx)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to load all existing concurrency policies", err)
+	}
+	for _, policy := range concurrencyPolicies {
+		if err := schedulerManager.UpsertConcurrencyPolicy(policy, correlationId); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	workflows, err := dbClient.AllWorkflows(ctx)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindDatabaseError, "failed to load all existing workflows", err)
+	}
+	for _, workflow := range workflows {
+		job, exists := jobsByName[workflow.JobName]
+		if !exists {
+			lc.Warnf("Skipping the workflow of the scheduled job: %s which is not loaded. Correlation-ID: %s", workflow.JobName, correlationId)
+			continue
+		}
+		if err := schedulerManager.UpsertWorkflow(workflow, job, correlationId); err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+
 	for _, job := range jobs {
 		err := schedulerManager.AddScheduleJob(job, correlationId)
 		if err != nil {
 			return errors.NewCommonEdgeXWrapper(err)
 		}
 
+		// The event-only job has no scheduled run to miss
+		if eventOnlyJobs[job.Name] {
+			lc.Debugf("Successfully loaded the existing event-only scheduled job: %s. Correlation-ID: %s", job.Name, correlationId)
+			continue
+		}
+
 		// If endTimestamp is set and expired, the missed schedule action records should not be generated
 		isEndExpired := isEndTimestampExpired(job.Definition.GetBaseScheduleDef().EndTimestamp)
 		if isEndExpired {
@@ -259,3 +351,11 @@ func generateMissedRecords(ctx context.Context, job models.ScheduleJob, dic *di.
 
 	return nil, hasMissedAction
 }
+
+// rollbackJobSetting restores the persisted setting of the schedule job once the setting fails to apply in the scheduler
+// manager, the failure of the rollback is only logged as the original error is returned to the caller
+func rollbackJobSetting(lc logger.LoggingClient, jobName, setting string, restore func() errors.EdgeX) {
+	if err := restore(); err != nil {
+		lc.Errorf("failed to roll back the %s of the scheduled job %s: %v", setting, jobName, err)
+	}
+}
@@ -0,0 +1,239 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// UpsertWorkflow sets the workflow of the schedule job, the job is rearranged in the scheduler manager so that its runs
+// execute the workflow
+func UpsertWorkflow(ctx context.Context, name string, dto dtos.Workflow, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	job, err := dbClient.ScheduleJobByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	workflow := dtos.ToWorkflowModel(name, dto)
+	old, err := dbClient.WorkflowByJobName(ctx, name)
+	if err != nil && errors.Kind(err) != errors.KindEntityDoesNotExist {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	exists := err == nil
+	err = dbClient.UpsertWorkflow(ctx, workflow)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpsertWorkflow(workflow, job, correlationId)
+	if err != nil {
+		rollbackJobSetting(lc, name, "workflow", func() errors.EdgeX {
+			if exists {
+				return dbClient.UpsertWorkflow(ctx, old)
+			}
+			return dbClient.DeleteWorkflowByJobName(ctx, name)
+		})
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpdateScheduleJob(job, correlationId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully set the workflow of the scheduled job: %s. Correlation-ID: %s", name, correlationId)
+	return nil
+}
+
+// WorkflowByJobName queries the workflow of the schedule job
+func WorkflowByJobName(ctx context.Context, name string, dic *di.Container) (dto dtos.Workflow, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return dto, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	workflow, err := dbClient.WorkflowByJobName(ctx, name)
+	if err != nil {
+		return dto, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromWorkflowModelToDTO(workflow), nil
+}
+
+// DeleteWorkflowByJobName deletes the workflow of the schedule job, the job is rearranged in the scheduler manager so
+// that its runs execute all the actions independently again
+func DeleteWorkflowByJobName(ctx context.Context, name string, dic *di.Container) errors.EdgeX {
+	if name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	correlationId := correlation.FromContext(ctx)
+
+	job, err := dbClient.ScheduleJobByName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	err = schedulerManager.DeleteWorkflowByJobName(name, correlationId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = dbClient.DeleteWorkflowByJobName(ctx, name)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	err = schedulerManager.UpdateScheduleJob(job, correlationId)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	lc.Debugf("Successfully deleted the workflow of the scheduled job: %s. Correlation-ID: %s", name, correlationId)
+	return nil
+}
+
+// WorkflowRuns queries the run history of the workflow of the schedule job with the specified time range, offset and
+// limit. The runs are built from the schedule action records of the workflow steps, newest first.
+func WorkflowRuns(ctx context.Context, name string, start, end int64, offset, limit int, dic *di.Container) (runDTOs []dtos.WorkflowRun, totalCount int64, edgeXerr errors.EdgeX) {
+	if name == "" {
+		return runDTOs, totalCount, errors.NewCommonEdgeX(errors.KindContractInvalid, "name is empty", nil)
+	}
+
+	dbClient := container.DBClientFrom(dic.Get)
+	workflow, err := dbClient.WorkflowByJobName(ctx, name)
+	if err != nil {
+		return runDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	job, err := dbClient.ScheduleJobByName(ctx, name)
+	if err != nil {
+		return runDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	var actionIds []string
+	for _, actionId := range workflowStepActionIds(workflow, job) {
+		if actionId != "" && !slices.Contains(actionIds, actionId) {
+			actionIds = append(actionIds, actionId)
+		}
+	}
+	if len(actionIds) == 0 {
+		return []dtos.WorkflowRun{}, totalCount, nil
+	}
+
+	totalCount, err = dbClient.WorkflowRunCountByJobName(ctx, name, actionIds, start, end)
+	if err != nil {
+		return runDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	cont, err := utils.CheckCountRange(totalCount, offset, limit)
+	if !cont {
+		return []dtos.WorkflowRun{}, totalCount, err
+	}
+
+	records, err := dbClient.WorkflowRunRecordsByJobName(ctx, name, actionIds, start, end, offset, limit)
+	if err != nil {
+		return runDTOs, totalCount, errors.NewCommonEdgeXWrapper(err)
+	}
+	return dtos.FromWorkflowRunModelsToDTOs(buildWorkflowRuns(workflow, job, records)), totalCount, nil
+}
+
+// workflowStepActionIds returns the ids of the job actions executed by the workflow steps, in the order of the steps. The
+// id is empty if the step refers to an action the job doesn't have.
+func workflowStepActionIds(workflow schedulerModels.Workflow, job models.ScheduleJob) []string {
+	stepActionIds := make([]string, len(workflow.Steps))
+	for i, step := range workflow.Steps {
+		if step.Action >= 0 && step.Action < len(job.Actions) {
+			stepActionIds[i] = job.Actions[step.Action].GetBaseScheduleAction().Id
+		}
+	}
+	return stepActionIds
+}
+
+// buildWorkflowRuns groups the schedule action records by scheduledAt, which identifies a run, and maps the records to
+// the workflow steps through the ids of the actions. The records of the actions which are not workflow steps are
+// ignored. A step without record is pending, and the run status is derived from the step statuses.
+func buildWorkflowRuns(workflow schedulerModels.Workflow, job models.ScheduleJob, records []models.ScheduleActionRecord) []schedulerModels.WorkflowRun {
+	stepActionIds := workflowStepActionIds(workflow, job)
+
+	recordsByRun := make(map[int64]map[string]models.ScheduleActionRecord)
+	for _, record := range records {
+		actionId := record.Action.GetBaseScheduleAction().Id
+		if !slices.Contains(stepActionIds, actionId) {
+			continue
+		}
+		actionRecords, exists := recordsByRun[record.ScheduledAt]
+		if !exists {
+			actionRecords = make(map[string]models.ScheduleActionRecord)
+			recordsByRun[record.ScheduledAt] = actionRecords
+		}
+		// The step retried by the retry policy has a record for each attempt, the latest attempt tells the status
+		if existing, exists := actionRecords[actionId]; !exists || record.Created > existing.Created {
+			actionRecords[actionId] = record
+		}
+	}
+
+	runs := make([]schedulerModels.WorkflowRun, 0, len(recordsByRun))
+	for scheduledAt, actionRecords := range recordsByRun {
+		run := schedulerModels.WorkflowRun{JobName: job.Name, ScheduledAt: scheduledAt, Nodes: make([]schedulerModels.WorkflowRunNode, len(workflow.Steps))}
+		var failed, missed, overlapped, pending bool
+		for i, step := range workflow.Steps {
+			node := schedulerModels.WorkflowRunNode{Step: step.Name, ActionId: stepActionIds[i], Status: schedulerModels.Pending}
+			if record, exists := actionRecords[stepActionIds[i]]; exists {
+				node.Status = string(record.Status)
+				node.Created = record.Created
+			}
+			switch node.Status {
+			case models.Failed:
+				failed = true
+			case models.Missed:
+				missed = true
+			case schedulerModels.Overlapped:
+				overlapped = true
+			case schedulerModels.Pending:
+				pending = true
+			}
+			run.Nodes[i] = node
+		}
+
+		switch {
+		case failed:
+			run.Status = models.Failed
+		case missed:
+			run.Status = models.Missed
+		case overlapped:
+			run.Status = schedulerModels.Overlapped
+		case pending:
+			run.Status = schedulerModels.Running
+		default:
+			run.Status = models.Succeeded
+		}
+		runs = append(runs, run)
+	}
+
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].ScheduledAt > runs[j].ScheduledAt
+	})
+	return runs
+}
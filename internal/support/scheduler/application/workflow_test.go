@@ -0,0 +1,87 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+func TestBuildWorkflowRuns(t *testing.T) {
+	action := func(id string) models.ScheduleAction {
+		return models.EdgeXMessageBusAction{
+			BaseScheduleAction: models.BaseScheduleAction{Id: id, Type: common.ActionEdgeXMessageBus},
+			Topic:              "testTopic",
+		}
+	}
+	job := models.ScheduleJob{Name: "testJob", Actions: []models.ScheduleAction{action("a"), action("b")}}
+	workflow := schedulerModels.Workflow{JobName: job.Name, Steps: []schedulerModels.WorkflowStep{
+		{Name: "collect", Action: 0},
+		{Name: "upload", Action: 1, DependsOn: []schedulerModels.WorkflowDependency{{Step: "collect"}}},
+	}}
+	record := func(id, status string, scheduledAt int64) models.ScheduleActionRecord {
+		r := models.ScheduleActionRecord{JobName: job.Name, Action: action(id), ScheduledAt: scheduledAt, Created: scheduledAt + 1}
+		switch status {
+		case models.Succeeded:
+			r.Status = models.Succeeded
+		case models.Failed:
+			r.Status = models.Failed
+		case models.Missed:
+			r.Status = models.Missed
+		case schedulerModels.Skipped:
+			r.Status = schedulerModels.Skipped
+		case schedulerModels.Overlapped:
+			r.Status = schedulerModels.Overlapped
+		}
+		return r
+	}
+	records := []models.ScheduleActionRecord{
+		record("a", models.Succeeded, 1000), record("b", models.Succeeded, 1000),
+		record("a", models.Failed, 2000), record("b", schedulerModels.Skipped, 2000),
+		record("a", models.Missed, 3000), record("b", models.Missed, 3000),
+		record("a", models.Succeeded, 4000),
+		record("a", schedulerModels.Overlapped, 5000), record("b", schedulerModels.Overlapped, 5000),
+	}
+	// the retried step fails at the first attempt and succeeds at the second one, the newest record comes first
+	failedAttempt, succeededAttempt := record("a", models.Failed, 1000), record("a", models.Succeeded, 1000)
+	failedAttempt.Created, succeededAttempt.Created = 1002, 1003
+	records = append(records, succeededAttempt, failedAttempt)
+	// the records of the actions which are not workflow steps don't make runs
+	records = append(records, record("c", models.Succeeded, 6000), record("c", models.Failed, 1000))
+
+	runs := buildWorkflowRuns(workflow, job, records)
+	require.Len(t, runs, 5)
+
+	expected := []struct {
+		scheduledAt int64
+		status      string
+		nodes       []string
+	}{
+		{5000, schedulerModels.Overlapped, []string{schedulerModels.Overlapped, schedulerModels.Overlapped}},
+		{4000, schedulerModels.Running, []string{models.Succeeded, schedulerModels.Pending}},
+		{3000, models.Missed, []string{models.Missed, models.Missed}},
+		{2000, models.Failed, []string{models.Failed, schedulerModels.Skipped}},
+		{1000, models.Succeeded, []string{models.Succeeded, models.Succeeded}},
+	}
+	for i, e := range expected {
+		assert.Equal(t, e.scheduledAt, runs[i].ScheduledAt)
+		assert.Equal(t, e.status, runs[i].Status)
+		require.Len(t, runs[i].Nodes, len(e.nodes))
+		for j, status := range e.nodes {
+			assert.Equal(t, workflow.Steps[j].Name, runs[i].Nodes[j].Step)
+			assert.Equal(t, status, runs[i].Nodes[j].Status)
+		}
+	}
+	assert.Equal(t, "b", runs[2].Nodes[1].ActionId)
+	assert.Zero(t, runs[1].Nodes[1].Created)
+}
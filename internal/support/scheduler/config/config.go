@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -18,6 +18,8 @@ type ConfigurationStruct struct {
 	Clients    bootstrapConfig.ClientsCollection
 	MessageBus bootstrapConfig.MessageBusInfo
 	Retention  RecordRetention
+	// EventTrigger configures the message bus subscriptions of the event triggered schedule jobs
+	EventTrigger EventTriggerInfo
 }
 
 type WritableInfo struct {
@@ -33,6 +35,15 @@ type RecordRetention struct {
 	MinCap   uint32
 }
 
+// EventTriggerInfo configures the message bus subscriptions which deliver the events to the event triggers of the
+// schedule jobs
+type EventTriggerInfo struct {
+	// Enabled indicates whether to subscribe the events for the event triggers
+	Enabled bool
+	// KeeperTopicPrefix is the base topic prefix of the key changes published by core-keeper
+	KeeperTopicPrefix string
+}
+
 // UpdateFromRaw converts configuration received from the registry to a service-specific configuration struct which is
 // then used to overwrite the service's existing configuration struct.
 func (c *ConfigurationStruct) UpdateFromRaw(rawConfig any) bool {
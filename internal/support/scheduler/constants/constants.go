@@ -0,0 +1,20 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+)
+
+// support-scheduler API routes not yet defined in go-mod-core-contracts
+const (
+	ApiScheduleJobEventTriggerRoute      = common.ApiScheduleJobByNameRoute + "/eventtrigger"
+	ApiScheduleJobWorkflowRoute          = common.ApiScheduleJobByNameRoute + "/workflow"
+	ApiScheduleJobWorkflowRunRoute       = ApiScheduleJobWorkflowRoute + "/run"
+	ApiScheduleJobRetryPolicyRoute       = common.ApiScheduleJobByNameRoute + "/retrypolicy"
+	ApiScheduleJobAttemptRoute           = common.ApiScheduleJobByNameRoute + "/attempt"
+	ApiScheduleJobConcurrencyPolicyRoute = common.ApiScheduleJobByNameRoute + "/concurrencypolicy"
+)
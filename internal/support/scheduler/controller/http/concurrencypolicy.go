@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/responses"
+)
+
+type ConcurrencyPolicyController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewConcurrencyPolicyController creates and initializes a ConcurrencyPolicyController
+func NewConcurrencyPolicyController(dic *di.Container) *ConcurrencyPolicyController {
+	return &ConcurrencyPolicyController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// UpsertConcurrencyPolicy sets the concurrency policy of the ScheduleJob specified by name
+func (cc *ConcurrencyPolicyController) UpsertConcurrencyPolicy(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(cc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	var reqDTO requests.ConcurrencyPolicyRequest
+	err := cc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	err = application.UpsertConcurrencyPolicy(ctx, name, reqDTO.ConcurrencyPolicy, cc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, reqDTO.RequestId)
+	}
+
+	response := commonDTO.NewBaseResponse(reqDTO.RequestId, "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ConcurrencyPolicyByJobName returns the concurrency policy of the ScheduleJob specified by name
+func (cc *ConcurrencyPolicyController) ConcurrencyPolicyByJobName(c echo.Context) error {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	policy, err := application.ConcurrencyPolicyByJobName(ctx, name, cc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewConcurrencyPolicyResponse("", "", http.StatusOK, policy)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteConcurrencyPolicyByJobName deletes the concurrency policy of the ScheduleJob specified by name
+func (cc *ConcurrencyPolicyController) DeleteConcurrencyPolicyByJobName(c echo.Context) error {
+	lc := container.LoggingClientFrom(cc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteConcurrencyPolicyByJobName(ctx, name, cc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,170 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/responses"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+func concurrencyPolicyRequestData() requests.ConcurrencyPolicyRequest {
+	return requests.ConcurrencyPolicyRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		ConcurrencyPolicy: dtos.ConcurrencyPolicy{
+			Mode:          schedulerModels.ConcurrencyQueue,
+			MaxQueueDepth: 2,
+		},
+	}
+}
+
+func TestUpsertConcurrencyPolicy(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+	rejectedJob := job
+	rejectedJob.Name = rejectedJobName
+	valid := concurrencyPolicyRequestData()
+	policy := dtos.ToConcurrencyPolicyModel(job.Name, valid.ConcurrencyPolicy)
+	rejectedConcurrencyPolicy := dtos.ToConcurrencyPolicyModel(rejectedJob.Name, valid.ConcurrencyPolicy)
+
+	dic, dbClientMock, schedulerManagerMock := mockJobSettingDic(job, rejectedJob)
+	dbClientMock.On("ConcurrencyPolicyByJobName", context.Background(), mock.Anything).Return(schedulerModels.ConcurrencyPolicy{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "concurrency policy doesn't exist in the database", nil))
+	dbClientMock.On("UpsertConcurrencyPolicy", context.Background(), mock.Anything).Return(nil)
+	dbClientMock.On("DeleteConcurrencyPolicyByJobName", context.Background(), rejectedJob.Name).Return(nil)
+	schedulerManagerMock.On("UpsertConcurrencyPolicy", policy, testCorrelationID).Return(nil)
+	unschedulableJob := job
+	unschedulableJob.Name = "unschedulableJobName"
+	unschedulableConcurrencyPolicy := dtos.ToConcurrencyPolicyModel(unschedulableJob.Name, valid.ConcurrencyPolicy)
+	dbClientMock.On("ScheduleJobByName", context.Background(), unschedulableJob.Name).Return(unschedulableJob, nil)
+	dbClientMock.On("DeleteConcurrencyPolicyByJobName", context.Background(), unschedulableJob.Name).Return(nil)
+	schedulerManagerMock.On("UpsertConcurrencyPolicy", unschedulableConcurrencyPolicy, testCorrelationID).Return(nil)
+	schedulerManagerMock.On("UpdateScheduleJob", unschedulableJob, testCorrelationID).Return(errors.NewCommonEdgeX(errors.KindServerError, "fail to rearrange the job", nil))
+	schedulerManagerMock.On("DeleteConcurrencyPolicyByJobName", unschedulableJob.Name, testCorrelationID).Return(nil)
+	schedulerManagerMock.On("UpsertConcurrencyPolicy", rejectedConcurrencyPolicy, testCorrelationID).Return(errors.NewCommonEdgeX(errors.KindContractInvalid, "rejected by the scheduler manager", nil))
+
+	controller := NewConcurrencyPolicyController(dic)
+	require.NotNil(t, controller)
+
+	noMode := concurrencyPolicyRequestData()
+	noMode.ConcurrencyPolicy.Mode = ""
+	unsupportedMode := concurrencyPolicyRequestData()
+	unsupportedMode.ConcurrencyPolicy.Mode = "SKIP"
+	negativeDepth := concurrencyPolicyRequestData()
+	negativeDepth.ConcurrencyPolicy.MaxQueueDepth = -1
+
+	tests := []struct {
+		name               string
+		jobName            string
+		request            requests.ConcurrencyPolicyRequest
+		expectedStatusCode int
+	}{
+		{"Valid - concurrency policy of the scheduled job", job.Name, valid, http.StatusOK},
+		{"Invalid - concurrency policy without mode", job.Name, noMode, http.StatusBadRequest},
+		{"Invalid - unsupported mode", job.Name, unsupportedMode, http.StatusBadRequest},
+		{"Invalid - negative max queue depth", job.Name, negativeDepth, http.StatusBadRequest},
+		{"Invalid - scheduled job not found by name", notFoundJobName, valid, http.StatusNotFound},
+		{"Invalid - rejected by the scheduler manager", rejectedJob.Name, valid, http.StatusBadRequest},
+		{"Invalid - failed to rearrange the scheduled job", unschedulableJob.Name, valid, http.StatusInternalServerError},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.UpsertConcurrencyPolicy, http.MethodPut, constants.ApiScheduleJobConcurrencyPolicyRoute, testCase.jobName, testCase.request)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+		})
+	}
+	schedulerManagerMock.AssertNumberOfCalls(t, "UpdateScheduleJob", 2)
+	// the concurrency policy rejected by the scheduler manager is rolled back
+	dbClientMock.AssertCalled(t, "DeleteConcurrencyPolicyByJobName", context.Background(), rejectedJob.Name)
+	// the concurrency policy of the job failed to rearrange is rolled back from both the scheduler manager and the database
+	schedulerManagerMock.AssertCalled(t, "DeleteConcurrencyPolicyByJobName", unschedulableJob.Name, testCorrelationID)
+	dbClientMock.AssertCalled(t, "DeleteConcurrencyPolicyByJobName", context.Background(), unschedulableJob.Name)
+}
+
+func TestConcurrencyPolicyByJobName(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+	policy := dtos.ToConcurrencyPolicyModel(job.Name, concurrencyPolicyRequestData().ConcurrencyPolicy)
+
+	dic, dbClientMock, _ := mockJobSettingDic(job)
+	dbClientMock.On("ConcurrencyPolicyByJobName", context.Background(), job.Name).Return(policy, nil)
+	dbClientMock.On("ConcurrencyPolicyByJobName", context.Background(), notFoundJobName).Return(schedulerModels.ConcurrencyPolicy{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "concurrency policy doesn't exist in the database", nil))
+
+	controller := NewConcurrencyPolicyController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		expectedStatusCode int
+	}{
+		{"Valid - concurrency policy by job name", job.Name, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - concurrency policy not found by job name", notFoundJobName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.ConcurrencyPolicyByJobName, http.MethodGet, constants.ApiScheduleJobConcurrencyPolicyRoute, testCase.jobName, nil)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res responses.ConcurrencyPolicyResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, dtos.FromConcurrencyPolicyModelToDTO(policy), res.ConcurrencyPolicy, "ConcurrencyPolicy not as expected")
+			}
+		})
+	}
+}
+
+func TestDeleteConcurrencyPolicyByJobName(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+
+	rejectedJob := job
+	rejectedJob.Name = rejectedJobName
+	policy := dtos.ToConcurrencyPolicyModel(job.Name, concurrencyPolicyRequestData().ConcurrencyPolicy)
+	rejectedConcurrencyPolicy := dtos.ToConcurrencyPolicyModel(rejectedJob.Name, concurrencyPolicyRequestData().ConcurrencyPolicy)
+
+	dic, dbClientMock, schedulerManagerMock := mockJobSettingDic(job, rejectedJob)
+	dbClientMock.On("ConcurrencyPolicyByJobName", context.Background(), job.Name).Return(policy, nil)
+	dbClientMock.On("ConcurrencyPolicyByJobName", context.Background(), rejectedJob.Name).Return(rejectedConcurrencyPolicy, nil)
+	dbClientMock.On("DeleteConcurrencyPolicyByJobName", context.Background(), mock.Anything).Return(nil)
+	dbClientMock.On("UpsertConcurrencyPolicy", context.Background(), rejectedConcurrencyPolicy).Return(nil)
+	schedulerManagerMock.On("DeleteConcurrencyPolicyByJobName", job.Name, testCorrelationID).Return(nil)
+	schedulerManagerMock.On("DeleteConcurrencyPolicyByJobName", rejectedJob.Name, testCorrelationID).Return(errors.NewCommonEdgeX(errors.KindServerError, "fail to remove the concurrency policy", nil))
+
+	controller := NewConcurrencyPolicyController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		expectedStatusCode int
+	}{
+		{"Valid - delete concurrency policy by job name", job.Name, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - scheduled job not found by name", notFoundJobName, http.StatusNotFound},
+		{"Invalid - rejected by the scheduler manager", rejectedJob.Name, http.StatusInternalServerError},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.DeleteConcurrencyPolicyByJobName, http.MethodDelete, constants.ApiScheduleJobConcurrencyPolicyRoute, testCase.jobName, nil)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+		})
+	}
+	// the concurrency policy is deleted from the database first and restored once the scheduler manager fails to remove it
+	dbClientMock.AssertCalled(t, "UpsertConcurrencyPolicy", context.Background(), rejectedConcurrencyPolicy)
+}
@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/responses"
+)
+
+type EventTriggerController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewEventTriggerController creates and initializes an EventTriggerController
+func NewEventTriggerController(dic *di.Container) *EventTriggerController {
+	return &EventTriggerController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// UpsertEventTrigger sets the event trigger of the ScheduleJob specified by name
+func (tc *EventTriggerController) UpsertEventTrigger(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(tc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	var reqDTO requests.EventTriggerRequest
+	err := tc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	err = application.UpsertEventTrigger(ctx, name, reqDTO.EventTrigger, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, reqDTO.RequestId)
+	}
+
+	response := commonDTO.NewBaseResponse(reqDTO.RequestId, "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// EventTriggerByJobName returns the event trigger of the ScheduleJob specified by name
+func (tc *EventTriggerController) EventTriggerByJobName(c echo.Context) error {
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	trigger, err := application.EventTriggerByJobName(ctx, name, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewEventTriggerResponse("", "", http.StatusOK, trigger)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteEventTriggerByJobName deletes the event trigger of the ScheduleJob specified by name
+func (tc *EventTriggerController) DeleteEventTriggerByJobName(c echo.Context) error {
+	lc := container.LoggingClientFrom(tc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteEventTriggerByJobName(ctx, name, tc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/responses"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+func eventTriggerRequestData() requests.EventTriggerRequest {
+	return requests.EventTriggerRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		EventTrigger: dtos.EventTrigger{
+			Type:               schedulerModels.TriggerSystemEvent,
+			SystemEventTypes:   []string{common.DeviceSystemEventType},
+			SystemEventActions: []string{common.SystemEventActionAdd, common.SystemEventActionDelete},
+			Debounce:           "5s",
+			Cooldown:           "1m",
+		},
+	}
+}
+
+func TestUpsertEventTrigger(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+	rejectedJob := job
+	rejectedJob.Name = rejectedJobName
+	valid := eventTriggerRequestData()
+	trigger := dtos.ToEventTriggerModel(job.Name, valid.EventTrigger)
+	rejectedEventTrigger := dtos.ToEventTriggerModel(rejectedJob.Name, valid.EventTrigger)
+
+	dic, dbClientMock, schedulerManagerMock := mockJobSettingDic(job, rejectedJob)
+	dbClientMock.On("EventTriggerByJobName", context.Background(), mock.Anything).Return(schedulerModels.EventTrigger{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "event trigger doesn't exist in the database", nil))
+	dbClientMock.On("UpsertEventTrigger", context.Background(), mock.Anything).Return(nil)
+	dbClientMock.On("DeleteEventTriggerByJobName", context.Background(), rejectedJob.Name).Return(nil)
+	schedulerManagerMock.On("UpsertEventTrigger", trigger, testCorrelationID).Return(nil)
+	schedulerManagerMock.On("UpsertEventTrigger", rejectedEventTrigger, testCorrelationID).Return(errors.NewCommonEdgeX(errors.KindContractInvalid, "rejected by the scheduler manager", nil))
+
+	controller := NewEventTriggerController(dic)
+	require.NotNil(t, controller)
+
+	invalidType := eventTriggerRequestData()
+	invalidType.EventTrigger.Type = "TIMER"
+	invalidDebounce := eventTriggerRequestData()
+	invalidDebounce.EventTrigger.Debounce = "abc"
+
+	tests := []struct {
+		name               string
+		jobName            string
+		request            requests.EventTriggerRequest
+		expectedStatusCode int
+	}{
+		{"Valid - event trigger of the scheduled job", job.Name, valid, http.StatusOK},
+		{"Invalid - unsupported trigger type", job.Name, invalidType, http.StatusBadRequest},
+		{"Invalid - invalid debounce", job.Name, invalidDebounce, http.StatusBadRequest},
+		{"Invalid - scheduled job not found by name", notFoundJobName, valid, http.StatusNotFound},
+		{"Invalid - rejected by the scheduler manager", rejectedJob.Name, valid, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.UpsertEventTrigger, http.MethodPut, constants.ApiScheduleJobEventTriggerRoute, testCase.jobName, testCase.request)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+		})
+	}
+	schedulerManagerMock.AssertNumberOfCalls(t, "UpdateScheduleJob", 1)
+	// the event trigger rejected by the scheduler manager is rolled back
+	dbClientMock.AssertCalled(t, "DeleteEventTriggerByJobName", context.Background(), rejectedJob.Name)
+}
+
+func TestEventTriggerByJobName(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+	trigger := dtos.ToEventTriggerModel(job.Name, eventTriggerRequestData().EventTrigger)
+
+	dic, dbClientMock, _ := mockJobSettingDic(job)
+	dbClientMock.On("EventTriggerByJobName", context.Background(), job.Name).Return(trigger, nil)
+	dbClientMock.On("EventTriggerByJobName", context.Background(), notFoundJobName).Return(schedulerModels.EventTrigger{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "event trigger doesn't exist in the database", nil))
+
+	controller := NewEventTriggerController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		expectedStatusCode int
+	}{
+		{"Valid - event trigger by job name", job.Name, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - event trigger not found by job name", notFoundJobName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.EventTriggerByJobName, http.MethodGet, constants.ApiScheduleJobEventTriggerRoute, testCase.jobName, nil)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res responses.EventTriggerResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, dtos.FromEventTriggerModelToDTO(trigger), res.EventTrigger, "Event trigger not as expected")
+			}
+		})
+	}
+}
+
+func TestDeleteEventTriggerByJobName(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+
+	dic, dbClientMock, schedulerManagerMock := mockJobSettingDic(job)
+	dbClientMock.On("DeleteEventTriggerByJobName", context.Background(), job.Name).Return(nil)
+	schedulerManagerMock.On("DeleteEventTriggerByJobName", job.Name, testCorrelationID).Return(nil)
+
+	controller := NewEventTriggerController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		expectedStatusCode int
+	}{
+		{"Valid - delete event trigger by job name", job.Name, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - scheduled job not found by name", notFoundJobName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.DeleteEventTriggerByJobName, http.MethodDelete, constants.ApiScheduleJobEventTriggerRoute, testCase.jobName, nil)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application"
+	schedulerContainer "github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/responses"
+)
+
+type RetryPolicyController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewRetryPolicyController creates and initializes a RetryPolicyController
+func NewRetryPolicyController(dic *di.Container) *RetryPolicyController {
+	return &RetryPolicyController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// UpsertRetryPolicy sets the retry policy of the ScheduleJob specified by name
+func (pc *RetryPolicyController) UpsertRetryPolicy(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(pc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	var reqDTO requests.RetryPolicyRequest
+	err := pc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	err = application.UpsertRetryPolicy(ctx, name, reqDTO.RetryPolicy, pc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, reqDTO.RequestId)
+	}
+
+	response := commonDTO.NewBaseResponse(reqDTO.RequestId, "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// RetryPolicyByJobName returns the retry policy of the ScheduleJob specified by name
+func (pc *RetryPolicyController) RetryPolicyByJobName(c echo.Context) error {
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	policy, err := application.RetryPolicyByJobName(ctx, name, pc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewRetryPolicyResponse("", "", http.StatusOK, policy)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteRetryPolicyByJobName deletes the retry policy of the ScheduleJob specified by name
+func (pc *RetryPolicyController) DeleteRetryPolicyByJobName(c echo.Context) error {
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteRetryPolicyByJobName(ctx, name, pc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// ScheduleActionAttemptsByJobName returns the schedule action records with attempt numbers of the ScheduleJob
+// specified by name
+func (pc *RetryPolicyController) ScheduleActionAttemptsByJobName(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(pc.dic.Get)
+	config := schedulerContainer.ConfigurationFrom(pc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	// Parse time range (start, end), offset, and limit from incoming request
+	start, end, offset, limit, err := utils.ParseQueryStringTimeRangeOffsetLimit(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	attempts, totalCount, err := application.ScheduleActionAttemptsByJobName(ctx, name, start, end, offset, limit, pc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiScheduleActionAttemptsResponse("", "", http.StatusOK, totalCount, attempts)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
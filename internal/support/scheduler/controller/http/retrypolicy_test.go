@@ -0,0 +1,245 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/responses"
+	csMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+func retryPolicyRequestData() requests.RetryPolicyRequest {
+	return requests.RetryPolicyRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		RetryPolicy: dtos.RetryPolicy{
+			Actions: []dtos.ActionRetryPolicy{
+				{Action: 0, MaxAttempts: 3, InitialBackoff: "1s", MaxBackoff: "10s", BackoffMultiplier: 2, RetryableErrorKinds: []string{"ServiceUnavailable"}, Timeout: "30s"},
+				{Action: 1, Timeout: "5s"},
+			},
+		},
+	}
+}
+
+func TestUpsertRetryPolicy(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+	rejectedJob := job
+	rejectedJob.Name = rejectedJobName
+	valid := retryPolicyRequestData()
+	policy := dtos.ToRetryPolicyModel(job.Name, valid.RetryPolicy)
+	rejectedRetryPolicy := dtos.ToRetryPolicyModel(rejectedJob.Name, valid.RetryPolicy)
+
+	dic, dbClientMock, schedulerManagerMock := mockJobSettingDic(job, rejectedJob)
+	dbClientMock.On("RetryPolicyByJobName", context.Background(), mock.Anything).Return(schedulerModels.RetryPolicy{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "retry policy doesn't exist in the database", nil))
+	dbClientMock.On("UpsertRetryPolicy", context.Background(), mock.Anything).Return(nil)
+	dbClientMock.On("DeleteRetryPolicyByJobName", context.Background(), rejectedJob.Name).Return(nil)
+	schedulerManagerMock.On("UpsertRetryPolicy", policy, job, testCorrelationID).Return(nil)
+	unschedulableJob := job
+	unschedulableJob.Name = "unschedulableJobName"
+	unschedulableRetryPolicy := dtos.ToRetryPolicyModel(unschedulableJob.Name, valid.RetryPolicy)
+	dbClientMock.On("ScheduleJobByName", context.Background(), unschedulableJob.Name).Return(unschedulableJob, nil)
+	dbClientMock.On("DeleteRetryPolicyByJobName", context.Background(), unschedulableJob.Name).Return(nil)
+	schedulerManagerMock.On("UpsertRetryPolicy", unschedulableRetryPolicy, unschedulableJob, testCorrelationID).Return(nil)
+	schedulerManagerMock.On("UpdateScheduleJob", unschedulableJob, testCorrelationID).Return(errors.NewCommonEdgeX(errors.KindServerError, "fail to rearrange the job", nil))
+	schedulerManagerMock.On("DeleteRetryPolicyByJobName", unschedulableJob.Name, testCorrelationID).Return(nil)
+	schedulerManagerMock.On("UpsertRetryPolicy", rejectedRetryPolicy, rejectedJob, testCorrelationID).Return(errors.NewCommonEdgeX(errors.KindContractInvalid, "rejected by the scheduler manager", nil))
+
+	controller := NewRetryPolicyController(dic)
+	require.NotNil(t, controller)
+
+	noAction := retryPolicyRequestData()
+	noAction.RetryPolicy.Actions = nil
+	invalidMultiplier := retryPolicyRequestData()
+	invalidMultiplier.RetryPolicy.Actions[0].BackoffMultiplier = 0.5
+	invalidTimeout := retryPolicyRequestData()
+	invalidTimeout.RetryPolicy.Actions[0].Timeout = "abc"
+
+	tests := []struct {
+		name               string
+		jobName            string
+		request            requests.RetryPolicyRequest
+		expectedStatusCode int
+	}{
+		{"Valid - retry policy of the scheduled job", job.Name, valid, http.StatusOK},
+		{"Invalid - retry policy without action", job.Name, noAction, http.StatusBadRequest},
+		{"Invalid - backoff multiplier less than 1", job.Name, invalidMultiplier, http.StatusBadRequest},
+		{"Invalid - invalid timeout", job.Name, invalidTimeout, http.StatusBadRequest},
+		{"Invalid - scheduled job not found by name", notFoundJobName, valid, http.StatusNotFound},
+		{"Invalid - rejected by the scheduler manager", rejectedJob.Name, valid, http.StatusBadRequest},
+		{"Invalid - failed to rearrange the scheduled job", unschedulableJob.Name, valid, http.StatusInternalServerError},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.UpsertRetryPolicy, http.MethodPut, constants.ApiScheduleJobRetryPolicyRoute, testCase.jobName, testCase.request)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+		})
+	}
+	schedulerManagerMock.AssertNumberOfCalls(t, "UpdateScheduleJob", 2)
+	// the retry policy rejected by the scheduler manager is rolled back
+	dbClientMock.AssertCalled(t, "DeleteRetryPolicyByJobName", context.Background(), rejectedJob.Name)
+	// the retry policy of the job failed to rearrange is rolled back from both the scheduler manager and the database
+	schedulerManagerMock.AssertCalled(t, "DeleteRetryPolicyByJobName", unschedulableJob.Name, testCorrelationID)
+	dbClientMock.AssertCalled(t, "DeleteRetryPolicyByJobName", context.Background(), unschedulableJob.Name)
+}
+
+func TestRetryPolicyByJobName(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+	policy := dtos.ToRetryPolicyModel(job.Name, retryPolicyRequestData().RetryPolicy)
+
+	dic, dbClientMock, _ := mockJobSettingDic(job)
+	dbClientMock.On("RetryPolicyByJobName", context.Background(), job.Name).Return(policy, nil)
+	dbClientMock.On("RetryPolicyByJobName", context.Background(), notFoundJobName).Return(schedulerModels.RetryPolicy{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "retry policy doesn't exist in the database", nil))
+
+	controller := NewRetryPolicyController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		expectedStatusCode int
+	}{
+		{"Valid - retry policy by job name", job.Name, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - retry policy not found by job name", notFoundJobName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.RetryPolicyByJobName, http.MethodGet, constants.ApiScheduleJobRetryPolicyRoute, testCase.jobName, nil)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res responses.RetryPolicyResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, dtos.FromRetryPolicyModelToDTO(policy), res.RetryPolicy, "RetryPolicy not as expected")
+			}
+		})
+	}
+}
+
+func TestDeleteRetryPolicyByJobName(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+
+	rejectedJob := job
+	rejectedJob.Name = rejectedJobName
+	policy := dtos.ToRetryPolicyModel(job.Name, retryPolicyRequestData().RetryPolicy)
+	rejectedRetryPolicy := dtos.ToRetryPolicyModel(rejectedJob.Name, retryPolicyRequestData().RetryPolicy)
+
+	dic, dbClientMock, schedulerManagerMock := mockJobSettingDic(job, rejectedJob)
+	dbClientMock.On("RetryPolicyByJobName", context.Background(), job.Name).Return(policy, nil)
+	dbClientMock.On("RetryPolicyByJobName", context.Background(), rejectedJob.Name).Return(rejectedRetryPolicy, nil)
+	dbClientMock.On("DeleteRetryPolicyByJobName", context.Background(), mock.Anything).Return(nil)
+	dbClientMock.On("UpsertRetryPolicy", context.Background(), rejectedRetryPolicy).Return(nil)
+	schedulerManagerMock.On("DeleteRetryPolicyByJobName", job.Name, testCorrelationID).Return(nil)
+	schedulerManagerMock.On("DeleteRetryPolicyByJobName", rejectedJob.Name, testCorrelationID).Return(errors.NewCommonEdgeX(errors.KindServerError, "fail to remove the retry policy", nil))
+
+	controller := NewRetryPolicyController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		expectedStatusCode int
+	}{
+		{"Valid - delete retry policy by job name", job.Name, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - scheduled job not found by name", notFoundJobName, http.StatusNotFound},
+		{"Invalid - rejected by the scheduler manager", rejectedJob.Name, http.StatusInternalServerError},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.DeleteRetryPolicyByJobName, http.MethodDelete, constants.ApiScheduleJobRetryPolicyRoute, testCase.jobName, nil)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+		})
+	}
+	// the retry policy is deleted from the database first and restored once the scheduler manager fails to remove it
+	dbClientMock.AssertCalled(t, "UpsertRetryPolicy", context.Background(), rejectedRetryPolicy)
+}
+
+func TestScheduleActionAttemptsByJobName(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+	attempts := []schedulerModels.ScheduleActionAttempt{
+		{Record: models.ScheduleActionRecord{JobName: job.Name, Action: job.Actions[0], Status: models.Succeeded, ScheduledAt: 1000, Created: 1003}, Attempt: 2, MaxAttempts: 3},
+		{Record: models.ScheduleActionRecord{JobName: job.Name, Action: job.Actions[0], Status: models.Failed, ScheduledAt: 1000, Created: 1001}, Attempt: 1, MaxAttempts: 3},
+	}
+
+	dic := mockDic()
+	dbClientMock := &csMock.DBClient{}
+	dbClientMock.On("ScheduleActionAttemptCountByJobName", context.Background(), job.Name, int64(0), mock.AnythingOfType("int64")).Return(int64(len(attempts)), nil)
+	dbClientMock.On("ScheduleActionAttemptsByJobName", context.Background(), job.Name, int64(0), mock.AnythingOfType("int64"), 0, 20).Return(attempts, nil)
+	dbClientMock.On("ScheduleActionAttemptsByJobName", context.Background(), job.Name, int64(0), mock.AnythingOfType("int64"), 1, 1).Return(attempts[1:], nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) any {
+			return dbClientMock
+		},
+	})
+
+	controller := NewRetryPolicyController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		offset             string
+		limit              string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - schedule action attempts by job name", job.Name, "0", "20", 2, http.StatusOK},
+		{"Valid - schedule action attempts with offset and limit", job.Name, "1", "1", 1, http.StatusOK},
+		{"Invalid - offset out of range", job.Name, "3", "20", 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - name parameter is empty", "", "0", "20", 0, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiScheduleJobAttemptRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(common.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.jobName)
+			err = controller.ScheduleActionAttemptsByJobName(c)
+			require.NoError(t, err)
+			var res responses.MultiScheduleActionAttemptsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, int64(2), res.TotalCount, "Response total count not as expected")
+				assert.Len(t, res.ScheduleActionAttempts, testCase.expectedCount, "Schedule action attempt count not as expected")
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -339,6 +339,14 @@ func TestDeleteScheduleJobByName(t *testing.T) {
 	schedulerManagerMock := &csMock.SchedulerManager{}
 	dbClientMock.On("DeleteScheduleJobByName", context.Background(), job.Name).Return(nil)
 	schedulerManagerMock.On("DeleteScheduleJobByName", job.Name, testCorrelationID).Return(nil)
+	dbClientMock.On("DeleteEventTriggerByJobName", context.Background(), job.Name).Return(nil)
+	schedulerManagerMock.On("DeleteEventTriggerByJobName", job.Name, testCorrelationID).Return(nil)
+	dbClientMock.On("DeleteWorkflowByJobName", context.Background(), job.Name).Return(nil)
+	schedulerManagerMock.On("DeleteWorkflowByJobName", job.Name, testCorrelationID).Return(nil)
+	dbClientMock.On("DeleteRetryPolicyByJobName", context.Background(), job.Name).Return(nil)
+	schedulerManagerMock.On("DeleteRetryPolicyByJobName", job.Name, testCorrelationID).Return(nil)
+	dbClientMock.On("DeleteConcurrencyPolicyByJobName", context.Background(), job.Name).Return(nil)
+	schedulerManagerMock.On("DeleteConcurrencyPolicyByJobName", job.Name, testCorrelationID).Return(nil)
 	schedulerManagerMock.On("DeleteScheduleJobByName", noName, testCorrelationID).Return(errors.NewCommonEdgeX(errors.KindContractInvalid, "scheduled job name is required", nil))
 	schedulerManagerMock.On("DeleteScheduleJobByName", notFoundName, testCorrelationID).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "scheduled job doesn't exist in the scheduler manager", nil))
 	dic.Update(di.ServiceConstructorMap{
@@ -6,10 +6,27 @@
 package http
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
 
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	csMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
 )
 
 const (
@@ -18,6 +35,8 @@ const (
 	testScheduleJobName = "jobName"
 	testStatus          = "SUCCEEDED"
 	testTimestamp       = 1723642440000
+	notFoundJobName     = "notFoundName"
+	rejectedJobName     = "rejectedJobName"
 )
 
 var (
@@ -49,3 +68,57 @@ var (
 		},
 	}
 )
+
+// mockJobSettingDic returns the container with the DB client and the scheduler manager mocks for the tests of the
+// schedule job settings, the jobs are found by their names and the job of notFoundJobName doesn't exist
+func mockJobSettingDic(jobs ...models.ScheduleJob) (*di.Container, *csMock.DBClient, *csMock.SchedulerManager) {
+	dic := mockDic()
+	dbClientMock := &csMock.DBClient{}
+	schedulerManagerMock := &csMock.SchedulerManager{}
+	for _, job := range jobs {
+		dbClientMock.On("ScheduleJobByName", context.Background(), job.Name).Return(job, nil)
+		schedulerManagerMock.On("UpdateScheduleJob", job, testCorrelationID).Return(nil)
+	}
+	dbClientMock.On("ScheduleJobByName", context.Background(), notFoundJobName).Return(models.ScheduleJob{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "scheduled job doesn't exist in the database", nil))
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) any {
+			return dbClientMock
+		},
+		container.SchedulerManagerName: func(get di.Get) any {
+			return schedulerManagerMock
+		},
+	})
+	return dic, dbClientMock, schedulerManagerMock
+}
+
+// executeJobSettingRequest executes the handler of the schedule job setting route with the job name, the request is
+// sent as the JSON body if it isn't nil
+func executeJobSettingRequest(t *testing.T, handler echo.HandlerFunc, method, route, jobName string, request any) *httptest.ResponseRecorder {
+	var body io.Reader = http.NoBody
+	if request != nil {
+		jsonData, err := json.Marshal(request)
+		require.NoError(t, err)
+		body = strings.NewReader(string(jsonData))
+	}
+	req, err := http.NewRequest(method, route, body)
+	require.NoError(t, err)
+
+	recorder := httptest.NewRecorder()
+	c := echo.New().NewContext(req, recorder)
+	c.SetParamNames(common.Name)
+	c.SetParamValues(jobName)
+	err = handler(c)
+	require.NoError(t, err)
+	return recorder
+}
+
+// assertBaseResponse asserts the API version and the status code of the response
+func assertBaseResponse(t *testing.T, recorder *httptest.ResponseRecorder, expectedStatusCode int) {
+	var res commonDTO.BaseResponse
+	err := json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+
+	assert.Equal(t, common.ApiVersion, res.ApiVersion, "API Version not as expected")
+	assert.Equal(t, expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, expectedStatusCode, res.StatusCode, "Response status code not as expected")
+}
@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/io"
+	"github.com/edgexfoundry/edgex-go/internal/pkg"
+	"github.com/edgexfoundry/edgex-go/internal/pkg/utils"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application"
+	schedulerContainer "github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/responses"
+)
+
+type WorkflowController struct {
+	reader io.DtoReader
+	dic    *di.Container
+}
+
+// NewWorkflowController creates and initializes a WorkflowController
+func NewWorkflowController(dic *di.Container) *WorkflowController {
+	return &WorkflowController{
+		reader: io.NewJsonDtoReader(),
+		dic:    dic,
+	}
+}
+
+// UpsertWorkflow sets the workflow of the ScheduleJob specified by name
+func (wc *WorkflowController) UpsertWorkflow(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+	if r.Body != nil {
+		defer func() { _ = r.Body.Close() }()
+	}
+
+	lc := container.LoggingClientFrom(wc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	var reqDTO requests.WorkflowRequest
+	err := wc.reader.Read(r.Body, &reqDTO)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	err = application.UpsertWorkflow(ctx, name, reqDTO.Workflow, wc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, reqDTO.RequestId)
+	}
+
+	response := commonDTO.NewBaseResponse(reqDTO.RequestId, "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// WorkflowByJobName returns the workflow of the ScheduleJob specified by name
+func (wc *WorkflowController) WorkflowByJobName(c echo.Context) error {
+	lc := container.LoggingClientFrom(wc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	workflow, err := application.WorkflowByJobName(ctx, name, wc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewWorkflowResponse("", "", http.StatusOK, workflow)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// DeleteWorkflowByJobName deletes the workflow of the ScheduleJob specified by name
+func (wc *WorkflowController) DeleteWorkflowByJobName(c echo.Context) error {
+	lc := container.LoggingClientFrom(wc.dic.Get)
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	err := application.DeleteWorkflowByJobName(ctx, name, wc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := commonDTO.NewBaseResponse("", "", http.StatusOK)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
+
+// WorkflowRuns returns the run history of the workflow of the ScheduleJob specified by name
+func (wc *WorkflowController) WorkflowRuns(c echo.Context) error {
+	r := c.Request()
+	w := c.Response()
+	ctx := r.Context()
+
+	lc := container.LoggingClientFrom(wc.dic.Get)
+	config := schedulerContainer.ConfigurationFrom(wc.dic.Get)
+
+	// URL parameters
+	name := c.Param(common.Name)
+
+	// Parse time range (start, end), offset, and limit from incoming request
+	start, end, offset, limit, err := utils.ParseQueryStringTimeRangeOffsetLimit(c, 0, math.MaxInt32, -1, config.Service.MaxResultCount)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+	runs, totalCount, err := application.WorkflowRuns(ctx, name, start, end, offset, limit, wc.dic)
+	if err != nil {
+		return utils.WriteErrorResponse(w, ctx, lc, err, "")
+	}
+
+	response := responses.NewMultiWorkflowRunsResponse("", "", http.StatusOK, totalCount, runs)
+	utils.WriteHttpHeader(w, ctx, http.StatusOK)
+	return pkg.EncodeAndWriteResponse(response, w, lc)
+}
@@ -0,0 +1,234 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/constants"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos/responses"
+	csMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+func workflowRequestData() requests.WorkflowRequest {
+	return requests.WorkflowRequest{
+		BaseRequest: commonDTO.NewBaseRequest(),
+		Workflow: dtos.Workflow{
+			Steps: []dtos.WorkflowStep{
+				{Name: "collect", Action: 0, MaxAttempts: 3, RetryInterval: "10s", Timeout: "30s"},
+				{Name: "notify", Action: 1, DependsOn: []dtos.WorkflowDependency{{Step: "collect", Condition: schedulerModels.ConditionFailed}}},
+			},
+		},
+	}
+}
+
+func TestUpsertWorkflow(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+	rejectedJob := job
+	rejectedJob.Name = rejectedJobName
+	valid := workflowRequestData()
+	workflow := dtos.ToWorkflowModel(job.Name, valid.Workflow)
+	rejectedWorkflow := dtos.ToWorkflowModel(rejectedJob.Name, valid.Workflow)
+
+	dic, dbClientMock, schedulerManagerMock := mockJobSettingDic(job, rejectedJob)
+	dbClientMock.On("WorkflowByJobName", context.Background(), mock.Anything).Return(schedulerModels.Workflow{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "workflow doesn't exist in the database", nil))
+	dbClientMock.On("UpsertWorkflow", context.Background(), mock.Anything).Return(nil)
+	dbClientMock.On("DeleteWorkflowByJobName", context.Background(), rejectedJob.Name).Return(nil)
+	schedulerManagerMock.On("UpsertWorkflow", workflow, job, testCorrelationID).Return(nil)
+	schedulerManagerMock.On("UpsertWorkflow", rejectedWorkflow, rejectedJob, testCorrelationID).Return(errors.NewCommonEdgeX(errors.KindContractInvalid, "rejected by the scheduler manager", nil))
+
+	controller := NewWorkflowController(dic)
+	require.NotNil(t, controller)
+
+	noStep := workflowRequestData()
+	noStep.Workflow.Steps = nil
+	invalidCondition := workflowRequestData()
+	invalidCondition.Workflow.Steps[1].DependsOn[0].Condition = "SKIPPED"
+	invalidTimeout := workflowRequestData()
+	invalidTimeout.Workflow.Steps[0].Timeout = "abc"
+
+	tests := []struct {
+		name               string
+		jobName            string
+		request            requests.WorkflowRequest
+		expectedStatusCode int
+	}{
+		{"Valid - workflow of the scheduled job", job.Name, valid, http.StatusOK},
+		{"Invalid - workflow without step", job.Name, noStep, http.StatusBadRequest},
+		{"Invalid - unsupported dependency condition", job.Name, invalidCondition, http.StatusBadRequest},
+		{"Invalid - invalid timeout", job.Name, invalidTimeout, http.StatusBadRequest},
+		{"Invalid - scheduled job not found by name", notFoundJobName, valid, http.StatusNotFound},
+		{"Invalid - rejected by the scheduler manager", rejectedJob.Name, valid, http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.UpsertWorkflow, http.MethodPut, constants.ApiScheduleJobWorkflowRoute, testCase.jobName, testCase.request)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+		})
+	}
+	schedulerManagerMock.AssertNumberOfCalls(t, "UpdateScheduleJob", 1)
+	// the workflow rejected by the scheduler manager is rolled back
+	dbClientMock.AssertCalled(t, "DeleteWorkflowByJobName", context.Background(), rejectedJob.Name)
+}
+
+func TestWorkflowByJobName(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+	workflow := dtos.ToWorkflowModel(job.Name, workflowRequestData().Workflow)
+
+	dic, dbClientMock, _ := mockJobSettingDic(job)
+	dbClientMock.On("WorkflowByJobName", context.Background(), job.Name).Return(workflow, nil)
+	dbClientMock.On("WorkflowByJobName", context.Background(), notFoundJobName).Return(schedulerModels.Workflow{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "workflow doesn't exist in the database", nil))
+
+	controller := NewWorkflowController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		expectedStatusCode int
+	}{
+		{"Valid - workflow by job name", job.Name, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - workflow not found by job name", notFoundJobName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.WorkflowByJobName, http.MethodGet, constants.ApiScheduleJobWorkflowRoute, testCase.jobName, nil)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+			if testCase.expectedStatusCode == http.StatusOK {
+				var res responses.WorkflowResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &res)
+				require.NoError(t, err)
+				assert.Equal(t, dtos.FromWorkflowModelToDTO(workflow), res.Workflow, "Workflow not as expected")
+			}
+		})
+	}
+}
+
+func TestDeleteWorkflowByJobName(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+
+	dic, dbClientMock, schedulerManagerMock := mockJobSettingDic(job)
+	dbClientMock.On("DeleteWorkflowByJobName", context.Background(), job.Name).Return(nil)
+	schedulerManagerMock.On("DeleteWorkflowByJobName", job.Name, testCorrelationID).Return(nil)
+
+	controller := NewWorkflowController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		expectedStatusCode int
+	}{
+		{"Valid - delete workflow by job name", job.Name, http.StatusOK},
+		{"Invalid - name parameter is empty", "", http.StatusBadRequest},
+		{"Invalid - scheduled job not found by name", notFoundJobName, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := executeJobSettingRequest(t, controller.DeleteWorkflowByJobName, http.MethodDelete, constants.ApiScheduleJobWorkflowRoute, testCase.jobName, nil)
+			assertBaseResponse(t, recorder, testCase.expectedStatusCode)
+		})
+	}
+}
+
+func TestWorkflowRuns(t *testing.T) {
+	job := dtos.ToScheduleJobModel(addScheduleJobRequestData().ScheduleJob)
+	notFoundName := "notFoundName"
+	actionIds := []string{uuid.NewString(), uuid.NewString()}
+	for i, actionId := range actionIds {
+		job.Actions[i] = job.Actions[i].WithId(actionId)
+	}
+	workflow := dtos.ToWorkflowModel(job.Name, workflowRequestData().Workflow)
+	records := []models.ScheduleActionRecord{
+		{JobName: job.Name, Action: job.Actions[0], Status: models.Failed, ScheduledAt: 2000, Created: 2001},
+		{JobName: job.Name, Action: job.Actions[1], Status: models.Succeeded, ScheduledAt: 2000, Created: 2002},
+		{JobName: job.Name, Action: job.Actions[0], Status: models.Succeeded, ScheduledAt: 1000, Created: 1001},
+		{JobName: job.Name, Action: job.Actions[1], Status: schedulerModels.Skipped, ScheduledAt: 1000, Created: 1002},
+	}
+
+	dic := mockDic()
+	dbClientMock := &csMock.DBClient{}
+	dbClientMock.On("WorkflowByJobName", context.Background(), job.Name).Return(workflow, nil)
+	dbClientMock.On("WorkflowByJobName", context.Background(), notFoundName).Return(schedulerModels.Workflow{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "workflow doesn't exist in the database", nil))
+	dbClientMock.On("ScheduleJobByName", context.Background(), job.Name).Return(job, nil)
+	dbClientMock.On("WorkflowRunCountByJobName", context.Background(), job.Name, actionIds, int64(0), mock.AnythingOfType("int64")).Return(int64(2), nil)
+	dbClientMock.On("WorkflowRunRecordsByJobName", context.Background(), job.Name, actionIds, int64(0), mock.AnythingOfType("int64"), 0, 20).Return(records, nil)
+	dbClientMock.On("WorkflowRunRecordsByJobName", context.Background(), job.Name, actionIds, int64(0), mock.AnythingOfType("int64"), 1, 1).Return(records[2:], nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) any {
+			return dbClientMock
+		},
+	})
+
+	controller := NewWorkflowController(dic)
+	require.NotNil(t, controller)
+
+	tests := []struct {
+		name               string
+		jobName            string
+		offset             string
+		limit              string
+		expectedCount      int
+		expectedStatusCode int
+	}{
+		{"Valid - workflow runs by job name", job.Name, "0", "20", 2, http.StatusOK},
+		{"Valid - workflow runs with offset and limit", job.Name, "1", "1", 1, http.StatusOK},
+		{"Invalid - offset out of range", job.Name, "3", "20", 0, http.StatusRequestedRangeNotSatisfiable},
+		{"Invalid - name parameter is empty", "", "0", "20", 0, http.StatusBadRequest},
+		{"Invalid - workflow not found by job name", notFoundName, "0", "20", 0, http.StatusNotFound},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			e := echo.New()
+			req, err := http.NewRequest(http.MethodGet, constants.ApiScheduleJobWorkflowRunRoute, http.NoBody)
+			require.NoError(t, err)
+			query := req.URL.Query()
+			query.Add(common.Offset, testCase.offset)
+			query.Add(common.Limit, testCase.limit)
+			req.URL.RawQuery = query.Encode()
+
+			// Act
+			recorder := httptest.NewRecorder()
+			c := e.NewContext(req, recorder)
+			c.SetParamNames(common.Name)
+			c.SetParamValues(testCase.jobName)
+			err = controller.WorkflowRuns(c)
+			require.NoError(t, err)
+			var res responses.MultiWorkflowRunsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedStatusCode, res.StatusCode, "Response status code not as expected")
+			if testCase.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, int64(2), res.TotalCount, "Response total count not as expected")
+				assert.Len(t, res.WorkflowRuns, testCase.expectedCount, "Workflow run count not as expected")
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package messaging
+
+import (
+	"context"
+	"strings"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+	"github.com/edgexfoundry/go-mod-messaging/v4/pkg/types"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// SubscribeEventTriggerEvents subscribes the system events published by core-metadata, the events published by the
+// device services and the key changes published by core-keeper, and fires the event triggers of the schedule jobs
+// matching the received events
+func SubscribeEventTriggerEvents(ctx context.Context, dic *di.Container) errors.EdgeX {
+	lc := bootstrapContainer.LoggingClientFrom(dic.Get)
+	configuration := container.ConfigurationFrom(dic.Get)
+	schedulerManager := container.SchedulerManagerFrom(dic.Get)
+	baseTopicPrefix := configuration.MessageBus.GetBaseTopicPrefix()
+
+	messageBus := bootstrapContainer.MessagingClientFrom(dic.Get)
+	if messageBus == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "message bus client is not available to subscribe the events of the event triggers", nil)
+	}
+
+	// system event topic scheme: edgex/system-events/core-metadata/<type>/<action>/<owner>/...
+	systemEventTopic := common.NewPathBuilder().EnableNameFieldEscape(configuration.Service.EnableNameFieldEscape).
+		SetPath(baseTopicPrefix).SetPath(common.SystemEventPublishTopic).SetPath(common.CoreMetaDataServiceKey).
+		SetPath("#").BuildPath()
+	dataEventTopic := common.BuildTopic(baseTopicPrefix, common.CoreDataEventSubscribeTopic)
+	keeperTopic := common.BuildTopic(configuration.EventTrigger.KeeperTopicPrefix, "#")
+
+	systemEvents := make(chan types.MessageEnvelope, 1)
+	dataEvents := make(chan types.MessageEnvelope, 1)
+	keyChanges := make(chan types.MessageEnvelope, 1)
+	messageErrors := make(chan error, 1)
+	topics := []types.TopicChannel{
+		{Topic: systemEventTopic, Messages: systemEvents},
+		{Topic: dataEventTopic, Messages: dataEvents},
+		{Topic: keeperTopic, Messages: keyChanges},
+	}
+
+	err := messageBus.Subscribe(topics, messageErrors)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	for _, t := range topics {
+		lc.Infof("Subscribed to topics: %s", t.Topic)
+	}
+
+	go func() {
+		for {
+			var event schedulerModels.EventTriggerEvent
+			var err error
+			select {
+			case <-ctx.Done():
+				lc.Info("Exiting waiting for MessageBus event trigger messages")
+				return
+			case e := <-messageErrors:
+				lc.Error(e.Error())
+				continue
+			case msgEnvelope := <-systemEvents:
+				event, err = toSystemEventTriggerEvent(msgEnvelope)
+			case msgEnvelope := <-dataEvents:
+				event, err = toDataEventTriggerEvent(msgEnvelope)
+			case msgEnvelope := <-keyChanges:
+				event, err = toKeeperKeyTriggerEvent(msgEnvelope, configuration.EventTrigger.KeeperTopicPrefix)
+			}
+			if err != nil {
+				lc.Errorf("failed to decode the message of the event triggers: %v", err)
+				continue
+			}
+			schedulerManager.FireEventTriggers(event)
+		}
+	}()
+
+	return nil
+}
+
+// toSystemEventTriggerEvent decodes the system event, the name of the object is taken from the event details
+func toSystemEventTriggerEvent(msgEnvelope types.MessageEnvelope) (schedulerModels.EventTriggerEvent, error) {
+	systemEvent, err := types.GetMsgPayload[dtos.SystemEvent](msgEnvelope)
+	if err != nil {
+		return schedulerModels.EventTriggerEvent{}, err
+	}
+	// the details of the device, device profile, device service and provision watcher system events all have the name
+	var details struct {
+		Name string
+	}
+	if err = systemEvent.DecodeDetails(&details); err != nil {
+		return schedulerModels.EventTriggerEvent{}, err
+	}
+	return schedulerModels.EventTriggerEvent{
+		Type:              schedulerModels.TriggerSystemEvent,
+		SystemEventType:   systemEvent.Type,
+		SystemEventAction: systemEvent.Action,
+		Name:              details.Name,
+	}, nil
+}
+
+// toDataEventTriggerEvent decodes the AddEventRequest published by the device services
+func toDataEventTriggerEvent(msgEnvelope types.MessageEnvelope) (schedulerModels.EventTriggerEvent, error) {
+	request, err := types.GetMsgPayload[requests.AddEventRequest](msgEnvelope)
+	if err != nil {
+		return schedulerModels.EventTriggerEvent{}, err
+	}
+	resourceNames := make([]string, len(request.Event.Readings))
+	for i, reading := range request.Event.Readings {
+		resourceNames[i] = reading.ResourceName
+	}
+	return schedulerModels.EventTriggerEvent{
+		Type:          schedulerModels.TriggerDataEvent,
+		DeviceName:    request.Event.DeviceName,
+		ResourceNames: resourceNames,
+	}, nil
+}
+
+// toKeeperKeyTriggerEvent decodes the key change published by core-keeper, the key is taken from the topic if the
+// payload doesn't carry it
+func toKeeperKeyTriggerEvent(msgEnvelope types.MessageEnvelope, keeperTopicPrefix string) (schedulerModels.EventTriggerEvent, error) {
+	kv, err := types.GetMsgPayload[models.KVS](msgEnvelope)
+	if err != nil {
+		return schedulerModels.EventTriggerEvent{}, err
+	}
+	key := kv.Key
+	if key == "" {
+		key = strings.TrimPrefix(msgEnvelope.ReceivedTopic, keeperTopicPrefix+"/")
+	}
+	return schedulerModels.EventTriggerEvent{
+		Type: schedulerModels.TriggerKeeperKey,
+		Key:  key,
+	}, nil
+}
@@ -0,0 +1,36 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// ConcurrencyPolicy and its properties are defined in the APIv3 specification:
+// openapi/support-scheduler.yaml
+type ConcurrencyPolicy struct {
+	JobName       string `json:"jobName,omitempty"`
+	Mode          string `json:"mode" validate:"required,oneof='ALLOW' 'FORBID' 'REPLACE' 'QUEUE'"`
+	MaxQueueDepth int    `json:"maxQueueDepth,omitempty" validate:"gte=0"`
+}
+
+// ToConcurrencyPolicyModel transforms the ConcurrencyPolicy DTO of the schedule job to the ConcurrencyPolicy model
+func ToConcurrencyPolicyModel(jobName string, dto ConcurrencyPolicy) models.ConcurrencyPolicy {
+	return models.ConcurrencyPolicy{
+		JobName:       jobName,
+		Mode:          dto.Mode,
+		MaxQueueDepth: dto.MaxQueueDepth,
+	}
+}
+
+// FromConcurrencyPolicyModelToDTO transforms the ConcurrencyPolicy model to the ConcurrencyPolicy DTO
+func FromConcurrencyPolicyModelToDTO(policy models.ConcurrencyPolicy) ConcurrencyPolicy {
+	return ConcurrencyPolicy{
+		JobName:       policy.JobName,
+		Mode:          policy.Mode,
+		MaxQueueDepth: policy.MaxQueueDepth,
+	}
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// EventTrigger and its properties are defined in the APIv3 specification:
+// openapi/support-scheduler.yaml
+type EventTrigger struct {
+	JobName            string   `json:"jobName,omitempty"`
+	Type               string   `json:"type" validate:"required,oneof='SYSTEM_EVENT' 'DATA_EVENT' 'KEEPER_KEY'"`
+	SystemEventTypes   []string `json:"systemEventTypes,omitempty"`
+	SystemEventActions []string `json:"systemEventActions,omitempty"`
+	Names              []string `json:"names,omitempty"`
+	DeviceNames        []string `json:"deviceNames,omitempty"`
+	ResourceNames      []string `json:"resourceNames,omitempty"`
+	KeyPrefix          string   `json:"keyPrefix,omitempty"`
+	Debounce           string   `json:"debounce,omitempty" validate:"omitempty,edgex-dto-duration"`
+	Cooldown           string   `json:"cooldown,omitempty" validate:"omitempty,edgex-dto-duration"`
+	EventOnly          bool     `json:"eventOnly,omitempty"`
+}
+
+// ToEventTriggerModel transforms the EventTrigger DTO of the schedule job to the EventTrigger model
+func ToEventTriggerModel(jobName string, dto EventTrigger) models.EventTrigger {
+	return models.EventTrigger{
+		JobName:            jobName,
+		Type:               dto.Type,
+		SystemEventTypes:   dto.SystemEventTypes,
+		SystemEventActions: dto.SystemEventActions,
+		Names:              dto.Names,
+		DeviceNames:        dto.DeviceNames,
+		ResourceNames:      dto.ResourceNames,
+		KeyPrefix:          dto.KeyPrefix,
+		Debounce:           dto.Debounce,
+		Cooldown:           dto.Cooldown,
+		EventOnly:          dto.EventOnly,
+	}
+}
+
+// FromEventTriggerModelToDTO transforms the EventTrigger model to the EventTrigger DTO
+func FromEventTriggerModelToDTO(trigger models.EventTrigger) EventTrigger {
+	return EventTrigger{
+		JobName:            trigger.JobName,
+		Type:               trigger.Type,
+		SystemEventTypes:   trigger.SystemEventTypes,
+		SystemEventActions: trigger.SystemEventActions,
+		Names:              trigger.Names,
+		DeviceNames:        trigger.DeviceNames,
+		ResourceNames:      trigger.ResourceNames,
+		KeyPrefix:          trigger.KeyPrefix,
+		Debounce:           trigger.Debounce,
+		Cooldown:           trigger.Cooldown,
+		EventOnly:          trigger.EventOnly,
+	}
+}
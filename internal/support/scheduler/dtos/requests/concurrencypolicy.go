@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// ConcurrencyPolicyRequest defines the Request Content for PUT ConcurrencyPolicy DTO.
+type ConcurrencyPolicyRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	ConcurrencyPolicy     dtos.ConcurrencyPolicy `json:"concurrencyPolicy"`
+}
+
+// Validate satisfies the Validator interface
+func (cr ConcurrencyPolicyRequest) Validate() error {
+	err := common.Validate(cr)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the ConcurrencyPolicyRequest type
+func (cr *ConcurrencyPolicyRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		ConcurrencyPolicy dtos.ConcurrencyPolicy
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*cr = ConcurrencyPolicyRequest(alias)
+
+	// validate ConcurrencyPolicyRequest DTO
+	if err := cr.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
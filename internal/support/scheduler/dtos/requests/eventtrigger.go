@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// EventTriggerRequest defines the Request Content for PUT EventTrigger DTO.
+type EventTriggerRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	EventTrigger          dtos.EventTrigger `json:"eventTrigger"`
+}
+
+// Validate satisfies the Validator interface
+func (et EventTriggerRequest) Validate() error {
+	err := common.Validate(et)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the EventTriggerRequest type
+func (et *EventTriggerRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		EventTrigger dtos.EventTrigger
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*et = EventTriggerRequest(alias)
+
+	// validate EventTriggerRequest DTO
+	if err := et.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// RetryPolicyRequest defines the Request Content for PUT RetryPolicy DTO.
+type RetryPolicyRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	RetryPolicy           dtos.RetryPolicy `json:"retryPolicy"`
+}
+
+// Validate satisfies the Validator interface
+func (rr RetryPolicyRequest) Validate() error {
+	err := common.Validate(rr)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the RetryPolicyRequest type
+func (rr *RetryPolicyRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		RetryPolicy dtos.RetryPolicy
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*rr = RetryPolicyRequest(alias)
+
+	// validate RetryPolicyRequest DTO
+	if err := rr.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// WorkflowRequest defines the Request Content for PUT Workflow DTO.
+type WorkflowRequest struct {
+	dtoCommon.BaseRequest `json:",inline"`
+	Workflow              dtos.Workflow `json:"workflow"`
+}
+
+// Validate satisfies the Validator interface
+func (wr WorkflowRequest) Validate() error {
+	err := common.Validate(wr)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the WorkflowRequest type
+func (wr *WorkflowRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		dtoCommon.BaseRequest
+		Workflow dtos.Workflow
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*wr = WorkflowRequest(alias)
+
+	// validate WorkflowRequest DTO
+	if err := wr.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
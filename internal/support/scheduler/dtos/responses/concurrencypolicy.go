@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// ConcurrencyPolicyResponse defines the Response Content for GET ConcurrencyPolicy DTO.
+type ConcurrencyPolicyResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	ConcurrencyPolicy      dtos.ConcurrencyPolicy `json:"concurrencyPolicy"`
+}
+
+func NewConcurrencyPolicyResponse(requestId string, message string, statusCode int, policy dtos.ConcurrencyPolicy) ConcurrencyPolicyResponse {
+	return ConcurrencyPolicyResponse{
+		BaseResponse:      dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		ConcurrencyPolicy: policy,
+	}
+}
@@ -0,0 +1,25 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// EventTriggerResponse defines the Response Content for GET EventTrigger DTO.
+type EventTriggerResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	EventTrigger           dtos.EventTrigger `json:"eventTrigger"`
+}
+
+func NewEventTriggerResponse(requestId string, message string, statusCode int, eventTrigger dtos.EventTrigger) EventTriggerResponse {
+	return EventTriggerResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		EventTrigger: eventTrigger,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// RetryPolicyResponse defines the Response Content for GET RetryPolicy DTO.
+type RetryPolicyResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	RetryPolicy            dtos.RetryPolicy `json:"retryPolicy"`
+}
+
+func NewRetryPolicyResponse(requestId string, message string, statusCode int, policy dtos.RetryPolicy) RetryPolicyResponse {
+	return RetryPolicyResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		RetryPolicy:  policy,
+	}
+}
+
+// MultiScheduleActionAttemptsResponse defines the Response Content for GET multiple ScheduleActionAttempt DTOs.
+type MultiScheduleActionAttemptsResponse struct {
+	dtoCommon.BaseWithTotalCountResponse `json:",inline"`
+	ScheduleActionAttempts               []dtos.ScheduleActionAttempt `json:"scheduleActionAttempts"`
+}
+
+func NewMultiScheduleActionAttemptsResponse(requestId string, message string, statusCode int, totalCount int64, attempts []dtos.ScheduleActionAttempt) MultiScheduleActionAttemptsResponse {
+	return MultiScheduleActionAttemptsResponse{
+		BaseWithTotalCountResponse: dtoCommon.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		ScheduleActionAttempts:     attempts,
+	}
+}
@@ -0,0 +1,38 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	dtoCommon "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/dtos"
+)
+
+// WorkflowResponse defines the Response Content for GET Workflow DTO.
+type WorkflowResponse struct {
+	dtoCommon.BaseResponse `json:",inline"`
+	Workflow               dtos.Workflow `json:"workflow"`
+}
+
+func NewWorkflowResponse(requestId string, message string, statusCode int, workflow dtos.Workflow) WorkflowResponse {
+	return WorkflowResponse{
+		BaseResponse: dtoCommon.NewBaseResponse(requestId, message, statusCode),
+		Workflow:     workflow,
+	}
+}
+
+// MultiWorkflowRunsResponse defines the Response Content for GET multiple WorkflowRun DTOs.
+type MultiWorkflowRunsResponse struct {
+	dtoCommon.BaseWithTotalCountResponse `json:",inline"`
+	WorkflowRuns                         []dtos.WorkflowRun `json:"workflowRuns"`
+}
+
+func NewMultiWorkflowRunsResponse(requestId string, message string, statusCode int, totalCount int64, runs []dtos.WorkflowRun) MultiWorkflowRunsResponse {
+	return MultiWorkflowRunsResponse{
+		BaseWithTotalCountResponse: dtoCommon.NewBaseWithTotalCountResponse(requestId, message, statusCode, totalCount),
+		WorkflowRuns:               runs,
+	}
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	coreDtos "github.com/edgexfoundry/go-mod-core-contracts/v4/dtos"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// RetryPolicy and its properties are defined in the APIv3 specification:
+// openapi/support-scheduler.yaml
+type RetryPolicy struct {
+	JobName string              `json:"jobName,omitempty"`
+	Actions []ActionRetryPolicy `json:"actions" validate:"required,gt=0,dive"`
+}
+
+// ActionRetryPolicy and its properties are defined in the APIv3 specification:
+// openapi/support-scheduler.yaml
+type ActionRetryPolicy struct {
+	Action              int      `json:"action" validate:"gte=0"`
+	MaxAttempts         int      `json:"maxAttempts,omitempty" validate:"gte=0"`
+	InitialBackoff      string   `json:"initialBackoff,omitempty" validate:"omitempty,edgex-dto-duration"`
+	MaxBackoff          string   `json:"maxBackoff,omitempty" validate:"omitempty,edgex-dto-duration"`
+	BackoffMultiplier   float64  `json:"backoffMultiplier,omitempty" validate:"omitempty,gte=1"`
+	RetryableErrorKinds []string `json:"retryableErrorKinds,omitempty"`
+	Timeout             string   `json:"timeout,omitempty" validate:"omitempty,edgex-dto-duration"`
+}
+
+// ScheduleActionAttempt and its properties are defined in the APIv3 specification:
+// openapi/support-scheduler.yaml
+type ScheduleActionAttempt struct {
+	coreDtos.ScheduleActionRecord `json:",inline"`
+	Attempt                       int `json:"attempt"`
+	MaxAttempts                   int `json:"maxAttempts"`
+}
+
+// ToRetryPolicyModel transforms the RetryPolicy DTO of the schedule job to the RetryPolicy model
+func ToRetryPolicyModel(jobName string, dto RetryPolicy) models.RetryPolicy {
+	actions := make([]models.ActionRetryPolicy, len(dto.Actions))
+	for i, a := range dto.Actions {
+		actions[i] = models.ActionRetryPolicy{
+			Action:              a.Action,
+			MaxAttempts:         a.MaxAttempts,
+			InitialBackoff:      a.InitialBackoff,
+			MaxBackoff:          a.MaxBackoff,
+			BackoffMultiplier:   a.BackoffMultiplier,
+			RetryableErrorKinds: a.RetryableErrorKinds,
+			Timeout:             a.Timeout,
+		}
+	}
+	return models.RetryPolicy{
+		JobName: jobName,
+		Actions: actions,
+	}
+}
+
+// FromRetryPolicyModelToDTO transforms the RetryPolicy model to the RetryPolicy DTO
+func FromRetryPolicyModelToDTO(policy models.RetryPolicy) RetryPolicy {
+	actions := make([]ActionRetryPolicy, len(policy.Actions))
+	for i, a := range policy.Actions {
+		actions[i] = ActionRetryPolicy{
+			Action:              a.Action,
+			MaxAttempts:         a.MaxAttempts,
+			InitialBackoff:      a.InitialBackoff,
+			MaxBackoff:          a.MaxBackoff,
+			BackoffMultiplier:   a.BackoffMultiplier,
+			RetryableErrorKinds: a.RetryableErrorKinds,
+			Timeout:             a.Timeout,
+		}
+	}
+	return RetryPolicy{
+		JobName: policy.JobName,
+		Actions: actions,
+	}
+}
+
+// FromScheduleActionAttemptModelsToDTOs transforms the ScheduleActionAttempt models to the ScheduleActionAttempt DTOs
+func FromScheduleActionAttemptModelsToDTOs(attempts []models.ScheduleActionAttempt) []ScheduleActionAttempt {
+	dtos := make([]ScheduleActionAttempt, len(attempts))
+	for i, a := range attempts {
+		dtos[i] = ScheduleActionAttempt{
+			ScheduleActionRecord: coreDtos.FromScheduleActionRecordModelToDTO(a.Record),
+			Attempt:              a.Attempt,
+			MaxAttempts:          a.MaxAttempts,
+		}
+	}
+	return dtos
+}
@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// Workflow and its properties are defined in the APIv3 specification:
+// openapi/support-scheduler.yaml
+type Workflow struct {
+	JobName string         `json:"jobName,omitempty"`
+	Steps   []WorkflowStep `json:"steps" validate:"required,gt=0,dive"`
+}
+
+// WorkflowStep and its properties are defined in the APIv3 specification:
+// openapi/support-scheduler.yaml
+type WorkflowStep struct {
+	Name          string               `json:"name" validate:"required"`
+	Action        int                  `json:"action" validate:"gte=0"`
+	DependsOn     []WorkflowDependency `json:"dependsOn,omitempty" validate:"dive"`
+	MaxAttempts   int                  `json:"maxAttempts,omitempty" validate:"gte=0"`
+	RetryInterval string               `json:"retryInterval,omitempty" validate:"omitempty,edgex-dto-duration"`
+	Timeout       string               `json:"timeout,omitempty" validate:"omitempty,edgex-dto-duration"`
+}
+
+// WorkflowDependency and its properties are defined in the APIv3 specification:
+// openapi/support-scheduler.yaml
+type WorkflowDependency struct {
+	Step      string `json:"step" validate:"required"`
+	Condition string `json:"condition,omitempty" validate:"omitempty,oneof='SUCCEEDED' 'FAILED' 'COMPLETED'"`
+}
+
+// WorkflowRun and its properties are defined in the APIv3 specification:
+// openapi/support-scheduler.yaml
+type WorkflowRun struct {
+	JobName     string            `json:"jobName"`
+	ScheduledAt int64             `json:"scheduledAt"`
+	Status      string            `json:"status"`
+	Nodes       []WorkflowRunNode `json:"nodes"`
+}
+
+// WorkflowRunNode and its properties are defined in the APIv3 specification:
+// openapi/support-scheduler.yaml
+type WorkflowRunNode struct {
+	Step     string `json:"step"`
+	ActionId string `json:"actionId,omitempty"`
+	Status   string `json:"status"`
+	Created  int64  `json:"created,omitempty"`
+}
+
+// ToWorkflowModel transforms the Workflow DTO of the schedule job to the Workflow model
+func ToWorkflowModel(jobName string, dto Workflow) models.Workflow {
+	steps := make([]models.WorkflowStep, len(dto.Steps))
+	for i, s := range dto.Steps {
+		dependsOn := make([]models.WorkflowDependency, len(s.DependsOn))
+		for j, d := range s.DependsOn {
+			dependsOn[j] = models.WorkflowDependency{Step: d.Step, Condition: d.Condition}
+		}
+		steps[i] = models.WorkflowStep{
+			Name:          s.Name,
+			Action:        s.Action,
+			DependsOn:     dependsOn,
+			MaxAttempts:   s.MaxAttempts,
+			RetryInterval: s.RetryInterval,
+			Timeout:       s.Timeout,
+		}
+	}
+	return models.Workflow{
+		JobName: jobName,
+		Steps:   steps,
+	}
+}
+
+// FromWorkflowModelToDTO transforms the Workflow model to the Workflow DTO
+func FromWorkflowModelToDTO(workflow models.Workflow) Workflow {
+	steps := make([]WorkflowStep, len(workflow.Steps))
+	for i, s := range workflow.Steps {
+		var dependsOn []WorkflowDependency
+		for _, d := range s.DependsOn {
+			dependsOn = append(dependsOn, WorkflowDependency{Step: d.Step, Condition: d.Condition})
+		}
+		steps[i] = WorkflowStep{
+			Name:          s.Name,
+			Action:        s.Action,
+			DependsOn:     dependsOn,
+			MaxAttempts:   s.MaxAttempts,
+			RetryInterval: s.RetryInterval,
+			Timeout:       s.Timeout,
+		}
+	}
+	return Workflow{
+		JobName: workflow.JobName,
+		Steps:   steps,
+	}
+}
+
+// FromWorkflowRunModelsToDTOs transforms the WorkflowRun models to the WorkflowRun DTOs
+func FromWorkflowRunModelsToDTOs(runs []models.WorkflowRun) []WorkflowRun {
+	dtos := make([]WorkflowRun, len(runs))
+	for i, r := range runs {
+		nodes := make([]WorkflowRunNode, len(r.Nodes))
+		for j, n := range r.Nodes {
+			nodes[j] = WorkflowRunNode{Step: n.Step, ActionId: n.ActionId, Status: n.Status, Created: n.Created}
+		}
+		dtos[i] = WorkflowRun{
+			JobName:     r.JobName,
+			ScheduledAt: r.ScheduledAt,
+			Status:      r.Status,
+			Nodes:       nodes,
+		}
+	}
+	return dtos
+}
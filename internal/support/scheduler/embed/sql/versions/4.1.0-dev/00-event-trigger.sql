@@ -0,0 +1,10 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- support_scheduler.event_trigger is used to store the event trigger setting of the schedule jobs
+CREATE TABLE IF NOT EXISTS support_scheduler.event_trigger (
+    job_name TEXT PRIMARY KEY,
+    content JSONB NOT NULL
+);
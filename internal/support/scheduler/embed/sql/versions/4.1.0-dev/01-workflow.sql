@@ -0,0 +1,10 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- support_scheduler.workflow is used to store the workflow setting of the schedule jobs
+CREATE TABLE IF NOT EXISTS support_scheduler.workflow (
+    job_name TEXT PRIMARY KEY,
+    content JSONB NOT NULL
+);
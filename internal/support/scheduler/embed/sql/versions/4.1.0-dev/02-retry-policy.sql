@@ -0,0 +1,22 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- support_scheduler.retry_policy is used to store the retry policy setting of the schedule jobs
+CREATE TABLE IF NOT EXISTS support_scheduler.retry_policy (
+    job_name TEXT PRIMARY KEY,
+    content JSONB NOT NULL
+);
+
+-- support_scheduler.record_attempt is used to store the attempt numbers of the schedule action records of the actions
+-- with retry policies
+CREATE TABLE IF NOT EXISTS support_scheduler.record_attempt (
+    record_id UUID PRIMARY KEY,
+    attempt INTEGER NOT NULL,
+    max_attempts INTEGER NOT NULL,
+    CONSTRAINT fk_record
+        FOREIGN KEY(record_id)
+        REFERENCES support_scheduler.record(id)
+        ON DELETE CASCADE
+);
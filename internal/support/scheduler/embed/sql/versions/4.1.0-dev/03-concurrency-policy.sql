@@ -0,0 +1,10 @@
+--
+-- Copyright (C) 2026 IOTech Ltd
+--
+-- SPDX-License-Identifier: Apache-2.0
+
+-- support_scheduler.concurrency_policy is used to store the concurrency policy setting of the schedule jobs
+CREATE TABLE IF NOT EXISTS support_scheduler.concurrency_policy (
+    job_name TEXT PRIMARY KEY,
+    content JSONB NOT NULL
+);
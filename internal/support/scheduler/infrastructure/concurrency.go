@@ -0,0 +1,242 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// runGuard applies the concurrency policy to the runs of an action or a workflow. The running run holds the slot, and
+// the runs waiting for the slot are counted as pending along with the running one.
+type runGuard struct {
+	mode          string
+	maxQueueDepth int
+
+	slot    chan struct{}
+	mu      sync.Mutex
+	pending int
+	cancel  context.CancelFunc
+	runId   uint64
+}
+
+// newRunGuard validates the concurrency policy and creates a run guard applying it, the empty mode allows all the runs
+func newRunGuard(policy schedulerModels.ConcurrencyPolicy) (*runGuard, errors.EdgeX) {
+	switch policy.Mode {
+	case "", schedulerModels.ConcurrencyAllow, schedulerModels.ConcurrencyForbid, schedulerModels.ConcurrencyReplace:
+		if policy.MaxQueueDepth != 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the maxQueueDepth only applies to the %s mode", schedulerModels.ConcurrencyQueue), nil)
+		}
+	case schedulerModels.ConcurrencyQueue:
+		if policy.MaxQueueDepth <= 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the maxQueueDepth of the %s mode must be greater than 0", schedulerModels.ConcurrencyQueue), nil)
+		}
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported concurrency mode %s", policy.Mode), nil)
+	}
+
+	return &runGuard{
+		mode:          policy.Mode,
+		maxQueueDepth: policy.MaxQueueDepth,
+		slot:          make(chan struct{}, 1),
+	}, nil
+}
+
+// acquire waits until the run can start by the concurrency policy, and returns the context of the run and the function
+// to release the guard after the run completes. It returns false if the run is skipped, which either overlaps the
+// running run in the FORBID mode, finds the queue full in the QUEUE mode, is replaced before it starts in the
+// REPLACE mode, or its context is cancelled while waiting, e.g. the job is removed from the scheduler manager.
+func (g *runGuard) acquire(ctx context.Context) (context.Context, func(), bool) {
+	switch g.mode {
+	case schedulerModels.ConcurrencyForbid, schedulerModels.ConcurrencyQueue:
+		g.mu.Lock()
+		if g.pending > g.maxQueueDepth {
+			g.mu.Unlock()
+			return nil, nil, false
+		}
+		g.pending++
+		g.mu.Unlock()
+
+		select {
+		case g.slot <- struct{}{}:
+		case <-ctx.Done():
+			g.mu.Lock()
+			g.pending--
+			g.mu.Unlock()
+			return nil, nil, false
+		}
+		return ctx, func() {
+			<-g.slot
+			g.mu.Lock()
+			g.pending--
+			g.mu.Unlock()
+		}, true
+	case schedulerModels.ConcurrencyReplace:
+		runCtx, cancel := context.WithCancel(ctx)
+		g.mu.Lock()
+		if g.cancel != nil {
+			g.cancel()
+		}
+		g.runId++
+		runId := g.runId
+		g.cancel = cancel
+		g.mu.Unlock()
+
+		// Wait for the cancelled run to stop, the run is skipped if it's replaced or cancelled while waiting
+		select {
+		case g.slot <- struct{}{}:
+		case <-ctx.Done():
+			g.mu.Lock()
+			if g.runId == runId {
+				g.cancel = nil
+			}
+			g.mu.Unlock()
+			cancel()
+			return nil, nil, false
+		}
+		release := func() {
+			<-g.slot
+			g.mu.Lock()
+			if g.runId == runId {
+				g.cancel = nil
+			}
+			g.mu.Unlock()
+			cancel()
+		}
+		if runCtx.Err() != nil {
+			release()
+			return nil, nil, false
+		}
+		return runCtx, release, true
+	default:
+		return ctx, func() {}, true
+	}
+}
+
+// runGuards holds the run guards of a ScheduleJob, the runs of each action are guarded separately, and the runs of the
+// workflow are guarded as a whole
+type runGuards struct {
+	actions  []*runGuard
+	workflow *runGuard
+}
+
+// UpsertConcurrencyPolicy validates the concurrency policy and registers it in the scheduler manager, the job needs to
+// be updated in the scheduler manager afterward so that its runs apply the concurrency policy
+func (m *manager) UpsertConcurrencyPolicy(policy schedulerModels.ConcurrencyPolicy, correlationId string) errors.EdgeX {
+	if _, err := newRunGuard(policy); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	m.concurrencyMu.Lock()
+	m.concurrencyPolicies[policy.JobName] = policy
+	m.concurrencyMu.Unlock()
+
+	m.lc.Debugf("The concurrency policy %s of the scheduled job %s was registered in the scheduler manager. Correlation-ID: %s", policy.Mode, policy.JobName, correlationId)
+	return nil
+}
+
+// DeleteConcurrencyPolicyByJobName removes the concurrency policy of a ScheduleJob from the scheduler manager, nothing
+// is removed if the job has no concurrency policy
+func (m *manager) DeleteConcurrencyPolicyByJobName(name, correlationId string) errors.EdgeX {
+	m.concurrencyMu.Lock()
+	_, exists := m.concurrencyPolicies[name]
+	delete(m.concurrencyPolicies, name)
+	m.concurrencyMu.Unlock()
+
+	if exists {
+		m.lc.Debugf("The concurrency policy of the scheduled job %s was removed from the scheduler manager. Correlation-ID: %s", name, correlationId)
+	}
+	return nil
+}
+
+// newRunGuards creates the run guards of the ScheduleJob with its concurrency policy and registers them in the
+// scheduler manager, so that the scheduled, triggered and event triggered runs of the job share the guards
+func (m *manager) newRunGuards(job models.ScheduleJob) (*runGuards, errors.EdgeX) {
+	m.concurrencyMu.Lock()
+	defer m.concurrencyMu.Unlock()
+
+	policy := m.concurrencyPolicies[job.Name]
+	guards := &runGuards{actions: make([]*runGuard, len(job.Actions))}
+	var err errors.EdgeX
+	for i := range job.Actions {
+		if guards.actions[i], err = newRunGuard(policy); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+	}
+	if guards.workflow, err = newRunGuard(policy); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	m.runGuards[job.Name] = guards
+	return guards, nil
+}
+
+// runGuardsOfJob returns the run guards of the ScheduleJob, the guards allowing all the runs are returned if the job
+// was not added to the scheduler manager
+func (m *manager) runGuardsOfJob(job models.ScheduleJob) *runGuards {
+	m.concurrencyMu.RLock()
+	defer m.concurrencyMu.RUnlock()
+
+	guards, exists := m.runGuards[job.Name]
+	if !exists || len(guards.actions) != len(job.Actions) {
+		guards = &runGuards{actions: make([]*runGuard, len(job.Actions)), workflow: &runGuard{}}
+		for i := range guards.actions {
+			guards.actions[i] = &runGuard{}
+		}
+	}
+	return guards
+}
+
+// runGuardedAction runs the action with its retry policy once the run guard allows the run, the skipped run adds an
+// overlapped schedule action record instead unless the job was removed while the run was waiting
+func (m *manager) runGuardedAction(ctx context.Context, job models.ScheduleJob, a models.ScheduleAction, actionFunc func(ctx context.Context) errors.EdgeX,
+	policy actionPolicy, guard *runGuard, scheduledAt int64) {
+	runCtx, release, ok := guard.acquire(ctx)
+	if !ok {
+		if ctx.Err() == nil {
+			m.addOverlappedRecords(ctx, job, []models.ScheduleAction{a}, scheduledAt)
+		}
+		return
+	}
+	defer release()
+	m.runAction(runCtx, job, a, actionFunc, policy, scheduledAt)
+}
+
+// runGuardedWorkflow runs the workflow nodes once the run guard allows the run, the skipped run adds an overlapped
+// schedule action record for every step instead unless the job was removed while the run was waiting
+func (m *manager) runGuardedWorkflow(ctx context.Context, job models.ScheduleJob, nodes []workflowNode, guard *runGuard) {
+	scheduledAt := time.Now().UnixMilli()
+	runCtx, release, ok := guard.acquire(ctx)
+	if !ok {
+		if ctx.Err() != nil {
+			return
+		}
+		actions := make([]models.ScheduleAction, len(nodes))
+		for i, node := range nodes {
+			actions[i] = node.action
+		}
+		m.addOverlappedRecords(ctx, job, actions, scheduledAt)
+		return
+	}
+	defer release()
+	m.runWorkflow(runCtx, job, nodes, scheduledAt)
+}
+
+// addOverlappedRecords adds the schedule action records of the actions of a run skipped by the concurrency policy
+func (m *manager) addOverlappedRecords(ctx context.Context, job models.ScheduleJob, actions []models.ScheduleAction, scheduledAt int64) {
+	m.lc.Debugf("Skipping the run of job %s scheduled at %d: the previous run is still running under the concurrency policy", job.Name, scheduledAt)
+	for _, a := range actions {
+		record := models.ScheduleActionRecord{JobName: job.Name, Action: a, Status: schedulerModels.Overlapped, ScheduledAt: scheduledAt}
+		m.addScheduleActionRecord(ctx, record, nil)
+	}
+}
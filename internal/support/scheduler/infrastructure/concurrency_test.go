@@ -0,0 +1,261 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	csMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+func TestNewRunGuard(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        schedulerModels.ConcurrencyPolicy
+		expectedError bool
+	}{
+		{"empty mode", schedulerModels.ConcurrencyPolicy{}, false},
+		{"allow", schedulerModels.ConcurrencyPolicy{Mode: schedulerModels.ConcurrencyAllow}, false},
+		{"forbid", schedulerModels.ConcurrencyPolicy{Mode: schedulerModels.ConcurrencyForbid}, false},
+		{"replace", schedulerModels.ConcurrencyPolicy{Mode: schedulerModels.ConcurrencyReplace}, false},
+		{"queue", schedulerModels.ConcurrencyPolicy{Mode: schedulerModels.ConcurrencyQueue, MaxQueueDepth: 2}, false},
+		{"unsupported mode", schedulerModels.ConcurrencyPolicy{Mode: "SKIP"}, true},
+		{"queue without depth", schedulerModels.ConcurrencyPolicy{Mode: schedulerModels.ConcurrencyQueue}, true},
+		{"depth of forbid", schedulerModels.ConcurrencyPolicy{Mode: schedulerModels.ConcurrencyForbid, MaxQueueDepth: 1}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := newRunGuard(testCase.policy)
+			if testCase.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRunGuardForbid(t *testing.T) {
+	guard, err := newRunGuard(schedulerModels.ConcurrencyPolicy{Mode: schedulerModels.ConcurrencyForbid})
+	require.NoError(t, err)
+
+	_, release, ok := guard.acquire(context.Background())
+	require.True(t, ok)
+	_, _, ok = guard.acquire(context.Background())
+	assert.False(t, ok, "the overlapping run should be skipped")
+
+	release()
+	_, release, ok = guard.acquire(context.Background())
+	assert.True(t, ok, "the run after the previous one completes should start")
+	release()
+}
+
+func TestRunGuardQueue(t *testing.T) {
+	guard, err := newRunGuard(schedulerModels.ConcurrencyPolicy{Mode: schedulerModels.ConcurrencyQueue, MaxQueueDepth: 1})
+	require.NoError(t, err)
+
+	_, release, ok := guard.acquire(context.Background())
+	require.True(t, ok)
+
+	started := make(chan struct{})
+	go func() {
+		_, queuedRelease, queuedOk := guard.acquire(context.Background())
+		if queuedOk {
+			queuedRelease()
+		}
+		close(started)
+	}()
+	require.Eventually(t, func() bool {
+		guard.mu.Lock()
+		defer guard.mu.Unlock()
+		return guard.pending == 2
+	}, time.Second, time.Millisecond, "the second run should be queued")
+
+	_, _, ok = guard.acquire(context.Background())
+	assert.False(t, ok, "the run should be skipped when the queue is full")
+
+	select {
+	case <-started:
+		t.Fatal("the queued run should wait for the running one")
+	default:
+	}
+	release()
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("the queued run should start after the running one completes")
+	}
+}
+
+func TestRunGuardReplace(t *testing.T) {
+	guard, err := newRunGuard(schedulerModels.ConcurrencyPolicy{Mode: schedulerModels.ConcurrencyReplace})
+	require.NoError(t, err)
+
+	runCtx, release, ok := guard.acquire(context.Background())
+	require.True(t, ok)
+
+	// the running run stops once its context is cancelled by the replacing run
+	go func() {
+		<-runCtx.Done()
+		release()
+	}()
+	replacingCtx, replacingRelease, ok := guard.acquire(context.Background())
+	require.True(t, ok)
+	assert.Error(t, runCtx.Err())
+	assert.NoError(t, replacingCtx.Err())
+	replacingRelease()
+}
+
+func TestRunGuardedAction(t *testing.T) {
+	dic := mockDic()
+	var mu sync.Mutex
+	var statuses []string
+	dbClientMock := &csMock.DBClient{}
+	dbClientMock.On("AddScheduleActionRecord", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		mu.Lock()
+		statuses = append(statuses, string(args.Get(1).(models.ScheduleActionRecord).Status))
+		mu.Unlock()
+	}).Return(models.ScheduleActionRecord{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) any {
+			return dbClientMock
+		},
+	})
+	mockManager := NewManager(dic).(*manager)
+	job := workflowScheduleJob()
+	guard, err := newRunGuard(schedulerModels.ConcurrencyPolicy{Mode: schedulerModels.ConcurrencyForbid})
+	require.NoError(t, err)
+
+	running := make(chan struct{})
+	finish := make(chan struct{})
+	slow := func(ctx context.Context) errors.EdgeX {
+		close(running)
+		<-finish
+		return nil
+	}
+	done := make(chan struct{})
+	go func() {
+		mockManager.runGuardedAction(context.Background(), job, testEdgeXMessageBusScheduleAction, slow, actionPolicy{}, guard, 1000)
+		close(done)
+	}()
+	<-running
+
+	// the overlapping run is recorded as overlapped instead of running the action
+	mockManager.runGuardedAction(context.Background(), job, testEdgeXMessageBusScheduleAction, slow, actionPolicy{}, guard, 2000)
+	close(finish)
+	<-done
+
+	assert.Equal(t, []string{schedulerModels.Overlapped, models.Succeeded}, statuses)
+}
+
+func TestUpsertConcurrencyPolicy(t *testing.T) {
+	mockManager := NewManager(mockDic()).(*manager)
+	job := validScheduleJob()
+
+	err := mockManager.UpsertConcurrencyPolicy(schedulerModels.ConcurrencyPolicy{JobName: testName, Mode: "SKIP"}, testCorrelationID)
+	assert.Error(t, err)
+
+	err = mockManager.UpsertConcurrencyPolicy(schedulerModels.ConcurrencyPolicy{JobName: testName, Mode: schedulerModels.ConcurrencyForbid}, testCorrelationID)
+	require.NoError(t, err)
+	err = mockManager.AddScheduleJob(job, testCorrelationID)
+	require.NoError(t, err)
+	guards := mockManager.runGuardsOfJob(job)
+	require.Len(t, guards.actions, len(job.Actions))
+	assert.Equal(t, schedulerModels.ConcurrencyForbid, guards.actions[0].mode)
+	assert.Equal(t, schedulerModels.ConcurrencyForbid, guards.workflow.mode)
+
+	err = mockManager.DeleteConcurrencyPolicyByJobName(testName, testCorrelationID)
+	require.NoError(t, err)
+	err = mockManager.UpdateScheduleJob(job, testCorrelationID)
+	require.NoError(t, err)
+	assert.Empty(t, mockManager.runGuardsOfJob(job).actions[0].mode)
+}
+
+func TestRunGuardedActionQueuedWhileJobDeleted(t *testing.T) {
+	dic := mockDic()
+	var mu sync.Mutex
+	var statuses []string
+	dbClientMock := &csMock.DBClient{}
+	dbClientMock.On("AddScheduleActionRecord", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		mu.Lock()
+		statuses = append(statuses, string(args.Get(1).(models.ScheduleActionRecord).Status))
+		mu.Unlock()
+	}).Return(models.ScheduleActionRecord{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) any {
+			return dbClientMock
+		},
+	})
+	mockManager := NewManager(dic).(*manager)
+	job := validScheduleJob()
+	err := mockManager.UpsertConcurrencyPolicy(schedulerModels.ConcurrencyPolicy{JobName: job.Name, Mode: schedulerModels.ConcurrencyQueue, MaxQueueDepth: 1}, testCorrelationID)
+	require.NoError(t, err)
+	err = mockManager.AddScheduleJob(job, testCorrelationID)
+	require.NoError(t, err)
+	ctx, exists := mockManager.contextOfJob(job.Name)
+	require.True(t, exists)
+	guard := mockManager.runGuardsOfJob(job).actions[0]
+
+	var runs int
+	running := make(chan struct{})
+	finish := make(chan struct{})
+	slow := func(ctx context.Context) errors.EdgeX {
+		mu.Lock()
+		runs++
+		mu.Unlock()
+		close(running)
+		<-finish
+		return nil
+	}
+	done := make(chan struct{})
+	go func() {
+		mockManager.runGuardedAction(ctx, job, testEdgeXMessageBusScheduleAction, slow, actionPolicy{}, guard, 1000)
+		close(done)
+	}()
+	<-running
+	queuedDone := make(chan struct{})
+	go func() {
+		mockManager.runGuardedAction(ctx, job, testEdgeXMessageBusScheduleAction, slow, actionPolicy{}, guard, 2000)
+		close(queuedDone)
+	}()
+	require.Eventually(t, func() bool {
+		guard.mu.Lock()
+		defer guard.mu.Unlock()
+		return guard.pending == 2
+	}, time.Second, time.Millisecond, "the second run should be queued")
+
+	// the queued run stops waiting for the slot once the job is removed
+	err = mockManager.DeleteScheduleJobByName(job.Name, testCorrelationID)
+	require.NoError(t, err)
+	select {
+	case <-queuedDone:
+	case <-time.After(time.Second):
+		t.Fatal("the queued run should return once the job is removed")
+	}
+	close(finish)
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, runs, "the queued run should not run the action")
+	assert.NotContains(t, statuses, schedulerModels.Overlapped)
+	guard.mu.Lock()
+	defer guard.mu.Unlock()
+	assert.Zero(t, guard.pending)
+}
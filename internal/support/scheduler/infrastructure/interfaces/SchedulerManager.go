@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -8,6 +8,8 @@ package interfaces
 import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 type SchedulerManager interface {
@@ -19,5 +21,18 @@ type SchedulerManager interface {
 	TriggerScheduleJobByName(name, correlationId string) errors.EdgeX
 	ValidateUpdatingScheduleJob(job models.ScheduleJob) errors.EdgeX
 
+	UpsertEventTrigger(trigger schedulerModels.EventTrigger, correlationId string) errors.EdgeX
+	DeleteEventTriggerByJobName(name, correlationId string) errors.EdgeX
+	FireEventTriggers(event schedulerModels.EventTriggerEvent)
+
+	UpsertWorkflow(workflow schedulerModels.Workflow, job models.ScheduleJob, correlationId string) errors.EdgeX
+	DeleteWorkflowByJobName(name, correlationId string) errors.EdgeX
+
+	UpsertRetryPolicy(policy schedulerModels.RetryPolicy, job models.ScheduleJob, correlationId string) errors.EdgeX
+	DeleteRetryPolicyByJobName(name, correlationId string) errors.EdgeX
+
+	UpsertConcurrencyPolicy(policy schedulerModels.ConcurrencyPolicy, correlationId string) errors.EdgeX
+	DeleteConcurrencyPolicyByJobName(name, correlationId string) errors.EdgeX
+
 	Shutdown(correlationId string) errors.EdgeX
 }
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -10,6 +10,8 @@ import (
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
 	model "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 type DBClient interface {
@@ -36,4 +38,29 @@ type DBClient interface {
 	ScheduleActionRecordCountByJobName(ctx context.Context, jobName string, start, end int64) (int64, errors.EdgeX)
 	ScheduleActionRecordCountByJobNameAndStatus(ctx context.Context, jobName, status string, start, end int64) (int64, errors.EdgeX)
 	DeleteScheduleActionRecordByAge(ctx context.Context, age int64) errors.EdgeX
+
+	UpsertEventTrigger(ctx context.Context, trigger models.EventTrigger) errors.EdgeX
+	EventTriggerByJobName(ctx context.Context, name string) (models.EventTrigger, errors.EdgeX)
+	AllEventTriggers(ctx context.Context) ([]models.EventTrigger, errors.EdgeX)
+	DeleteEventTriggerByJobName(ctx context.Context, name string) errors.EdgeX
+
+	UpsertWorkflow(ctx context.Context, workflow models.Workflow) errors.EdgeX
+	WorkflowByJobName(ctx context.Context, name string) (models.Workflow, errors.EdgeX)
+	AllWorkflows(ctx context.Context) ([]models.Workflow, errors.EdgeX)
+	DeleteWorkflowByJobName(ctx context.Context, name string) errors.EdgeX
+	WorkflowRunRecordsByJobName(ctx context.Context, jobName string, actionIds []string, start, end int64, offset, limit int) ([]model.ScheduleActionRecord, errors.EdgeX)
+	WorkflowRunCountByJobName(ctx context.Context, jobName string, actionIds []string, start, end int64) (int64, errors.EdgeX)
+
+	UpsertRetryPolicy(ctx context.Context, policy models.RetryPolicy) errors.EdgeX
+	RetryPolicyByJobName(ctx context.Context, name string) (models.RetryPolicy, errors.EdgeX)
+	AllRetryPolicies(ctx context.Context) ([]models.RetryPolicy, errors.EdgeX)
+	DeleteRetryPolicyByJobName(ctx context.Context, name string) errors.EdgeX
+	AddScheduleActionAttempt(ctx context.Context, attempt models.ScheduleActionAttempt) (models.ScheduleActionAttempt, errors.EdgeX)
+	ScheduleActionAttemptsByJobName(ctx context.Context, jobName string, start, end int64, offset, limit int) ([]models.ScheduleActionAttempt, errors.EdgeX)
+	ScheduleActionAttemptCountByJobName(ctx context.Context, jobName string, start, end int64) (int64, errors.EdgeX)
+
+	UpsertConcurrencyPolicy(ctx context.Context, policy models.ConcurrencyPolicy) errors.EdgeX
+	ConcurrencyPolicyByJobName(ctx context.Context, name string) (models.ConcurrencyPolicy, errors.EdgeX)
+	AllConcurrencyPolicies(ctx context.Context) ([]models.ConcurrencyPolicy, errors.EdgeX)
+	DeleteConcurrencyPolicyByJobName(ctx context.Context, name string) errors.EdgeX
 }
@@ -10,6 +10,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	schedulermodels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 // DBClient is an autogenerated mock type for the DBClient type
@@ -17,6 +19,36 @@ type DBClient struct {
 	mock.Mock
 }
 
+// AddScheduleActionAttempt provides a mock function with given fields: ctx, attempt
+func (_m *DBClient) AddScheduleActionAttempt(ctx context.Context, attempt schedulermodels.ScheduleActionAttempt) (schedulermodels.ScheduleActionAttempt, errors.EdgeX) {
+	ret := _m.Called(ctx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddScheduleActionAttempt")
+	}
+
+	var r0 schedulermodels.ScheduleActionAttempt
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, schedulermodels.ScheduleActionAttempt) (schedulermodels.ScheduleActionAttempt, errors.EdgeX)); ok {
+		return rf(ctx, attempt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schedulermodels.ScheduleActionAttempt) schedulermodels.ScheduleActionAttempt); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		r0 = ret.Get(0).(schedulermodels.ScheduleActionAttempt)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schedulermodels.ScheduleActionAttempt) errors.EdgeX); ok {
+		r1 = rf(ctx, attempt)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AddScheduleActionRecord provides a mock function with given fields: ctx, scheduleActionRecord
 func (_m *DBClient) AddScheduleActionRecord(ctx context.Context, scheduleActionRecord models.ScheduleActionRecord) (models.ScheduleActionRecord, errors.EdgeX) {
 	ret := _m.Called(ctx, scheduleActionRecord)
@@ -109,6 +141,102 @@ func (_m *DBClient) AddScheduleJob(ctx context.Context, scheduleJob models.Sched
 	return r0, r1
 }
 
+// AllConcurrencyPolicies provides a mock function with given fields: ctx
+func (_m *DBClient) AllConcurrencyPolicies(ctx context.Context) ([]schedulermodels.ConcurrencyPolicy, errors.EdgeX) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllConcurrencyPolicies")
+	}
+
+	var r0 []schedulermodels.ConcurrencyPolicy
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schedulermodels.ConcurrencyPolicy, errors.EdgeX)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schedulermodels.ConcurrencyPolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulermodels.ConcurrencyPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) errors.EdgeX); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllEventTriggers provides a mock function with given fields: ctx
+func (_m *DBClient) AllEventTriggers(ctx context.Context) ([]schedulermodels.EventTrigger, errors.EdgeX) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllEventTriggers")
+	}
+
+	var r0 []schedulermodels.EventTrigger
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schedulermodels.EventTrigger, errors.EdgeX)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schedulermodels.EventTrigger); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulermodels.EventTrigger)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) errors.EdgeX); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AllRetryPolicies provides a mock function with given fields: ctx
+func (_m *DBClient) AllRetryPolicies(ctx context.Context) ([]schedulermodels.RetryPolicy, errors.EdgeX) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllRetryPolicies")
+	}
+
+	var r0 []schedulermodels.RetryPolicy
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schedulermodels.RetryPolicy, errors.EdgeX)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schedulermodels.RetryPolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulermodels.RetryPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) errors.EdgeX); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllScheduleActionRecords provides a mock function with given fields: ctx, start, end, offset, limit
 func (_m *DBClient) AllScheduleActionRecords(ctx context.Context, start int64, end int64, offset int, limit int) ([]models.ScheduleActionRecord, errors.EdgeX) {
 	ret := _m.Called(ctx, start, end, offset, limit)
@@ -173,11 +301,133 @@ func (_m *DBClient) AllScheduleJobs(ctx context.Context, labels []string, offset
 	return r0, r1
 }
 
+// AllWorkflows provides a mock function with given fields: ctx
+func (_m *DBClient) AllWorkflows(ctx context.Context) ([]schedulermodels.Workflow, errors.EdgeX) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllWorkflows")
+	}
+
+	var r0 []schedulermodels.Workflow
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context) ([]schedulermodels.Workflow, errors.EdgeX)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []schedulermodels.Workflow); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulermodels.Workflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) errors.EdgeX); ok {
+		r1 = rf(ctx)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // CloseSession provides a mock function with no fields
 func (_m *DBClient) CloseSession() {
 	_m.Called()
 }
 
+// ConcurrencyPolicyByJobName provides a mock function with given fields: ctx, name
+func (_m *DBClient) ConcurrencyPolicyByJobName(ctx context.Context, name string) (schedulermodels.ConcurrencyPolicy, errors.EdgeX) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConcurrencyPolicyByJobName")
+	}
+
+	var r0 schedulermodels.ConcurrencyPolicy
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (schedulermodels.ConcurrencyPolicy, errors.EdgeX)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) schedulermodels.ConcurrencyPolicy); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(schedulermodels.ConcurrencyPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteConcurrencyPolicyByJobName provides a mock function with given fields: ctx, name
+func (_m *DBClient) DeleteConcurrencyPolicyByJobName(ctx context.Context, name string) errors.EdgeX {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConcurrencyPolicyByJobName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) errors.EdgeX); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteEventTriggerByJobName provides a mock function with given fields: ctx, name
+func (_m *DBClient) DeleteEventTriggerByJobName(ctx context.Context, name string) errors.EdgeX {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEventTriggerByJobName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) errors.EdgeX); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteRetryPolicyByJobName provides a mock function with given fields: ctx, name
+func (_m *DBClient) DeleteRetryPolicyByJobName(ctx context.Context, name string) errors.EdgeX {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRetryPolicyByJobName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) errors.EdgeX); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteScheduleActionRecordByAge provides a mock function with given fields: ctx, age
 func (_m *DBClient) DeleteScheduleActionRecordByAge(ctx context.Context, age int64) errors.EdgeX {
 	ret := _m.Called(ctx, age)
@@ -218,6 +468,56 @@ func (_m *DBClient) DeleteScheduleJobByName(ctx context.Context, name string) er
 	return r0
 }
 
+// DeleteWorkflowByJobName provides a mock function with given fields: ctx, name
+func (_m *DBClient) DeleteWorkflowByJobName(ctx context.Context, name string) errors.EdgeX {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkflowByJobName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) errors.EdgeX); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// EventTriggerByJobName provides a mock function with given fields: ctx, name
+func (_m *DBClient) EventTriggerByJobName(ctx context.Context, name string) (schedulermodels.EventTrigger, errors.EdgeX) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EventTriggerByJobName")
+	}
+
+	var r0 schedulermodels.EventTrigger
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (schedulermodels.EventTrigger, errors.EdgeX)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) schedulermodels.EventTrigger); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(schedulermodels.EventTrigger)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // LatestScheduleActionRecordsByJobName provides a mock function with given fields: ctx, jobName
 func (_m *DBClient) LatestScheduleActionRecordsByJobName(ctx context.Context, jobName string) ([]models.ScheduleActionRecord, errors.EdgeX) {
 	ret := _m.Called(ctx, jobName)
@@ -280,6 +580,98 @@ func (_m *DBClient) LatestScheduleActionRecordsByOffset(ctx context.Context, off
 	return r0, r1
 }
 
+// RetryPolicyByJobName provides a mock function with given fields: ctx, name
+func (_m *DBClient) RetryPolicyByJobName(ctx context.Context, name string) (schedulermodels.RetryPolicy, errors.EdgeX) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetryPolicyByJobName")
+	}
+
+	var r0 schedulermodels.RetryPolicy
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (schedulermodels.RetryPolicy, errors.EdgeX)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) schedulermodels.RetryPolicy); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(schedulermodels.RetryPolicy)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ScheduleActionAttemptCountByJobName provides a mock function with given fields: ctx, jobName, start, end
+func (_m *DBClient) ScheduleActionAttemptCountByJobName(ctx context.Context, jobName string, start int64, end int64) (int64, errors.EdgeX) {
+	ret := _m.Called(ctx, jobName, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleActionAttemptCountByJobName")
+	}
+
+	var r0 int64
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) (int64, errors.EdgeX)); ok {
+		return rf(ctx, jobName, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) int64); ok {
+		r0 = rf(ctx, jobName, start, end)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64) errors.EdgeX); ok {
+		r1 = rf(ctx, jobName, start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// ScheduleActionAttemptsByJobName provides a mock function with given fields: ctx, jobName, start, end, offset, limit
+func (_m *DBClient) ScheduleActionAttemptsByJobName(ctx context.Context, jobName string, start int64, end int64, offset int, limit int) ([]schedulermodels.ScheduleActionAttempt, errors.EdgeX) {
+	ret := _m.Called(ctx, jobName, start, end, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleActionAttemptsByJobName")
+	}
+
+	var r0 []schedulermodels.ScheduleActionAttempt
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, int, int) ([]schedulermodels.ScheduleActionAttempt, errors.EdgeX)); ok {
+		return rf(ctx, jobName, start, end, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64, int, int) []schedulermodels.ScheduleActionAttempt); ok {
+		r0 = rf(ctx, jobName, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedulermodels.ScheduleActionAttempt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, jobName, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // ScheduleActionRecordCountByJobName provides a mock function with given fields: ctx, jobName, start, end
 func (_m *DBClient) ScheduleActionRecordCountByJobName(ctx context.Context, jobName string, start int64, end int64) (int64, errors.EdgeX) {
 	ret := _m.Called(ctx, jobName, start, end)
@@ -606,6 +998,178 @@ func (_m *DBClient) UpdateScheduleJob(ctx context.Context, scheduleJob models.Sc
 	return r0
 }
 
+// UpsertConcurrencyPolicy provides a mock function with given fields: ctx, policy
+func (_m *DBClient) UpsertConcurrencyPolicy(ctx context.Context, policy schedulermodels.ConcurrencyPolicy) errors.EdgeX {
+	ret := _m.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertConcurrencyPolicy")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, schedulermodels.ConcurrencyPolicy) errors.EdgeX); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpsertEventTrigger provides a mock function with given fields: ctx, trigger
+func (_m *DBClient) UpsertEventTrigger(ctx context.Context, trigger schedulermodels.EventTrigger) errors.EdgeX {
+	ret := _m.Called(ctx, trigger)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertEventTrigger")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, schedulermodels.EventTrigger) errors.EdgeX); ok {
+		r0 = rf(ctx, trigger)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpsertRetryPolicy provides a mock function with given fields: ctx, policy
+func (_m *DBClient) UpsertRetryPolicy(ctx context.Context, policy schedulermodels.RetryPolicy) errors.EdgeX {
+	ret := _m.Called(ctx, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertRetryPolicy")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, schedulermodels.RetryPolicy) errors.EdgeX); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpsertWorkflow provides a mock function with given fields: ctx, workflow
+func (_m *DBClient) UpsertWorkflow(ctx context.Context, workflow schedulermodels.Workflow) errors.EdgeX {
+	ret := _m.Called(ctx, workflow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertWorkflow")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, schedulermodels.Workflow) errors.EdgeX); ok {
+		r0 = rf(ctx, workflow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// WorkflowByJobName provides a mock function with given fields: ctx, name
+func (_m *DBClient) WorkflowByJobName(ctx context.Context, name string) (schedulermodels.Workflow, errors.EdgeX) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowByJobName")
+	}
+
+	var r0 schedulermodels.Workflow
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string) (schedulermodels.Workflow, errors.EdgeX)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) schedulermodels.Workflow); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(schedulermodels.Workflow)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) errors.EdgeX); ok {
+		r1 = rf(ctx, name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// WorkflowRunCountByJobName provides a mock function with given fields: ctx, jobName, actionIds, start, end
+func (_m *DBClient) WorkflowRunCountByJobName(ctx context.Context, jobName string, actionIds []string, start int64, end int64) (int64, errors.EdgeX) {
+	ret := _m.Called(ctx, jobName, actionIds, start, end)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowRunCountByJobName")
+	}
+
+	var r0 int64
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int64, int64) (int64, errors.EdgeX)); ok {
+		return rf(ctx, jobName, actionIds, start, end)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int64, int64) int64); ok {
+		r0 = rf(ctx, jobName, actionIds, start, end)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int64, int64) errors.EdgeX); ok {
+		r1 = rf(ctx, jobName, actionIds, start, end)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// WorkflowRunRecordsByJobName provides a mock function with given fields: ctx, jobName, actionIds, start, end, offset, limit
+func (_m *DBClient) WorkflowRunRecordsByJobName(ctx context.Context, jobName string, actionIds []string, start int64, end int64, offset int, limit int) ([]models.ScheduleActionRecord, errors.EdgeX) {
+	ret := _m.Called(ctx, jobName, actionIds, start, end, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowRunRecordsByJobName")
+	}
+
+	var r0 []models.ScheduleActionRecord
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int64, int64, int, int) ([]models.ScheduleActionRecord, errors.EdgeX)); ok {
+		return rf(ctx, jobName, actionIds, start, end, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, int64, int64, int, int) []models.ScheduleActionRecord); ok {
+		r0 = rf(ctx, jobName, actionIds, start, end, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ScheduleActionRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, int64, int64, int, int) errors.EdgeX); ok {
+		r1 = rf(ctx, jobName, actionIds, start, end, offset, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // NewDBClient creates a new instance of DBClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewDBClient(t interface {
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	schedulermodels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 // SchedulerManager is an autogenerated mock type for the SchedulerManager type
@@ -35,6 +37,66 @@ func (_m *SchedulerManager) AddScheduleJob(job models.ScheduleJob, correlationId
 	return r0
 }
 
+// DeleteConcurrencyPolicyByJobName provides a mock function with given fields: name, correlationId
+func (_m *SchedulerManager) DeleteConcurrencyPolicyByJobName(name string, correlationId string) errors.EdgeX {
+	ret := _m.Called(name, correlationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteConcurrencyPolicyByJobName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) errors.EdgeX); ok {
+		r0 = rf(name, correlationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteEventTriggerByJobName provides a mock function with given fields: name, correlationId
+func (_m *SchedulerManager) DeleteEventTriggerByJobName(name string, correlationId string) errors.EdgeX {
+	ret := _m.Called(name, correlationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEventTriggerByJobName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) errors.EdgeX); ok {
+		r0 = rf(name, correlationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// DeleteRetryPolicyByJobName provides a mock function with given fields: name, correlationId
+func (_m *SchedulerManager) DeleteRetryPolicyByJobName(name string, correlationId string) errors.EdgeX {
+	ret := _m.Called(name, correlationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRetryPolicyByJobName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) errors.EdgeX); ok {
+		r0 = rf(name, correlationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // DeleteScheduleJobByName provides a mock function with given fields: name, correlationId
 func (_m *SchedulerManager) DeleteScheduleJobByName(name string, correlationId string) errors.EdgeX {
 	ret := _m.Called(name, correlationId)
@@ -55,6 +117,31 @@ func (_m *SchedulerManager) DeleteScheduleJobByName(name string, correlationId s
 	return r0
 }
 
+// DeleteWorkflowByJobName provides a mock function with given fields: name, correlationId
+func (_m *SchedulerManager) DeleteWorkflowByJobName(name string, correlationId string) errors.EdgeX {
+	ret := _m.Called(name, correlationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkflowByJobName")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(string, string) errors.EdgeX); ok {
+		r0 = rf(name, correlationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// FireEventTriggers provides a mock function with given fields: event
+func (_m *SchedulerManager) FireEventTriggers(event schedulermodels.EventTriggerEvent) {
+	_m.Called(event)
+}
+
 // Shutdown provides a mock function with given fields: correlationId
 func (_m *SchedulerManager) Shutdown(correlationId string) errors.EdgeX {
 	ret := _m.Called(correlationId)
@@ -155,6 +242,86 @@ func (_m *SchedulerManager) UpdateScheduleJob(job models.ScheduleJob, correlatio
 	return r0
 }
 
+// UpsertConcurrencyPolicy provides a mock function with given fields: policy, correlationId
+func (_m *SchedulerManager) UpsertConcurrencyPolicy(policy schedulermodels.ConcurrencyPolicy, correlationId string) errors.EdgeX {
+	ret := _m.Called(policy, correlationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertConcurrencyPolicy")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(schedulermodels.ConcurrencyPolicy, string) errors.EdgeX); ok {
+		r0 = rf(policy, correlationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpsertEventTrigger provides a mock function with given fields: trigger, correlationId
+func (_m *SchedulerManager) UpsertEventTrigger(trigger schedulermodels.EventTrigger, correlationId string) errors.EdgeX {
+	ret := _m.Called(trigger, correlationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertEventTrigger")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(schedulermodels.EventTrigger, string) errors.EdgeX); ok {
+		r0 = rf(trigger, correlationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpsertRetryPolicy provides a mock function with given fields: policy, job, correlationId
+func (_m *SchedulerManager) UpsertRetryPolicy(policy schedulermodels.RetryPolicy, job models.ScheduleJob, correlationId string) errors.EdgeX {
+	ret := _m.Called(policy, job, correlationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertRetryPolicy")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(schedulermodels.RetryPolicy, models.ScheduleJob, string) errors.EdgeX); ok {
+		r0 = rf(policy, job, correlationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
+// UpsertWorkflow provides a mock function with given fields: workflow, job, correlationId
+func (_m *SchedulerManager) UpsertWorkflow(workflow schedulermodels.Workflow, job models.ScheduleJob, correlationId string) errors.EdgeX {
+	ret := _m.Called(workflow, job, correlationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertWorkflow")
+	}
+
+	var r0 errors.EdgeX
+	if rf, ok := ret.Get(0).(func(schedulermodels.Workflow, models.ScheduleJob, string) errors.EdgeX); ok {
+		r0 = rf(workflow, job, correlationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(errors.EdgeX)
+		}
+	}
+
+	return r0
+}
+
 // ValidateUpdatingScheduleJob provides a mock function with given fields: job
 func (_m *SchedulerManager) ValidateUpdatingScheduleJob(job models.ScheduleJob) errors.EdgeX {
 	ret := _m.Called(job)
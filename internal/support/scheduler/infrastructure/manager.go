@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"slices"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/go-co-op/gocron/v2"
@@ -28,6 +27,7 @@ import (
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/config"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
 )
 
 const (
@@ -40,12 +40,29 @@ const (
 var errOutsideWindow = errors.NewCommonEdgeX(errors.KindStatusConflict, "skipped: outside active yearly time window", nil)
 
 type manager struct {
-	lc             logger.LoggingClient
-	dic            *di.Container
-	config         *config.ConfigurationStruct
-	mu             sync.RWMutex
-	schedulers     map[string]gocron.Scheduler
-	secretProvider bootstrapInterfaces.SecretProviderExt
+	lc                  logger.LoggingClient
+	dic                 *di.Container
+	config              *config.ConfigurationStruct
+	mu                  sync.RWMutex
+	schedulers          map[string]gocron.Scheduler
+	jobContexts         map[string]jobContext
+	secretProvider      bootstrapInterfaces.SecretProviderExt
+	triggerMu           sync.RWMutex
+	triggers            map[string]*triggerState
+	workflowMu          sync.RWMutex
+	workflows           map[string]schedulerModels.Workflow
+	retryPolicyMu       sync.RWMutex
+	retryPolicies       map[string]schedulerModels.RetryPolicy
+	concurrencyMu       sync.RWMutex
+	concurrencyPolicies map[string]schedulerModels.ConcurrencyPolicy
+	runGuards           map[string]*runGuards
+}
+
+// jobContext is the context of the runs of a ScheduleJob, it is cancelled once the job is removed from the scheduler
+// manager so that the running actions, their retries and their workflows stop
+type jobContext struct {
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // NewManager creates a new scheduler manager for running the ScheduleJob
@@ -55,11 +72,17 @@ func NewManager(dic *di.Container) interfaces.SchedulerManager {
 	configuration := container.ConfigurationFrom(dic.Get)
 
 	return &manager{
-		lc:             lc,
-		dic:            dic,
-		config:         configuration,
-		schedulers:     make(map[string]gocron.Scheduler),
-		secretProvider: secretProvider,
+		lc:                  lc,
+		dic:                 dic,
+		config:              configuration,
+		schedulers:          make(map[string]gocron.Scheduler),
+		jobContexts:         make(map[string]jobContext),
+		secretProvider:      secretProvider,
+		triggers:            make(map[string]*triggerState),
+		workflows:           make(map[string]schedulerModels.Workflow),
+		retryPolicies:       make(map[string]schedulerModels.RetryPolicy),
+		concurrencyPolicies: make(map[string]schedulerModels.ConcurrencyPolicy),
+		runGuards:           make(map[string]*runGuards),
 	}
 }
 
@@ -107,6 +130,14 @@ func (m *manager) DeleteScheduleJobByName(name, correlationId string) errors.Edg
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	// Cancel the running actions first so that the shutdown doesn't wait for their retries
+	m.mu.Lock()
+	if jobCtx, exists := m.jobContexts[name]; exists {
+		jobCtx.cancel()
+		delete(m.jobContexts, name)
+	}
+	m.mu.Unlock()
+
 	if err := scheduler.Shutdown(); err != nil {
 		return errors.NewCommonEdgeX(errors.KindServerError,
 			fmt.Sprintf("failed to shutdown and delete the scheduler for job: %s", name), err)
@@ -116,6 +147,10 @@ func (m *manager) DeleteScheduleJobByName(name, correlationId string) errors.Edg
 	delete(m.schedulers, name)
 	m.mu.Unlock()
 
+	m.concurrencyMu.Lock()
+	delete(m.runGuards, name)
+	m.concurrencyMu.Unlock()
+
 	m.lc.Debugf("The scheduled job %s was stopped and removed from the scheduler manager. Correlation-ID: %s", name, correlationId)
 	return nil
 }
@@ -154,6 +189,10 @@ func (m *manager) TriggerScheduleJobByName(name, correlationId string) errors.Ed
 		return errors.NewCommonEdgeXWrapper(err)
 	}
 
+	// The event-only job has no gocron job to run, so the actions run in the same way as the event triggered runs
+	if m.isEventOnly(name) {
+		go m.runTriggeredJob(name)
+	}
 	for _, job := range scheduler.Jobs() {
 		if err := job.RunNow(); err != nil {
 			return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("failed to trigger scheduler action for job: %s", name), err)
@@ -174,8 +213,29 @@ func (m *manager) Shutdown(correlationId string) errors.EdgeX {
 
 	m.mu.Lock()
 	m.schedulers = make(map[string]gocron.Scheduler)
+	m.jobContexts = make(map[string]jobContext)
 	m.mu.Unlock()
 
+	m.triggerMu.Lock()
+	for _, state := range m.triggers {
+		state.stop()
+	}
+	m.triggers = make(map[string]*triggerState)
+	m.triggerMu.Unlock()
+
+	m.workflowMu.Lock()
+	m.workflows = make(map[string]schedulerModels.Workflow)
+	m.workflowMu.Unlock()
+
+	m.retryPolicyMu.Lock()
+	m.retryPolicies = make(map[string]schedulerModels.RetryPolicy)
+	m.retryPolicyMu.Unlock()
+
+	m.concurrencyMu.Lock()
+	m.concurrencyPolicies = make(map[string]schedulerModels.ConcurrencyPolicy)
+	m.runGuards = make(map[string]*runGuards)
+	m.concurrencyMu.Unlock()
+
 	m.lc.Debugf("All scheduled jobs were stopped and removed from the scheduler manager. Correlation-ID: %s", correlationId)
 	return nil
 }
@@ -220,6 +280,18 @@ func (m *manager) ValidateUpdatingScheduleJob(job models.ScheduleJob) errors.Edg
 	// Remove the jobs for validation from the scheduler
 	scheduler.RemoveByTags(validationTag)
 
+	// The retry policy and the workflow refer to the actions by index, so the updated actions must still match them
+	if policy, exists := m.retryPolicyByJobName(job.Name); exists {
+		if _, edgeXerr := buildActionPolicies(policy, job); edgeXerr != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "the updated actions don't match the retry policy of the scheduled job", edgeXerr)
+		}
+	}
+	if workflow, exists := m.workflowByJobName(job.Name); exists {
+		if _, edgeXerr := m.buildWorkflow(workflow, job); edgeXerr != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "the updated actions don't match the workflow of the scheduled job", edgeXerr)
+		}
+	}
+
 	return nil
 }
 
@@ -235,8 +307,6 @@ func (m *manager) getSchedulerByJobName(name string) (gocron.Scheduler, errors.E
 }
 
 func (m *manager) addNewJob(job models.ScheduleJob) errors.EdgeX {
-	ctx, correlationId := correlation.FromContextOrNew(context.Background())
-
 	scheduler, err := gocron.NewScheduler()
 	if err != nil {
 		return errors.NewCommonEdgeX(errors.KindServerError,
@@ -247,11 +317,24 @@ func (m *manager) addNewJob(job models.ScheduleJob) errors.EdgeX {
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
+	guards, edgeXerr := m.newRunGuards(job)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	// The runs of the job share the job context, which is cancelled once the job is removed from the scheduler manager
+	jobCtx, cancel := context.WithCancel(context.Background())
+	ctx, correlationId := correlation.FromContextOrNew(jobCtx)
 
 	// Add options for the scheduled job based on the startTimestamp and endTimestamp
 	toTrigger, startOption, endOption := m.arrangeScheduleJob(ctx, job)
+	if toTrigger && m.isEventOnly(job.Name) {
+		m.lc.Debugf("The scheduled job %s only runs on the events of its event trigger. Correlation-ID: %s", job.Name, correlationId)
+		toTrigger = false
+	}
 	if toTrigger {
-		if edgeXerr := m.startJobActions(ctx, scheduler, definition, job, startOption, endOption); edgeXerr != nil {
+		if edgeXerr := m.startJobActions(ctx, scheduler, definition, job, guards, startOption, endOption); edgeXerr != nil {
+			cancel()
 			return errors.NewCommonEdgeXWrapper(edgeXerr)
 		}
 		m.lc.Debugf("The scheduled job %s was started. Correlation-ID: %s", job.Name, correlationId)
@@ -260,15 +343,25 @@ func (m *manager) addNewJob(job models.ScheduleJob) errors.EdgeX {
 	// Whether the job is going to be triggered or not, the scheduler will be added to the manager to sync with the database
 	m.mu.Lock()
 	m.schedulers[job.Name] = scheduler
+	m.jobContexts[job.Name] = jobContext{ctx: jobCtx, cancel: cancel}
 	m.mu.Unlock()
 
 	return nil
 }
 
+// contextOfJob returns the context of the runs of the ScheduleJob, which is cancelled once the job is removed from the
+// scheduler manager
+func (m *manager) contextOfJob(name string) (context.Context, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	jobCtx, exists := m.jobContexts[name]
+	return jobCtx.ctx, exists
+}
+
 // startJobActions registers a gocron job for every action of the schedule job and starts the scheduler. It
 // resolves the window timezone once and builds the shared base options and window gate reused by each action.
 func (m *manager) startJobActions(ctx context.Context, scheduler gocron.Scheduler, definition gocron.JobDefinition,
-	job models.ScheduleJob, startOption, endOption gocron.JobOption) errors.EdgeX {
+	job models.ScheduleJob, guards *runGuards, startOption, endOption gocron.JobOption) errors.EdgeX {
 	window := job.Definition.GetBaseScheduleDef().ActiveYearlyTimeWindow
 
 	// Resolve the timezone once so the window is evaluated against the schedule's calendar day.
@@ -292,56 +385,42 @@ func (m *manager) startJobActions(ctx context.Context, scheduler gocron.Schedule
 	}
 	gate := m.windowGate(job, window, windowLoc)
 
-	for _, a := range job.Actions {
-		if edgeXerr := m.addActionJob(ctx, scheduler, definition, job, a, baseOptions, gate); edgeXerr != nil {
+	if workflow, exists := m.workflowByJobName(job.Name); exists {
+		// The workflow runs all the actions as its steps in a single gocron job
+		if edgeXerr := m.addWorkflowJob(ctx, scheduler, definition, job, workflow, guards.workflow, baseOptions, gate); edgeXerr != nil {
+			return errors.NewCommonEdgeXWrapper(edgeXerr)
+		}
+	} else {
+		policies, edgeXerr := m.actionPoliciesOfJob(job)
+		if edgeXerr != nil {
 			return errors.NewCommonEdgeXWrapper(edgeXerr)
 		}
+		for i, a := range job.Actions {
+			if edgeXerr := m.addActionJob(ctx, scheduler, definition, job, a, policies[i], guards.actions[i], baseOptions, gate); edgeXerr != nil {
+				return errors.NewCommonEdgeXWrapper(edgeXerr)
+			}
+		}
 	}
 
 	scheduler.Start()
 	return nil
 }
 
-// addActionJob creates a gocron job for a single ScheduleAction with its own event listeners (window gate,
-// scheduledAt capture, and success/failure record writers) and registers it on the scheduler. baseOptions
-// holds the job-level options (lifetime start/stop) shared by every action; gate is the window gate listener.
+// addActionJob creates a gocron job for a single ScheduleAction with the window gate listener and registers it on the
+// scheduler. The task runs the action under its run guard with its retry policy and adds the schedule action records
+// of the attempts. baseOptions holds the job-level options (lifetime start/stop) shared by every action; gate is the
+// window gate listener.
 func (m *manager) addActionJob(ctx context.Context, scheduler gocron.Scheduler, definition gocron.JobDefinition,
-	job models.ScheduleJob, a models.ScheduleAction, baseOptions []gocron.JobOption, gate gocron.EventListener) errors.EdgeX {
-	task, edgeXerr := action.ToGocronTask(m.lc, m.dic, m.secretProvider, a)
+	job models.ScheduleJob, a models.ScheduleAction, policy actionPolicy, guard *runGuard, baseOptions []gocron.JobOption, gate gocron.EventListener) errors.EdgeX {
+	actionFunc, edgeXerr := action.ToActionFunc(m.lc, m.dic, m.secretProvider, a)
 	if edgeXerr != nil {
 		return errors.NewCommonEdgeXWrapper(edgeXerr)
 	}
 
-	jobOptions := slices.Clone(baseOptions)
-
-	// Capture the scheduled time in BeforeJobRuns to avoid race condition
-	// where the job may be removed from the scheduler before AfterJobRuns fires.
-	var scheduledAt atomic.Int64
-	jobOptions = append(jobOptions, gocron.WithEventListeners(
-		gate,
-		gocron.BeforeJobRuns(
-			func(jobID uuid.UUID, jobName string) {
-				scheduledAt.Store(time.Now().UnixMilli())
-			}),
-		gocron.AfterJobRuns(
-			func(jobID uuid.UUID, jobName string) {
-				m.addScheduleActionRecord(ctx, models.ScheduleActionRecord{
-					JobName:     job.Name,
-					Action:      a,
-					Status:      models.Succeeded,
-					ScheduledAt: scheduledAt.Load(),
-				}, nil)
-			}),
-		gocron.AfterJobRunsWithError(
-			func(jobID uuid.UUID, jobName string, err error) {
-				m.addScheduleActionRecord(ctx, models.ScheduleActionRecord{
-					JobName:     job.Name,
-					Action:      a,
-					Status:      models.Failed,
-					ScheduledAt: scheduledAt.Load(),
-				}, err)
-			}),
-	))
+	jobOptions := append(slices.Clone(baseOptions), gocron.WithEventListeners(gate))
+	task := gocron.NewTask(func() {
+		m.runGuardedAction(ctx, job, a, actionFunc, policy, guard, time.Now().UnixMilli())
+	})
 
 	// A "ScheduleAction" will be treated as a "Job" in gocron scheduler
 	if _, err := scheduler.NewJob(definition, task, jobOptions...); err != nil {
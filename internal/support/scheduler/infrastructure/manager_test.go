@@ -6,9 +6,11 @@
 package infrastructure
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v4/bootstrap/container"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v4/config"
@@ -117,6 +119,24 @@ func TestAddScheduleJobWithActiveYearlyTimeWindow(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// TestDeleteScheduleJobByNameCancelsJobContext verifies that removing the job from the scheduler manager cancels the
+// context of its runs, so that the running actions and their retries stop.
+func TestDeleteScheduleJobByNameCancelsJobContext(t *testing.T) {
+	mockManager := NewManager(mockDic()).(*manager)
+	err := mockManager.AddScheduleJob(validScheduleJob(), testCorrelationID)
+	require.NoError(t, err)
+
+	ctx, exists := mockManager.contextOfJob(testName)
+	require.True(t, exists)
+	assert.NoError(t, ctx.Err())
+
+	err = mockManager.DeleteScheduleJobByName(testName, testCorrelationID)
+	require.NoError(t, err)
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	_, exists = mockManager.contextOfJob(testName)
+	assert.False(t, exists)
+}
+
 func TestValidateUpdatingScheduleJob(t *testing.T) {
 	dic := mockDic()
 	mockManager := NewManager(dic)
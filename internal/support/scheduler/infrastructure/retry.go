@@ -0,0 +1,237 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// actionPolicy is the validated retry policy and execution timeout of an action, the zero value runs the action once
+// without timeout
+type actionPolicy struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	multiplier     float64
+	retryableKinds []string
+	timeout        time.Duration
+}
+
+// newActionPolicy validates the retry policy of an action
+func newActionPolicy(policy schedulerModels.ActionRetryPolicy) (actionPolicy, errors.EdgeX) {
+	if policy.MaxAttempts < 0 {
+		return actionPolicy{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the maxAttempts of action %d must not be negative", policy.Action), nil)
+	}
+	if policy.BackoffMultiplier != 0 && policy.BackoffMultiplier < 1 {
+		return actionPolicy{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the backoffMultiplier of action %d must not be less than 1", policy.Action), nil)
+	}
+	initialBackoff, err := parseOptionalDuration("initialBackoff", policy.InitialBackoff)
+	if err != nil {
+		return actionPolicy{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	maxBackoff, err := parseOptionalDuration("maxBackoff", policy.MaxBackoff)
+	if err != nil {
+		return actionPolicy{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if maxBackoff > 0 && maxBackoff < initialBackoff {
+		return actionPolicy{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the maxBackoff of action %d must not be less than the initialBackoff", policy.Action), nil)
+	}
+	timeout, err := parseOptionalDuration("timeout", policy.Timeout)
+	if err != nil {
+		return actionPolicy{}, errors.NewCommonEdgeXWrapper(err)
+	}
+
+	return actionPolicy{
+		maxAttempts:    policy.MaxAttempts,
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		multiplier:     policy.BackoffMultiplier,
+		retryableKinds: policy.RetryableErrorKinds,
+		timeout:        timeout,
+	}, nil
+}
+
+// attempts returns the number of attempts to run the action
+func (p actionPolicy) attempts() int {
+	return max(p.maxAttempts, 1)
+}
+
+// backoff returns the duration to wait after the failed attempt, which grows exponentially by the multiplier and is
+// capped by the max backoff
+func (p actionPolicy) backoff(attempt int) time.Duration {
+	backoff := float64(p.initialBackoff)
+	if p.multiplier > 1 {
+		backoff *= math.Pow(p.multiplier, float64(attempt-1))
+	}
+	if p.maxBackoff > 0 && backoff > float64(p.maxBackoff) {
+		return p.maxBackoff
+	}
+	return time.Duration(backoff)
+}
+
+// retryable returns whether the error of the failed attempt is retried, the invalid actions are not retried unless the
+// ContractInvalid kind is listed explicitly
+func (p actionPolicy) retryable(err errors.EdgeX) bool {
+	kind := string(errors.Kind(err))
+	if len(p.retryableKinds) == 0 {
+		return kind != string(errors.KindContractInvalid)
+	}
+	return slices.Contains(p.retryableKinds, kind)
+}
+
+// UpsertRetryPolicy validates the retry policy against the actions of the ScheduleJob and registers it in the scheduler
+// manager, the job needs to be updated in the scheduler manager afterward so that its runs apply the retry policy
+func (m *manager) UpsertRetryPolicy(policy schedulerModels.RetryPolicy, job models.ScheduleJob, correlationId string) errors.EdgeX {
+	if _, err := buildActionPolicies(policy, job); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	m.retryPolicyMu.Lock()
+	m.retryPolicies[policy.JobName] = policy
+	m.retryPolicyMu.Unlock()
+
+	m.lc.Debugf("The retry policy of %d actions of the scheduled job %s was registered in the scheduler manager. Correlation-ID: %s", len(policy.Actions), policy.JobName, correlationId)
+	return nil
+}
+
+// DeleteRetryPolicyByJobName removes the retry policy of a ScheduleJob from the scheduler manager, nothing is removed if
+// the job has no retry policy
+func (m *manager) DeleteRetryPolicyByJobName(name, correlationId string) errors.EdgeX {
+	m.retryPolicyMu.Lock()
+	_, exists := m.retryPolicies[name]
+	delete(m.retryPolicies, name)
+	m.retryPolicyMu.Unlock()
+
+	if exists {
+		m.lc.Debugf("The retry policy of the scheduled job %s was removed from the scheduler manager. Correlation-ID: %s", name, correlationId)
+	}
+	return nil
+}
+
+func (m *manager) retryPolicyByJobName(name string) (schedulerModels.RetryPolicy, bool) {
+	m.retryPolicyMu.RLock()
+	defer m.retryPolicyMu.RUnlock()
+	policy, exists := m.retryPolicies[name]
+	return policy, exists
+}
+
+// actionPoliciesOfJob returns the policies of the actions of the ScheduleJob indexed as the actions, the actions
+// without retry policy run once without timeout
+func (m *manager) actionPoliciesOfJob(job models.ScheduleJob) ([]actionPolicy, errors.EdgeX) {
+	policy, exists := m.retryPolicyByJobName(job.Name)
+	if !exists {
+		return make([]actionPolicy, len(job.Actions)), nil
+	}
+	return buildActionPolicies(policy, job)
+}
+
+// buildActionPolicies validates the retry policy against the actions of the ScheduleJob and returns the policies indexed
+// as the actions, each action can have one policy only
+func buildActionPolicies(policy schedulerModels.RetryPolicy, job models.ScheduleJob) ([]actionPolicy, errors.EdgeX) {
+	policies := make([]actionPolicy, len(job.Actions))
+	configured := make(map[int]bool, len(policy.Actions))
+	for _, p := range policy.Actions {
+		if p.Action < 0 || p.Action >= len(job.Actions) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the action %d of the retry policy is out of the %d actions of job %s", p.Action, len(job.Actions), job.Name), nil)
+		}
+		if configured[p.Action] {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the retry policy of action %d is duplicated", p.Action), nil)
+		}
+		configured[p.Action] = true
+
+		ap, err := newActionPolicy(p)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		policies[p.Action] = ap
+	}
+	return policies, nil
+}
+
+// runAction runs the action up to the max attempts of the policy with the backoff between the attempts, and adds a
+// schedule action record for every attempt with the same scheduledAt. The error of the last attempt is returned.
+func (m *manager) runAction(ctx context.Context, job models.ScheduleJob, a models.ScheduleAction, actionFunc func(ctx context.Context) errors.EdgeX,
+	policy actionPolicy, scheduledAt int64) errors.EdgeX {
+	attempts := policy.attempts()
+	for attempt := 1; ; attempt++ {
+		err := runWithTimeout(ctx, actionFunc, policy.timeout)
+		m.addScheduleActionAttempt(ctx, job.Name, a, scheduledAt, err, attempt, attempts)
+		if err == nil {
+			return nil
+		}
+		if attempt >= attempts || !policy.retryable(err) {
+			return err
+		}
+
+		backoff := policy.backoff(attempt)
+		m.lc.Debugf("Retrying the %s action of job %s in %v after the attempt %d of %d failed: %v",
+			a.GetBaseScheduleAction().Type, job.Name, backoff, attempt, attempts, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// addScheduleActionAttempt adds the schedule action record of the attempt, the attempt number is recorded only if the
+// action has more than one attempt
+func (m *manager) addScheduleActionAttempt(ctx context.Context, jobName string, a models.ScheduleAction, scheduledAt int64, err errors.EdgeX, attempt, attempts int) {
+	record := models.ScheduleActionRecord{JobName: jobName, Action: a, ScheduledAt: scheduledAt}
+	if err != nil {
+		record.Status = models.Failed
+	} else {
+		record.Status = models.Succeeded
+	}
+	if attempts <= 1 {
+		m.addScheduleActionRecord(ctx, record, err)
+		return
+	}
+
+	dbClient := container.DBClientFrom(m.dic.Get)
+	correlationId := correlation.FromContext(ctx)
+	newAttempt, dbErr := dbClient.AddScheduleActionAttempt(ctx, schedulerModels.ScheduleActionAttempt{Record: record, Attempt: attempt, MaxAttempts: attempts})
+	if dbErr != nil {
+		m.lc.Errorf("failed to add a new schedule action record of attempt %d for job: %s, Correlation-ID: %s, err: %v", attempt, jobName, correlationId, dbErr)
+		return
+	}
+	m.lc.Debugf("A new schedule action record with type: %s and status: %s was added for the attempt %d of %d of job: %s, record ID: %s, Correlation-ID: %s",
+		a.GetBaseScheduleAction().Type, record.Status, attempt, attempts, jobName, newAttempt.Record.Id, correlationId)
+}
+
+// runWithTimeout runs the action and fails if it doesn't complete within the timeout. The context of the action is
+// cancelled after the timeout, and the result of the action which doesn't stop on the cancellation is discarded. The
+// action runs without timeout if the timeout is zero.
+func runWithTimeout(ctx context.Context, actionFunc func(ctx context.Context) errors.EdgeX, timeout time.Duration) errors.EdgeX {
+	if timeout <= 0 {
+		return actionFunc(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result := make(chan errors.EdgeX, 1)
+	go func() {
+		result <- actionFunc(ctx)
+	}()
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("the action timed out after %v", timeout), ctx.Err())
+	}
+}
@@ -0,0 +1,153 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	csMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+func TestBuildActionPolicies(t *testing.T) {
+	job := workflowScheduleJob()
+	withActions := func(actions ...schedulerModels.ActionRetryPolicy) schedulerModels.RetryPolicy {
+		return schedulerModels.RetryPolicy{JobName: testName, Actions: actions}
+	}
+
+	tests := []struct {
+		name          string
+		policy        schedulerModels.RetryPolicy
+		expectedError bool
+	}{
+		{"valid retry policy", withActions(schedulerModels.ActionRetryPolicy{Action: 1, MaxAttempts: 3, InitialBackoff: "1s", MaxBackoff: "10s", BackoffMultiplier: 2, Timeout: "5s"}), false},
+		{"action out of range", withActions(schedulerModels.ActionRetryPolicy{Action: 2}), true},
+		{"duplicated action", withActions(schedulerModels.ActionRetryPolicy{Action: 0}, schedulerModels.ActionRetryPolicy{Action: 0}), true},
+		{"negative max attempts", withActions(schedulerModels.ActionRetryPolicy{Action: 0, MaxAttempts: -1}), true},
+		{"multiplier less than 1", withActions(schedulerModels.ActionRetryPolicy{Action: 0, BackoffMultiplier: 0.5}), true},
+		{"invalid initial backoff", withActions(schedulerModels.ActionRetryPolicy{Action: 0, InitialBackoff: "abc"}), true},
+		{"max backoff less than initial backoff", withActions(schedulerModels.ActionRetryPolicy{Action: 0, InitialBackoff: "10s", MaxBackoff: "1s"}), true},
+		{"invalid timeout", withActions(schedulerModels.ActionRetryPolicy{Action: 0, Timeout: "-1s"}), true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			policies, err := buildActionPolicies(testCase.policy, job)
+			if testCase.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, policies, len(job.Actions))
+			assert.Equal(t, actionPolicy{}, policies[0])
+			assert.Equal(t, 3, policies[1].attempts())
+			assert.Equal(t, 5*time.Second, policies[1].timeout)
+		})
+	}
+}
+
+func TestActionPolicyBackoff(t *testing.T) {
+	policy := actionPolicy{initialBackoff: time.Second, maxBackoff: 5 * time.Second, multiplier: 2}
+	assert.Equal(t, time.Second, policy.backoff(1))
+	assert.Equal(t, 2*time.Second, policy.backoff(2))
+	assert.Equal(t, 4*time.Second, policy.backoff(3))
+	assert.Equal(t, 5*time.Second, policy.backoff(4))
+
+	constant := actionPolicy{initialBackoff: time.Second}
+	assert.Equal(t, time.Second, constant.backoff(3))
+}
+
+func TestActionPolicyRetryable(t *testing.T) {
+	serverErr := errors.NewCommonEdgeX(errors.KindServerError, "failed", nil)
+	unavailableErr := errors.NewCommonEdgeX(errors.KindServiceUnavailable, "failed", nil)
+	invalidErr := errors.NewCommonEdgeX(errors.KindContractInvalid, "failed", nil)
+
+	all := actionPolicy{}
+	assert.True(t, all.retryable(serverErr))
+	assert.True(t, all.retryable(unavailableErr))
+	assert.False(t, all.retryable(invalidErr))
+
+	listed := actionPolicy{retryableKinds: []string{string(errors.KindServiceUnavailable)}}
+	assert.False(t, listed.retryable(serverErr))
+	assert.True(t, listed.retryable(unavailableErr))
+}
+
+func TestRunAction(t *testing.T) {
+	dic := mockDic()
+	var attempts []schedulerModels.ScheduleActionAttempt
+	dbClientMock := &csMock.DBClient{}
+	dbClientMock.On("AddScheduleActionAttempt", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		attempts = append(attempts, args.Get(1).(schedulerModels.ScheduleActionAttempt))
+	}).Return(schedulerModels.ScheduleActionAttempt{}, nil)
+	dbClientMock.On("AddScheduleActionRecord", mock.Anything, mock.Anything).Return(models.ScheduleActionRecord{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) any {
+			return dbClientMock
+		},
+	})
+	mockManager := NewManager(dic).(*manager)
+	job := workflowScheduleJob()
+
+	calls := 0
+	failTwice := func(ctx context.Context) errors.EdgeX {
+		calls++
+		if calls < 3 {
+			return errors.NewCommonEdgeX(errors.KindServiceUnavailable, "failed", nil)
+		}
+		return nil
+	}
+	policy := actionPolicy{maxAttempts: 3, initialBackoff: time.Millisecond, multiplier: 2}
+	err := mockManager.runAction(context.Background(), job, testEdgeXMessageBusScheduleAction, failTwice, policy, 1000)
+	require.NoError(t, err)
+	require.Len(t, attempts, 3)
+	for i, attempt := range attempts {
+		assert.Equal(t, i+1, attempt.Attempt)
+		assert.Equal(t, 3, attempt.MaxAttempts)
+		assert.Equal(t, int64(1000), attempt.Record.ScheduledAt)
+	}
+	assert.Equal(t, models.Failed, string(attempts[0].Record.Status))
+	assert.Equal(t, models.Succeeded, string(attempts[2].Record.Status))
+
+	// the error kind which is not retryable stops the retries
+	calls, attempts = 0, nil
+	policy.retryableKinds = []string{string(errors.KindServerError)}
+	err = mockManager.runAction(context.Background(), job, testEdgeXMessageBusScheduleAction, failTwice, policy, 1000)
+	assert.Error(t, err)
+	assert.Len(t, attempts, 1)
+
+	// the action without retry policy runs once and adds a plain schedule action record
+	calls, attempts = 0, nil
+	err = mockManager.runAction(context.Background(), job, testEdgeXMessageBusScheduleAction, failTwice, actionPolicy{}, 1000)
+	assert.Error(t, err)
+	assert.Empty(t, attempts)
+	dbClientMock.AssertNumberOfCalls(t, "AddScheduleActionRecord", 1)
+}
+
+func TestRunWithTimeout(t *testing.T) {
+	slow := func(ctx context.Context) errors.EdgeX {
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	}
+	assert.Error(t, runWithTimeout(context.Background(), slow, 10*time.Millisecond))
+	assert.NoError(t, runWithTimeout(context.Background(), slow, time.Second))
+	assert.NoError(t, runWithTimeout(context.Background(), slow, 0))
+
+	cancellable := func(ctx context.Context) errors.EdgeX {
+		<-ctx.Done()
+		return errors.NewCommonEdgeX(errors.KindServerError, "cancelled", ctx.Err())
+	}
+	assert.Error(t, runWithTimeout(context.Background(), cancellable, 10*time.Millisecond))
+}
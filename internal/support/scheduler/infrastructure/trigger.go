@@ -0,0 +1,293 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/pkg/correlation"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application/action"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// triggerState holds the event trigger of a schedule job with its debounce timer and the time of the last triggered run
+type triggerState struct {
+	trigger  schedulerModels.EventTrigger
+	debounce time.Duration
+	cooldown time.Duration
+
+	mu      sync.Mutex
+	timer   *time.Timer
+	lastRun time.Time
+}
+
+// stop cancels the pending debounced run of the trigger
+func (s *triggerState) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+}
+
+// fire schedules the run of the trigger for a matching event, the event is ignored within the cooldown after the last
+// run, and the pending run is postponed by the debounce duration for each matching event
+func (s *triggerState) fire(run func()) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cooldown > 0 && !s.lastRun.IsZero() && time.Since(s.lastRun) < s.cooldown {
+		return false
+	}
+
+	if s.debounce <= 0 {
+		s.lastRun = time.Now()
+		go run()
+		return true
+	}
+
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(s.debounce, func() {
+		s.mu.Lock()
+		// the timer is superseded if another matching event arrived or the trigger was stopped
+		if s.timer != timer {
+			s.mu.Unlock()
+			return
+		}
+		s.timer = nil
+		s.lastRun = time.Now()
+		s.mu.Unlock()
+		run()
+	})
+	s.timer = timer
+	return true
+}
+
+// parseOptionalDuration parses the optional duration string of the setting, zero is returned if it's empty
+func parseOptionalDuration(field, value string) (time.Duration, errors.EdgeX) {
+	if value == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("fail to parse %s string %s to a duration time value", field, value), err)
+	}
+	if d < 0 {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s %s must not be negative", field, value), nil)
+	}
+	return d, nil
+}
+
+// UpsertEventTrigger registers the event trigger of a ScheduleJob in the scheduler manager, or replaces the existing one
+func (m *manager) UpsertEventTrigger(trigger schedulerModels.EventTrigger, correlationId string) errors.EdgeX {
+	switch trigger.Type {
+	case schedulerModels.TriggerSystemEvent, schedulerModels.TriggerDataEvent, schedulerModels.TriggerKeeperKey:
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported event trigger type: %s", trigger.Type), nil)
+	}
+	debounce, err := parseOptionalDuration("debounce", trigger.Debounce)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	cooldown, err := parseOptionalDuration("cooldown", trigger.Cooldown)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	state := &triggerState{trigger: trigger, debounce: debounce, cooldown: cooldown}
+	m.triggerMu.Lock()
+	if old, exists := m.triggers[trigger.JobName]; exists {
+		old.stop()
+		// keep the last run so that replacing the trigger doesn't reset the cooldown
+		old.mu.Lock()
+		state.lastRun = old.lastRun
+		old.mu.Unlock()
+	}
+	m.triggers[trigger.JobName] = state
+	m.triggerMu.Unlock()
+
+	m.lc.Debugf("The event trigger with type %s of the scheduled job %s was registered in the scheduler manager. Correlation-ID: %s", trigger.Type, trigger.JobName, correlationId)
+	return nil
+}
+
+// DeleteEventTriggerByJobName removes the event trigger of a ScheduleJob from the scheduler manager and cancels the
+// pending debounced run, nothing is removed if the job has no event trigger
+func (m *manager) DeleteEventTriggerByJobName(name, correlationId string) errors.EdgeX {
+	m.triggerMu.Lock()
+	state, exists := m.triggers[name]
+	delete(m.triggers, name)
+	m.triggerMu.Unlock()
+
+	if exists {
+		state.stop()
+		m.lc.Debugf("The event trigger of the scheduled job %s was removed from the scheduler manager. Correlation-ID: %s", name, correlationId)
+	}
+	return nil
+}
+
+// FireEventTriggers runs the actions of the ScheduleJobs whose event triggers match the event, subject to the debounce
+// and cooldown settings of each trigger
+func (m *manager) FireEventTriggers(event schedulerModels.EventTriggerEvent) {
+	m.triggerMu.RLock()
+	var matched []*triggerState
+	for _, state := range m.triggers {
+		if matchEventTrigger(state.trigger, event) {
+			matched = append(matched, state)
+		}
+	}
+	m.triggerMu.RUnlock()
+
+	for _, state := range matched {
+		jobName := state.trigger.JobName
+		if !state.fire(func() { m.runTriggeredJob(jobName) }) {
+			m.lc.Debugf("Skipping the %s event for the scheduled job %s: the event trigger is cooling down", event.Type, jobName)
+		}
+	}
+}
+
+// isEventOnly returns whether the runs scheduled by the definition of the job are disabled by its event trigger
+func (m *manager) isEventOnly(name string) bool {
+	m.triggerMu.RLock()
+	defer m.triggerMu.RUnlock()
+	state, exists := m.triggers[name]
+	return exists && state.trigger.EventOnly
+}
+
+// matchEventTrigger returns whether the event matches the type and the filters of the event trigger, an empty filter
+// matches any value
+func matchEventTrigger(trigger schedulerModels.EventTrigger, event schedulerModels.EventTriggerEvent) bool {
+	if trigger.Type != event.Type {
+		return false
+	}
+	matchAny := func(filter []string, value string) bool {
+		return len(filter) == 0 || slices.Contains(filter, value)
+	}
+
+	switch trigger.Type {
+	case schedulerModels.TriggerSystemEvent:
+		return matchAny(trigger.SystemEventTypes, event.SystemEventType) &&
+			matchAny(trigger.SystemEventActions, event.SystemEventAction) &&
+			matchAny(trigger.Names, event.Name)
+	case schedulerModels.TriggerDataEvent:
+		if !matchAny(trigger.DeviceNames, event.DeviceName) {
+			return false
+		}
+		if len(trigger.ResourceNames) == 0 {
+			return true
+		}
+		for _, resourceName := range event.ResourceNames {
+			if slices.Contains(trigger.ResourceNames, resourceName) {
+				return true
+			}
+		}
+		return false
+	case schedulerModels.TriggerKeeperKey:
+		return strings.HasPrefix(event.Key, trigger.KeyPrefix)
+	}
+	return false
+}
+
+// runTriggeredJob runs all the actions of the ScheduleJob for an event and adds the schedule action records in the same
+// way as the scheduled runs. The run is skipped if the job is locked, outside its start and end timestamps or outside
+// its active yearly time window.
+func (m *manager) runTriggeredJob(name string) {
+	jobCtx, exists := m.contextOfJob(name)
+	if !exists {
+		m.lc.Debugf("The scheduled job %s triggered by the event was removed from the scheduler manager", name)
+		return
+	}
+	ctx, correlationId := correlation.FromContextOrNew(jobCtx)
+	dbClient := container.DBClientFrom(m.dic.Get)
+
+	job, err := dbClient.ScheduleJobByName(ctx, name)
+	if err != nil {
+		m.lc.Errorf("failed to load the scheduled job %s triggered by the event, Correlation-ID: %s, err: %v", name, correlationId, err)
+		return
+	}
+	if !m.isTriggerable(job, correlationId) {
+		return
+	}
+
+	m.lc.Debugf("The scheduled job %s has been triggered by the event. Correlation-ID: %s", name, correlationId)
+	if workflow, exists := m.workflowByJobName(name); exists {
+		m.runWorkflowOfJob(ctx, workflow, job)
+		return
+	}
+	m.runJobActions(ctx, job)
+}
+
+// runJobActions runs all the actions of the ScheduleJob immediately under their run guards with their retry policies,
+// and adds the schedule action records of each action
+func (m *manager) runJobActions(ctx context.Context, job models.ScheduleJob) {
+	policies, err := m.actionPoliciesOfJob(job)
+	if err != nil {
+		m.lc.Errorf("failed to run the actions of job %s, %v", job.Name, err)
+		return
+	}
+
+	guards := m.runGuardsOfJob(job)
+	scheduledAt := time.Now().UnixMilli()
+	var wg sync.WaitGroup
+	for i, a := range job.Actions {
+		wg.Add(1)
+		go func(a models.ScheduleAction, policy actionPolicy, guard *runGuard) {
+			defer wg.Done()
+			actionFunc, err := action.ToActionFunc(m.lc, m.dic, m.secretProvider, a)
+			if err != nil {
+				record := models.ScheduleActionRecord{JobName: job.Name, Action: a, Status: models.Failed, ScheduledAt: scheduledAt}
+				m.addScheduleActionRecord(ctx, record, err)
+				return
+			}
+			m.runGuardedAction(ctx, job, a, actionFunc, policy, guard, scheduledAt)
+		}(a, policies[i], guards.actions[i])
+	}
+	wg.Wait()
+}
+
+// isTriggerable returns whether the ScheduleJob can run for an event at the moment
+func (m *manager) isTriggerable(job models.ScheduleJob, correlationId string) bool {
+	if job.AdminState != models.Unlocked {
+		m.lc.Debugf("Skipping the event triggered run of job %s: the admin state is locked. Correlation-ID: %s", job.Name, correlationId)
+		return false
+	}
+
+	now := time.Now()
+	def := job.Definition.GetBaseScheduleDef()
+	if def.StartTimestamp != 0 && now.Before(time.UnixMilli(def.StartTimestamp)) {
+		m.lc.Debugf("Skipping the event triggered run of job %s: the startTimestamp is not reached. Correlation-ID: %s", job.Name, correlationId)
+		return false
+	}
+	if def.EndTimestamp != 0 && now.After(time.UnixMilli(def.EndTimestamp)) {
+		m.lc.Debugf("Skipping the event triggered run of job %s: the endTimestamp is expired. Correlation-ID: %s", job.Name, correlationId)
+		return false
+	}
+
+	if window := def.ActiveYearlyTimeWindow; window != nil {
+		loc, err := action.WindowLocation(job.Definition)
+		if err != nil {
+			m.lc.Errorf("failed to resolve timezone for active yearly time window of job %s, Correlation-ID: %s, err: %v", job.Name, correlationId, err)
+			return false
+		}
+		if !action.InWindow(now.In(loc), *window) {
+			m.lc.Debugf("Skipping the event triggered run of job %s: current date is outside its active yearly time window. Correlation-ID: %s", job.Name, correlationId)
+			return false
+		}
+	}
+	return true
+}
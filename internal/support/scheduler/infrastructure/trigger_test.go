@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
+
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+func TestMatchEventTrigger(t *testing.T) {
+	deviceAdded := schedulerModels.EventTriggerEvent{
+		Type:              schedulerModels.TriggerSystemEvent,
+		SystemEventType:   common.DeviceSystemEventType,
+		SystemEventAction: common.SystemEventActionAdd,
+		Name:              "device-1",
+	}
+	dataEvent := schedulerModels.EventTriggerEvent{
+		Type:          schedulerModels.TriggerDataEvent,
+		DeviceName:    "device-1",
+		ResourceNames: []string{"temperature", "humidity"},
+	}
+	keyChange := schedulerModels.EventTriggerEvent{
+		Type: schedulerModels.TriggerKeeperKey,
+		Key:  "edgex/v4/core-data/Writable/LogLevel",
+	}
+
+	tests := []struct {
+		name     string
+		trigger  schedulerModels.EventTrigger
+		event    schedulerModels.EventTriggerEvent
+		expected bool
+	}{
+		{"system event without filters", schedulerModels.EventTrigger{Type: schedulerModels.TriggerSystemEvent}, deviceAdded, true},
+		{"system event matching type and action", schedulerModels.EventTrigger{Type: schedulerModels.TriggerSystemEvent,
+			SystemEventTypes: []string{common.DeviceSystemEventType}, SystemEventActions: []string{common.SystemEventActionAdd}}, deviceAdded, true},
+		{"system event of other action", schedulerModels.EventTrigger{Type: schedulerModels.TriggerSystemEvent,
+			SystemEventActions: []string{common.SystemEventActionDelete}}, deviceAdded, false},
+		{"system event of other name", schedulerModels.EventTrigger{Type: schedulerModels.TriggerSystemEvent, Names: []string{"device-2"}}, deviceAdded, false},
+		{"data event matching device and resource", schedulerModels.EventTrigger{Type: schedulerModels.TriggerDataEvent,
+			DeviceNames: []string{"device-1"}, ResourceNames: []string{"humidity"}}, dataEvent, true},
+		{"data event without matching resource", schedulerModels.EventTrigger{Type: schedulerModels.TriggerDataEvent,
+			ResourceNames: []string{"pressure"}}, dataEvent, false},
+		{"keeper key matching prefix", schedulerModels.EventTrigger{Type: schedulerModels.TriggerKeeperKey, KeyPrefix: "edgex/v4/core-data/"}, keyChange, true},
+		{"keeper key of other prefix", schedulerModels.EventTrigger{Type: schedulerModels.TriggerKeeperKey, KeyPrefix: "edgex/v4/core-metadata/"}, keyChange, false},
+		{"event of other type", schedulerModels.EventTrigger{Type: schedulerModels.TriggerKeeperKey}, deviceAdded, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, matchEventTrigger(testCase.trigger, testCase.event))
+		})
+	}
+}
+
+func TestTriggerStateFire(t *testing.T) {
+	t.Run("debounce runs once after the burst", func(t *testing.T) {
+		var runs atomic.Int32
+		state := &triggerState{debounce: 50 * time.Millisecond}
+		for i := 0; i < 5; i++ {
+			assert.True(t, state.fire(func() { runs.Add(1) }))
+		}
+		assert.Eventually(t, func() bool { return runs.Load() == 1 }, time.Second, 10*time.Millisecond)
+		time.Sleep(100 * time.Millisecond)
+		assert.Equal(t, int32(1), runs.Load())
+	})
+
+	t.Run("cooldown ignores the events after a run", func(t *testing.T) {
+		var runs atomic.Int32
+		state := &triggerState{cooldown: time.Hour}
+		assert.True(t, state.fire(func() { runs.Add(1) }))
+		assert.False(t, state.fire(func() { runs.Add(1) }))
+		assert.Eventually(t, func() bool { return runs.Load() == 1 }, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("stop cancels the pending run", func(t *testing.T) {
+		var runs atomic.Int32
+		state := &triggerState{debounce: 50 * time.Millisecond}
+		assert.True(t, state.fire(func() { runs.Add(1) }))
+		state.stop()
+		time.Sleep(100 * time.Millisecond)
+		assert.Equal(t, int32(0), runs.Load())
+	})
+}
+
+func TestUpsertEventTrigger(t *testing.T) {
+	dic := mockDic()
+	mockManager := NewManager(dic)
+
+	tests := []struct {
+		name          string
+		trigger       schedulerModels.EventTrigger
+		expectedError bool
+	}{
+		{"valid event trigger", schedulerModels.EventTrigger{JobName: testName, Type: schedulerModels.TriggerDataEvent, Debounce: "1s", Cooldown: "1m"}, false},
+		{"unsupported type", schedulerModels.EventTrigger{JobName: testName, Type: "TIMER"}, true},
+		{"invalid debounce", schedulerModels.EventTrigger{JobName: testName, Type: schedulerModels.TriggerDataEvent, Debounce: "abc"}, true},
+		{"negative cooldown", schedulerModels.EventTrigger{JobName: testName, Type: schedulerModels.TriggerDataEvent, Cooldown: "-1s"}, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := mockManager.UpsertEventTrigger(testCase.trigger, testCorrelationID)
+			if testCase.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	// the event-only job is added without the runs scheduled by its definition
+	err := mockManager.UpsertEventTrigger(schedulerModels.EventTrigger{JobName: testName, Type: schedulerModels.TriggerKeeperKey, EventOnly: true}, testCorrelationID)
+	require.NoError(t, err)
+	err = mockManager.AddScheduleJob(validScheduleJob(), testCorrelationID)
+	require.NoError(t, err)
+	scheduler, err := mockManager.(*manager).getSchedulerByJobName(testName)
+	require.NoError(t, err)
+	assert.Empty(t, scheduler.Jobs())
+
+	err = mockManager.DeleteEventTriggerByJobName(testName, testCorrelationID)
+	require.NoError(t, err)
+	err = mockManager.UpdateScheduleJob(validScheduleJob(), testCorrelationID)
+	require.NoError(t, err)
+	scheduler, err = mockManager.(*manager).getSchedulerByJobName(testName)
+	require.NoError(t, err)
+	assert.Len(t, scheduler.Jobs(), 1)
+}
@@ -0,0 +1,263 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/go-co-op/gocron/v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application/action"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+// workflowNode is a validated workflow step with the action it runs and the policy of the action
+type workflowNode struct {
+	step       schedulerModels.WorkflowStep
+	action     models.ScheduleAction
+	actionFunc func(ctx context.Context) errors.EdgeX
+	policy     actionPolicy
+}
+
+// UpsertWorkflow validates the workflow against the actions of the ScheduleJob and registers it in the scheduler
+// manager, the job needs to be updated in the scheduler manager afterward so that its runs execute the workflow
+func (m *manager) UpsertWorkflow(workflow schedulerModels.Workflow, job models.ScheduleJob, correlationId string) errors.EdgeX {
+	if _, err := m.buildWorkflow(workflow, job); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	m.workflowMu.Lock()
+	m.workflows[workflow.JobName] = workflow
+	m.workflowMu.Unlock()
+
+	m.lc.Debugf("The workflow with %d steps of the scheduled job %s was registered in the scheduler manager. Correlation-ID: %s", len(workflow.Steps), workflow.JobName, correlationId)
+	return nil
+}
+
+// DeleteWorkflowByJobName removes the workflow of a ScheduleJob from the scheduler manager, nothing is removed if the
+// job has no workflow
+func (m *manager) DeleteWorkflowByJobName(name, correlationId string) errors.EdgeX {
+	m.workflowMu.Lock()
+	_, exists := m.workflows[name]
+	delete(m.workflows, name)
+	m.workflowMu.Unlock()
+
+	if exists {
+		m.lc.Debugf("The workflow of the scheduled job %s was removed from the scheduler manager. Correlation-ID: %s", name, correlationId)
+	}
+	return nil
+}
+
+func (m *manager) workflowByJobName(name string) (schedulerModels.Workflow, bool) {
+	m.workflowMu.RLock()
+	defer m.workflowMu.RUnlock()
+	workflow, exists := m.workflows[name]
+	return workflow, exists
+}
+
+// buildWorkflow validates the workflow against the actions of the ScheduleJob and returns the workflow nodes. The step
+// names must be unique, each action can be run by one step only, and the dependencies must form a directed acyclic graph.
+func (m *manager) buildWorkflow(workflow schedulerModels.Workflow, job models.ScheduleJob) ([]workflowNode, errors.EdgeX) {
+	if len(workflow.Steps) == 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the workflow of job %s has no step", job.Name), nil)
+	}
+
+	policies, edgeXerr := m.actionPoliciesOfJob(job)
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	nodes := make([]workflowNode, len(workflow.Steps))
+	stepIndexes := make(map[string]int, len(workflow.Steps))
+	actionSteps := make(map[int]string, len(workflow.Steps))
+	for i, step := range workflow.Steps {
+		if step.Name == "" {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the name of step %d is empty", i), nil)
+		}
+		if _, exists := stepIndexes[step.Name]; exists {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the step name %s is duplicated", step.Name), nil)
+		}
+		stepIndexes[step.Name] = i
+
+		if step.Action < 0 || step.Action >= len(job.Actions) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the action %d of step %s is out of the %d actions of job %s", step.Action, step.Name, len(job.Actions), job.Name), nil)
+		}
+		if other, exists := actionSteps[step.Action]; exists {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the action %d is run by both step %s and step %s", step.Action, other, step.Name), nil)
+		}
+		actionSteps[step.Action] = step.Name
+
+		if step.MaxAttempts < 0 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the maxAttempts of step %s must not be negative", step.Name), nil)
+		}
+		retryInterval, err := parseOptionalDuration("retryInterval", step.RetryInterval)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		timeout, err := parseOptionalDuration("timeout", step.Timeout)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+
+		// The retry settings of the step override the retry policy of the action
+		policy := policies[step.Action]
+		if step.MaxAttempts > 0 {
+			policy.maxAttempts = step.MaxAttempts
+		}
+		if step.RetryInterval != "" {
+			policy.initialBackoff, policy.maxBackoff, policy.multiplier = retryInterval, 0, 0
+		}
+		if step.Timeout != "" {
+			policy.timeout = timeout
+		}
+
+		a := job.Actions[step.Action]
+		actionFunc, err := action.ToActionFunc(m.lc, m.dic, m.secretProvider, a)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		nodes[i] = workflowNode{step: step, action: a, actionFunc: actionFunc, policy: policy}
+	}
+
+	// Kahn's algorithm, the steps left with dependencies form a cycle
+	inDegrees := make([]int, len(nodes))
+	dependents := make([][]int, len(nodes))
+	for i, node := range nodes {
+		for _, dep := range node.step.DependsOn {
+			depIndex, exists := stepIndexes[dep.Step]
+			if !exists {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("step %s depends on the step %s which doesn't exist", node.step.Name, dep.Step), nil)
+			}
+			switch dep.Condition {
+			case "", schedulerModels.ConditionSucceeded, schedulerModels.ConditionFailed, schedulerModels.ConditionCompleted:
+			default:
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported condition %s of the dependency of step %s", dep.Condition, node.step.Name), nil)
+			}
+			inDegrees[i]++
+			dependents[depIndex] = append(dependents[depIndex], i)
+		}
+	}
+	var ready []int
+	for i, inDegree := range inDegrees {
+		if inDegree == 0 {
+			ready = append(ready, i)
+		}
+	}
+	visited := 0
+	for len(ready) > 0 {
+		current := ready[0]
+		ready = ready[1:]
+		visited++
+		for _, dependent := range dependents[current] {
+			inDegrees[dependent]--
+			if inDegrees[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+	if visited != len(nodes) {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the steps of the workflow of job %s have cyclic dependencies", job.Name), nil)
+	}
+
+	return nodes, nil
+}
+
+// addWorkflowJob creates a single gocron job running the workflow of the ScheduleJob, the workflow steps add their own
+// schedule action records
+func (m *manager) addWorkflowJob(ctx context.Context, scheduler gocron.Scheduler, definition gocron.JobDefinition,
+	job models.ScheduleJob, workflow schedulerModels.Workflow, guard *runGuard, baseOptions []gocron.JobOption, gate gocron.EventListener) errors.EdgeX {
+	nodes, edgeXerr := m.buildWorkflow(workflow, job)
+	if edgeXerr != nil {
+		return errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
+
+	jobOptions := append(slices.Clone(baseOptions), gocron.WithEventListeners(gate))
+	task := gocron.NewTask(func() {
+		m.runGuardedWorkflow(ctx, job, nodes, guard)
+	})
+	if _, err := scheduler.NewJob(definition, task, jobOptions...); err != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError,
+			fmt.Sprintf("failed to create new scheduled workflow for job: %s", job.Name), err)
+	}
+	return nil
+}
+
+// runWorkflowOfJob runs the workflow of the ScheduleJob immediately
+func (m *manager) runWorkflowOfJob(ctx context.Context, workflow schedulerModels.Workflow, job models.ScheduleJob) {
+	nodes, err := m.buildWorkflow(workflow, job)
+	if err != nil {
+		m.lc.Errorf("failed to run the workflow of job %s, %v", job.Name, err)
+		return
+	}
+	m.runGuardedWorkflow(ctx, job, nodes, m.runGuardsOfJob(job).workflow)
+}
+
+// runWorkflow runs the workflow nodes, each node starts after all its dependencies complete, so the independent
+// branches run concurrently. A node runs only if the conditions of all its dependencies are met, otherwise it is
+// skipped. Every node adds its schedule action records with the same scheduledAt, which identifies the run.
+func (m *manager) runWorkflow(ctx context.Context, job models.ScheduleJob, nodes []workflowNode, scheduledAt int64) {
+	var mu sync.Mutex
+	statuses := make(map[string]string, len(nodes))
+	done := make(map[string]chan struct{}, len(nodes))
+	for _, node := range nodes {
+		done[node.step.Name] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
+	for _, node := range nodes {
+		wg.Add(1)
+		go func(node workflowNode) {
+			defer wg.Done()
+			defer close(done[node.step.Name])
+
+			run := true
+			for _, dep := range node.step.DependsOn {
+				<-done[dep.Step]
+				mu.Lock()
+				depStatus := statuses[dep.Step]
+				mu.Unlock()
+				if !conditionMet(dep.Condition, depStatus) {
+					run = false
+				}
+			}
+
+			// The action records its own attempts, only the skipped step is recorded here
+			status := models.Succeeded
+			if !run {
+				m.lc.Debugf("Skipping the step %s of the workflow of job %s: the conditions of its dependencies are not met", node.step.Name, job.Name)
+				status = schedulerModels.Skipped
+				record := models.ScheduleActionRecord{JobName: job.Name, Action: node.action, Status: schedulerModels.Skipped, ScheduledAt: scheduledAt}
+				m.addScheduleActionRecord(ctx, record, nil)
+			} else if err := m.runAction(ctx, job, node.action, node.actionFunc, node.policy, scheduledAt); err != nil {
+				status = models.Failed
+			}
+
+			mu.Lock()
+			statuses[node.step.Name] = status
+			mu.Unlock()
+		}(node)
+	}
+	wg.Wait()
+}
+
+// conditionMet returns whether the status of the dependency meets the condition of the dependent step
+func conditionMet(condition, status string) bool {
+	switch condition {
+	case schedulerModels.ConditionFailed:
+		return status == models.Failed
+	case schedulerModels.ConditionCompleted:
+		return status == models.Succeeded || status == models.Failed
+	default:
+		return status == models.Succeeded
+	}
+}
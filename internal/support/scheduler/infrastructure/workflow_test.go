@@ -0,0 +1,165 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v4/di"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	csMock "github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure/interfaces/mocks"
+	schedulerModels "github.com/edgexfoundry/edgex-go/internal/support/scheduler/models"
+)
+
+func workflowScheduleJob() models.ScheduleJob {
+	job := validScheduleJob()
+	job.Actions = []models.ScheduleAction{testEdgeXMessageBusScheduleAction, testDeviceControlScheduleAction}
+	return job
+}
+
+func TestBuildWorkflow(t *testing.T) {
+	dic := mockDic()
+	mockManager := NewManager(dic).(*manager)
+	job := workflowScheduleJob()
+
+	valid := schedulerModels.Workflow{JobName: testName, Steps: []schedulerModels.WorkflowStep{
+		{Name: "collect", Action: 0, MaxAttempts: 3, RetryInterval: "1s", Timeout: "10s"},
+		{Name: "notify", Action: 1, DependsOn: []schedulerModels.WorkflowDependency{{Step: "collect", Condition: schedulerModels.ConditionFailed}}},
+	}}
+	withSteps := func(steps ...schedulerModels.WorkflowStep) schedulerModels.Workflow {
+		return schedulerModels.Workflow{JobName: testName, Steps: steps}
+	}
+
+	tests := []struct {
+		name          string
+		workflow      schedulerModels.Workflow
+		expectedError bool
+	}{
+		{"valid workflow", valid, false},
+		{"no step", withSteps(), true},
+		{"empty step name", withSteps(schedulerModels.WorkflowStep{Action: 0}), true},
+		{"duplicated step name", withSteps(schedulerModels.WorkflowStep{Name: "a", Action: 0}, schedulerModels.WorkflowStep{Name: "a", Action: 1}), true},
+		{"action out of range", withSteps(schedulerModels.WorkflowStep{Name: "a", Action: 2}), true},
+		{"action run by two steps", withSteps(schedulerModels.WorkflowStep{Name: "a", Action: 0}, schedulerModels.WorkflowStep{Name: "b", Action: 0}), true},
+		{"negative max attempts", withSteps(schedulerModels.WorkflowStep{Name: "a", Action: 0, MaxAttempts: -1}), true},
+		{"invalid timeout", withSteps(schedulerModels.WorkflowStep{Name: "a", Action: 0, Timeout: "abc"}), true},
+		{"unknown dependency", withSteps(schedulerModels.WorkflowStep{Name: "a", Action: 0,
+			DependsOn: []schedulerModels.WorkflowDependency{{Step: "b"}}}), true},
+		{"unsupported condition", withSteps(schedulerModels.WorkflowStep{Name: "a", Action: 0},
+			schedulerModels.WorkflowStep{Name: "b", Action: 1, DependsOn: []schedulerModels.WorkflowDependency{{Step: "a", Condition: "SKIPPED"}}}), true},
+		{"cyclic dependencies", withSteps(
+			schedulerModels.WorkflowStep{Name: "a", Action: 0, DependsOn: []schedulerModels.WorkflowDependency{{Step: "b"}}},
+			schedulerModels.WorkflowStep{Name: "b", Action: 1, DependsOn: []schedulerModels.WorkflowDependency{{Step: "a"}}}), true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			nodes, err := mockManager.buildWorkflow(testCase.workflow, job)
+			if testCase.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, nodes, len(testCase.workflow.Steps))
+			assert.Equal(t, 3, nodes[0].policy.maxAttempts)
+			assert.Equal(t, time.Second, nodes[0].policy.backoff(2))
+			assert.Equal(t, 10*time.Second, nodes[0].policy.timeout)
+		})
+	}
+}
+
+func TestRunWorkflow(t *testing.T) {
+	dic := mockDic()
+	var mu sync.Mutex
+	statuses := make(map[string]string)
+	dbClientMock := &csMock.DBClient{}
+	dbClientMock.On("AddScheduleActionRecord", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		record := args.Get(1).(models.ScheduleActionRecord)
+		mu.Lock()
+		statuses[record.Action.GetBaseScheduleAction().Id] = string(record.Status)
+		mu.Unlock()
+	}).Return(models.ScheduleActionRecord{}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) any {
+			return dbClientMock
+		},
+	})
+	mockManager := NewManager(dic).(*manager)
+
+	succeed := func(ctx context.Context) errors.EdgeX { return nil }
+	fail := func(ctx context.Context) errors.EdgeX {
+		return errors.NewCommonEdgeX(errors.KindServerError, "failed", nil)
+	}
+	node := func(name, actionId string, actionFunc func(ctx context.Context) errors.EdgeX, deps ...schedulerModels.WorkflowDependency) workflowNode {
+		a := testEdgeXMessageBusScheduleAction
+		a.Id = actionId
+		return workflowNode{step: schedulerModels.WorkflowStep{Name: name, DependsOn: deps}, action: a, actionFunc: actionFunc}
+	}
+
+	// collect fails, so the failure branch runs and the success branch is skipped, and cleanup runs either way
+	nodes := []workflowNode{
+		node("collect", "1", fail),
+		node("upload", "2", succeed, schedulerModels.WorkflowDependency{Step: "collect"}),
+		node("alert", "3", succeed, schedulerModels.WorkflowDependency{Step: "collect", Condition: schedulerModels.ConditionFailed}),
+		node("cleanup", "4", succeed, schedulerModels.WorkflowDependency{Step: "collect", Condition: schedulerModels.ConditionCompleted}),
+		node("report", "5", succeed, schedulerModels.WorkflowDependency{Step: "upload", Condition: schedulerModels.ConditionCompleted}),
+	}
+	mockManager.runWorkflow(context.Background(), workflowScheduleJob(), nodes, time.Now().UnixMilli())
+
+	expected := map[string]string{
+		"1": models.Failed,
+		"2": schedulerModels.Skipped,
+		"3": models.Succeeded,
+		"4": models.Succeeded,
+		"5": schedulerModels.Skipped,
+	}
+	assert.Equal(t, expected, statuses)
+}
+
+func TestUpsertWorkflow(t *testing.T) {
+	dic := mockDic()
+	mockManager := NewManager(dic)
+	job := workflowScheduleJob()
+	workflow := schedulerModels.Workflow{JobName: testName, Steps: []schedulerModels.WorkflowStep{
+		{Name: "collect", Action: 0},
+		{Name: "notify", Action: 1, DependsOn: []schedulerModels.WorkflowDependency{{Step: "collect"}}},
+	}}
+
+	err := mockManager.UpsertWorkflow(schedulerModels.Workflow{JobName: testName}, job, testCorrelationID)
+	assert.Error(t, err)
+
+	// the job with a workflow runs all its actions in a single gocron job
+	err = mockManager.UpsertWorkflow(workflow, job, testCorrelationID)
+	require.NoError(t, err)
+	err = mockManager.AddScheduleJob(job, testCorrelationID)
+	require.NoError(t, err)
+	scheduler, err := mockManager.(*manager).getSchedulerByJobName(testName)
+	require.NoError(t, err)
+	assert.Len(t, scheduler.Jobs(), 1)
+
+	// the updated actions must still match the workflow
+	updated := job
+	updated.Actions = job.Actions[:1]
+	err = mockManager.ValidateUpdatingScheduleJob(updated)
+	assert.Error(t, err)
+
+	err = mockManager.DeleteWorkflowByJobName(testName, testCorrelationID)
+	require.NoError(t, err)
+	err = mockManager.UpdateScheduleJob(job, testCorrelationID)
+	require.NoError(t, err)
+	scheduler, err = mockManager.(*manager).getSchedulerByJobName(testName)
+	require.NoError(t, err)
+	assert.Len(t, scheduler.Jobs(), 2)
+}
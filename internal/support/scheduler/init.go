@@ -1,5 +1,5 @@
 /*******************************************************************************
- * Copyright (C) 2024 IOTech Ltd
+ * Copyright (C) 2024-2026 IOTech Ltd
  *
  * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
  * in compliance with the License. You may obtain a copy of the License at
@@ -27,6 +27,7 @@ import (
 
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/application"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/container"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/controller/messaging"
 	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/infrastructure"
 )
 
@@ -64,6 +65,12 @@ func (b *Bootstrap) BootstrapHandler(ctx context.Context, wg *sync.WaitGroup, _
 	}
 
 	config := container.ConfigurationFrom(dic.Get)
+	if config.EventTrigger.Enabled {
+		if err := messaging.SubscribeEventTriggerEvents(ctx, dic); err != nil {
+			lc.Errorf("Failed to subscribe the events of the event triggers from message bus, %v", err)
+			return false
+		}
+	}
 	if config.Retention.Enabled {
 		retentionInterval, err := time.ParseDuration(config.Retention.Interval)
 		if err != nil {
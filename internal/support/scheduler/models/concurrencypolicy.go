@@ -0,0 +1,31 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+const (
+	// ConcurrencyAllow starts the run even if the previous run is still running, which is the default
+	ConcurrencyAllow = "ALLOW"
+	// ConcurrencyForbid skips the run if the previous run is still running
+	ConcurrencyForbid = "FORBID"
+	// ConcurrencyReplace cancels the previous run if it's still running and starts the run after it stops
+	ConcurrencyReplace = "REPLACE"
+	// ConcurrencyQueue queues the run until the previous runs complete, and skips the run if the queue is full
+	ConcurrencyQueue = "QUEUE"
+)
+
+// Overlapped is the status of the schedule action record of a run which was skipped by the concurrency policy because
+// the previous run was still running
+const Overlapped = "OVERLAPPED"
+
+// ConcurrencyPolicy is the concurrency policy setting of a schedule job, which controls the runs overlapping the
+// previous runs. The runs of each action are guarded separately, and the runs of a workflow are guarded as a whole.
+type ConcurrencyPolicy struct {
+	JobName string
+	// Mode is one of ALLOW, FORBID, REPLACE and QUEUE
+	Mode string
+	// MaxQueueDepth is the maximum number of runs waiting for the running one, which applies to the QUEUE mode only
+	MaxQueueDepth int
+}
@@ -0,0 +1,55 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+const (
+	// TriggerSystemEvent triggers the schedule job by the system events published by core-metadata, e.g. device added
+	TriggerSystemEvent = "SYSTEM_EVENT"
+	// TriggerDataEvent triggers the schedule job by the events published by core-data or the device services
+	TriggerDataEvent = "DATA_EVENT"
+	// TriggerKeeperKey triggers the schedule job by the key changes published by core-keeper
+	TriggerKeeperKey = "KEEPER_KEY"
+)
+
+// EventTrigger is the event trigger setting of a schedule job, the actions of the job run when an event matching the
+// trigger arrives in addition to the runs scheduled by the definition of the job
+type EventTrigger struct {
+	JobName string
+	Type    string
+	// SystemEventTypes filters the system events by type, e.g. device or deviceprofile, any type matches if it's empty
+	SystemEventTypes []string
+	// SystemEventActions filters the system events by action, e.g. add or delete, any action matches if it's empty
+	SystemEventActions []string
+	// Names filters the system events by the name of the object in the event details, any name matches if it's empty
+	Names []string
+	// DeviceNames filters the data events by device name, any device matches if it's empty
+	DeviceNames []string
+	// ResourceNames filters the data events by the resource name of the readings, any resource matches if it's empty
+	ResourceNames []string
+	// KeyPrefix filters the keeper key changes by the key prefix, any key matches if it's empty
+	KeyPrefix string
+	// Debounce is the duration string to wait for the burst of the matching events to settle, the actions run once
+	// after no matching event arrives within the duration. The actions run on each matching event if it's empty.
+	Debounce string
+	// Cooldown is the duration string after a triggered run within which the matching events are ignored
+	Cooldown string
+	// EventOnly disables the runs scheduled by the definition of the job, so that the job only runs on the events
+	EventOnly bool
+}
+
+// EventTriggerEvent is an event received from the message bus, which is matched against the event triggers
+type EventTriggerEvent struct {
+	Type string
+	// SystemEventType, SystemEventAction and Name are set for the system events
+	SystemEventType   string
+	SystemEventAction string
+	Name              string
+	// DeviceName and ResourceNames are set for the data events
+	DeviceName    string
+	ResourceNames []string
+	// Key is set for the keeper key changes
+	Key string
+}
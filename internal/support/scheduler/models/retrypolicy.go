@@ -0,0 +1,43 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v4/models"
+)
+
+// RetryPolicy is the retry policy setting of a schedule job, which holds the retry policies and the execution timeouts
+// of the actions of the job
+type RetryPolicy struct {
+	JobName string
+	Actions []ActionRetryPolicy
+}
+
+// ActionRetryPolicy is the retry policy and the execution timeout of an action of the schedule job
+type ActionRetryPolicy struct {
+	// Action is the index of the action of the schedule job, each action can have one policy only
+	Action int
+	// MaxAttempts is the maximum number of attempts to run the action, the action runs once if it's zero
+	MaxAttempts int
+	// InitialBackoff is the duration string to wait before the first retry
+	InitialBackoff string
+	// MaxBackoff is the duration string which caps the backoff, the backoff is not capped if it's empty
+	MaxBackoff string
+	// BackoffMultiplier multiplies the backoff after each retry, the backoff is constant if it's zero
+	BackoffMultiplier float64
+	// RetryableErrorKinds lists the error kinds to retry, e.g. ServerError or ServiceUnavailable. All error kinds but
+	// ContractInvalid are retried if it's empty.
+	RetryableErrorKinds []string
+	// Timeout is the duration string after which an attempt fails, the attempt never times out if it's empty
+	Timeout string
+}
+
+// ScheduleActionAttempt is a schedule action record of an action with a retry policy, with the number of the attempt
+type ScheduleActionAttempt struct {
+	Record      models.ScheduleActionRecord
+	Attempt     int
+	MaxAttempts int
+}
@@ -0,0 +1,76 @@
+//
+// Copyright (C) 2026 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+const (
+	// ConditionSucceeded runs the step if the dependency succeeded
+	ConditionSucceeded = "SUCCEEDED"
+	// ConditionFailed runs the step if the dependency failed, which is used for the failure branches
+	ConditionFailed = "FAILED"
+	// ConditionCompleted runs the step if the dependency either succeeded or failed
+	ConditionCompleted = "COMPLETED"
+)
+
+const (
+	// Skipped is the status of the schedule action record of a workflow step which didn't run because the conditions
+	// of its dependencies were not met
+	Skipped = "SKIPPED"
+	// Pending is the status of a workflow step in the run history which has no schedule action record yet
+	Pending = "PENDING"
+	// Running is the status of a workflow run in the run history which has pending steps
+	Running = "RUNNING"
+)
+
+// Workflow is the workflow setting of a schedule job, the runs of the job execute the actions of the job as the steps of
+// a directed acyclic graph instead of running all the actions independently
+type Workflow struct {
+	JobName string
+	Steps   []WorkflowStep
+}
+
+// WorkflowStep is a node of the workflow which runs an action of the schedule job
+type WorkflowStep struct {
+	// Name identifies the step in the workflow
+	Name string
+	// Action is the index of the action of the schedule job run by the step, each action can be run by one step only
+	Action int
+	// DependsOn lists the dependencies of the step, the step starts after all the dependencies complete and runs only
+	// if the conditions of all the dependencies are met. The step without dependencies starts when the workflow starts.
+	DependsOn []WorkflowDependency
+	// MaxAttempts is the maximum number of attempts to run the action, which overrides the retry policy of the action
+	// if it's not zero
+	MaxAttempts int
+	// RetryInterval is the constant duration string to wait between the attempts, which overrides the backoff of the
+	// retry policy of the action if it's not empty
+	RetryInterval string
+	// Timeout is the duration string after which an attempt fails, which overrides the timeout of the retry policy of
+	// the action if it's not empty
+	Timeout string
+}
+
+// WorkflowDependency is an edge of the workflow from the step it depends on
+type WorkflowDependency struct {
+	Step string
+	// Condition is the status of the step it depends on to run the dependent step, SUCCEEDED is used if it's empty
+	Condition string
+}
+
+// WorkflowRun is a run of the workflow in the run history, the status of each step comes from its schedule action
+// record of the run
+type WorkflowRun struct {
+	JobName     string
+	ScheduledAt int64
+	Status      string
+	Nodes       []WorkflowRunNode
+}
+
+// WorkflowRunNode is the status of a workflow step in a run
+type WorkflowRunNode struct {
+	Step     string
+	ActionId string
+	Status   string
+	Created  int64
+}
@@ -1,5 +1,5 @@
 //
-// Copyright (C) 2024-2025 IOTech Ltd
+// Copyright (C) 2024-2026 IOTech Ltd
 //
 // SPDX-License-Identifier: Apache-2.0
 
@@ -14,6 +14,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v4/common"
 
 	"github.com/edgexfoundry/edgex-go"
+	"github.com/edgexfoundry/edgex-go/internal/support/scheduler/constants"
 	schedulerController "github.com/edgexfoundry/edgex-go/internal/support/scheduler/controller/http"
 )
 
@@ -32,6 +33,32 @@ func LoadRestRoutes(r *echo.Echo, dic *di.Container, serviceName string) {
 	r.GET(common.ApiScheduleJobByNameRoute, jc.ScheduleJobByName, authenticationHook)
 	r.DELETE(common.ApiScheduleJobByNameRoute, jc.DeleteScheduleJobByName, authenticationHook)
 
+	// EventTrigger
+	tc := schedulerController.NewEventTriggerController(dic)
+	r.PUT(constants.ApiScheduleJobEventTriggerRoute, tc.UpsertEventTrigger, authenticationHook)
+	r.GET(constants.ApiScheduleJobEventTriggerRoute, tc.EventTriggerByJobName, authenticationHook)
+	r.DELETE(constants.ApiScheduleJobEventTriggerRoute, tc.DeleteEventTriggerByJobName, authenticationHook)
+
+	// Workflow
+	wc := schedulerController.NewWorkflowController(dic)
+	r.PUT(constants.ApiScheduleJobWorkflowRoute, wc.UpsertWorkflow, authenticationHook)
+	r.GET(constants.ApiScheduleJobWorkflowRoute, wc.WorkflowByJobName, authenticationHook)
+	r.DELETE(constants.ApiScheduleJobWorkflowRoute, wc.DeleteWorkflowByJobName, authenticationHook)
+	r.GET(constants.ApiScheduleJobWorkflowRunRoute, wc.WorkflowRuns, authenticationHook)
+
+	// RetryPolicy
+	pc := schedulerController.NewRetryPolicyController(dic)
+	r.PUT(constants.ApiScheduleJobRetryPolicyRoute, pc.UpsertRetryPolicy, authenticationHook)
+	r.GET(constants.ApiScheduleJobRetryPolicyRoute, pc.RetryPolicyByJobName, authenticationHook)
+	r.DELETE(constants.ApiScheduleJobRetryPolicyRoute, pc.DeleteRetryPolicyByJobName, authenticationHook)
+	r.GET(constants.ApiScheduleJobAttemptRoute, pc.ScheduleActionAttemptsByJobName, authenticationHook)
+
+	// ConcurrencyPolicy
+	cc := schedulerController.NewConcurrencyPolicyController(dic)
+	r.PUT(constants.ApiScheduleJobConcurrencyPolicyRoute, cc.UpsertConcurrencyPolicy, authenticationHook)
+	r.GET(constants.ApiScheduleJobConcurrencyPolicyRoute, cc.ConcurrencyPolicyByJobName, authenticationHook)
+	r.DELETE(constants.ApiScheduleJobConcurrencyPolicyRoute, cc.DeleteConcurrencyPolicyByJobName, authenticationHook)
+
 	// ScheduleActionRecord
 	rc := schedulerController.NewScheduleActionRecordController(dic)
 	r.GET(common.ApiAllScheduleActionRecordRoute, rc.AllScheduleActionRecords, authenticationHook)